@@ -34,23 +34,94 @@ CREATE TABLE IF NOT EXISTS notes (
     header          TEXT
                     CONSTRAINT header_length CHECK (char_length(header) <= 255),
     is_public       BOOLEAN     NOT NULL
-                    DEFAULT false
+                    DEFAULT false,
+    is_template     BOOLEAN     NOT NULL
+                    DEFAULT false,
+    last_editor     UUID,
+    deleted_at      TIMESTAMP,
+    version         BIGINT      NOT NULL
+                    DEFAULT 1
 );
 
+-- столбцы, добавленные после создания таблицы, в уже развернутой базе
+ALTER TABLE notes ADD COLUMN IF NOT EXISTS last_editor UUID;
+ALTER TABLE notes ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+ALTER TABLE notes ADD COLUMN IF NOT EXISTS is_template BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE notes ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+
 CREATE TABLE IF NOT EXISTS favorites (
-    note_id UUID REFERENCES notes (id),
+    note_id UUID REFERENCES notes (id) ON DELETE CASCADE,
     user_id UUID REFERENCES users (id),
     PRIMARY KEY(note_id, user_id)
 );
 
+-- окончательное удаление заметки из корзины убирает ее из избранного
+ALTER TABLE favorites DROP CONSTRAINT IF EXISTS favorites_note_id_fkey;
+ALTER TABLE favorites ADD CONSTRAINT favorites_note_id_fkey FOREIGN KEY (note_id) REFERENCES notes (id) ON DELETE CASCADE;
+
+CREATE TABLE IF NOT EXISTS archived_notes (
+    note_id     UUID REFERENCES notes (id) ON DELETE CASCADE,
+    user_id     UUID REFERENCES users (id) ON DELETE CASCADE,
+    archived_at TIMESTAMP NOT NULL,
+    PRIMARY KEY(note_id, user_id)
+);
+
+CREATE TABLE IF NOT EXISTS note_positions (
+    note_id  UUID REFERENCES notes (id) ON DELETE CASCADE,
+    user_id  UUID REFERENCES users (id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    PRIMARY KEY(note_id, user_id)
+);
+
+CREATE TABLE IF NOT EXISTS note_links (
+    source_id UUID REFERENCES notes (id) ON DELETE CASCADE,
+    target_id UUID REFERENCES notes (id) ON DELETE CASCADE,
+    PRIMARY KEY(source_id, target_id)
+);
+
+CREATE INDEX IF NOT EXISTS note_links_target_id_idx ON note_links (target_id);
+
 CREATE TABLE IF NOT EXISTS all_tags (
     tag_name    TEXT
                 CONSTRAINT tag_name_length CHECK (char_length(tag_name) <= 255),
     user_id     UUID
                 REFERENCES users (id),
+    color       TEXT        NOT NULL
+                DEFAULT ''
+                CONSTRAINT tag_color_length CHECK (char_length(color) <= 7),
+    emoji       TEXT        NOT NULL
+                DEFAULT ''
+                CONSTRAINT tag_emoji_length CHECK (char_length(emoji) <= 16),
+    description TEXT        NOT NULL
+                DEFAULT ''
+                CONSTRAINT tag_description_length CHECK (char_length(description) <= 255),
     PRIMARY KEY (tag_name, user_id)
 );
 
+ALTER TABLE all_tags ADD COLUMN IF NOT EXISTS color TEXT NOT NULL DEFAULT ''
+    CONSTRAINT tag_color_length CHECK (char_length(color) <= 7);
+ALTER TABLE all_tags ADD COLUMN IF NOT EXISTS emoji TEXT NOT NULL DEFAULT ''
+    CONSTRAINT tag_emoji_length CHECK (char_length(emoji) <= 16);
+ALTER TABLE all_tags ADD COLUMN IF NOT EXISTS description TEXT NOT NULL DEFAULT ''
+    CONSTRAINT tag_description_length CHECK (char_length(description) <= 255);
+
+-- теги чужих заметок в уже развернутой базе попадают в списки один раз, дальше их добавляют операции записи
+INSERT INTO all_tags(tag_name, user_id)
+    SELECT DISTINCT t.tag_name, u.user_id
+    FROM notes n, unnest(n.tags) AS t(tag_name), unnest(array_append(n.collaborators, n.owner_id)) AS u(user_id)
+    WHERE n.deleted_at IS NULL
+ON CONFLICT (tag_name, user_id) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS trash (
+    note_id     UUID        PRIMARY KEY
+                REFERENCES notes (id) ON DELETE CASCADE,
+    user_id     UUID        NOT NULL
+                REFERENCES users (id),
+    deleted_at  TIMESTAMP   NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS trash_deleted_at_idx ON trash (deleted_at);
+
 CREATE TABLE IF NOT EXISTS attaches (
     id UUID PRIMARY KEY,
     path TEXT
@@ -60,59 +131,165 @@ CREATE TABLE IF NOT EXISTS attaches (
         NOT NULL
 );
 
+CREATE TABLE IF NOT EXISTS collaborators (
+    note_id     UUID    REFERENCES notes (id) ON DELETE CASCADE,
+    user_id     UUID    REFERENCES users (id),
+    role        TEXT    NOT NULL
+                DEFAULT 'editor'
+                CONSTRAINT role_value CHECK (role IN ('viewer', 'commenter', 'editor')),
+    PRIMARY KEY (note_id, user_id)
+);
+
+INSERT INTO collaborators(note_id, user_id, role)
+    SELECT id, unnest(collaborators), 'editor' FROM notes WHERE parent = '00000000-0000-0000-0000-000000000000'::UUID
+ON CONFLICT (note_id, user_id) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS invites (
+    id          UUID        PRIMARY KEY,
+    note_id     UUID        NOT NULL
+                REFERENCES notes (id) ON DELETE CASCADE,
+    inviter_id  UUID        NOT NULL
+                REFERENCES users (id),
+    invitee_id  UUID        NOT NULL
+                REFERENCES users (id),
+    role        TEXT        NOT NULL
+                CONSTRAINT invite_role_value CHECK (role IN ('viewer', 'commenter', 'editor')),
+    created     TIMESTAMP   NOT NULL,
+    expires_at  TIMESTAMP   NOT NULL,
+    UNIQUE (note_id, invitee_id)
+);
+
+CREATE INDEX IF NOT EXISTS invites_invitee_id_idx ON invites (invitee_id);
+CREATE INDEX IF NOT EXISTS invites_inviter_id_idx ON invites (inviter_id);
+
+CREATE TABLE IF NOT EXISTS ownership_transfers (
+    note_id     UUID        PRIMARY KEY
+                REFERENCES notes (id) ON DELETE CASCADE,
+    from_id     UUID        NOT NULL
+                REFERENCES users (id),
+    to_id       UUID        NOT NULL
+                REFERENCES users (id),
+    created     TIMESTAMP   NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS share_links (
+    id              UUID        PRIMARY KEY,
+    token           TEXT        NOT NULL
+                    UNIQUE
+                    CONSTRAINT token_length CHECK (char_length(token) <= 255),
+    note_id         UUID        NOT NULL
+                    REFERENCES notes (id) ON DELETE CASCADE,
+    creator_id      UUID        NOT NULL
+                    REFERENCES users (id),
+    password_hash   TEXT        NOT NULL
+                    DEFAULT ''
+                    CONSTRAINT share_password_hash_length CHECK (char_length(password_hash) <= 511),
+    include_subtree BOOLEAN     NOT NULL
+                    DEFAULT false,
+    created         TIMESTAMP   NOT NULL,
+    expires_at      TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS share_links_note_id_idx ON share_links (note_id);
+
+CREATE TABLE IF NOT EXISTS comments (
+    id          UUID        PRIMARY KEY,
+    note_id     UUID        NOT NULL
+                REFERENCES notes (id) ON DELETE CASCADE,
+    parent      UUID        NOT NULL
+                DEFAULT '00000000-0000-0000-0000-000000000000',
+    author_id   UUID        NOT NULL
+                REFERENCES users (id),
+    block_id    TEXT        NOT NULL
+                DEFAULT ''
+                CONSTRAINT comment_block_id_length CHECK (char_length(block_id) <= 255),
+    text        TEXT        NOT NULL
+                CONSTRAINT comment_text_length CHECK (char_length(text) <= 12000),
+    created     TIMESTAMP   NOT NULL,
+    updated     TIMESTAMP   NOT NULL,
+    resolved    BOOLEAN     NOT NULL
+                DEFAULT false,
+    deleted     BOOLEAN     NOT NULL
+                DEFAULT false
+);
+
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS deleted BOOLEAN NOT NULL DEFAULT false;
+
+CREATE INDEX IF NOT EXISTS comments_note_id_created_idx ON comments (note_id, created);
+
+CREATE TABLE IF NOT EXISTS reminders (
+    id          UUID        PRIMARY KEY,
+    note_id     UUID        NOT NULL
+                REFERENCES notes (id) ON DELETE CASCADE,
+    user_id     UUID        NOT NULL
+                REFERENCES users (id) ON DELETE CASCADE,
+    remind_at   TIMESTAMP   NOT NULL,
+    repeat      TEXT        NOT NULL
+                DEFAULT ''
+                CONSTRAINT reminder_repeat CHECK (repeat IN ('', 'daily', 'weekly', 'monthly', 'yearly')),
+    fired       BOOLEAN     NOT NULL
+                DEFAULT false,
+    created     TIMESTAMP   NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS reminders_fired_remind_at_idx ON reminders (fired, remind_at);
+CREATE INDEX IF NOT EXISTS reminders_user_id_remind_at_idx ON reminders (user_id, remind_at);
+
+CREATE TABLE IF NOT EXISTS system_templates (
+    id      UUID    PRIMARY KEY,
+    data    JSON    NOT NULL
+);
+
+INSERT INTO system_templates(id, data) VALUES
+    ('c5e3c4f0-4a4b-4c1f-9a55-6f2f4c0b7d01', '{"title":"YouNote❤️","content":[{"pluginName":"textBlock","content":"Привет, {{username}}!"},{"pluginName":"div","children":[{"pluginName":"br"}]}]}'),
+    ('1f0c7a52-3d1e-4b8e-8f61-2a9d4c7e5b02', '{"title":"Встреча {{date}}","content":[{"pluginName":"textBlock","content":"Участники:"},{"pluginName":"textBlock","content":"Повестка:"},{"pluginName":"textBlock","content":"Решения:"},{"pluginName":"div","children":[{"pluginName":"br"}]}]}'),
+    ('7b9e2d14-6a3f-4c5d-9e8b-1c4f7a2d6e03', '{"title":"Дневник {{date}}","content":[{"pluginName":"textBlock","content":"Что произошло сегодня?"},{"pluginName":"div","children":[{"pluginName":"br"}]}]}'),
+    ('e4a8c6f2-9b1d-4e7a-8c3f-5d2b9e6a1f04', '{"title":"Список дел","content":[{"pluginName":"textBlock","content":"Задачи на {{date}}:"},{"pluginName":"div","children":[{"pluginName":"br"}]}]}')
+ON CONFLICT (id) DO NOTHING;
+
 CREATE TABLE IF NOT EXISTS messages (
+    id              UUID        PRIMARY KEY
+                    DEFAULT gen_random_uuid(),
     note_id         UUID        NOT NULL,
     created         TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    author_id       UUID,
     message_info    JSON
 );
 
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS id UUID NOT NULL DEFAULT gen_random_uuid();
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS author_id UUID;
 
-CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conrelid = 'messages'::regclass AND contype = 'p') THEN
+        ALTER TABLE messages ADD PRIMARY KEY (id);
+    END IF;
+END
+$$;
 
-CREATE OR REPLACE FUNCTION delete_children_notes()
-    RETURNS trigger
-    LANGUAGE 'plpgsql'
-    AS $BODY$
-    BEGIN
-        DELETE FROM notes WHERE id = ANY(SELECT UNNEST(OLD.children));
-        RETURN OLD;
-    END;
-$BODY$;
+CREATE INDEX IF NOT EXISTS messages_note_id_created_idx ON messages (note_id, created);
 
-CREATE OR REPLACE TRIGGER trigger_delete_children_notes
-    BEFORE DELETE
-    ON notes
-    FOR EACH ROW
-    EXECUTE FUNCTION delete_children_notes();
+
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+
+DROP TRIGGER IF EXISTS trigger_delete_children_notes ON notes;
+DROP FUNCTION IF EXISTS delete_children_notes();
 
 CREATE OR REPLACE FUNCTION insert_message()
     RETURNS trigger
     LANGUAGE 'plpgsql'
 AS $BODY$
 BEGIN
-    INSERT INTO messages(note_id, created, message_info) VALUES (NEW.id, CURRENT_TIMESTAMP, NEW.data);
+    INSERT INTO messages(note_id, created, author_id, message_info) VALUES (NEW.id, CURRENT_TIMESTAMP, NEW.last_editor, NEW.data);
     RETURN NEW;
 END;
 $BODY$;
 
 CREATE OR REPLACE TRIGGER trigger_insert_message
-    AFTER UPDATE
+    AFTER UPDATE OF data
     ON notes
     FOR EACH ROW
 EXECUTE FUNCTION insert_message();
 
-CREATE OR REPLACE FUNCTION update_tags()
-    RETURNS trigger
-    LANGUAGE 'plpgsql'
-    AS $BODY$
-    BEGIN
-        UPDATE notes SET tags = array_replace(tags, OLD.tag_name, NEW.tag_name) WHERE owner_id=OLD.user_id;
-        RETURN OLD;
-    END;
-$BODY$;
-
-CREATE OR REPLACE TRIGGER trigger_update_tags
-    AFTER UPDATE
-    ON all_tags
-    FOR EACH ROW
-    EXECUTE FUNCTION  update_tags();
+DROP TRIGGER IF EXISTS trigger_update_tags ON all_tags;
+DROP FUNCTION IF EXISTS update_tags();
@@ -114,7 +114,6 @@ func main() {
 	}
 
 	NoteBaseRepo := noteRepo.CreateNotePostgres(db, &postgresMetrics)
-	NoteHub := hub.NewHub(NoteBaseRepo, cfg.Hub, websocketMetrics)
 
 	AttachRepo := attachRepo.CreateAttachRepo(db, &postgresMetrics)
 	AttachUsecase := attachUsecase.CreateAttachUsecase(AttachRepo, NoteBaseRepo)
@@ -123,6 +122,8 @@ func main() {
 	AuthClient := grpcAuth.NewAuthClient(authConn)
 	NoteClient := grpcNote.NewNoteClient(noteConn)
 
+	NoteHub := hub.NewHub(NoteBaseRepo, NoteClient, cfg.Hub, websocketMetrics)
+
 	AuthDelivery := authDelivery.CreateAuthHandler(AuthClient, NoteClient, cfg.AuthHandler, cfg.Validation)
 	NoteDelivery := noteDelivery.CreateNotesHandler(NoteClient, AuthClient, NoteHub)
 
@@ -166,13 +167,24 @@ func main() {
 	note.Use(protection.ReadAndCloseBody, CsrfMiddleware)
 	{
 		note.Handle("/get_all", JwtMiddleware(http.HandlerFunc(NoteDelivery.GetAllNotes))).Methods(http.MethodGet, http.MethodOptions)
+		note.Handle("/graph", JwtMiddleware(http.HandlerFunc(NoteDelivery.GetNoteGraph))).Methods(http.MethodGet, http.MethodOptions)
 		note.Handle("/{id}", JwtMiddleware(http.HandlerFunc(NoteDelivery.GetNote))).Methods(http.MethodGet, http.MethodOptions)
 		note.Handle("/add", JwtMiddleware(http.HandlerFunc(NoteDelivery.AddNote))).Methods(http.MethodPost, http.MethodOptions)
+		note.Handle("/reorder", JwtMiddleware(http.HandlerFunc(NoteDelivery.ReorderNotes))).Methods(http.MethodPut, http.MethodOptions)
+		note.Handle("/bulk", JwtMiddleware(http.HandlerFunc(NoteDelivery.BulkUpdate))).Methods(http.MethodPost, http.MethodOptions)
 		note.Handle("/{id}/edit", JwtMiddleware(http.HandlerFunc(NoteDelivery.UpdateNote))).Methods(http.MethodPost, http.MethodOptions)
 		note.Handle("/{id}/delete", JwtMiddleware(http.HandlerFunc(NoteDelivery.DeleteNote))).Methods(http.MethodDelete, http.MethodOptions)
 		note.Handle("/{id}/add_attach", JwtMiddleware(http.HandlerFunc(AttachDelivery.AddAttach))).Methods(http.MethodPost, http.MethodOptions)
 		note.Handle("/{id}/add_subnote", JwtMiddleware(http.HandlerFunc(NoteDelivery.CreateSubNote))).Methods(http.MethodPost, http.MethodOptions)
+		note.Handle("/{id}/move", JwtMiddleware(http.HandlerFunc(NoteDelivery.MoveNote))).Methods(http.MethodPost, http.MethodOptions)
+		note.Handle("/{id}/duplicate", JwtMiddleware(http.HandlerFunc(NoteDelivery.DuplicateNote))).Methods(http.MethodPost, http.MethodOptions)
+		note.Handle("/{id}/backlinks", JwtMiddleware(http.HandlerFunc(NoteDelivery.GetBacklinks))).Methods(http.MethodGet, http.MethodOptions)
 		note.Handle("/{id}/add_collaborator", JwtMiddleware(http.HandlerFunc(NoteDelivery.AddCollaborator))).Methods(http.MethodPost, http.MethodOptions)
+		note.Handle("/{id}/remove_collaborator", JwtMiddleware(http.HandlerFunc(NoteDelivery.RemoveCollaborator))).Methods(http.MethodDelete, http.MethodOptions)
+		note.Handle("/{id}/leave", JwtMiddleware(http.HandlerFunc(NoteDelivery.LeaveNote))).Methods(http.MethodPost, http.MethodOptions)
+		note.Handle("/{id}/transfer", JwtMiddleware(http.HandlerFunc(NoteDelivery.ProposeTransfer))).Methods(http.MethodPost, http.MethodOptions)
+		note.Handle("/{id}/accept_transfer", JwtMiddleware(http.HandlerFunc(NoteDelivery.AcceptTransfer))).Methods(http.MethodPost, http.MethodOptions)
+		note.Handle("/{id}/set_role", JwtMiddleware(http.HandlerFunc(NoteDelivery.SetCollaboratorRole))).Methods(http.MethodPut, http.MethodOptions)
 		note.Handle("/{id}/subscribe_on_updates", JwtWebsocketMiddleware(http.HandlerFunc(NoteDelivery.SubscribeOnUpdates))).Methods(http.MethodGet, http.MethodOptions)
 		note.Handle("/{id}/add_tag", JwtMiddleware(http.HandlerFunc(NoteDelivery.AddTag))).Methods(http.MethodPost, http.MethodOptions)
 		note.Handle("/{id}/delete_tag", JwtMiddleware(http.HandlerFunc(NoteDelivery.DeleteTag))).Methods(http.MethodDelete, http.MethodOptions)
@@ -180,16 +192,35 @@ func main() {
 		note.Handle("/{id}/set_header", JwtMiddleware(http.HandlerFunc(NoteDelivery.SetHeader))).Methods(http.MethodPost, http.MethodOptions)
 		note.Handle("/{id}/add_fav", JwtMiddleware(http.HandlerFunc(NoteDelivery.AddFavorite))).Methods(http.MethodPut, http.MethodOptions)
 		note.Handle("/{id}/del_fav", JwtMiddleware(http.HandlerFunc(NoteDelivery.DeleteFavorite))).Methods(http.MethodPut, http.MethodOptions)
+		note.Handle("/{id}/archive", JwtMiddleware(http.HandlerFunc(NoteDelivery.ArchiveNote))).Methods(http.MethodPut, http.MethodOptions)
+		note.Handle("/{id}/unarchive", JwtMiddleware(http.HandlerFunc(NoteDelivery.UnarchiveNote))).Methods(http.MethodPut, http.MethodOptions)
 		note.Handle("/{id}/set_public", JwtMiddleware(http.HandlerFunc(NoteDelivery.SetPublic))).Methods(http.MethodPut, http.MethodOptions)
 		note.Handle("/{id}/set_private", JwtMiddleware(http.HandlerFunc(NoteDelivery.SetPrivate))).Methods(http.MethodPut, http.MethodOptions)
+		note.Handle("/{id}/set_template", JwtMiddleware(http.HandlerFunc(NoteDelivery.SetTemplate))).Methods(http.MethodPut, http.MethodOptions)
+		note.Handle("/{id}/unset_template", JwtMiddleware(http.HandlerFunc(NoteDelivery.UnsetTemplate))).Methods(http.MethodPut, http.MethodOptions)
 		note.Handle("/{id}/make_zip", JwtMiddleware(http.HandlerFunc(NoteDelivery.ExportZip))).Methods(http.MethodPost, http.MethodOptions)
+		note.Handle("/{id}/share_links", JwtMiddleware(http.HandlerFunc(NoteDelivery.GetShareLinks))).Methods(http.MethodGet, http.MethodOptions)
+		note.Handle("/{id}/share_links", JwtMiddleware(http.HandlerFunc(NoteDelivery.CreateShareLink))).Methods(http.MethodPost, http.MethodOptions)
+		note.Handle("/{id}/share_links/{link_id}", JwtMiddleware(http.HandlerFunc(NoteDelivery.RevokeShareLink))).Methods(http.MethodDelete, http.MethodOptions)
+		note.Handle("/{id}/comments", JwtMiddleware(http.HandlerFunc(NoteDelivery.GetComments))).Methods(http.MethodGet, http.MethodOptions)
+		note.Handle("/{id}/comments", JwtMiddleware(http.HandlerFunc(NoteDelivery.AddComment))).Methods(http.MethodPost, http.MethodOptions)
+		note.Handle("/{id}/comments/{comment_id}", JwtMiddleware(http.HandlerFunc(NoteDelivery.UpdateComment))).Methods(http.MethodPut, http.MethodOptions)
+		note.Handle("/{id}/comments/{comment_id}", JwtMiddleware(http.HandlerFunc(NoteDelivery.DeleteComment))).Methods(http.MethodDelete, http.MethodOptions)
+		note.Handle("/{id}/comments/{comment_id}/resolve", JwtMiddleware(http.HandlerFunc(NoteDelivery.ResolveComment))).Methods(http.MethodPut, http.MethodOptions)
+		note.Handle("/{id}/comments/{comment_id}/unresolve", JwtMiddleware(http.HandlerFunc(NoteDelivery.UnresolveComment))).Methods(http.MethodPut, http.MethodOptions)
+		note.Handle("/{id}/reminders", JwtMiddleware(http.HandlerFunc(NoteDelivery.GetReminders))).Methods(http.MethodGet, http.MethodOptions)
+		note.Handle("/{id}/reminders", JwtMiddleware(http.HandlerFunc(NoteDelivery.AddReminder))).Methods(http.MethodPost, http.MethodOptions)
+		note.Handle("/{id}/revisions", JwtMiddleware(http.HandlerFunc(NoteDelivery.GetRevisions))).Methods(http.MethodGet, http.MethodOptions)
+		note.Handle("/{id}/revisions/diff", JwtMiddleware(http.HandlerFunc(NoteDelivery.GetRevisionDiff))).Methods(http.MethodGet, http.MethodOptions)
+		note.Handle("/{id}/revisions/{revision_id}", JwtMiddleware(http.HandlerFunc(NoteDelivery.GetRevision))).Methods(http.MethodGet, http.MethodOptions)
+		note.Handle("/{id}/revisions/{revision_id}/restore", JwtMiddleware(http.HandlerFunc(NoteDelivery.RestoreRevision))).Methods(http.MethodPost, http.MethodOptions)
 		note.Handle("/subscribe/on_invites", JwtWebsocketMiddleware(http.HandlerFunc(NoteDelivery.SubscribeOnInvites))).Methods(http.MethodGet, http.MethodOptions)
 	}
 
 	shared := r.PathPrefix("/shared").Subrouter()
 	{
 		shared.Handle("/note/{id}", http.HandlerFunc(NoteDelivery.GetPublicNote)).Methods(http.MethodGet, http.MethodOptions)
-		shared.Handle("/note/{id}/make_zip", http.HandlerFunc(NoteDelivery.ExportZip)).Methods(http.MethodPost, http.MethodOptions)
+		shared.Handle("/note/{id}/make_zip", http.HandlerFunc(NoteDelivery.ExportZipShared)).Methods(http.MethodPost, http.MethodOptions)
 		shared.Handle("/attach/{id}", http.HandlerFunc(AttachDelivery.GetSharedAttach)).Methods(http.MethodGet, http.MethodOptions)
 	}
 
@@ -215,6 +246,41 @@ func main() {
 		tags.Handle("/remember", http.HandlerFunc(NoteDelivery.RememberTag)).Methods(http.MethodPost, http.MethodOptions)
 		tags.Handle("/forget", http.HandlerFunc(NoteDelivery.ForgetTag)).Methods(http.MethodDelete, http.MethodOptions)
 		tags.Handle("/update", http.HandlerFunc(NoteDelivery.UpdateTag)).Methods(http.MethodPost, http.MethodOptions)
+		tags.Handle("/merge", http.HandlerFunc(NoteDelivery.MergeTags)).Methods(http.MethodPost, http.MethodOptions)
+		tags.Handle("/info", http.HandlerFunc(NoteDelivery.SetTagInfo)).Methods(http.MethodPost, http.MethodOptions)
+	}
+
+	trash := r.PathPrefix("/trash").Subrouter()
+	trash.Use(JwtMiddleware, CsrfMiddleware)
+	{
+		trash.Handle("", http.HandlerFunc(NoteDelivery.GetTrash)).Methods(http.MethodGet, http.MethodOptions)
+		trash.Handle("/{id}/restore", http.HandlerFunc(NoteDelivery.RestoreNote)).Methods(http.MethodPost, http.MethodOptions)
+		trash.Handle("/{id}/delete", http.HandlerFunc(NoteDelivery.DeleteNotePermanently)).Methods(http.MethodDelete, http.MethodOptions)
+	}
+
+	invites := r.PathPrefix("/invites").Subrouter()
+	invites.Use(JwtMiddleware, CsrfMiddleware)
+	{
+		invites.Handle("/sent", http.HandlerFunc(NoteDelivery.GetSentInvites)).Methods(http.MethodGet, http.MethodOptions)
+		invites.Handle("/received", http.HandlerFunc(NoteDelivery.GetReceivedInvites)).Methods(http.MethodGet, http.MethodOptions)
+		invites.Handle("/{id}/accept", http.HandlerFunc(NoteDelivery.AcceptInvite)).Methods(http.MethodPost, http.MethodOptions)
+		invites.Handle("/{id}/decline", http.HandlerFunc(NoteDelivery.DeclineInvite)).Methods(http.MethodPost, http.MethodOptions)
+		invites.Handle("/{id}/revoke", http.HandlerFunc(NoteDelivery.RevokeInvite)).Methods(http.MethodDelete, http.MethodOptions)
+	}
+
+	reminders := r.PathPrefix("/reminders").Subrouter()
+	reminders.Use(JwtMiddleware, CsrfMiddleware)
+	{
+		reminders.Handle("", http.HandlerFunc(NoteDelivery.GetUpcomingReminders)).Methods(http.MethodGet, http.MethodOptions)
+		reminders.Handle("/{id}/ack", http.HandlerFunc(NoteDelivery.AcknowledgeReminder)).Methods(http.MethodPost, http.MethodOptions)
+		reminders.Handle("/{id}", http.HandlerFunc(NoteDelivery.DeleteReminder)).Methods(http.MethodDelete, http.MethodOptions)
+	}
+
+	templates := r.PathPrefix("/templates").Subrouter()
+	templates.Use(JwtMiddleware, CsrfMiddleware)
+	{
+		templates.Handle("", http.HandlerFunc(NoteDelivery.GetTemplates)).Methods(http.MethodGet, http.MethodOptions)
+		templates.Handle("/{id}/create", http.HandlerFunc(NoteDelivery.CreateFromTemplate)).Methods(http.MethodPost, http.MethodOptions)
 	}
 
 	export := r.PathPrefix("/export_to_pdf").Subrouter()
@@ -230,6 +296,8 @@ func main() {
 	go NoteHub.Run(context.WithValue(context.Background(), config.LoggerContextKey, logger))
 	go NoteHub.StartCache(context.WithValue(context.Background(), config.LoggerContextKey, logger))
 	go NoteHub.StartCacheMain(context.WithValue(context.Background(), config.LoggerContextKey, logger))
+	go NoteHub.RunReminders(context.WithValue(context.Background(), config.LoggerContextKey, logger))
+	go NoteHub.RunPersist(context.WithValue(context.Background(), config.LoggerContextKey, logger))
 
 	signalCh := make(chan os.Signal, 1)
 	signal.Notify(signalCh, syscall.SIGINT, syscall.SIGTERM)
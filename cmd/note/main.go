@@ -96,6 +96,8 @@ func run() (err error) {
 	NoteUsecase := noteUsecase.CreateNoteUsecase(NoteBaseRepo, NoteSearchRepo, cfg.Elastic, cfg.Constraints, &sync.WaitGroup{})
 	NoteDelivery := grpcNote.NewGrpcNoteHandler(NoteUsecase)
 
+	go NoteUsecase.RunTrashPurger(context.WithValue(context.Background(), config.LoggerContextKey, logger), cfg.Trash)
+
 	MetricsMiddleware := metricsmw.NewGrpcMw(grpcMetrics)
 	LogMiddleware := log.NewGrpcLogMw(logger)
 
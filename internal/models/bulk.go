@@ -0,0 +1,115 @@
+package models
+
+import (
+	"errors"
+	"html"
+
+	"github.com/satori/uuid"
+)
+
+const (
+	BulkAddTag     = "add_tag"
+	BulkDeleteTag  = "delete_tag"
+	BulkAddFav     = "add_fav"
+	BulkDelFav     = "del_fav"
+	BulkSetPublic  = "set_public"
+	BulkSetPrivate = "set_private"
+	BulkMove       = "move"
+	BulkDelete     = "delete"
+)
+
+const (
+	maxBulkNotes      = 100
+	maxBulkOperations = 10
+)
+
+// BulkOperation - одна операция пакета: Value - имя тега для операций с тегами, id нового родителя для move
+type BulkOperation struct {
+	Type  string `json:"type"`
+	Value string `json:"value,omitempty"`
+}
+
+type BulkRequest struct {
+	Notes      []uuid.UUID     `json:"notes"`
+	Operations []BulkOperation `json:"operations"`
+}
+
+func (payload *BulkRequest) Sanitize() {
+	for i := range payload.Operations {
+		payload.Operations[i].Value = html.EscapeString(payload.Operations[i].Value)
+	}
+}
+
+func (payload *BulkRequest) Validate() error {
+	if len(payload.Notes) == 0 {
+		return errors.New("notes list is empty")
+	}
+	if len(payload.Notes) > maxBulkNotes {
+		return errors.New("too many notes")
+	}
+
+	seen := make(map[uuid.UUID]struct{}, len(payload.Notes))
+	for _, id := range payload.Notes {
+		if _, ok := seen[id]; ok {
+			return errors.New("duplicate note id")
+		}
+		seen[id] = struct{}{}
+	}
+
+	if len(payload.Operations) == 0 {
+		return errors.New("operations list is empty")
+	}
+	if len(payload.Operations) > maxBulkOperations {
+		return errors.New("too many operations")
+	}
+
+	for i, operation := range payload.Operations {
+		if err := operation.Validate(); err != nil {
+			return err
+		}
+		// после удаления заметка уже в корзине, остальные операции к ней не применить
+		if operation.Type == BulkDelete && i != len(payload.Operations)-1 {
+			return errors.New("delete must be the last operation")
+		}
+	}
+	return nil
+}
+
+func (operation *BulkOperation) Validate() error {
+	switch operation.Type {
+	case BulkAddTag, BulkDeleteTag:
+		if operation.Value == "" {
+			return errors.New("tag name is empty")
+		}
+		return validateTagPath(operation.Value)
+	case BulkMove:
+		if operation.Value == "" {
+			return nil
+		}
+		if _, err := uuid.FromString(operation.Value); err != nil {
+			return errors.New("parent id must be a type of uuid")
+		}
+		return nil
+	case BulkAddFav, BulkDelFav, BulkSetPublic, BulkSetPrivate, BulkDelete:
+		return nil
+	default:
+		return errors.New("unknown operation: " + operation.Type)
+	}
+}
+
+// ParentID - новый родитель для move, пустое значение переносит заметку в корень
+func (operation *BulkOperation) ParentID() uuid.UUID {
+	parentID, _ := uuid.FromString(operation.Value)
+	return parentID
+}
+
+// BulkResult - итог пакета для одной заметки: Error - ошибка первой неудавшейся операции
+type BulkResult struct {
+	NoteID  uuid.UUID `json:"note_id"`
+	Success bool      `json:"success"`
+	Error   string    `json:"error,omitempty"`
+}
+
+type BulkResponse struct {
+	Results []BulkResult `json:"results"`
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulkRequest_Validate(t *testing.T) {
+	first := uuid.NewV4()
+	second := uuid.NewV4()
+
+	tests := []struct {
+		name    string
+		payload BulkRequest
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			payload: BulkRequest{
+				Notes:      []uuid.UUID{first, second},
+				Operations: []BulkOperation{{Type: BulkAddTag, Value: "work/todo"}, {Type: BulkMove, Value: first.String()}, {Type: BulkDelete}},
+			},
+		},
+		{name: "move to root", payload: BulkRequest{Notes: []uuid.UUID{first}, Operations: []BulkOperation{{Type: BulkMove}}}},
+		{name: "no notes", payload: BulkRequest{Operations: []BulkOperation{{Type: BulkAddFav}}}, wantErr: true},
+		{name: "duplicate note", payload: BulkRequest{Notes: []uuid.UUID{first, first}, Operations: []BulkOperation{{Type: BulkAddFav}}}, wantErr: true},
+		{name: "too many notes", payload: BulkRequest{Notes: make([]uuid.UUID, maxBulkNotes+1), Operations: []BulkOperation{{Type: BulkAddFav}}}, wantErr: true},
+		{name: "no operations", payload: BulkRequest{Notes: []uuid.UUID{first}}, wantErr: true},
+		{name: "unknown operation", payload: BulkRequest{Notes: []uuid.UUID{first}, Operations: []BulkOperation{{Type: "rename"}}}, wantErr: true},
+		{name: "empty tag", payload: BulkRequest{Notes: []uuid.UUID{first}, Operations: []BulkOperation{{Type: BulkAddTag}}}, wantErr: true},
+		{name: "bad parent", payload: BulkRequest{Notes: []uuid.UUID{first}, Operations: []BulkOperation{{Type: BulkMove, Value: "parent"}}}, wantErr: true},
+		{name: "delete not last", payload: BulkRequest{Notes: []uuid.UUID{first}, Operations: []BulkOperation{{Type: BulkDelete}, {Type: BulkAddFav}}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantErr, tt.payload.Validate() != nil)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package models
+
+import (
+	"errors"
+)
+
+const (
+	RoleViewer    = "viewer"
+	RoleCommenter = "commenter"
+	RoleEditor    = "editor"
+	RoleOwner     = "owner"
+)
+
+// чем больше уровень, тем больше прав у роли
+var roleLevels = map[string]int{
+	RoleViewer:    1,
+	RoleCommenter: 2,
+	RoleEditor:    3,
+	RoleOwner:     4,
+}
+
+func HasRole(role string, required string) bool {
+	level, ok := roleLevels[role]
+	if !ok {
+		return false
+	}
+	return level >= roleLevels[required]
+}
+
+func CheckCollaboratorRole(role string) error {
+	if role == RoleOwner {
+		return errors.New("owner role can`t be given to collaborator")
+	}
+	if _, ok := roleLevels[role]; !ok {
+		return errors.New("unknown role")
+	}
+	return nil
+}
+
+type AddCollaboratorRequest struct {
+	Username string `json:"username"`
+	Role     string `json:"role,omitempty"`
+}
+
+func (payload *AddCollaboratorRequest) Validate() error {
+	if payload.Role == "" {
+		payload.Role = RoleEditor
+	}
+	return CheckCollaboratorRole(payload.Role)
+}
+
+type SetRoleRequest struct {
+	Username string `json:"username"`
+	Role     string `json:"role"`
+}
+
+func (payload *SetRoleRequest) Validate() error {
+	return CheckCollaboratorRole(payload.Role)
+}
+
+type RemoveCollaboratorRequest struct {
+	Username string `json:"username"`
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHasRole(t *testing.T) {
+	tests := []struct {
+		role     string
+		required string
+		expected bool
+	}{
+		{role: RoleOwner, required: RoleEditor, expected: true},
+		{role: RoleEditor, required: RoleEditor, expected: true},
+		{role: RoleCommenter, required: RoleEditor, expected: false},
+		{role: RoleViewer, required: RoleCommenter, expected: false},
+		{role: RoleViewer, required: RoleViewer, expected: true},
+		{role: "", required: RoleViewer, expected: false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, HasRole(tt.role, tt.required))
+	}
+}
+
+func TestAddCollaboratorRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		payload  AddCollaboratorRequest
+		wantRole string
+		wantErr  bool
+	}{
+		{name: "default role", payload: AddCollaboratorRequest{Username: "user"}, wantRole: RoleEditor},
+		{name: "viewer", payload: AddCollaboratorRequest{Username: "user", Role: RoleViewer}, wantRole: RoleViewer},
+		{name: "owner", payload: AddCollaboratorRequest{Username: "user", Role: RoleOwner}, wantErr: true},
+		{name: "unknown", payload: AddCollaboratorRequest{Username: "user", Role: "admin"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.payload.Validate()
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.wantRole, tt.payload.Role)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package models
+
+import (
+	"errors"
+	"html"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/satori/uuid"
+)
+
+const (
+	maxCommentLength = 2000
+	maxBlockIdLength = 255
+)
+
+type Comment struct {
+	Id       uuid.UUID `json:"id"`
+	NoteId   uuid.UUID `json:"note_id"`
+	Parent   uuid.UUID `json:"parent"`
+	AuthorId uuid.UUID `json:"author_id"`
+	Author   string    `json:"author"`
+	BlockId  string    `json:"block_id"`
+	Text     string    `json:"text"`
+	Created  time.Time `json:"created"`
+	Updated  time.Time `json:"updated"`
+	Resolved bool      `json:"resolved"`
+	Deleted  bool      `json:"deleted"`
+}
+
+type CommentMessage struct {
+	Type    string    `json:"type"`
+	NoteId  uuid.UUID `json:"note_id"`
+	Comment Comment   `json:"comment"`
+}
+
+type AddCommentRequest struct {
+	Text     string    `json:"text"`
+	BlockId  string    `json:"block_id,omitempty"`
+	ParentId uuid.UUID `json:"parent_id"`
+}
+
+func (payload *AddCommentRequest) Sanitize() {
+	payload.Text = html.EscapeString(payload.Text)
+	payload.BlockId = html.EscapeString(payload.BlockId)
+}
+
+func (payload *AddCommentRequest) Validate() error {
+	if err := validateCommentText(payload.Text); err != nil {
+		return err
+	}
+	if utf8.RuneCountInString(payload.BlockId) > maxBlockIdLength {
+		return errors.New("block id too long")
+	}
+	return nil
+}
+
+type UpdateCommentRequest struct {
+	Text string `json:"text"`
+}
+
+func (payload *UpdateCommentRequest) Sanitize() {
+	payload.Text = html.EscapeString(payload.Text)
+}
+
+func (payload *UpdateCommentRequest) Validate() error {
+	return validateCommentText(payload.Text)
+}
+
+func validateCommentText(text string) error {
+	if strings.TrimSpace(text) == "" {
+		return errors.New("comment is empty")
+	}
+	if utf8.RuneCountInString(text) > maxCommentLength {
+		return errors.New("comment too long")
+	}
+	return nil
+}
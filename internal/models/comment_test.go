@@ -0,0 +1,40 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddCommentRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload AddCommentRequest
+		wantErr bool
+	}{
+		{name: "ok", payload: AddCommentRequest{Text: "TODO review", BlockId: "block"}},
+		{name: "empty", payload: AddCommentRequest{Text: "  "}, wantErr: true},
+		{name: "long text", payload: AddCommentRequest{Text: strings.Repeat("a", 2001)}, wantErr: true},
+		{name: "long block id", payload: AddCommentRequest{Text: "text", BlockId: strings.Repeat("a", 256)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.payload.Validate()
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestAddCommentRequest_Sanitize(t *testing.T) {
+	payload := AddCommentRequest{Text: "<b>text</b>"}
+	payload.Sanitize()
+
+	assert.Equal(t, "&lt;b&gt;text&lt;/b&gt;", payload.Text)
+}
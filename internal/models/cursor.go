@@ -0,0 +1,44 @@
+package models
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/satori/uuid"
+)
+
+// NoteCursor - ключ сортировки последней заметки страницы
+type NoteCursor struct {
+	Sort        string        `json:"sort"`
+	Favorite    bool          `json:"favorite"`
+	Position    int           `json:"position"`
+	Created     time.Time     `json:"created"`
+	Title       string        `json:"title"`
+	OwnerId     uuid.UUID     `json:"owner_id"`
+	Updated     time.Time     `json:"updated"`
+	Id          uuid.UUID     `json:"id"`
+	SearchAfter []interface{} `json:"search_after,omitempty"`
+}
+
+func (cursor NoteCursor) Encode() string {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeNoteCursor(cursor string) (NoteCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return NoteCursor{}, errors.New("invalid cursor")
+	}
+
+	result := NoteCursor{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return NoteCursor{}, errors.New("invalid cursor")
+	}
+	return result, nil
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNoteCursor_Encode(t *testing.T) {
+	cursor := NoteCursor{
+		Sort:     SortTitle,
+		Favorite: true,
+		Title:    "title",
+		OwnerId:  uuid.NewV4(),
+		Updated:  time.Date(2024, 5, 1, 12, 0, 0, 123456000, time.UTC),
+		Id:       uuid.NewV4(),
+	}
+
+	decoded, err := DecodeNoteCursor(cursor.Encode())
+	assert.Nil(t, err)
+	assert.Equal(t, cursor, decoded)
+}
+
+func TestDecodeNoteCursor(t *testing.T) {
+	tests := []struct {
+		name    string
+		cursor  string
+		wantErr bool
+	}{
+		{name: "search after", cursor: NoteCursor{Sort: SortUpdated, SearchAfter: []interface{}{float64(1714564800000), "id"}}.Encode()},
+		{name: "not base64", cursor: "%%%", wantErr: true},
+		{name: "not json", cursor: "bm90IGpzb24", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DecodeNoteCursor(tt.cursor)
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+		})
+	}
+}
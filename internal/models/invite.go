@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"github.com/satori/uuid"
+)
+
+type Invite struct {
+	Id        uuid.UUID `json:"id"`
+	NoteId    uuid.UUID `json:"note_id"`
+	NoteTitle string    `json:"note_title"`
+	InviterId uuid.UUID `json:"inviter_id"`
+	Inviter   string    `json:"inviter"`
+	InviteeId uuid.UUID `json:"invitee_id"`
+	Invitee   string    `json:"invitee"`
+	Role      string    `json:"role"`
+	Created   time.Time `json:"created"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
@@ -0,0 +1,20 @@
+package models
+
+import "github.com/satori/uuid"
+
+// NoteLink - заметка в списке обратных ссылок и вершина графа
+type NoteLink struct {
+	Id    uuid.UUID `json:"id"`
+	Title string    `json:"title"`
+	Icon  string    `json:"icon"`
+}
+
+type NoteEdge struct {
+	Source uuid.UUID `json:"source"`
+	Target uuid.UUID `json:"target"`
+}
+
+type NoteGraph struct {
+	Nodes []NoteLink `json:"nodes"`
+	Edges []NoteEdge `json:"edges"`
+}
@@ -26,19 +26,59 @@ type JoinMessage struct {
 	Type      string    `json:"type"`
 	NoteId    uuid.UUID `json:"note_id"`
 	UserId    uuid.UUID `json:"user_id"`
+	SocketID  uuid.UUID `json:"socket_id"`
 	Username  string    `json:"username,omitempty"`
 	ImagePath string    `json:"image_path,omitempty"`
 }
 
+// Participant - участник, открывший заметку; у одного пользователя может быть несколько подключений
+type Participant struct {
+	SocketID  uuid.UUID `json:"socket_id"`
+	UserId    uuid.UUID `json:"user_id"`
+	Username  string    `json:"username"`
+	ImagePath string    `json:"image_path"`
+	Role      string    `json:"role"`
+}
+
+type ParticipantsMessage struct {
+	Type         string        `json:"type"`
+	NoteId       uuid.UUID     `json:"note_id"`
+	Participants []Participant `json:"participants"`
+}
+
+type CursorPosition struct {
+	Block  int `json:"block"`
+	Offset int `json:"offset"`
+}
+
+// CursorMessage - курсор участника: выделение от Anchor до Head, при их совпадении - просто курсор.
+// Revision - ревизия документа, к которой относятся позиции
+type CursorMessage struct {
+	Type     string         `json:"type"`
+	NoteId   uuid.UUID      `json:"note_id"`
+	SocketID uuid.UUID      `json:"socket_id"`
+	UserId   uuid.UUID      `json:"user_id"`
+	Revision int64          `json:"revision"`
+	Anchor   CursorPosition `json:"anchor"`
+	Head     CursorPosition `json:"head"`
+}
+
 type InviteMessage struct {
 	Type      string    `json:"type"`
 	NoteId    uuid.UUID `json:"note_id"`
 	NoteTitle string    `json:"note_title"`
 	Owner     string    `json:"owner"`
 	Created   time.Time `json:"created"`
+	InviteId  uuid.UUID `json:"invite_id"`
 }
 
 type SocketIDMessage struct {
 	Type     string    `json:"type"`
 	SocketID uuid.UUID `json:"socket_id"`
 }
+
+type RejectMessage struct {
+	Type   string    `json:"type"`
+	NoteId uuid.UUID `json:"note_id"`
+	Reason string    `json:"reason"`
+}
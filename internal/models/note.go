@@ -1,12 +1,29 @@
 package models
 
 import (
+	"errors"
 	"html"
 	"time"
 
 	"github.com/satori/uuid"
 )
 
+const (
+	ArchiveFilterActive   = "active"
+	ArchiveFilterArchived = "archived"
+	ArchiveFilterAll      = "all"
+)
+
+const (
+	SortManual  = "manual"
+	SortUpdated = "updated"
+	SortCreated = "created"
+	SortTitle   = "title"
+	SortOwner   = "owner"
+)
+
+const maxReorderNotes = 500
+
 type Note struct {
 	Id            uuid.UUID   `json:"id"`
 	Data          string      `json:"data,omitempty"`
@@ -21,6 +38,8 @@ type Note struct {
 	Header        string      `json:"header"`
 	Favorite      bool        `json:"favorite"`
 	Public        bool        `json:"public"`
+	Archived      bool        `json:"archived"`
+	Version       int64       `json:"version,omitempty"`
 }
 
 func (note *Note) Sanitize() {
@@ -36,8 +55,31 @@ type UpsertNoteRequest struct {
 	SocketID uuid.UUID   `json:"socket_id,omitempty"`
 }
 
-type AddCollaboratorRequest struct {
-	Username string `json:"username"`
+type MoveNoteRequest struct {
+	Parent uuid.UUID `json:"parent"`
+}
+
+type ReorderNotesRequest struct {
+	Parent uuid.UUID   `json:"parent"`
+	Notes  []uuid.UUID `json:"notes"`
+}
+
+func (payload *ReorderNotesRequest) Validate() error {
+	if len(payload.Notes) == 0 {
+		return errors.New("notes list is empty")
+	}
+	if len(payload.Notes) > maxReorderNotes {
+		return errors.New("too many notes")
+	}
+
+	seen := make(map[uuid.UUID]struct{}, len(payload.Notes))
+	for _, id := range payload.Notes {
+		if _, ok := seen[id]; ok {
+			return errors.New("duplicate note id")
+		}
+		seen[id] = struct{}{}
+	}
+	return nil
 }
 
 type SetIconRequest struct {
@@ -58,6 +100,11 @@ type NoteResponse struct {
 	OwnerInfo
 }
 
+type NotesPage struct {
+	Notes      []NoteResponse `json:"notes"`
+	NextCursor string         `json:"next_cursor"`
+}
+
 // ================================================================
 // only swagger examples
 
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"github.com/satori/uuid"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -31,3 +32,31 @@ func TestSanitizeNote(t *testing.T) {
 		assert.Equal(t, tt.output, note.Data)
 	}
 }
+
+func TestReorderNotesRequest_Validate(t *testing.T) {
+	first := uuid.NewV4()
+	second := uuid.NewV4()
+
+	tests := []struct {
+		name    string
+		payload ReorderNotesRequest
+		wantErr bool
+	}{
+		{name: "valid", payload: ReorderNotesRequest{Notes: []uuid.UUID{first, second}}},
+		{name: "empty", payload: ReorderNotesRequest{}, wantErr: true},
+		{name: "duplicate", payload: ReorderNotesRequest{Notes: []uuid.UUID{first, second, first}}, wantErr: true},
+		{name: "too many", payload: ReorderNotesRequest{Notes: make([]uuid.UUID, maxReorderNotes+1)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.payload.Validate()
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"encoding/json"
+
+	"github.com/satori/uuid"
+)
+
+const (
+	OperationInsert = "insert"
+	OperationDelete = "delete"
+	OperationUpdate = "update"
+	OperationTitle  = "title"
+	OperationNoop   = "noop"
+)
+
+// EditOperation - правка документа заметки: Index - позиция блока в content, Block - новый блок для insert и update
+type EditOperation struct {
+	Type  string          `json:"type"`
+	Index int             `json:"index"`
+	Block json.RawMessage `json:"block,omitempty"`
+	Title string          `json:"title,omitempty"`
+}
+
+// OperationMessage - операция в сокете заметки: клиент указывает BaseRevision, хаб в ответ рассылает операцию с присвоенной Revision,
+// отправителю она же служит подтверждением (совпадает SocketID)
+type OperationMessage struct {
+	Type         string        `json:"type"`
+	NoteId       uuid.UUID     `json:"note_id"`
+	SocketID     uuid.UUID     `json:"socket_id"`
+	UserId       uuid.UUID     `json:"user_id"`
+	BaseRevision int64         `json:"base_revision"`
+	Revision     int64         `json:"revision"`
+	Operation    EditOperation `json:"operation"`
+}
+
+// DocumentMessage - полное состояние документа: отправляется при подключении и при рассинхронизации клиента
+type DocumentMessage struct {
+	Type     string    `json:"type"`
+	NoteId   uuid.UUID `json:"note_id"`
+	Revision int64     `json:"revision"`
+	Data     string    `json:"data"`
+}
@@ -0,0 +1,77 @@
+package models
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/satori/uuid"
+)
+
+const (
+	RepeatNone    = ""
+	RepeatDaily   = "daily"
+	RepeatWeekly  = "weekly"
+	RepeatMonthly = "monthly"
+	RepeatYearly  = "yearly"
+)
+
+var repeatRules = []string{RepeatNone, RepeatDaily, RepeatWeekly, RepeatMonthly, RepeatYearly}
+
+type Reminder struct {
+	Id        uuid.UUID `json:"id"`
+	NoteId    uuid.UUID `json:"note_id"`
+	NoteTitle string    `json:"note_title"`
+	UserId    uuid.UUID `json:"user_id"`
+	RemindAt  time.Time `json:"remind_at"`
+	Repeat    string    `json:"repeat"`
+	Fired     bool      `json:"fired"`
+	Created   time.Time `json:"created"`
+}
+
+type ReminderMessage struct {
+	Type       string    `json:"type"`
+	ReminderId uuid.UUID `json:"reminder_id"`
+	NoteId     uuid.UUID `json:"note_id"`
+	NoteTitle  string    `json:"note_title"`
+	RemindAt   time.Time `json:"remind_at"`
+	Repeat     string    `json:"repeat"`
+}
+
+type AddReminderRequest struct {
+	RemindAt time.Time `json:"remind_at"`
+	Repeat   string    `json:"repeat,omitempty"`
+}
+
+func (payload *AddReminderRequest) Validate() error {
+	if payload.RemindAt.IsZero() {
+		return errors.New("remind time is required")
+	}
+	if !payload.RemindAt.After(time.Now()) {
+		return errors.New("remind time must be in the future")
+	}
+	if !slices.Contains(repeatRules, payload.Repeat) {
+		return errors.New("incorrect repeat rule")
+	}
+	return nil
+}
+
+// NextRemindTime возвращает первое срабатывание повторяющегося напоминания позже after
+func NextRemindTime(remindAt time.Time, repeat string, after time.Time) time.Time {
+	next := remindAt
+	for !next.After(after) {
+		switch repeat {
+		case RepeatDaily:
+			next = next.AddDate(0, 0, 1)
+		case RepeatWeekly:
+			next = next.AddDate(0, 0, 7)
+		case RepeatMonthly:
+			next = next.AddDate(0, 1, 0)
+		case RepeatYearly:
+			next = next.AddDate(1, 0, 0)
+		default:
+			return remindAt
+		}
+	}
+	return next
+}
@@ -0,0 +1,60 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddReminderRequest_Validate(t *testing.T) {
+	future := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name    string
+		payload AddReminderRequest
+		wantErr bool
+	}{
+		{name: "once", payload: AddReminderRequest{RemindAt: future}},
+		{name: "weekly", payload: AddReminderRequest{RemindAt: future, Repeat: RepeatWeekly}},
+		{name: "empty", payload: AddReminderRequest{}, wantErr: true},
+		{name: "past", payload: AddReminderRequest{RemindAt: past}, wantErr: true},
+		{name: "unknown repeat", payload: AddReminderRequest{RemindAt: future, Repeat: "hourly"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.payload.Validate()
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestNextRemindTime(t *testing.T) {
+	remindAt := time.Date(2024, time.January, 31, 9, 0, 0, 0, time.UTC)
+	now := time.Date(2024, time.February, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		repeat   string
+		expected time.Time
+	}{
+		{name: "none", repeat: RepeatNone, expected: remindAt},
+		{name: "daily", repeat: RepeatDaily, expected: time.Date(2024, time.February, 11, 9, 0, 0, 0, time.UTC)},
+		{name: "weekly", repeat: RepeatWeekly, expected: time.Date(2024, time.February, 14, 9, 0, 0, 0, time.UTC)},
+		{name: "monthly", repeat: RepeatMonthly, expected: time.Date(2024, time.March, 2, 9, 0, 0, 0, time.UTC)},
+		{name: "yearly", repeat: RepeatYearly, expected: time.Date(2025, time.January, 31, 9, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NextRemindTime(remindAt, tt.repeat, now))
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+
+	"github.com/satori/uuid"
+)
+
+const (
+	BlockAdded     = "added"
+	BlockRemoved   = "removed"
+	BlockUnchanged = "unchanged"
+)
+
+type NoteRevision struct {
+	Id       uuid.UUID `json:"id"`
+	NoteId   uuid.UUID `json:"note_id"`
+	Created  time.Time `json:"created"`
+	AuthorId uuid.UUID `json:"author_id"`
+	Username string    `json:"username"`
+	Data     string    `json:"data,omitempty"`
+}
+
+type BlockChange struct {
+	Type  string `json:"type"`
+	Block string `json:"block"`
+}
+
+type RevisionDiff struct {
+	From     uuid.UUID     `json:"from"`
+	To       uuid.UUID     `json:"to"`
+	OldTitle string        `json:"old_title"`
+	NewTitle string        `json:"new_title"`
+	Blocks   []BlockChange `json:"blocks"`
+}
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"errors"
+	"time"
+	"unicode/utf8"
+
+	"github.com/satori/uuid"
+)
+
+const (
+	maxSharePasswordLength = 64
+)
+
+type ShareLink struct {
+	Id             uuid.UUID  `json:"id"`
+	Token          string     `json:"token"`
+	NoteId         uuid.UUID  `json:"note_id"`
+	CreatorId      uuid.UUID  `json:"creator_id"`
+	PasswordHash   string     `json:"-"`
+	HasPassword    bool       `json:"has_password"`
+	IncludeSubtree bool       `json:"include_subtree"`
+	Created        time.Time  `json:"created"`
+	ExpiresAt      *time.Time `json:"expires_at,omitempty"`
+}
+
+type CreateShareLinkRequest struct {
+	Password       string     `json:"password,omitempty"`
+	IncludeSubtree bool       `json:"include_subtree"`
+	ExpiresAt      *time.Time `json:"expires_at,omitempty"`
+}
+
+func (payload *CreateShareLinkRequest) Validate() error {
+	if utf8.RuneCountInString(payload.Password) > maxSharePasswordLength {
+		return errors.New("password too long")
+	}
+	if payload.ExpiresAt != nil && !payload.ExpiresAt.After(time.Now()) {
+		return errors.New("expiration time must be in the future")
+	}
+	return nil
+}
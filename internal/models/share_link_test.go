@@ -0,0 +1,37 @@
+package models
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateShareLinkRequest_Validate(t *testing.T) {
+	future := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name    string
+		payload CreateShareLinkRequest
+		wantErr bool
+	}{
+		{name: "empty", payload: CreateShareLinkRequest{}},
+		{name: "with password and expiry", payload: CreateShareLinkRequest{Password: "secret", ExpiresAt: &future}},
+		{name: "expired", payload: CreateShareLinkRequest{ExpiresAt: &past}, wantErr: true},
+		{name: "long password", payload: CreateShareLinkRequest{Password: strings.Repeat("a", 65)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.payload.Validate()
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+		})
+	}
+}
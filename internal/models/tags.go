@@ -3,13 +3,24 @@ package models
 import (
 	"errors"
 	"html"
+	"regexp"
+	"slices"
+	"strings"
 	"unicode/utf8"
 )
 
 const (
-	maxTagLength = 20
+	maxTagLength            = 20
+	maxTagDepth             = 5
+	maxTagEmojiLength       = 16
+	maxMergeTags            = 20
+	maxTagDescriptionLength = 255
+
+	TagPathSeparator = "/"
 )
 
+var tagColorRegexp = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
 type TagRequest struct {
 	TagName string `json:"tag_name"`
 }
@@ -20,10 +31,7 @@ func (payload *TagRequest) Sanitize() {
 }
 
 func (payload *TagRequest) Validate() error {
-	if utf8.RuneCountInString(payload.TagName) > maxTagLength {
-		return errors.New("tag name too long")
-	}
-	return nil
+	return validateTagPath(payload.TagName)
 }
 
 type UpdateTagRequest struct {
@@ -38,15 +46,232 @@ func (payload *UpdateTagRequest) Sanitize() {
 }
 
 func (payload *UpdateTagRequest) Validate() error {
-	if utf8.RuneCountInString(payload.NewTag) > maxTagLength {
-		return errors.New("tag name too long")
+	if err := validateTagPath(payload.NewTag); err != nil {
+		return err
+	}
+	if err := validateTagPath(payload.OldTag); err != nil {
+		return err
+	}
+	if TagMatches(payload.NewTag, payload.OldTag) && payload.NewTag != payload.OldTag {
+		return errors.New("tag can`t be moved into itself")
+	}
+	return nil
+}
+
+type MergeTagsRequest struct {
+	Tags   []string `json:"tags"`
+	NewTag string   `json:"new_name"`
+}
+
+func (payload *MergeTagsRequest) Sanitize() {
+	for i := range payload.Tags {
+		payload.Tags[i] = html.EscapeString(payload.Tags[i])
+	}
+	payload.NewTag = html.EscapeString(payload.NewTag)
+}
+
+func (payload *MergeTagsRequest) Validate() error {
+	if len(payload.Tags) == 0 || len(payload.Tags) > maxMergeTags {
+		return errors.New("invalid tags count")
+	}
+	if err := validateTagPath(payload.NewTag); err != nil {
+		return err
+	}
+	for _, tag := range payload.Tags {
+		if err := validateTagPath(tag); err != nil {
+			return err
+		}
+		if TagMatches(payload.NewTag, tag) && payload.NewTag != tag {
+			return errors.New("tag can`t be moved into itself")
+		}
+	}
+	return nil
+}
+
+// validateTagPath - тег может быть вложенным: work/clients/acme, ограничение длины действует на каждый уровень
+func validateTagPath(tag string) error {
+	segments := strings.Split(tag, TagPathSeparator)
+	if len(segments) > maxTagDepth {
+		return errors.New("tag too deep")
+	}
+	for _, segment := range segments {
+		if utf8.RuneCountInString(segment) > maxTagLength {
+			return errors.New("tag name too long")
+		}
+		if segment == "" && len(segments) > 1 {
+			return errors.New("empty tag segment")
+		}
+	}
+	return nil
+}
+
+// TagMatches - тег совпадает с фильтром сам или является его потомком
+func TagMatches(tag string, filter string) bool {
+	return tag == filter || strings.HasPrefix(tag, filter+TagPathSeparator)
+}
+
+// RenameTagPath - меняет префикс oldTag на newTag у самого тега и у всех потомков
+func RenameTagPath(tag string, oldTag string, newTag string) (string, bool) {
+	if !TagMatches(tag, oldTag) {
+		return tag, false
+	}
+	return newTag + strings.TrimPrefix(tag, oldTag), true
+}
+
+type Tag struct {
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Emoji       string `json:"emoji"`
+	Description string `json:"description"`
+	NotesCount  int64  `json:"notes_count"`
+}
+
+type TagInfoRequest struct {
+	TagName     string `json:"tag_name"`
+	Color       string `json:"color"`
+	Emoji       string `json:"emoji"`
+	Description string `json:"description"`
+}
+
+func (payload *TagInfoRequest) Sanitize() {
+	payload.TagName = html.EscapeString(payload.TagName)
+	payload.Emoji = html.EscapeString(payload.Emoji)
+	payload.Description = html.EscapeString(payload.Description)
+}
+
+func (payload *TagInfoRequest) Validate() error {
+	if err := validateTagPath(payload.TagName); err != nil {
+		return err
+	}
+	if payload.Color != "" && !tagColorRegexp.MatchString(payload.Color) {
+		return errors.New("invalid tag color")
 	}
-	if utf8.RuneCountInString(payload.OldTag) > maxTagLength {
-		return errors.New("tag name too long")
+	if utf8.RuneCountInString(payload.Emoji) > maxTagEmojiLength {
+		return errors.New("tag emoji too long")
+	}
+	if utf8.RuneCountInString(payload.Description) > maxTagDescriptionLength {
+		return errors.New("tag description too long")
 	}
 	return nil
 }
 
 type GetTagsResponse struct {
 	Tags []string `json:"tags"`
+	Info []Tag    `json:"info"`
+}
+
+type TagNode struct {
+	Name        string    `json:"name"`
+	Path        string    `json:"path"`
+	Color       string    `json:"color"`
+	Emoji       string    `json:"emoji"`
+	Description string    `json:"description"`
+	NotesCount  int64     `json:"notes_count"`
+	Children    []TagNode `json:"children"`
+}
+
+type GetTagTreeResponse struct {
+	Tags []TagNode `json:"tags"`
+}
+
+// BuildTagTree - собирает дерево из плоского списка, недостающие родители добавляются автоматически
+func BuildTagTree(tags []Tag) []TagNode {
+	root := make([]TagNode, 0)
+	for _, tag := range tags {
+		level := &root
+		segments := strings.Split(tag.Name, TagPathSeparator)
+		for i, segment := range segments {
+			idx := slices.IndexFunc(*level, func(node TagNode) bool { return node.Name == segment })
+			if idx < 0 {
+				*level = append(*level, TagNode{
+					Name:     segment,
+					Path:     strings.Join(segments[:i+1], TagPathSeparator),
+					Children: make([]TagNode, 0),
+				})
+				idx = len(*level) - 1
+			}
+			if i == len(segments)-1 {
+				node := &(*level)[idx]
+				node.Color = tag.Color
+				node.Emoji = tag.Emoji
+				node.Description = tag.Description
+				node.NotesCount = tag.NotesCount
+			}
+			level = &(*level)[idx].Children
+		}
+	}
+	return root
+}
+
+const (
+	TagModeAll  = "all"
+	TagModeAny  = "any"
+	TagModeNone = "none"
+
+	excludedTagPrefix = "-"
+)
+
+// TagFilter - фильтр списка заметок по тегам, одинаковый для postgres и elastic
+type TagFilter struct {
+	Mode     string
+	Included []string
+	Excluded []string
+}
+
+func ParseTagFilter(tags []string, mode string) (TagFilter, error) {
+	if mode == "" {
+		mode = TagModeAll
+	}
+	if mode != TagModeAll && mode != TagModeAny && mode != TagModeNone {
+		return TagFilter{}, errors.New("invalid tag mode")
+	}
+
+	filter := TagFilter{
+		Mode:     mode,
+		Included: make([]string, 0),
+		Excluded: make([]string, 0),
+	}
+	for _, tag := range tags {
+		if tag == "" {
+			continue
+		}
+		if excluded, ok := strings.CutPrefix(tag, excludedTagPrefix); ok {
+			if excluded != "" {
+				filter.Excluded = append(filter.Excluded, excluded)
+			}
+			continue
+		}
+		filter.Included = append(filter.Included, tag)
+	}
+
+	return filter, nil
+}
+
+func (filter TagFilter) Matches(tags []string) bool {
+	for _, tag := range filter.Excluded {
+		if hasTag(tags, tag) {
+			return false
+		}
+	}
+	if len(filter.Included) == 0 {
+		return true
+	}
+
+	switch filter.Mode {
+	case TagModeAny:
+		return slices.ContainsFunc(filter.Included, func(tag string) bool { return hasTag(tags, tag) })
+	case TagModeNone:
+		return !slices.ContainsFunc(filter.Included, func(tag string) bool { return hasTag(tags, tag) })
+	default:
+		for _, tag := range filter.Included {
+			if !hasTag(tags, tag) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+func hasTag(tags []string, filter string) bool {
+	return slices.ContainsFunc(tags, func(tag string) bool { return TagMatches(tag, filter) })
 }
@@ -0,0 +1,203 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTagFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		tags    []string
+		mode    string
+		want    TagFilter
+		wantErr bool
+	}{
+		{
+			name: "default mode",
+			tags: []string{"first", "-second", "", "-"},
+			want: TagFilter{Mode: TagModeAll, Included: []string{"first"}, Excluded: []string{"second"}},
+		},
+		{
+			name: "any",
+			tags: []string{"first"},
+			mode: TagModeAny,
+			want: TagFilter{Mode: TagModeAny, Included: []string{"first"}, Excluded: []string{}},
+		},
+		{
+			name:    "invalid mode",
+			tags:    []string{"first"},
+			mode:    "some",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTagFilter(tt.tags, tt.mode)
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTagFilter_Matches(t *testing.T) {
+	tests := []struct {
+		name  string
+		tags  []string
+		mode  string
+		note  []string
+		match bool
+	}{
+		{name: "empty filter", tags: []string{}, mode: TagModeAll, note: []string{"first"}, match: true},
+		{name: "all match", tags: []string{"first", "second"}, mode: TagModeAll, note: []string{"first", "second", "third"}, match: true},
+		{name: "all partial", tags: []string{"first", "second"}, mode: TagModeAll, note: []string{"first"}, match: false},
+		{name: "any match", tags: []string{"first", "second"}, mode: TagModeAny, note: []string{"second"}, match: true},
+		{name: "any no tags", tags: []string{"first", "second"}, mode: TagModeAny, note: nil, match: false},
+		{name: "none match", tags: []string{"first", "second"}, mode: TagModeNone, note: []string{"third"}, match: true},
+		{name: "none no tags", tags: []string{"first"}, mode: TagModeNone, note: nil, match: true},
+		{name: "none partial", tags: []string{"first", "second"}, mode: TagModeNone, note: []string{"second"}, match: false},
+		{name: "excluded", tags: []string{"first", "-second"}, mode: TagModeAny, note: []string{"first", "second"}, match: false},
+		{name: "only excluded", tags: []string{"-second"}, mode: TagModeAll, note: []string{"first"}, match: true},
+		{name: "parent matches child", tags: []string{"work"}, mode: TagModeAll, note: []string{"work/clients/acme"}, match: true},
+		{name: "child not matches parent", tags: []string{"work/clients"}, mode: TagModeAll, note: []string{"work"}, match: false},
+		{name: "same prefix", tags: []string{"work"}, mode: TagModeAny, note: []string{"workshop"}, match: false},
+		{name: "excluded child", tags: []string{"work", "-work/private"}, mode: TagModeAll, note: []string{"work/private/diary"}, match: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := ParseTagFilter(tt.tags, tt.mode)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.match, filter.Matches(tt.note))
+		})
+	}
+}
+
+func TestTagRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		tag     string
+		wantErr bool
+	}{
+		{name: "flat", tag: "work"},
+		{name: "nested", tag: "work/clients/acme"},
+		{name: "too long", tag: "abcdefghijklmnopqrstuvwxyz", wantErr: true},
+		{name: "nested segment too long", tag: "work/abcdefghijklmnopqrstuvwxyz", wantErr: true},
+		{name: "empty segment", tag: "work//acme", wantErr: true},
+		{name: "trailing separator", tag: "work/", wantErr: true},
+		{name: "too deep", tag: "a/b/c/d/e/f", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload := TagRequest{TagName: tt.tag}
+			assert.Equal(t, tt.wantErr, payload.Validate() != nil)
+		})
+	}
+}
+
+func TestUpdateTagRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		oldTag  string
+		newTag  string
+		wantErr bool
+	}{
+		{name: "rename parent", oldTag: "work", newTag: "job"},
+		{name: "move", oldTag: "work/clients", newTag: "clients"},
+		{name: "into itself", oldTag: "work", newTag: "work/clients", wantErr: true},
+		{name: "bad new tag", oldTag: "work", newTag: "job/", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload := UpdateTagRequest{OldTag: tt.oldTag, NewTag: tt.newTag}
+			assert.Equal(t, tt.wantErr, payload.Validate() != nil)
+		})
+	}
+}
+
+func TestRenameTagPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		tag     string
+		want    string
+		renamed bool
+	}{
+		{name: "self", tag: "work", want: "job", renamed: true},
+		{name: "descendant", tag: "work/clients/acme", want: "job/clients/acme", renamed: true},
+		{name: "same prefix", tag: "workshop", want: "workshop", renamed: false},
+		{name: "other", tag: "home", want: "home", renamed: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, renamed := RenameTagPath(tt.tag, "work", "job")
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.renamed, renamed)
+		})
+	}
+}
+
+func TestBuildTagTree(t *testing.T) {
+	got := BuildTagTree([]Tag{
+		{Name: "work/clients/acme", Color: "#ff0000", NotesCount: 2},
+		{Name: "home", Emoji: "🏠", NotesCount: 1},
+		{Name: "work", Description: "job"},
+	})
+	assert.Equal(t, []TagNode{
+		{Name: "work", Path: "work", Description: "job", Children: []TagNode{
+			{Name: "clients", Path: "work/clients", Children: []TagNode{
+				{Name: "acme", Path: "work/clients/acme", Color: "#ff0000", NotesCount: 2, Children: []TagNode{}},
+			}},
+		}},
+		{Name: "home", Path: "home", Emoji: "🏠", NotesCount: 1, Children: []TagNode{}},
+	}, got)
+}
+
+func TestTagInfoRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload TagInfoRequest
+		wantErr bool
+	}{
+		{name: "full", payload: TagInfoRequest{TagName: "work", Color: "#00AAff", Emoji: "💼", Description: "job"}},
+		{name: "empty info", payload: TagInfoRequest{TagName: "work/clients"}},
+		{name: "bad color", payload: TagInfoRequest{TagName: "work", Color: "red"}, wantErr: true},
+		{name: "bad tag", payload: TagInfoRequest{TagName: "work/"}, wantErr: true},
+		{name: "long description", payload: TagInfoRequest{TagName: "work", Description: strings.Repeat("a", 256)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantErr, tt.payload.Validate() != nil)
+		})
+	}
+}
+
+func TestMergeTagsRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload MergeTagsRequest
+		wantErr bool
+	}{
+		{name: "merge", payload: MergeTagsRequest{Tags: []string{"todo", "tasks"}, NewTag: "work/todo"}},
+		{name: "same tag", payload: MergeTagsRequest{Tags: []string{"work"}, NewTag: "work"}},
+		{name: "no tags", payload: MergeTagsRequest{NewTag: "work"}, wantErr: true},
+		{name: "into itself", payload: MergeTagsRequest{Tags: []string{"work"}, NewTag: "work/todo"}, wantErr: true},
+		{name: "bad tag", payload: MergeTagsRequest{Tags: []string{"work/"}, NewTag: "todo"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantErr, tt.payload.Validate() != nil)
+		})
+	}
+}
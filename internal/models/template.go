@@ -0,0 +1,13 @@
+package models
+
+import "github.com/satori/uuid"
+
+type Template struct {
+	Id     uuid.UUID `json:"id"`
+	Data   string    `json:"data"`
+	System bool      `json:"system"`
+}
+
+type CreateFromTemplateRequest struct {
+	Parent uuid.UUID `json:"parent"`
+}
@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+
+	"github.com/satori/uuid"
+)
+
+type OwnershipTransfer struct {
+	NoteId  uuid.UUID `json:"note_id"`
+	FromId  uuid.UUID `json:"from_id"`
+	ToId    uuid.UUID `json:"to_id"`
+	Created time.Time `json:"created"`
+}
+
+type TransferOwnershipRequest struct {
+	Username string `json:"username"`
+}
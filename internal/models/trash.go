@@ -0,0 +1,10 @@
+package models
+
+import (
+	"time"
+)
+
+type TrashedNote struct {
+	Note
+	DeletedAt time.Time `json:"deleted_at"`
+}
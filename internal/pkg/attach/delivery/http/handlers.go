@@ -13,6 +13,7 @@ import (
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/attach"
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/auth"
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/config"
+	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/note"
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/utils/filework"
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/utils/log"
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/utils/responses"
@@ -225,8 +226,19 @@ func (h *AttachHandler) GetSharedAttach(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	attach, err := h.uc.GetSharedAttach(r.Context(), attachId)
+	attach, err := h.uc.GetSharedAttach(r.Context(), attachId, r.URL.Query().Get("token"), r.Header.Get("X-Share-Password"))
 	if err != nil {
+		if err.Error() == note.ErrShareLinkExpired {
+			log.LogHandlerError(logger, http.StatusGone, err.Error())
+			w.WriteHeader(http.StatusGone)
+			return
+		}
+		if err.Error() == note.ErrWrongPassword {
+			log.LogHandlerError(logger, http.StatusForbidden, err.Error())
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
 		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
 		w.WriteHeader(http.StatusNotFound)
 		return
@@ -14,7 +14,7 @@ type AttachUsecase interface {
 	AddAttach(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, attach io.ReadSeeker, extension string) (models.Attach, error)
 	DeleteAttach(ctx context.Context, attachID uuid.UUID, userID uuid.UUID) error
 	GetAttach(ctx context.Context, attachID uuid.UUID, userID uuid.UUID) (models.Attach, error)
-	GetSharedAttach(ctx context.Context, attachID uuid.UUID) (models.Attach, error)
+	GetSharedAttach(ctx context.Context, attachID uuid.UUID, token string, password string) (models.Attach, error)
 }
 
 type AttachRepo interface {
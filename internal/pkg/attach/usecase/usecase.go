@@ -8,12 +8,14 @@ import (
 	"os"
 	"path"
 	"slices"
+	"time"
 
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/models"
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/attach"
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/note"
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/utils/filework"
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/utils/log"
+	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/utils/passhash"
 	"github.com/satori/uuid"
 )
 
@@ -31,6 +33,27 @@ func CreateAttachUsecase(repo attach.AttachRepo, noteRepo note.NoteBaseRepo) *At
 	}
 }
 
+func (uc *AttachUsecase) checkEditor(ctx context.Context, resultNote models.Note, userID uuid.UUID) error {
+	if resultNote.OwnerId == userID {
+		return nil
+	}
+
+	if !slices.Contains(resultNote.Collaborators, userID) {
+		return errors.New("not found")
+	}
+
+	role, err := uc.noteRepo.GetCollaboratorRole(ctx, resultNote.Id, userID)
+	if err != nil {
+		return err
+	}
+
+	if !models.HasRole(role, models.RoleEditor) {
+		return errors.New(note.ErrNotEnoughRights)
+	}
+
+	return nil
+}
+
 func (uc *AttachUsecase) AddAttach(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, attach io.ReadSeeker, extension string) (models.Attach, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
@@ -39,9 +62,9 @@ func (uc *AttachUsecase) AddAttach(ctx context.Context, noteID uuid.UUID, userID
 		logger.Error(err.Error())
 		return models.Attach{}, err
 	}
-	if resultNote.OwnerId != userID && !slices.Contains(resultNote.Collaborators, userID) {
-		logger.Error("not owner and not collaborator")
-		return models.Attach{}, errors.New("not found")
+	if err := uc.checkEditor(ctx, resultNote.Note, userID); err != nil {
+		logger.Error(err.Error())
+		return models.Attach{}, err
 	}
 
 	attachBasePath := os.Getenv("ATTACHES_BASE_PATH")
@@ -83,9 +106,9 @@ func (uc *AttachUsecase) DeleteAttach(ctx context.Context, attachID uuid.UUID, u
 		logger.Error(err.Error())
 		return err
 	}
-	if resultNote.OwnerId != userID && !slices.Contains(resultNote.Collaborators, userID) {
-		logger.Error("not owner and not collaborator")
-		return nil
+	if err := uc.checkEditor(ctx, resultNote.Note, userID); err != nil {
+		logger.Error(err.Error())
+		return err
 	}
 
 	err = uc.repo.DeleteAttach(ctx, attachID)
@@ -124,7 +147,24 @@ func (uc *AttachUsecase) GetAttach(ctx context.Context, attachID uuid.UUID, user
 	return attachData, nil
 }
 
-func (uc *AttachUsecase) GetSharedAttach(ctx context.Context, attachID uuid.UUID) (models.Attach, error) {
+func (uc *AttachUsecase) checkShareLink(ctx context.Context, noteID uuid.UUID, token string, password string) error {
+	link, err := uc.noteRepo.GetShareLink(ctx, token, noteID)
+	if err != nil {
+		return errors.New("not found")
+	}
+
+	if link.ExpiresAt != nil && link.ExpiresAt.Before(time.Now().UTC()) {
+		return errors.New(note.ErrShareLinkExpired)
+	}
+
+	if link.PasswordHash != "" && !passhash.Check(link.PasswordHash, password) {
+		return errors.New(note.ErrWrongPassword)
+	}
+
+	return nil
+}
+
+func (uc *AttachUsecase) GetSharedAttach(ctx context.Context, attachID uuid.UUID, token string, password string) (models.Attach, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
 	attachData, err := uc.repo.GetAttach(ctx, attachID)
@@ -133,14 +173,14 @@ func (uc *AttachUsecase) GetSharedAttach(ctx context.Context, attachID uuid.UUID
 		return models.Attach{}, err
 	}
 
-	resultNote, err := uc.noteRepo.ReadPublicNote(ctx, attachData.NoteId)
-	if err != nil {
+	if err := uc.checkShareLink(ctx, attachData.NoteId, token, password); err != nil {
 		logger.Error(err.Error())
 		return models.Attach{}, err
 	}
-	if !resultNote.Public {
-		logger.Error("not public note")
-		return models.Attach{}, errors.New("not found")
+
+	if _, err := uc.noteRepo.ReadPublicNote(ctx, attachData.NoteId); err != nil {
+		logger.Error(err.Error())
+		return models.Attach{}, err
 	}
 
 	return attachData, nil
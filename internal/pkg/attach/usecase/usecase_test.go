@@ -11,7 +11,9 @@ import (
 
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/models"
 	mock_attach "github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/attach/mocks"
+	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/note"
 	mock_note "github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/note/mocks"
+	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/utils/responses"
 	"github.com/golang/mock/gomock"
 	"github.com/satori/uuid"
 	"github.com/stretchr/testify/assert"
@@ -144,6 +146,31 @@ func TestAttachUsecase_DeleteAttach(t *testing.T) {
 			},
 			expectedErr: errors.New("note not found"),
 		},
+		{
+			name: "TestDeleteAtatch_Fail_Viewer",
+			repoMocker: func(ctx context.Context, repo *mock_attach.MockAttachRepo, noteRepo *mock_note.MockNoteBaseRepo, data args) {
+				noteRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						Id:            data.noteID,
+						OwnerId:       uuid.NewV4(),
+						Collaborators: []uuid.UUID{data.userID},
+					},
+				}, nil).Times(1)
+				noteRepo.EXPECT().GetCollaboratorRole(ctx, noteId, userId).Return(models.RoleViewer, nil).Times(1)
+				repo.EXPECT().GetAttach(ctx, attachId).Return(models.Attach{
+					Id:     data.attachID,
+					NoteId: data.noteID,
+					Path:   "",
+				}, nil).Times(1)
+			},
+			args: args{
+				ctx:      context.Background(),
+				attachID: attachId,
+				userID:   userId,
+				noteID:   noteId,
+			},
+			expectedErr: errors.New(note.ErrNotEnoughRights),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -282,6 +309,86 @@ func TestAttachUsecase_GetAttach(t *testing.T) {
 	}
 }
 
+func TestAttachUsecase_GetSharedAttach(t *testing.T) {
+	attachId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	attachData := models.Attach{
+		Id:     attachId,
+		NoteId: noteId,
+		Path:   "",
+	}
+	expiresAt := time.Now().UTC().Add(-time.Hour)
+
+	tests := []struct {
+		name        string
+		repoMocker  func(context context.Context, repo *mock_attach.MockAttachRepo, noteRepo *mock_note.MockNoteBaseRepo)
+		want        models.Attach
+		expectedErr error
+	}{
+		{
+			name: "Test_GetSharedAttach_Success",
+			repoMocker: func(ctx context.Context, repo *mock_attach.MockAttachRepo, noteRepo *mock_note.MockNoteBaseRepo) {
+				repo.EXPECT().GetAttach(ctx, attachId).Return(attachData, nil).Times(1)
+				noteRepo.EXPECT().GetShareLink(ctx, "token", noteId).Return(models.ShareLink{
+					NoteId:       noteId,
+					PasswordHash: responses.GetHash("password"),
+				}, nil).Times(1)
+				noteRepo.EXPECT().ReadPublicNote(ctx, noteId).Return(models.NoteResponse{}, nil).Times(1)
+			},
+			want:        attachData,
+			expectedErr: nil,
+		},
+		{
+			name: "Test_GetSharedAttach_NoLink",
+			repoMocker: func(ctx context.Context, repo *mock_attach.MockAttachRepo, noteRepo *mock_note.MockNoteBaseRepo) {
+				repo.EXPECT().GetAttach(ctx, attachId).Return(attachData, nil).Times(1)
+				noteRepo.EXPECT().GetShareLink(ctx, "token", noteId).Return(models.ShareLink{}, errors.New("error")).Times(1)
+			},
+			want:        models.Attach{},
+			expectedErr: errors.New("not found"),
+		},
+		{
+			name: "Test_GetSharedAttach_Expired",
+			repoMocker: func(ctx context.Context, repo *mock_attach.MockAttachRepo, noteRepo *mock_note.MockNoteBaseRepo) {
+				repo.EXPECT().GetAttach(ctx, attachId).Return(attachData, nil).Times(1)
+				noteRepo.EXPECT().GetShareLink(ctx, "token", noteId).Return(models.ShareLink{
+					NoteId:    noteId,
+					ExpiresAt: &expiresAt,
+				}, nil).Times(1)
+			},
+			want:        models.Attach{},
+			expectedErr: errors.New(note.ErrShareLinkExpired),
+		},
+		{
+			name: "Test_GetSharedAttach_WrongPassword",
+			repoMocker: func(ctx context.Context, repo *mock_attach.MockAttachRepo, noteRepo *mock_note.MockNoteBaseRepo) {
+				repo.EXPECT().GetAttach(ctx, attachId).Return(attachData, nil).Times(1)
+				noteRepo.EXPECT().GetShareLink(ctx, "token", noteId).Return(models.ShareLink{
+					NoteId:       noteId,
+					PasswordHash: responses.GetHash("another"),
+				}, nil).Times(1)
+			},
+			want:        models.Attach{},
+			expectedErr: errors.New(note.ErrWrongPassword),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			noteRepo := mock_note.NewMockNoteBaseRepo(ctl)
+			repo := mock_attach.NewMockAttachRepo(ctl)
+			uc := CreateAttachUsecase(repo, noteRepo)
+
+			tt.repoMocker(context.Background(), repo, noteRepo)
+
+			got, err := uc.GetSharedAttach(context.Background(), attachId, "token", "password")
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestAttachUsecase_AddAttach(t *testing.T) {
 	userId := uuid.NewV4()
 	noteId := uuid.NewV4()
@@ -19,6 +19,7 @@ import (
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/auth"
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/config"
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/middleware/protection"
+	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/note"
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/utils/cookie"
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/utils/filework"
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/utils/log"
@@ -44,10 +45,6 @@ func CreateAuthHandler(client gen.AuthClient, noteClient noteGen.NoteClient, cfg
 	}
 }
 
-func makeHelloNoteData(username string) string {
-	return fmt.Sprintf(`{"title":"YouNote❤️","content":[{"pluginName":"textBlock","content":"Привет, %s!"},{"pluginName":"div","children":[{"pluginName":"br"}]}]}`, username)
-}
-
 func getUser(user *gen.User) (models.User, error) {
 	createTime, err := time.Parse(TimeLayout, user.CreateTime)
 	if err != nil {
@@ -128,9 +125,10 @@ func (h *AuthHandler) SignUp(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	_, err = h.noteClient.AddNote(r.Context(), &noteGen.AddNoteRequest{
-		Data:   string(makeHelloNoteData(response.User.Username)),
-		UserId: response.User.Id,
+	_, err = h.noteClient.CreateFromTemplate(r.Context(), &noteGen.CreateFromTemplateRequest{
+		TemplateId: note.WelcomeTemplateID,
+		UserId:     response.User.Id,
+		Username:   response.User.Username,
 	})
 	if err != nil {
 		logger.Error(err.Error())
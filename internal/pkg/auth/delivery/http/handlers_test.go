@@ -22,13 +22,6 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func TestMakeHelloNoteData(t *testing.T) {
-	username := "testuser"
-	expected := `{"title":"YouNote❤️","content":[{"pluginName":"textBlock","content":"Привет, testuser!"},{"pluginName":"div","children":[{"pluginName":"br"}]}]}`
-
-	assert.Equal(t, expected, makeHelloNoteData(username))
-}
-
 func TestAuthHandler_SignUp(t *testing.T) {
 	testConfig := config.Config{
 		AuthHandler: config.AuthHandlerConfig{
@@ -125,7 +118,7 @@ func TestAuthHandler_SignUp(t *testing.T) {
 			}
 
 			if tt.name == "AuthHandler_SignUp_Success" {
-				mockNote.EXPECT().AddNote(gomock.Any(), gomock.Any()).Return(&gen_note.AddNoteResponse{}, nil)
+				mockNote.EXPECT().CreateFromTemplate(gomock.Any(), gomock.Any()).Return(&gen_note.GetNoteResponse{}, nil)
 			}
 
 			req := httptest.NewRequest("POST", "http://example.com/api/handler", bytes.NewBufferString(tt.requestBody))
@@ -23,6 +23,7 @@ type Config struct {
 	Grpc        GrpcConfig        `yaml:"grpc"`
 	Hub         HubConfig         `yaml:"hub"`
 	Constraints ConstraintsConfig `yaml:"constraints"`
+	Trash       TrashConfig       `yaml:"trash"`
 }
 
 type MainConfig struct {
@@ -90,15 +91,25 @@ type GrpcConfig struct {
 }
 
 type HubConfig struct {
-	Period   time.Duration `yaml:"period"`
-	CacheTtl time.Duration `yaml:"cache_ttl"`
+	Period         time.Duration `yaml:"period"`
+	CacheTtl       time.Duration `yaml:"cache_ttl"`
+	ReminderPeriod time.Duration `yaml:"reminder_period"`
+	PersistPeriod  time.Duration `yaml:"persist_period"`
+	CursorPeriod   time.Duration `yaml:"cursor_period"`
+	AccessTtl      time.Duration `yaml:"access_ttl"`
 }
 
 type ConstraintsConfig struct {
-	MaxSubnotes      int `yaml:"max_subnotes"`
-	MaxDepth         int `yaml:"max_depth"`
-	MaxCollaborators int `yaml:"max_collaborators"`
-	MaxTags          int `yaml:"max_tags"`
+	MaxSubnotes      int           `yaml:"max_subnotes"`
+	MaxDepth         int           `yaml:"max_depth"`
+	MaxCollaborators int           `yaml:"max_collaborators"`
+	MaxTags          int           `yaml:"max_tags"`
+	InviteTtl        time.Duration `yaml:"invite_ttl"`
+}
+
+type TrashConfig struct {
+	Retention   time.Duration `yaml:"retention"`
+	PurgePeriod time.Duration `yaml:"purge_period"`
 }
 
 const (
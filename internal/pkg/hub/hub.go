@@ -6,12 +6,17 @@ import (
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/metrics"
 	"io"
 	"log/slog"
+	"slices"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/models"
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/config"
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/note"
+	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/note/delivery/grpc/gen"
+	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/utils/collab"
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/utils/log"
 	"github.com/gorilla/websocket"
 	"github.com/jellydator/ttlcache/v3"
@@ -20,6 +25,25 @@ import (
 
 const ErrHubWrite = "can`t write hub`s message: "
 
+const (
+	joinMessageType      = "opened"
+	leaveMessageType     = "closed"
+	cursorMessageType    = "cursor"
+	operationMessageType = "operation"
+)
+
+// сообщения присутствия может отправлять любой участник, остальные - только редакторы
+var presenceMessageTypes = []string{joinMessageType, leaveMessageType, cursorMessageType}
+
+// session - документ, который совместно редактируют подключенные к заметке клиенты
+type session struct {
+	sync.Mutex
+	document *collab.Document
+	editorID uuid.UUID // последний редактор, от его имени сохраняется документ
+	dirty    bool
+	closed   atomic.Bool // сессия сохранена и удаляется, правки в нее уже не принимаются
+}
+
 type Hub struct {
 	connect       sync.Map // wsConnection : noteID
 	currentOffset time.Time
@@ -29,12 +53,16 @@ type Hub struct {
 	currentOffsetMain time.Time
 	cacheMain         *ttlcache.Cache[uuid.UUID, []models.InviteMessage] // userID : []message
 
-	repo note.NoteBaseRepo
-	cfg  config.HubConfig
-	metr metrics.WSMetrics
+	sessions   sync.Map   // noteID : *session
+	sessionsMu sync.Mutex // открытие и закрытие сессий, чтобы подключившийся клиент не получил уже удаляемую сессию
+
+	repo   note.NoteBaseRepo
+	client gen.NoteClient
+	cfg    config.HubConfig
+	metr   metrics.WSMetrics
 }
 
-func NewHub(repo note.NoteBaseRepo, cfg config.HubConfig, metr metrics.WSMetrics) *Hub {
+func NewHub(repo note.NoteBaseRepo, client gen.NoteClient, cfg config.HubConfig, metr metrics.WSMetrics) *Hub {
 	return &Hub{
 		currentOffset: time.Now().UTC(),
 		cache:         ttlcache.New[uuid.UUID, models.CacheMessage](ttlcache.WithTTL[uuid.UUID, models.CacheMessage](cfg.CacheTtl)),
@@ -42,9 +70,10 @@ func NewHub(repo note.NoteBaseRepo, cfg config.HubConfig, metr metrics.WSMetrics
 		currentOffsetMain: time.Now().UTC(),
 		cacheMain:         ttlcache.New[uuid.UUID, []models.InviteMessage](ttlcache.WithTTL[uuid.UUID, []models.InviteMessage](cfg.CacheTtl)),
 
-		repo: repo,
-		cfg:  cfg,
-		metr: metr,
+		repo:   repo,
+		client: client,
+		cfg:    cfg,
+		metr:   metr,
 	}
 }
 
@@ -68,6 +97,20 @@ func (h *Hub) WriteToCache(ctx context.Context, message models.CacheMessage) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
 	h.cache.Set(message.NoteId, message, h.cfg.CacheTtl)
+
+	// заметку сохранили целиком в обход сессии: клиенты получают новый документ, запоздавшие операции отклоняются
+	if value, ok := h.sessions.Load(message.NoteId); ok {
+		current := value.(*session)
+		current.Lock()
+		if err := current.document.Reset(message.MessageInfo); err != nil {
+			logger.Error(err.Error())
+		} else {
+			current.dirty = false
+			h.broadcast(ctx, message.NoteId, h.getDocumentMessage(message.NoteId, current.document))
+		}
+		current.Unlock()
+	}
+
 	logger.Info("cache - new message")
 }
 
@@ -91,23 +134,92 @@ func (h *Hub) WriteToCacheMain(ctx context.Context, invitedID uuid.UUID, message
 
 type CustomClient struct {
 	*websocket.Conn
-	SocketID uuid.UUID
+	SocketID  uuid.UUID
+	UserID    uuid.UUID
+	Username  string
+	ImagePath string
+	Role      string
+
+	writeMu sync.Mutex
+
+	accessMu      sync.Mutex
+	accessChecked time.Time
+
+	cursorMu      sync.Mutex
+	cursorSent    time.Time
+	cursorPending *models.CursorMessage
 }
 
-func NewCustomClient(connection *websocket.Conn) *CustomClient {
+// NewCustomClient - клиент привязывается к пользователю из JWT: идентичность в исходящих сообщениях хаб проставляет сам
+func NewCustomClient(connection *websocket.Conn, payload models.JwtPayload) *CustomClient {
 	return &CustomClient{
 		Conn:     connection,
 		SocketID: uuid.UUID{},
+		UserID:   payload.Id,
+		Username: payload.Username,
+	}
+}
+
+// WriteJSON - запись в сокет из нескольких горутин (рассылки, тикеры, отложенные курсоры) должна быть последовательной
+func (client *CustomClient) WriteJSON(message interface{}) error {
+	client.writeMu.Lock()
+	defer client.writeMu.Unlock()
+
+	return client.Conn.WriteJSON(message)
+}
+
+// SetAccess - роль, уже проверенная при подключении, считается актуальной в течение AccessTtl
+func (client *CustomClient) SetAccess(role string) {
+	client.accessMu.Lock()
+	defer client.accessMu.Unlock()
+
+	client.Role = role
+	client.accessChecked = time.Now()
+}
+
+func (client *CustomClient) getRole() string {
+	client.accessMu.Lock()
+	defer client.accessMu.Unlock()
+
+	return client.Role
+}
+
+func (client *CustomClient) getParticipant() models.Participant {
+	return models.Participant{
+		SocketID:  client.SocketID,
+		UserId:    client.UserID,
+		Username:  client.Username,
+		ImagePath: client.ImagePath,
+		Role:      client.getRole(),
+	}
+}
+
+func (client *CustomClient) getJoinMessage(messageType string, noteID uuid.UUID) models.JoinMessage {
+	return models.JoinMessage{
+		Type:      messageType,
+		NoteId:    noteID,
+		UserId:    client.UserID,
+		SocketID:  client.SocketID,
+		Username:  client.Username,
+		ImagePath: client.ImagePath,
 	}
 }
 
 func (h *Hub) AddClient(ctx context.Context, noteID uuid.UUID, client *CustomClient) {
+	// горутина клиента живет дольше запроса, поэтому контекст запроса не должен ее отменять
+	ctx = context.WithoutCancel(ctx)
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
 	client.SocketID = uuid.NewV4()
 	h.connect.Store(client, noteID)
 	h.metr.IncreaseConnections()
 
+	client.SetCloseHandler(func(code int, text string) error {
+		h.removeClient(ctx, noteID, client)
+		h.metr.DecreaseConnections()
+		return nil
+	})
+
 	go func() {
 		if err := client.WriteJSON(models.SocketIDMessage{
 			Type:     "info",
@@ -116,10 +228,30 @@ func (h *Hub) AddClient(ctx context.Context, noteID uuid.UUID, client *CustomCli
 			logger.Error(ErrHubWrite + err.Error())
 		}
 
+		if err := client.WriteJSON(models.ParticipantsMessage{
+			Type:         "participants",
+			NoteId:       noteID,
+			Participants: h.getParticipants(noteID),
+		}); err != nil {
+			logger.Error(ErrHubWrite + err.Error())
+		}
+		h.broadcastExcept(ctx, noteID, client, client.getJoinMessage(joinMessageType, noteID))
+
+		current, err := h.lockSession(ctx, noteID, client.UserID)
+		if err != nil {
+			logger.Error(err.Error())
+		} else {
+			if err := client.WriteJSON(h.getDocumentMessage(noteID, current.document)); err != nil {
+				logger.Error(ErrHubWrite + err.Error())
+			}
+			current.Unlock()
+		}
+
 		for {
 			messageType, reader, err := client.NextReader()
 			if err != nil {
 				_ = client.Close()
+				h.removeClient(ctx, noteID, client)
 				h.metr.DecreaseConnections()
 				return
 			}
@@ -138,53 +270,594 @@ func (h *Hub) AddClient(ctx context.Context, noteID uuid.UUID, client *CustomCli
 					continue
 				}
 
-				h.connect.Range(func(key, value interface{}) bool {
-					connect := key.(*CustomClient)
-					noteId := value.(uuid.UUID)
+				if !h.authorize(ctx, noteID, client) {
+					logger.Error("message rejected: no access to note")
+					if err := client.WriteJSON(models.RejectMessage{
+						Type:   "removed",
+						NoteId: noteID,
+						Reason: note.ErrNotCollaborator,
+					}); err != nil {
+						logger.Error(ErrHubWrite + err.Error())
+					}
+					h.removeClient(ctx, noteID, client)
+					_ = client.Close()
+					continue
+				}
 
-					if noteId == message.NoteId {
-						if err := connect.WriteJSON(message); err != nil {
-							logger.Error(ErrHubWrite + err.Error())
-						}
+				if !slices.Contains(presenceMessageTypes, message.Type) && !models.HasRole(client.getRole(), models.RoleEditor) {
+					logger.Error("edit rejected: not enough rights")
+					if err := client.WriteJSON(models.RejectMessage{
+						Type:   "rejected",
+						NoteId: noteID,
+						Reason: note.ErrNotEnoughRights,
+					}); err != nil {
+						logger.Error(ErrHubWrite + err.Error())
 					}
+					continue
+				}
 
-					return true
-				})
+				switch message.Type {
+				case operationMessageType:
+					h.receiveOperation(ctx, noteID, client, messageBytes)
+				case cursorMessageType:
+					h.relayCursor(ctx, noteID, client, messageBytes)
+				case joinMessageType, leaveMessageType:
+					// вход и выход отслеживает сам хаб по подключениям
+				default:
+					logger.Error("unsupported message type: " + message.Type)
+				}
 
 			default:
 				logger.Error("received unsupported message type")
 			}
 		}
 	}()
+}
 
-	client.SetCloseHandler(func(code int, text string) error {
-		h.connect.Delete(client)
-		h.metr.DecreaseConnections()
+// resetAccess - следующее сообщение клиента снова проверит доступ
+func (client *CustomClient) resetAccess() {
+	client.accessMu.Lock()
+	defer client.accessMu.Unlock()
+
+	client.accessChecked = time.Time{}
+}
+
+// authorize - доступ клиента к заметке перепроверяется через сервис заметок, результат действует AccessTtl
+func (h *Hub) authorize(ctx context.Context, noteID uuid.UUID, client *CustomClient) bool {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	client.accessMu.Lock()
+	defer client.accessMu.Unlock()
+
+	if !client.accessChecked.IsZero() && time.Since(client.accessChecked) < h.cfg.AccessTtl {
+		return true
+	}
+
+	response, err := h.client.CheckPermissions(ctx, &gen.CheckPermissionsRequest{
+		NoteId: noteID.String(),
+		UserId: client.UserID.String(),
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		return false
+	}
+	if !response.Result {
+		return false
+	}
+
+	client.Role = response.Role
+	client.accessChecked = time.Now()
+	return true
+}
+
+// removeClient - отключение клиента от заметки: остальные участники получают событие выхода
+func (h *Hub) removeClient(ctx context.Context, noteID uuid.UUID, client *CustomClient) {
+	if _, loaded := h.connect.LoadAndDelete(client); !loaded {
+		return
+	}
+
+	client.cursorMu.Lock()
+	client.cursorPending = nil
+	client.cursorMu.Unlock()
+
+	h.broadcast(ctx, noteID, client.getJoinMessage(leaveMessageType, noteID))
+	h.closeSession(ctx, noteID)
+}
+
+func (h *Hub) getParticipants(noteID uuid.UUID) []models.Participant {
+	participants := make([]models.Participant, 0)
+
+	h.connect.Range(func(key, value interface{}) bool {
+		if value.(uuid.UUID) == noteID {
+			participants = append(participants, key.(*CustomClient).getParticipant())
+		}
+
+		return true
+	})
+
+	return participants
+}
+
+// relayCursor - рассылка курсора не чаще раза в CursorPeriod: промежуточные позиции отбрасываются, последняя уходит в конце периода
+func (h *Hub) relayCursor(ctx context.Context, noteID uuid.UUID, client *CustomClient, messageBytes []byte) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	var message models.CursorMessage
+	if err := json.Unmarshal(messageBytes, &message); err != nil {
+		logger.Error("incorrect message format: " + err.Error())
+		return
+	}
+	message.Type = cursorMessageType
+	message.NoteId = noteID
+	message.SocketID = client.SocketID
+	message.UserId = client.UserID
+
+	client.cursorMu.Lock()
+	defer client.cursorMu.Unlock()
+
+	wait := h.cfg.CursorPeriod - time.Since(client.cursorSent)
+	if wait <= 0 {
+		client.cursorSent = time.Now()
+		h.broadcastExcept(ctx, noteID, client, message)
+		return
+	}
+
+	scheduled := client.cursorPending != nil
+	client.cursorPending = &message
+	if !scheduled {
+		time.AfterFunc(wait, func() {
+			h.flushCursor(ctx, noteID, client)
+		})
+	}
+}
+
+func (h *Hub) flushCursor(ctx context.Context, noteID uuid.UUID, client *CustomClient) {
+	client.cursorMu.Lock()
+	defer client.cursorMu.Unlock()
+
+	if client.cursorPending == nil {
+		return
+	}
+
+	client.cursorSent = time.Now()
+	h.broadcastExcept(ctx, noteID, client, *client.cursorPending)
+	client.cursorPending = nil
+}
+
+func (h *Hub) DisconnectUser(ctx context.Context, userID uuid.UUID, noteIDs []uuid.UUID) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	h.connect.Range(func(key, value interface{}) bool {
+		connect := key.(*CustomClient)
+		noteID := value.(uuid.UUID)
+
+		if connect.UserID == userID && slices.Contains(noteIDs, noteID) {
+			if err := connect.WriteJSON(models.RejectMessage{
+				Type:   "removed",
+				NoteId: noteID,
+				Reason: note.ErrNotCollaborator,
+			}); err != nil {
+				logger.Error(ErrHubWrite + err.Error())
+			}
+
+			h.removeClient(ctx, noteID, connect)
+			_ = connect.Close()
+		}
+
+		return true
+	})
+
+	logger.Info("user disconnected")
+}
+
+// RefreshAccess - роль пользователя изменилась: доступ его клиентов перепроверяется сразу, не дожидаясь AccessTtl,
+// а участники заметки получают список с новой ролью
+func (h *Hub) RefreshAccess(ctx context.Context, userID uuid.UUID) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	h.connect.Range(func(key, value interface{}) bool {
+		connect := key.(*CustomClient)
+		noteID := value.(uuid.UUID)
+
+		if connect.UserID != userID {
+			return true
+		}
+
+		connect.resetAccess()
+		if !h.authorize(ctx, noteID, connect) {
+			if err := connect.WriteJSON(models.RejectMessage{
+				Type:   "removed",
+				NoteId: noteID,
+				Reason: note.ErrNotCollaborator,
+			}); err != nil {
+				logger.Error(ErrHubWrite + err.Error())
+			}
+
+			h.removeClient(ctx, noteID, connect)
+			_ = connect.Close()
+			return true
+		}
+
+		h.broadcast(ctx, noteID, models.ParticipantsMessage{
+			Type:         "participants",
+			NoteId:       noteID,
+			Participants: h.getParticipants(noteID),
+		})
+		return true
+	})
+
+	logger.Info("access refreshed")
+}
+
+func (h *Hub) BroadcastComment(ctx context.Context, message models.CommentMessage) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	h.connect.Range(func(key, value interface{}) bool {
+		connect := key.(*CustomClient)
+		noteID := value.(uuid.UUID)
+
+		if noteID == message.NoteId {
+			if err := connect.WriteJSON(message); err != nil {
+				logger.Error(ErrHubWrite + err.Error())
+			}
+		}
+
+		return true
+	})
+
+	logger.Info("comment broadcasted")
+}
+
+func (h *Hub) broadcast(ctx context.Context, noteID uuid.UUID, message interface{}) {
+	h.broadcastExcept(ctx, noteID, nil, message)
+}
+
+func (h *Hub) broadcastExcept(ctx context.Context, noteID uuid.UUID, except *CustomClient, message interface{}) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	h.connect.Range(func(key, value interface{}) bool {
+		connect := key.(*CustomClient)
+
+		if value.(uuid.UUID) == noteID && connect != except {
+			if err := connect.WriteJSON(message); err != nil {
+				logger.Error(ErrHubWrite + err.Error())
+			}
+		}
+
+		return true
+	})
+}
+
+func (h *Hub) getDocumentMessage(noteID uuid.UUID, document *collab.Document) models.DocumentMessage {
+	data, _ := document.Data()
+	return models.DocumentMessage{
+		Type:     "document",
+		NoteId:   noteID,
+		Revision: document.Revision,
+		Data:     data,
+	}
+}
+
+// openSession - сессия заметки, при первом подключении документ загружается из базы
+func (h *Hub) openSession(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) (*session, error) {
+	if value, ok := h.sessions.Load(noteID); ok && !value.(*session).closed.Load() {
+		return value.(*session), nil
+	}
+
+	// закрываемая сессия удаляется под той же блокировкой, поэтому после ожидания документ читается уже сохраненным
+	h.sessionsMu.Lock()
+	defer h.sessionsMu.Unlock()
+
+	if value, ok := h.sessions.Load(noteID); ok {
+		return value.(*session), nil
+	}
+
+	currentNote, err := h.repo.ReadNote(ctx, noteID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := collab.NewDocument(currentNote.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	current := &session{document: document, editorID: userID}
+	h.sessions.Store(noteID, current)
+	return current, nil
+}
+
+// lockSession - открытая сессия заметки под блокировкой
+func (h *Hub) lockSession(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) (*session, error) {
+	for {
+		current, err := h.openSession(ctx, noteID, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		current.Lock()
+		if !current.closed.Load() {
+			return current, nil
+		}
+		current.Unlock()
+	}
+}
+
+func (h *Hub) hasClients(noteID uuid.UUID) bool {
+	connected := false
+	h.connect.Range(func(key, value interface{}) bool {
+		connected = value.(uuid.UUID) == noteID
+		return !connected
+	})
+	return connected
+}
+
+// closeSession - после ухода последнего клиента документ сохраняется и сессия закрывается.
+// Если сохранить не удалось, сессия остается и RunPersist повторяет попытку, чтобы не потерять правки
+func (h *Hub) closeSession(ctx context.Context, noteID uuid.UUID) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	h.sessionsMu.Lock()
+	defer h.sessionsMu.Unlock()
+
+	value, ok := h.sessions.Load(noteID)
+	if !ok || h.hasClients(noteID) {
+		return
+	}
+
+	current := value.(*session)
+	if err := h.persistSession(ctx, noteID, current); err != nil {
+		logger.Error("session kept until saved: " + err.Error())
+		return
+	}
+
+	current.Lock()
+	defer current.Unlock()
+	// правка могла прийти во время сохранения
+	if current.dirty {
+		return
+	}
+	current.closed.Store(true)
+	h.sessions.Delete(noteID)
+}
+
+func (h *Hub) receiveOperation(ctx context.Context, noteID uuid.UUID, client *CustomClient, messageBytes []byte) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	var message models.OperationMessage
+	if err := json.Unmarshal(messageBytes, &message); err != nil {
+		logger.Error("incorrect message format: " + err.Error())
+		return
+	}
+
+	current, err := h.lockSession(ctx, noteID, client.UserID)
+	if err != nil {
+		logger.Error(err.Error())
+		return
+	}
+	defer current.Unlock()
+
+	operation, err := current.document.Receive(message.Operation, message.BaseRevision)
+	if err != nil {
+		logger.Error("operation rejected: " + err.Error())
+		if err := client.WriteJSON(models.RejectMessage{
+			Type:   "rejected",
+			NoteId: noteID,
+			Reason: err.Error(),
+		}); err != nil {
+			logger.Error(ErrHubWrite + err.Error())
+		}
+		// клиент рассинхронизирован и начинает с актуального документа
+		if err := client.WriteJSON(h.getDocumentMessage(noteID, current.document)); err != nil {
+			logger.Error(ErrHubWrite + err.Error())
+		}
+		return
+	}
+
+	current.editorID = client.UserID
+	current.dirty = true
+
+	// рассылка под блокировкой сессии, чтобы все клиенты получали операции в порядке ревизий
+	h.broadcast(ctx, noteID, models.OperationMessage{
+		Type:         operationMessageType,
+		NoteId:       noteID,
+		SocketID:     client.SocketID,
+		UserId:       client.UserID,
+		BaseRevision: message.BaseRevision,
+		Revision:     current.document.Revision,
+		Operation:    operation,
+	})
+}
+
+// persistSession - сохранение документа через сервис заметок, при ошибке документ остается несохраненным до следующей попытки
+func (h *Hub) persistSession(ctx context.Context, noteID uuid.UUID, current *session) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	current.Lock()
+	if !current.dirty {
+		current.Unlock()
 		return nil
+	}
+	data, err := current.document.Data()
+	revision := current.document.Revision
+	editorID := current.editorID
+	current.Unlock()
+
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	_, err = h.client.UpdateNote(ctx, &gen.UpdateNoteRequest{
+		Id:     noteID.String(),
+		UserId: editorID.String(),
+		Data:   data,
 	})
+	// правки редактора, потерявшего доступ, не сохраняются: сессия возвращается к документу из базы
+	if err != nil && isAccessError(err) {
+		logger.Error(err.Error())
+		h.resetSession(ctx, noteID, current, editorID)
+		return nil
+	}
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	current.Lock()
+	if current.document.Revision == revision {
+		current.dirty = false
+	}
+	current.Unlock()
+
+	return nil
+}
+
+// isAccessError - сервис заметок отказал редактору: прав не хватает или заметка ему больше не доступна
+func isAccessError(err error) bool {
+	message := err.Error()
+	return strings.HasSuffix(message, note.ErrNotEnoughRights) ||
+		strings.HasSuffix(message, note.ErrNotCollaborator) ||
+		strings.HasSuffix(message, "not found")
+}
+
+// resetSession - документ сессии заменяется сохраненным в базе, клиенты получают его целиком.
+// Если заметку прочитать не удалось, несохраненные правки просто отбрасываются
+func (h *Hub) resetSession(ctx context.Context, noteID uuid.UUID, current *session, userID uuid.UUID) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	storedNote, err := h.repo.ReadNote(ctx, noteID, userID)
+
+	current.Lock()
+	defer current.Unlock()
+
+	current.dirty = false
+	if err != nil {
+		logger.Error(err.Error())
+		return
+	}
+	if err := current.document.Reset(storedNote.Data); err != nil {
+		logger.Error(err.Error())
+		return
+	}
+	h.broadcast(ctx, noteID, h.getDocumentMessage(noteID, current.document))
+}
+
+func (h *Hub) RunPersist(ctx context.Context) {
+	t := time.NewTicker(h.cfg.PersistPeriod)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-t.C:
+			h.sessions.Range(func(key, value interface{}) bool {
+				noteID := key.(uuid.UUID)
+				// сессия без клиентов осталась после неудачного сохранения
+				if !h.hasClients(noteID) {
+					h.closeSession(ctx, noteID)
+					return true
+				}
+				_ = h.persistSession(ctx, noteID, value.(*session))
+				return true
+			})
+		case <-ctx.Done():
+			return
+		}
+	}
 }
 
 func (h *Hub) AddClientMain(ctx context.Context, invitedID uuid.UUID, client *CustomClient) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
 	client.SocketID = uuid.NewV4()
 	h.connectMain.Store(client, invitedID)
 	h.metr.IncreaseConnections()
 
+	// напоминания, сработавшие пока пользователь был офлайн, досылаются до подтверждения
+	pending, err := h.repo.GetPendingReminders(ctx, invitedID)
+	if err != nil {
+		logger.Error(err.Error())
+	}
+	for _, reminder := range pending {
+		if err := client.WriteJSON(getReminderMessage(reminder)); err != nil {
+			logger.Error(ErrHubWrite + err.Error())
+		}
+	}
+
+	client.SetCloseHandler(func(code int, text string) error {
+		h.removeClientMain(client)
+		return nil
+	})
+
 	go func() {
 		for {
 			_, _, err := client.NextReader()
 			if err != nil {
 				_ = client.Close()
-				h.metr.DecreaseConnections()
+				h.removeClientMain(client)
 				return
 			}
 		}
 	}()
+}
 
-	client.SetCloseHandler(func(code int, text string) error {
-		h.connect.Delete(client)
+// removeClientMain - отключение от общего сокета, повторный вызов после закрытия ничего не делает
+func (h *Hub) removeClientMain(client *CustomClient) {
+	if _, loaded := h.connectMain.LoadAndDelete(client); loaded {
 		h.metr.DecreaseConnections()
-		return nil
-	})
+	}
+}
+
+func getReminderMessage(reminder models.Reminder) models.ReminderMessage {
+	return models.ReminderMessage{
+		Type:       "reminder",
+		ReminderId: reminder.Id,
+		NoteId:     reminder.NoteId,
+		NoteTitle:  reminder.NoteTitle,
+		RemindAt:   reminder.RemindAt,
+		Repeat:     reminder.Repeat,
+	}
+}
+
+func (h *Hub) fireReminders(ctx context.Context) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	reminders, err := h.repo.FireReminders(ctx, time.Now().UTC())
+	if err != nil {
+		logger.Error(err.Error())
+		return
+	}
+
+	for _, reminder := range reminders {
+		message := getReminderMessage(reminder)
+
+		h.connectMain.Range(func(key, value interface{}) bool {
+			connect := key.(*CustomClient)
+			userID := value.(uuid.UUID)
+
+			if userID == reminder.UserId {
+				if err := connect.WriteJSON(message); err != nil {
+					logger.Error(ErrHubWrite + err.Error())
+				}
+			}
+
+			return true
+		})
+	}
+}
+
+func (h *Hub) RunReminders(ctx context.Context) {
+	t := time.NewTicker(h.cfg.ReminderPeriod)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-t.C:
+			h.fireReminders(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
 }
 
 func (h *Hub) Run(ctx context.Context) {
@@ -203,6 +876,11 @@ func (h *Hub) Run(ctx context.Context) {
 				connect := key.(*CustomClient)
 				noteID := value.(uuid.UUID)
 
+				// изменения заметок с открытой сессией расходятся операциями
+				if _, ok := h.sessions.Load(noteID); ok {
+					return true
+				}
+
 				if h.cache.Has(noteID) {
 					message := h.cache.Get(noteID).Value()
 
@@ -3,9 +3,14 @@ package hub
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/models"
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/config"
 	mock_metrics "github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/metrics/mocks"
+	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/note"
+	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/note/delivery/grpc/gen"
+	mock_grpc "github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/note/delivery/grpc/gen/mocks"
 	mock_note "github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/note/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/gorilla/websocket"
@@ -14,6 +19,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -30,7 +36,7 @@ func TestNewHub(t *testing.T) {
 		mockRepo := mock_note.NewMockNoteBaseRepo(ctrl)
 		mockMetrics := mock_metrics.NewMockWSMetrics(ctrl)
 
-		hub := NewHub(mockRepo, hubConfig, mockMetrics)
+		hub := NewHub(mockRepo, nil, hubConfig, mockMetrics)
 		assert.NotEqual(t, time.Time{}, hub.currentOffset)
 	})
 }
@@ -47,7 +53,7 @@ func TestHub_StartCache(t *testing.T) {
 		mockRepo := mock_note.NewMockNoteBaseRepo(ctrl)
 		mockMetrics := mock_metrics.NewMockWSMetrics(ctrl)
 
-		hub := NewHub(mockRepo, hubConfig, mockMetrics)
+		hub := NewHub(mockRepo, nil, hubConfig, mockMetrics)
 		go hub.StartCache(context.Background())
 
 		noteID := uuid.NewV4()
@@ -78,7 +84,7 @@ func TestHub_WriteToCache(t *testing.T) {
 		mockRepo := mock_note.NewMockNoteBaseRepo(ctrl)
 		mockMetrics := mock_metrics.NewMockWSMetrics(ctrl)
 
-		hub := NewHub(mockRepo, hubConfig, mockMetrics)
+		hub := NewHub(mockRepo, nil, hubConfig, mockMetrics)
 		go hub.StartCache(context.Background())
 
 		noteID := uuid.NewV4()
@@ -143,7 +149,7 @@ func echo(w http.ResponseWriter, r *http.Request) {
 //		defer res.Body.Close()
 //		defer connection.Close()
 //
-//		hub := NewHub(mockRepo, hubConfig, mockMetrics)
+//		hub := NewHub(mockRepo, nil, hubConfig, mockMetrics)
 //		go hub.StartCache(context.Background())
 //		defer hub.cache.Stop()
 //
@@ -206,13 +212,13 @@ func TestHub_Run(t *testing.T) {
 		defer res.Body.Close()
 		defer connection.Close()
 
-		hub := NewHub(mockRepo, hubConfig, mockMetrics)
+		hub := NewHub(mockRepo, nil, hubConfig, mockMetrics)
 		go hub.StartCache(context.Background())
 		defer hub.cache.Stop()
 
 		noteID := uuid.NewV4()
 		socketID := uuid.NewV4()
-		customConnection := NewCustomClient(connection)
+		customConnection := NewCustomClient(connection, models.JwtPayload{})
 		customConnection.SocketID = socketID
 		hub.connect.Store(customConnection, noteID)
 
@@ -232,7 +238,9 @@ func TestHub_Run(t *testing.T) {
 
 		mockRepo.EXPECT().GetUpdates(gomock.Any(), gomock.Any(), gomock.Any()).Return([]models.Message{updateMessage}, nil).Times(1)
 
-		go hub.Run(context.Background())
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go hub.Run(ctx)
 
 		hub.WriteToCache(context.Background(), updateCacheMessage)
 
@@ -265,3 +273,790 @@ func TestHub_Run(t *testing.T) {
 		assert.Equal(t, updateMessage.MessageInfo, received2.MessageInfo)
 	})
 }
+
+func TestHub_BroadcastComment(t *testing.T) {
+	hubConfig := config.HubConfig{
+		Period:   100 * time.Millisecond,
+		CacheTtl: 1 * time.Minute,
+	}
+
+	t.Run("hub --> broadcast comment test", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockRepo := mock_note.NewMockNoteBaseRepo(ctrl)
+		mockMetrics := mock_metrics.NewMockWSMetrics(ctrl)
+
+		s := httptest.NewServer(http.HandlerFunc(echo))
+		defer s.Close()
+
+		u := "ws" + strings.TrimPrefix(s.URL, "http")
+		connection, res, err := websocket.DefaultDialer.Dial(u, nil)
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+		defer res.Body.Close()
+		defer connection.Close()
+
+		hub := NewHub(mockRepo, nil, hubConfig, mockMetrics)
+
+		noteID := uuid.NewV4()
+		hub.connect.Store(NewCustomClient(connection, models.JwtPayload{}), noteID)
+
+		commentMessage := models.CommentMessage{
+			Type:   "comment_added",
+			NoteId: noteID,
+			Comment: models.Comment{
+				Id:     uuid.NewV4(),
+				NoteId: noteID,
+				Author: "test",
+				Text:   "TODO review",
+			},
+		}
+
+		hub.BroadcastComment(context.Background(), commentMessage)
+
+		_, byteMessage, err := connection.ReadMessage()
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+
+		received := models.CommentMessage{}
+		if err := json.Unmarshal(byteMessage, &received); err != nil {
+			t.Fatalf("%v", err)
+		}
+
+		assert.Equal(t, commentMessage.Type, received.Type)
+		assert.Equal(t, commentMessage.Comment.Text, received.Comment.Text)
+	})
+}
+
+func TestHub_RunReminders(t *testing.T) {
+	hubConfig := config.HubConfig{
+		Period:         100 * time.Millisecond,
+		CacheTtl:       1 * time.Minute,
+		ReminderPeriod: 100 * time.Millisecond,
+	}
+
+	t.Run("hub --> run reminders test", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockRepo := mock_note.NewMockNoteBaseRepo(ctrl)
+		mockMetrics := mock_metrics.NewMockWSMetrics(ctrl)
+
+		s := httptest.NewServer(http.HandlerFunc(echo))
+		defer s.Close()
+
+		u := "ws" + strings.TrimPrefix(s.URL, "http")
+		connection, res, err := websocket.DefaultDialer.Dial(u, nil)
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+		defer res.Body.Close()
+		defer connection.Close()
+
+		hub := NewHub(mockRepo, nil, hubConfig, mockMetrics)
+
+		userID := uuid.NewV4()
+		hub.connectMain.Store(NewCustomClient(connection, models.JwtPayload{}), userID)
+
+		reminder := models.Reminder{
+			Id:        uuid.NewV4(),
+			NoteId:    uuid.NewV4(),
+			NoteTitle: "title",
+			UserId:    userID,
+			RemindAt:  time.Now().UTC(),
+			Fired:     true,
+		}
+
+		mockRepo.EXPECT().FireReminders(gomock.Any(), gomock.Any()).Return([]models.Reminder{reminder}, nil).Times(1)
+		mockRepo.EXPECT().FireReminders(gomock.Any(), gomock.Any()).Return([]models.Reminder{}, nil).AnyTimes()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go hub.RunReminders(ctx)
+
+		_, byteMessage, err := connection.ReadMessage()
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+
+		received := models.ReminderMessage{}
+		if err := json.Unmarshal(byteMessage, &received); err != nil {
+			t.Fatalf("%v", err)
+		}
+
+		assert.Equal(t, "reminder", received.Type)
+		assert.Equal(t, reminder.Id, received.ReminderId)
+		assert.Equal(t, reminder.NoteTitle, received.NoteTitle)
+	})
+}
+
+func TestHub_AddClientMain_Remove(t *testing.T) {
+	hubConfig := config.HubConfig{
+		Period:   100 * time.Millisecond,
+		CacheTtl: 1 * time.Minute,
+	}
+
+	t.Run("hub --> disconnected main client removed test", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockRepo := mock_note.NewMockNoteBaseRepo(ctrl)
+		mockMetrics := mock_metrics.NewMockWSMetrics(ctrl)
+
+		hub := NewHub(mockRepo, nil, hubConfig, mockMetrics)
+		userID := uuid.NewV4()
+
+		mockRepo.EXPECT().GetPendingReminders(gomock.Any(), userID).Return([]models.Reminder{}, nil).Times(1)
+		mockMetrics.EXPECT().IncreaseConnections().Times(1)
+		mockMetrics.EXPECT().DecreaseConnections().Times(1)
+
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			c, err := upgrader.Upgrade(w, r, nil)
+			if err != nil {
+				return
+			}
+			hub.AddClientMain(context.Background(), userID, NewCustomClient(c, models.JwtPayload{Id: userID}))
+		}))
+		defer s.Close()
+
+		connection := dialEcho(t, s)
+		assert.Eventually(t, func() bool {
+			return anyKey(&hub.connectMain) != nil
+		}, 5*time.Second, 10*time.Millisecond)
+
+		_ = connection.Close()
+		assert.Eventually(t, func() bool {
+			return anyKey(&hub.connectMain) == nil
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+}
+
+func anyKey(m *sync.Map) interface{} {
+	var found interface{}
+	m.Range(func(key, value interface{}) bool {
+		found = key
+		return false
+	})
+	return found
+}
+
+func dialEcho(t *testing.T, s *httptest.Server) *websocket.Conn {
+	u := "ws" + strings.TrimPrefix(s.URL, "http")
+	connection, res, err := websocket.DefaultDialer.Dial(u, nil)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	_ = res.Body.Close()
+	return connection
+}
+
+func readOperation(t *testing.T, connection *websocket.Conn) models.OperationMessage {
+	_ = connection.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, byteMessage, err := connection.ReadMessage()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	received := models.OperationMessage{}
+	if err := json.Unmarshal(byteMessage, &received); err != nil {
+		t.Fatalf("%v", err)
+	}
+	return received
+}
+
+func TestHub_ReceiveOperation(t *testing.T) {
+	hubConfig := config.HubConfig{
+		Period:   100 * time.Millisecond,
+		CacheTtl: 1 * time.Minute,
+	}
+
+	t.Run("hub --> receive operation test", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockRepo := mock_note.NewMockNoteBaseRepo(ctrl)
+		mockClient := mock_grpc.NewMockNoteClient(ctrl)
+		mockMetrics := mock_metrics.NewMockWSMetrics(ctrl)
+
+		s := httptest.NewServer(http.HandlerFunc(echo))
+		defer s.Close()
+
+		first, second := dialEcho(t, s), dialEcho(t, s)
+		defer first.Close()
+		defer second.Close()
+
+		hub := NewHub(mockRepo, mockClient, hubConfig, mockMetrics)
+
+		noteID := uuid.NewV4()
+		firstClient, secondClient := NewCustomClient(first, models.JwtPayload{}), NewCustomClient(second, models.JwtPayload{})
+		firstClient.SocketID, firstClient.UserID = uuid.NewV4(), uuid.NewV4()
+		secondClient.SocketID, secondClient.UserID = uuid.NewV4(), uuid.NewV4()
+		hub.connect.Store(firstClient, noteID)
+		hub.connect.Store(secondClient, noteID)
+
+		mockRepo.EXPECT().ReadNote(gomock.Any(), noteID, firstClient.UserID).Return(models.NoteResponse{
+			Note: models.Note{Id: noteID, Data: `{"title":"title","content":[{"content":"a"}]}`},
+		}, nil).Times(1)
+
+		// оба клиента вставляют блок в начало поверх ревизии 0
+		hub.receiveOperation(context.Background(), noteID, firstClient, []byte(`{"type":"operation","base_revision":0,"operation":{"type":"insert","index":0,"block":{"content":"first"}}}`))
+		hub.receiveOperation(context.Background(), noteID, secondClient, []byte(`{"type":"operation","base_revision":0,"operation":{"type":"insert","index":0,"block":{"content":"second"}}}`))
+
+		for _, connection := range []*websocket.Conn{first, second} {
+			received := readOperation(t, connection)
+			assert.Equal(t, int64(1), received.Revision)
+			assert.Equal(t, firstClient.SocketID, received.SocketID)
+			assert.Equal(t, 0, received.Operation.Index)
+
+			received = readOperation(t, connection)
+			assert.Equal(t, int64(2), received.Revision)
+			assert.Equal(t, secondClient.SocketID, received.SocketID)
+			assert.Equal(t, 1, received.Operation.Index)
+		}
+
+		// операция поверх несуществующей ревизии отклоняется, клиент получает актуальный документ
+		hub.receiveOperation(context.Background(), noteID, firstClient, []byte(`{"type":"operation","base_revision":5,"operation":{"type":"delete","index":0}}`))
+
+		_, byteMessage, err := first.ReadMessage()
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+		rejected := models.RejectMessage{}
+		assert.NoError(t, json.Unmarshal(byteMessage, &rejected))
+		assert.Equal(t, "rejected", rejected.Type)
+
+		_, byteMessage, err = first.ReadMessage()
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+		document := models.DocumentMessage{}
+		assert.NoError(t, json.Unmarshal(byteMessage, &document))
+		assert.Equal(t, int64(2), document.Revision)
+		assert.JSONEq(t, `{"title":"title","content":[{"content":"first"},{"content":"second"},{"content":"a"}]}`, document.Data)
+
+		// сохранение от имени последнего редактора, повторно чистый документ не сохраняется
+		mockClient.EXPECT().UpdateNote(gomock.Any(), &gen.UpdateNoteRequest{
+			Id:     noteID.String(),
+			UserId: secondClient.UserID.String(),
+			Data:   document.Data,
+		}).Return(&gen.UpdateNoteResponse{}, nil).Times(1)
+
+		value, _ := hub.sessions.Load(noteID)
+		hub.persistSession(context.Background(), noteID, value.(*session))
+		hub.persistSession(context.Background(), noteID, value.(*session))
+
+		hub.connect.Delete(firstClient)
+		hub.connect.Delete(secondClient)
+		hub.closeSession(context.Background(), noteID)
+		_, ok := hub.sessions.Load(noteID)
+		assert.False(t, ok)
+	})
+}
+
+func TestHub_CloseSession(t *testing.T) {
+	hubConfig := config.HubConfig{
+		Period:   100 * time.Millisecond,
+		CacheTtl: 1 * time.Minute,
+	}
+
+	t.Run("hub --> session kept until saved test", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockRepo := mock_note.NewMockNoteBaseRepo(ctrl)
+		mockClient := mock_grpc.NewMockNoteClient(ctrl)
+		mockMetrics := mock_metrics.NewMockWSMetrics(ctrl)
+
+		s := httptest.NewServer(http.HandlerFunc(echo))
+		defer s.Close()
+
+		connection := dialEcho(t, s)
+		defer connection.Close()
+
+		hub := NewHub(mockRepo, mockClient, hubConfig, mockMetrics)
+
+		noteID := uuid.NewV4()
+		client := NewCustomClient(connection, models.JwtPayload{Id: uuid.NewV4()})
+		hub.connect.Store(client, noteID)
+
+		mockRepo.EXPECT().ReadNote(gomock.Any(), noteID, client.UserID).Return(models.NoteResponse{
+			Note: models.Note{Id: noteID, Data: `{"title":"title","content":[]}`},
+		}, nil).Times(1)
+
+		hub.receiveOperation(context.Background(), noteID, client, []byte(`{"type":"operation","base_revision":0,"operation":{"type":"title","title":"new"}}`))
+		readOperation(t, connection)
+		hub.connect.Delete(client)
+
+		// сервис заметок недоступен: правки остаются в сессии
+		mockClient.EXPECT().UpdateNote(gomock.Any(), gomock.Any()).Return(nil, errors.New("unavailable")).Times(1)
+		hub.closeSession(context.Background(), noteID)
+		_, ok := hub.sessions.Load(noteID)
+		assert.True(t, ok)
+
+		// редактор потерял доступ: правки отбрасываются, документ не сохраняется от чужого имени, сессия закрывается
+		mockClient.EXPECT().UpdateNote(gomock.Any(), &gen.UpdateNoteRequest{
+			Id:     noteID.String(),
+			UserId: client.UserID.String(),
+			Data:   `{"content":[],"title":"new"}`,
+		}).Return(nil, errors.New(note.ErrNotCollaborator)).Times(1)
+		mockRepo.EXPECT().ReadNote(gomock.Any(), noteID, client.UserID).Return(models.NoteResponse{
+			Note: models.Note{Id: noteID, Data: `{"title":"title","content":[]}`},
+		}, nil).Times(1)
+		hub.closeSession(context.Background(), noteID)
+		_, ok = hub.sessions.Load(noteID)
+		assert.False(t, ok)
+	})
+
+	t.Run("hub --> session reset on lost access test", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockRepo := mock_note.NewMockNoteBaseRepo(ctrl)
+		mockClient := mock_grpc.NewMockNoteClient(ctrl)
+		mockMetrics := mock_metrics.NewMockWSMetrics(ctrl)
+
+		s := httptest.NewServer(http.HandlerFunc(echo))
+		defer s.Close()
+
+		connection := dialEcho(t, s)
+		defer connection.Close()
+
+		hub := NewHub(mockRepo, mockClient, hubConfig, mockMetrics)
+
+		noteID := uuid.NewV4()
+		client := NewCustomClient(connection, models.JwtPayload{Id: uuid.NewV4()})
+		hub.connect.Store(client, noteID)
+
+		mockRepo.EXPECT().ReadNote(gomock.Any(), noteID, client.UserID).Return(models.NoteResponse{
+			Note: models.Note{Id: noteID, Data: `{"title":"title","content":[]}`},
+		}, nil).Times(2)
+		mockClient.EXPECT().UpdateNote(gomock.Any(), gomock.Any()).Return(nil, errors.New(note.ErrNotEnoughRights)).Times(1)
+
+		hub.receiveOperation(context.Background(), noteID, client, []byte(`{"type":"operation","base_revision":0,"operation":{"type":"title","title":"new"}}`))
+		readOperation(t, connection)
+
+		value, _ := hub.sessions.Load(noteID)
+		assert.NoError(t, hub.persistSession(context.Background(), noteID, value.(*session)))
+
+		document := models.DocumentMessage{}
+		readJSON(t, connection, &document)
+		assert.Equal(t, "document", document.Type)
+		assert.JSONEq(t, `{"title":"title","content":[]}`, document.Data)
+		assert.False(t, value.(*session).dirty)
+	})
+}
+
+func TestHub_WriteToCache_ResetSession(t *testing.T) {
+	hubConfig := config.HubConfig{
+		Period:   100 * time.Millisecond,
+		CacheTtl: 1 * time.Minute,
+	}
+
+	t.Run("hub --> write to cache resets session test", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockRepo := mock_note.NewMockNoteBaseRepo(ctrl)
+		mockMetrics := mock_metrics.NewMockWSMetrics(ctrl)
+
+		s := httptest.NewServer(http.HandlerFunc(echo))
+		defer s.Close()
+
+		connection := dialEcho(t, s)
+		defer connection.Close()
+
+		hub := NewHub(mockRepo, nil, hubConfig, mockMetrics)
+
+		noteID := uuid.NewV4()
+		hub.connect.Store(NewCustomClient(connection, models.JwtPayload{}), noteID)
+
+		mockRepo.EXPECT().ReadNote(gomock.Any(), noteID, gomock.Any()).Return(models.NoteResponse{
+			Note: models.Note{Id: noteID, Data: `{"title":"old","content":[]}`},
+		}, nil).Times(1)
+		_, err := hub.openSession(context.Background(), noteID, uuid.NewV4())
+		assert.NoError(t, err)
+
+		hub.WriteToCache(context.Background(), models.CacheMessage{
+			Type:        "updated",
+			NoteId:      noteID,
+			Created:     time.Now().UTC(),
+			MessageInfo: `{"title":"new","content":[]}`,
+		})
+
+		_, byteMessage, err := connection.ReadMessage()
+		if err != nil {
+			t.Fatalf("%v", err)
+		}
+		document := models.DocumentMessage{}
+		assert.NoError(t, json.Unmarshal(byteMessage, &document))
+		assert.Equal(t, "document", document.Type)
+		assert.Equal(t, int64(1), document.Revision)
+		assert.JSONEq(t, `{"title":"new","content":[]}`, document.Data)
+	})
+}
+
+func readJSON(t *testing.T, connection *websocket.Conn, message interface{}) {
+	_ = connection.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, byteMessage, err := connection.ReadMessage()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	if err := json.Unmarshal(byteMessage, message); err != nil {
+		t.Fatalf("%v", err)
+	}
+}
+
+func TestHub_AddClient_Presence(t *testing.T) {
+	hubConfig := config.HubConfig{
+		Period:   100 * time.Millisecond,
+		CacheTtl: 1 * time.Minute,
+	}
+
+	t.Run("hub --> join and leave test", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockRepo := mock_note.NewMockNoteBaseRepo(ctrl)
+		mockMetrics := mock_metrics.NewMockWSMetrics(ctrl)
+
+		mockMetrics.EXPECT().IncreaseConnections().Return().AnyTimes()
+		mockMetrics.EXPECT().DecreaseConnections().Return().AnyTimes()
+
+		hub := NewHub(mockRepo, nil, hubConfig, mockMetrics)
+		noteID := uuid.NewV4()
+
+		mockRepo.EXPECT().ReadNote(gomock.Any(), noteID, gomock.Any()).Return(models.NoteResponse{
+			Note: models.Note{Id: noteID, Data: `{"title":"title","content":[]}`},
+		}, nil).AnyTimes()
+
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			connection, err := upgrader.Upgrade(w, r, nil)
+			if err != nil {
+				return
+			}
+
+			client := NewCustomClient(connection, models.JwtPayload{
+				Id:       uuid.FromStringOrNil(r.URL.Query().Get("user")),
+				Username: r.URL.Query().Get("username"),
+			})
+			client.Role = models.RoleViewer
+			hub.AddClient(context.Background(), noteID, client)
+		}))
+		defer s.Close()
+
+		dial := func(userID uuid.UUID, username string) *websocket.Conn {
+			u := "ws" + strings.TrimPrefix(s.URL, "http") + "?user=" + userID.String() + "&username=" + username
+			connection, res, err := websocket.DefaultDialer.Dial(u, nil)
+			if err != nil {
+				t.Fatalf("%v", err)
+			}
+			_ = res.Body.Close()
+			return connection
+		}
+
+		firstID, secondID := uuid.NewV4(), uuid.NewV4()
+
+		first := dial(firstID, "first")
+		defer first.Close()
+
+		info := models.SocketIDMessage{}
+		readJSON(t, first, &info)
+		participants := models.ParticipantsMessage{}
+		readJSON(t, first, &participants)
+		assert.Equal(t, "participants", participants.Type)
+		assert.Len(t, participants.Participants, 1)
+		assert.Equal(t, firstID, participants.Participants[0].UserId)
+		document := models.DocumentMessage{}
+		readJSON(t, first, &document)
+		assert.Equal(t, "document", document.Type)
+
+		second := dial(secondID, "second")
+
+		secondInfo := models.SocketIDMessage{}
+		readJSON(t, second, &secondInfo)
+		participants = models.ParticipantsMessage{}
+		readJSON(t, second, &participants)
+		assert.Len(t, participants.Participants, 2)
+
+		joined := models.JoinMessage{}
+		readJSON(t, first, &joined)
+		assert.Equal(t, "opened", joined.Type)
+		assert.Equal(t, secondID, joined.UserId)
+		assert.Equal(t, secondInfo.SocketID, joined.SocketID)
+		assert.Equal(t, "second", joined.Username)
+
+		_ = second.Close()
+
+		left := models.JoinMessage{}
+		readJSON(t, first, &left)
+		assert.Equal(t, "closed", left.Type)
+		assert.Equal(t, secondID, left.UserId)
+		assert.Equal(t, secondInfo.SocketID, left.SocketID)
+		assert.Len(t, hub.getParticipants(noteID), 1)
+	})
+}
+
+func TestHub_RelayCursor(t *testing.T) {
+	hubConfig := config.HubConfig{
+		Period:       100 * time.Millisecond,
+		CacheTtl:     1 * time.Minute,
+		CursorPeriod: 100 * time.Millisecond,
+	}
+
+	t.Run("hub --> throttled cursor test", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockRepo := mock_note.NewMockNoteBaseRepo(ctrl)
+		mockMetrics := mock_metrics.NewMockWSMetrics(ctrl)
+
+		s := httptest.NewServer(http.HandlerFunc(echo))
+		defer s.Close()
+
+		first, second := dialEcho(t, s), dialEcho(t, s)
+		defer first.Close()
+		defer second.Close()
+
+		hub := NewHub(mockRepo, nil, hubConfig, mockMetrics)
+
+		noteID := uuid.NewV4()
+		sender, receiver := NewCustomClient(first, models.JwtPayload{}), NewCustomClient(second, models.JwtPayload{})
+		sender.SocketID, sender.UserID = uuid.NewV4(), uuid.NewV4()
+		receiver.SocketID = uuid.NewV4()
+		hub.connect.Store(sender, noteID)
+		hub.connect.Store(receiver, noteID)
+
+		for offset := 1; offset <= 3; offset++ {
+			hub.relayCursor(context.Background(), noteID, sender, []byte(
+				`{"type":"cursor","revision":2,"anchor":{"block":0,"offset":`+fmt.Sprint(offset)+`},"head":{"block":1,"offset":0}}`,
+			))
+		}
+
+		// первая позиция уходит сразу, вторая отбрасывается, последняя приходит по окончании периода
+		cursor := models.CursorMessage{}
+		readJSON(t, second, &cursor)
+		assert.Equal(t, "cursor", cursor.Type)
+		assert.Equal(t, sender.SocketID, cursor.SocketID)
+		assert.Equal(t, sender.UserID, cursor.UserId)
+		assert.Equal(t, noteID, cursor.NoteId)
+		assert.Equal(t, int64(2), cursor.Revision)
+		assert.Equal(t, 1, cursor.Anchor.Offset)
+		assert.Equal(t, models.CursorPosition{Block: 1, Offset: 0}, cursor.Head)
+
+		cursor = models.CursorMessage{}
+		readJSON(t, second, &cursor)
+		assert.Equal(t, 3, cursor.Anchor.Offset)
+
+		// отправитель свой курсор не получает
+		_ = first.SetReadDeadline(time.Now().Add(200 * time.Millisecond))
+		_, _, err := first.ReadMessage()
+		assert.Error(t, err)
+	})
+}
+
+func TestHub_AddClient_Access(t *testing.T) {
+	hubConfig := config.HubConfig{
+		Period:   100 * time.Millisecond,
+		CacheTtl: 1 * time.Minute,
+	}
+
+	t.Run("hub --> stamped identity and revoked access test", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockRepo := mock_note.NewMockNoteBaseRepo(ctrl)
+		mockClient := mock_grpc.NewMockNoteClient(ctrl)
+		mockMetrics := mock_metrics.NewMockWSMetrics(ctrl)
+
+		mockMetrics.EXPECT().IncreaseConnections().Return().AnyTimes()
+		mockMetrics.EXPECT().DecreaseConnections().Return().AnyTimes()
+
+		hub := NewHub(mockRepo, mockClient, hubConfig, mockMetrics)
+		noteID := uuid.NewV4()
+		payload := models.JwtPayload{Id: uuid.NewV4(), Username: "sender"}
+
+		mockRepo.EXPECT().ReadNote(gomock.Any(), noteID, payload.Id).Return(models.NoteResponse{
+			Note: models.Note{Id: noteID, Data: `{"title":"title","content":[]}`},
+		}, nil).AnyTimes()
+
+		permissionsRequest := &gen.CheckPermissionsRequest{NoteId: noteID.String(), UserId: payload.Id.String()}
+		gomock.InOrder(
+			mockClient.EXPECT().CheckPermissions(gomock.Any(), permissionsRequest).Return(&gen.CheckPermissionsResponse{Result: true, Role: models.RoleViewer}, nil),
+			mockClient.EXPECT().CheckPermissions(gomock.Any(), permissionsRequest).Return(&gen.CheckPermissionsResponse{Result: false}, nil),
+		)
+
+		echoServer := httptest.NewServer(http.HandlerFunc(echo))
+		defer echoServer.Close()
+		observer := dialEcho(t, echoServer)
+		defer observer.Close()
+		hub.connect.Store(NewCustomClient(observer, models.JwtPayload{Id: uuid.NewV4()}), noteID)
+
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			connection, err := upgrader.Upgrade(w, r, nil)
+			if err != nil {
+				return
+			}
+			hub.AddClient(context.Background(), noteID, NewCustomClient(connection, payload))
+		}))
+		defer s.Close()
+
+		sender := dialEcho(t, s)
+		defer sender.Close()
+
+		info := models.SocketIDMessage{}
+		readJSON(t, sender, &info)
+		readJSON(t, sender, &models.ParticipantsMessage{})
+		readJSON(t, sender, &models.DocumentMessage{})
+		readJSON(t, observer, &models.JoinMessage{})
+
+		// чужие идентификаторы в сообщении заменяются данными из JWT
+		spoofed := uuid.NewV4()
+		assert.NoError(t, sender.WriteMessage(websocket.TextMessage, []byte(
+			`{"type":"cursor","user_id":"`+spoofed.String()+`","socket_id":"`+spoofed.String()+`","anchor":{"block":0,"offset":1}}`,
+		)))
+
+		cursor := models.CursorMessage{}
+		readJSON(t, observer, &cursor)
+		assert.Equal(t, payload.Id, cursor.UserId)
+		assert.Equal(t, info.SocketID, cursor.SocketID)
+
+		// после отзыва доступа любое сообщение приводит к отключению
+		assert.NoError(t, sender.WriteMessage(websocket.TextMessage, []byte(`{"type":"cursor"}`)))
+
+		removed := models.RejectMessage{}
+		readJSON(t, sender, &removed)
+		assert.Equal(t, "removed", removed.Type)
+
+		left := models.JoinMessage{}
+		readJSON(t, observer, &left)
+		assert.Equal(t, "closed", left.Type)
+		assert.Equal(t, payload.Id, left.UserId)
+		assert.Len(t, hub.getParticipants(noteID), 1)
+	})
+}
+
+func TestHub_RefreshAccess(t *testing.T) {
+	hubConfig := config.HubConfig{
+		Period:    100 * time.Millisecond,
+		CacheTtl:  1 * time.Minute,
+		AccessTtl: 1 * time.Minute,
+	}
+
+	t.Run("hub --> role change pushed to connected clients test", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockRepo := mock_note.NewMockNoteBaseRepo(ctrl)
+		mockClient := mock_grpc.NewMockNoteClient(ctrl)
+		mockMetrics := mock_metrics.NewMockWSMetrics(ctrl)
+
+		hub := NewHub(mockRepo, mockClient, hubConfig, mockMetrics)
+		noteID := uuid.NewV4()
+
+		s := httptest.NewServer(http.HandlerFunc(echo))
+		defer s.Close()
+
+		connection := dialEcho(t, s)
+		defer connection.Close()
+		observer := dialEcho(t, s)
+		defer observer.Close()
+
+		client := NewCustomClient(connection, models.JwtPayload{Id: uuid.NewV4()})
+		client.SetAccess(models.RoleEditor)
+		hub.connect.Store(client, noteID)
+		hub.connect.Store(NewCustomClient(observer, models.JwtPayload{Id: uuid.NewV4()}), noteID)
+
+		permissionsRequest := &gen.CheckPermissionsRequest{NoteId: noteID.String(), UserId: client.UserID.String()}
+		gomock.InOrder(
+			mockClient.EXPECT().CheckPermissions(gomock.Any(), permissionsRequest).Return(&gen.CheckPermissionsResponse{Result: true, Role: models.RoleViewer}, nil),
+			mockClient.EXPECT().CheckPermissions(gomock.Any(), permissionsRequest).Return(&gen.CheckPermissionsResponse{Result: false}, nil),
+		)
+
+		// понижение действует сразу, хотя проверка доступа еще не устарела
+		hub.RefreshAccess(context.Background(), client.UserID)
+		assert.Equal(t, models.RoleViewer, client.getRole())
+
+		participants := models.ParticipantsMessage{}
+		readJSON(t, observer, &participants)
+		assert.Equal(t, "participants", participants.Type)
+		for _, participant := range participants.Participants {
+			if participant.UserId == client.UserID {
+				assert.Equal(t, models.RoleViewer, participant.Role)
+			}
+		}
+		readJSON(t, connection, &models.ParticipantsMessage{})
+
+		// без доступа клиент отключается
+		hub.RefreshAccess(context.Background(), client.UserID)
+
+		left := models.JoinMessage{}
+		readJSON(t, observer, &left)
+		assert.Equal(t, leaveMessageType, left.Type)
+		assert.Equal(t, client.UserID, left.UserId)
+		assert.Len(t, hub.getParticipants(noteID), 1)
+	})
+}
+
+func TestHub_AddClient_CancelledContext(t *testing.T) {
+	hubConfig := config.HubConfig{
+		Period:   100 * time.Millisecond,
+		CacheTtl: 1 * time.Minute,
+	}
+
+	t.Run("hub --> client outlives request context test", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockRepo := mock_note.NewMockNoteBaseRepo(ctrl)
+		mockClient := mock_grpc.NewMockNoteClient(ctrl)
+		mockMetrics := mock_metrics.NewMockWSMetrics(ctrl)
+
+		mockMetrics.EXPECT().IncreaseConnections().Return().AnyTimes()
+		mockMetrics.EXPECT().DecreaseConnections().Return().AnyTimes()
+
+		hub := NewHub(mockRepo, mockClient, hubConfig, mockMetrics)
+		noteID := uuid.NewV4()
+		payload := models.JwtPayload{Id: uuid.NewV4(), Username: "sender"}
+
+		// как и настоящий gRPC-клиент, моки не отвечают на отмененный контекст
+		mockRepo.EXPECT().ReadNote(gomock.Any(), noteID, payload.Id).DoAndReturn(
+			func(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) (models.NoteResponse, error) {
+				if ctx.Err() != nil {
+					return models.NoteResponse{}, ctx.Err()
+				}
+				return models.NoteResponse{Note: models.Note{Id: noteID, Data: `{"title":"title","content":[]}`}}, nil
+			}).AnyTimes()
+		mockClient.EXPECT().CheckPermissions(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, in *gen.CheckPermissionsRequest, opts ...interface{}) (*gen.CheckPermissionsResponse, error) {
+				if ctx.Err() != nil {
+					return nil, ctx.Err()
+				}
+				return &gen.CheckPermissionsResponse{Result: true, Role: models.RoleEditor}, nil
+			}).AnyTimes()
+
+		echoServer := httptest.NewServer(http.HandlerFunc(echo))
+		defer echoServer.Close()
+		observer := dialEcho(t, echoServer)
+		defer observer.Close()
+		hub.connect.Store(NewCustomClient(observer, models.JwtPayload{Id: uuid.NewV4()}), noteID)
+
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			connection, err := upgrader.Upgrade(w, r, nil)
+			if err != nil {
+				return
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			hub.AddClient(ctx, noteID, NewCustomClient(connection, payload))
+		}))
+		defer s.Close()
+
+		sender := dialEcho(t, s)
+		defer sender.Close()
+
+		readJSON(t, sender, &models.SocketIDMessage{})
+		readJSON(t, sender, &models.ParticipantsMessage{})
+		document := models.DocumentMessage{}
+		readJSON(t, sender, &document)
+		assert.Equal(t, "document", document.Type)
+		readJSON(t, observer, &models.JoinMessage{})
+
+		assert.NoError(t, sender.WriteMessage(websocket.TextMessage, []byte(`{"type":"cursor","anchor":{"block":0,"offset":1}}`)))
+
+		cursor := models.CursorMessage{}
+		readJSON(t, observer, &cursor)
+		assert.Equal(t, cursorMessageType, cursor.Type)
+		assert.Equal(t, payload.Id, cursor.UserId)
+	})
+}
@@ -16,5 +16,10 @@ type HubInterface interface {
 	WriteToCacheMain(context.Context, uuid.UUID, models.InviteMessage)
 	AddClient(context.Context, uuid.UUID, *CustomClient)
 	AddClientMain(context.Context, uuid.UUID, *CustomClient)
+	DisconnectUser(ctx context.Context, userID uuid.UUID, noteIDs []uuid.UUID)
+	RefreshAccess(ctx context.Context, userID uuid.UUID)
+	BroadcastComment(ctx context.Context, message models.CommentMessage)
 	Run(context.Context)
+	RunReminders(ctx context.Context)
+	RunPersist(ctx context.Context)
 }
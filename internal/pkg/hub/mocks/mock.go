@@ -61,6 +61,42 @@ func (mr *MockHubInterfaceMockRecorder) AddClientMain(arg0, arg1, arg2 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddClientMain", reflect.TypeOf((*MockHubInterface)(nil).AddClientMain), arg0, arg1, arg2)
 }
 
+// BroadcastComment mocks base method.
+func (m *MockHubInterface) BroadcastComment(ctx context.Context, message models.CommentMessage) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "BroadcastComment", ctx, message)
+}
+
+// BroadcastComment indicates an expected call of BroadcastComment.
+func (mr *MockHubInterfaceMockRecorder) BroadcastComment(ctx, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BroadcastComment", reflect.TypeOf((*MockHubInterface)(nil).BroadcastComment), ctx, message)
+}
+
+// DisconnectUser mocks base method.
+func (m *MockHubInterface) DisconnectUser(ctx context.Context, userID uuid.UUID, noteIDs []uuid.UUID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DisconnectUser", ctx, userID, noteIDs)
+}
+
+// DisconnectUser indicates an expected call of DisconnectUser.
+func (mr *MockHubInterfaceMockRecorder) DisconnectUser(ctx, userID, noteIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisconnectUser", reflect.TypeOf((*MockHubInterface)(nil).DisconnectUser), ctx, userID, noteIDs)
+}
+
+// RefreshAccess mocks base method.
+func (m *MockHubInterface) RefreshAccess(ctx context.Context, userID uuid.UUID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RefreshAccess", ctx, userID)
+}
+
+// RefreshAccess indicates an expected call of RefreshAccess.
+func (mr *MockHubInterfaceMockRecorder) RefreshAccess(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshAccess", reflect.TypeOf((*MockHubInterface)(nil).RefreshAccess), ctx, userID)
+}
+
 // Run mocks base method.
 func (m *MockHubInterface) Run(arg0 context.Context) {
 	m.ctrl.T.Helper()
@@ -73,6 +109,30 @@ func (mr *MockHubInterfaceMockRecorder) Run(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockHubInterface)(nil).Run), arg0)
 }
 
+// RunPersist mocks base method.
+func (m *MockHubInterface) RunPersist(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RunPersist", ctx)
+}
+
+// RunPersist indicates an expected call of RunPersist.
+func (mr *MockHubInterfaceMockRecorder) RunPersist(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunPersist", reflect.TypeOf((*MockHubInterface)(nil).RunPersist), ctx)
+}
+
+// RunReminders mocks base method.
+func (m *MockHubInterface) RunReminders(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RunReminders", ctx)
+}
+
+// RunReminders indicates an expected call of RunReminders.
+func (mr *MockHubInterfaceMockRecorder) RunReminders(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunReminders", reflect.TypeOf((*MockHubInterface)(nil).RunReminders), ctx)
+}
+
 // StartCache mocks base method.
 func (m *MockHubInterface) StartCache(arg0 context.Context) {
 	m.ctrl.T.Helper()
@@ -36,6 +36,66 @@ func (m *MockNoteClient) EXPECT() *MockNoteClientMockRecorder {
 	return m.recorder
 }
 
+// AcceptInvite mocks base method.
+func (m *MockNoteClient) AcceptInvite(ctx context.Context, in *gen.InviteRequest, opts ...grpc.CallOption) (*gen.InviteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AcceptInvite", varargs...)
+	ret0, _ := ret[0].(*gen.InviteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptInvite indicates an expected call of AcceptInvite.
+func (mr *MockNoteClientMockRecorder) AcceptInvite(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvite", reflect.TypeOf((*MockNoteClient)(nil).AcceptInvite), varargs...)
+}
+
+// AcceptTransfer mocks base method.
+func (m *MockNoteClient) AcceptTransfer(ctx context.Context, in *gen.AcceptTransferRequest, opts ...grpc.CallOption) (*gen.AcceptTransferResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AcceptTransfer", varargs...)
+	ret0, _ := ret[0].(*gen.AcceptTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptTransfer indicates an expected call of AcceptTransfer.
+func (mr *MockNoteClientMockRecorder) AcceptTransfer(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptTransfer", reflect.TypeOf((*MockNoteClient)(nil).AcceptTransfer), varargs...)
+}
+
+// AcknowledgeReminder mocks base method.
+func (m *MockNoteClient) AcknowledgeReminder(ctx context.Context, in *gen.ReminderRequest, opts ...grpc.CallOption) (*gen.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AcknowledgeReminder", varargs...)
+	ret0, _ := ret[0].(*gen.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcknowledgeReminder indicates an expected call of AcknowledgeReminder.
+func (mr *MockNoteClientMockRecorder) AcknowledgeReminder(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcknowledgeReminder", reflect.TypeOf((*MockNoteClient)(nil).AcknowledgeReminder), varargs...)
+}
+
 // AddCollaborator mocks base method.
 func (m *MockNoteClient) AddCollaborator(ctx context.Context, in *gen.AddCollaboratorRequest, opts ...grpc.CallOption) (*gen.AddCollaboratorResponse, error) {
 	m.ctrl.T.Helper()
@@ -56,6 +116,26 @@ func (mr *MockNoteClientMockRecorder) AddCollaborator(ctx, in interface{}, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCollaborator", reflect.TypeOf((*MockNoteClient)(nil).AddCollaborator), varargs...)
 }
 
+// AddComment mocks base method.
+func (m *MockNoteClient) AddComment(ctx context.Context, in *gen.AddCommentRequest, opts ...grpc.CallOption) (*gen.CommentResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddComment", varargs...)
+	ret0, _ := ret[0].(*gen.CommentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddComment indicates an expected call of AddComment.
+func (mr *MockNoteClientMockRecorder) AddComment(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddComment", reflect.TypeOf((*MockNoteClient)(nil).AddComment), varargs...)
+}
+
 // AddFav mocks base method.
 func (m *MockNoteClient) AddFav(ctx context.Context, in *gen.ChangeFlagRequest, opts ...grpc.CallOption) (*gen.GetNoteResponse, error) {
 	m.ctrl.T.Helper()
@@ -96,6 +176,26 @@ func (mr *MockNoteClientMockRecorder) AddNote(ctx, in interface{}, opts ...inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNote", reflect.TypeOf((*MockNoteClient)(nil).AddNote), varargs...)
 }
 
+// AddReminder mocks base method.
+func (m *MockNoteClient) AddReminder(ctx context.Context, in *gen.AddReminderRequest, opts ...grpc.CallOption) (*gen.ReminderResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddReminder", varargs...)
+	ret0, _ := ret[0].(*gen.ReminderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddReminder indicates an expected call of AddReminder.
+func (mr *MockNoteClientMockRecorder) AddReminder(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReminder", reflect.TypeOf((*MockNoteClient)(nil).AddReminder), varargs...)
+}
+
 // AddTag mocks base method.
 func (m *MockNoteClient) AddTag(ctx context.Context, in *gen.TagRequest, opts ...grpc.CallOption) (*gen.GetNoteResponse, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +216,46 @@ func (mr *MockNoteClientMockRecorder) AddTag(ctx, in interface{}, opts ...interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTag", reflect.TypeOf((*MockNoteClient)(nil).AddTag), varargs...)
 }
 
+// ArchiveNote mocks base method.
+func (m *MockNoteClient) ArchiveNote(ctx context.Context, in *gen.ChangeFlagRequest, opts ...grpc.CallOption) (*gen.GetNoteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ArchiveNote", varargs...)
+	ret0, _ := ret[0].(*gen.GetNoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveNote indicates an expected call of ArchiveNote.
+func (mr *MockNoteClientMockRecorder) ArchiveNote(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveNote", reflect.TypeOf((*MockNoteClient)(nil).ArchiveNote), varargs...)
+}
+
+// BulkUpdate mocks base method.
+func (m *MockNoteClient) BulkUpdate(ctx context.Context, in *gen.BulkRequest, opts ...grpc.CallOption) (*gen.BulkResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BulkUpdate", varargs...)
+	ret0, _ := ret[0].(*gen.BulkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkUpdate indicates an expected call of BulkUpdate.
+func (mr *MockNoteClientMockRecorder) BulkUpdate(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpdate", reflect.TypeOf((*MockNoteClient)(nil).BulkUpdate), varargs...)
+}
+
 // CheckPermissions mocks base method.
 func (m *MockNoteClient) CheckPermissions(ctx context.Context, in *gen.CheckPermissionsRequest, opts ...grpc.CallOption) (*gen.CheckPermissionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -136,6 +276,46 @@ func (mr *MockNoteClientMockRecorder) CheckPermissions(ctx, in interface{}, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPermissions", reflect.TypeOf((*MockNoteClient)(nil).CheckPermissions), varargs...)
 }
 
+// CreateFromTemplate mocks base method.
+func (m *MockNoteClient) CreateFromTemplate(ctx context.Context, in *gen.CreateFromTemplateRequest, opts ...grpc.CallOption) (*gen.GetNoteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateFromTemplate", varargs...)
+	ret0, _ := ret[0].(*gen.GetNoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFromTemplate indicates an expected call of CreateFromTemplate.
+func (mr *MockNoteClientMockRecorder) CreateFromTemplate(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFromTemplate", reflect.TypeOf((*MockNoteClient)(nil).CreateFromTemplate), varargs...)
+}
+
+// CreateShareLink mocks base method.
+func (m *MockNoteClient) CreateShareLink(ctx context.Context, in *gen.CreateShareLinkRequest, opts ...grpc.CallOption) (*gen.ShareLinkResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateShareLink", varargs...)
+	ret0, _ := ret[0].(*gen.ShareLinkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateShareLink indicates an expected call of CreateShareLink.
+func (mr *MockNoteClientMockRecorder) CreateShareLink(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShareLink", reflect.TypeOf((*MockNoteClient)(nil).CreateShareLink), varargs...)
+}
+
 // CreateSubNote mocks base method.
 func (m *MockNoteClient) CreateSubNote(ctx context.Context, in *gen.CreateSubNoteRequest, opts ...grpc.CallOption) (*gen.CreateSubNoteResponse, error) {
 	m.ctrl.T.Helper()
@@ -156,6 +336,26 @@ func (mr *MockNoteClientMockRecorder) CreateSubNote(ctx, in interface{}, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubNote", reflect.TypeOf((*MockNoteClient)(nil).CreateSubNote), varargs...)
 }
 
+// DeclineInvite mocks base method.
+func (m *MockNoteClient) DeclineInvite(ctx context.Context, in *gen.InviteRequest, opts ...grpc.CallOption) (*gen.InviteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeclineInvite", varargs...)
+	ret0, _ := ret[0].(*gen.InviteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeclineInvite indicates an expected call of DeclineInvite.
+func (mr *MockNoteClientMockRecorder) DeclineInvite(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclineInvite", reflect.TypeOf((*MockNoteClient)(nil).DeclineInvite), varargs...)
+}
+
 // DelFav mocks base method.
 func (m *MockNoteClient) DelFav(ctx context.Context, in *gen.ChangeFlagRequest, opts ...grpc.CallOption) (*gen.GetNoteResponse, error) {
 	m.ctrl.T.Helper()
@@ -176,6 +376,26 @@ func (mr *MockNoteClientMockRecorder) DelFav(ctx, in interface{}, opts ...interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelFav", reflect.TypeOf((*MockNoteClient)(nil).DelFav), varargs...)
 }
 
+// DeleteComment mocks base method.
+func (m *MockNoteClient) DeleteComment(ctx context.Context, in *gen.CommentRequest, opts ...grpc.CallOption) (*gen.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteComment", varargs...)
+	ret0, _ := ret[0].(*gen.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteComment indicates an expected call of DeleteComment.
+func (mr *MockNoteClientMockRecorder) DeleteComment(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockNoteClient)(nil).DeleteComment), varargs...)
+}
+
 // DeleteNote mocks base method.
 func (m *MockNoteClient) DeleteNote(ctx context.Context, in *gen.DeleteNoteRequest, opts ...grpc.CallOption) (*gen.DeleteNoteResponse, error) {
 	m.ctrl.T.Helper()
@@ -196,6 +416,46 @@ func (mr *MockNoteClientMockRecorder) DeleteNote(ctx, in interface{}, opts ...in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNote", reflect.TypeOf((*MockNoteClient)(nil).DeleteNote), varargs...)
 }
 
+// DeleteNotePermanently mocks base method.
+func (m *MockNoteClient) DeleteNotePermanently(ctx context.Context, in *gen.TrashNoteRequest, opts ...grpc.CallOption) (*gen.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteNotePermanently", varargs...)
+	ret0, _ := ret[0].(*gen.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNotePermanently indicates an expected call of DeleteNotePermanently.
+func (mr *MockNoteClientMockRecorder) DeleteNotePermanently(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotePermanently", reflect.TypeOf((*MockNoteClient)(nil).DeleteNotePermanently), varargs...)
+}
+
+// DeleteReminder mocks base method.
+func (m *MockNoteClient) DeleteReminder(ctx context.Context, in *gen.ReminderRequest, opts ...grpc.CallOption) (*gen.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteReminder", varargs...)
+	ret0, _ := ret[0].(*gen.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteReminder indicates an expected call of DeleteReminder.
+func (mr *MockNoteClientMockRecorder) DeleteReminder(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReminder", reflect.TypeOf((*MockNoteClient)(nil).DeleteReminder), varargs...)
+}
+
 // DeleteTag mocks base method.
 func (m *MockNoteClient) DeleteTag(ctx context.Context, in *gen.TagRequest, opts ...grpc.CallOption) (*gen.GetNoteResponse, error) {
 	m.ctrl.T.Helper()
@@ -216,6 +476,26 @@ func (mr *MockNoteClientMockRecorder) DeleteTag(ctx, in interface{}, opts ...int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTag", reflect.TypeOf((*MockNoteClient)(nil).DeleteTag), varargs...)
 }
 
+// DuplicateNote mocks base method.
+func (m *MockNoteClient) DuplicateNote(ctx context.Context, in *gen.DuplicateNoteRequest, opts ...grpc.CallOption) (*gen.GetNoteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DuplicateNote", varargs...)
+	ret0, _ := ret[0].(*gen.GetNoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DuplicateNote indicates an expected call of DuplicateNote.
+func (mr *MockNoteClientMockRecorder) DuplicateNote(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DuplicateNote", reflect.TypeOf((*MockNoteClient)(nil).DuplicateNote), varargs...)
+}
+
 // ForgetTag mocks base method.
 func (m *MockNoteClient) ForgetTag(ctx context.Context, in *gen.AllTagRequest, opts ...grpc.CallOption) (*gen.EmptyResponse, error) {
 	m.ctrl.T.Helper()
@@ -276,155 +556,655 @@ func (mr *MockNoteClientMockRecorder) GetAttachList(ctx, in interface{}, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachList", reflect.TypeOf((*MockNoteClient)(nil).GetAttachList), varargs...)
 }
 
-// GetNote mocks base method.
-func (m *MockNoteClient) GetNote(ctx context.Context, in *gen.GetNoteRequest, opts ...grpc.CallOption) (*gen.GetNoteResponseResponse, error) {
+// GetBacklinks mocks base method.
+func (m *MockNoteClient) GetBacklinks(ctx context.Context, in *gen.GetBacklinksRequest, opts ...grpc.CallOption) (*gen.GetBacklinksResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetNote", varargs...)
-	ret0, _ := ret[0].(*gen.GetNoteResponseResponse)
+	ret := m.ctrl.Call(m, "GetBacklinks", varargs...)
+	ret0, _ := ret[0].(*gen.GetBacklinksResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetNote indicates an expected call of GetNote.
-func (mr *MockNoteClientMockRecorder) GetNote(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// GetBacklinks indicates an expected call of GetBacklinks.
+func (mr *MockNoteClientMockRecorder) GetBacklinks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNote", reflect.TypeOf((*MockNoteClient)(nil).GetNote), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBacklinks", reflect.TypeOf((*MockNoteClient)(nil).GetBacklinks), varargs...)
 }
 
-// GetPublicNote mocks base method.
-func (m *MockNoteClient) GetPublicNote(ctx context.Context, in *gen.GetPublicNoteRequest, opts ...grpc.CallOption) (*gen.GetNoteResponseResponse, error) {
+// GetComments mocks base method.
+func (m *MockNoteClient) GetComments(ctx context.Context, in *gen.GetCommentsRequest, opts ...grpc.CallOption) (*gen.GetCommentsResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetPublicNote", varargs...)
-	ret0, _ := ret[0].(*gen.GetNoteResponseResponse)
+	ret := m.ctrl.Call(m, "GetComments", varargs...)
+	ret0, _ := ret[0].(*gen.GetCommentsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPublicNote indicates an expected call of GetPublicNote.
-func (mr *MockNoteClientMockRecorder) GetPublicNote(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// GetComments indicates an expected call of GetComments.
+func (mr *MockNoteClientMockRecorder) GetComments(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicNote", reflect.TypeOf((*MockNoteClient)(nil).GetPublicNote), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComments", reflect.TypeOf((*MockNoteClient)(nil).GetComments), varargs...)
 }
 
-// GetSharedAttachList mocks base method.
-func (m *MockNoteClient) GetSharedAttachList(ctx context.Context, in *gen.GetSharedAttachListRequest, opts ...grpc.CallOption) (*gen.GetAttachListResponse, error) {
+// GetNote mocks base method.
+func (m *MockNoteClient) GetNote(ctx context.Context, in *gen.GetNoteRequest, opts ...grpc.CallOption) (*gen.GetNoteResponseResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetSharedAttachList", varargs...)
-	ret0, _ := ret[0].(*gen.GetAttachListResponse)
+	ret := m.ctrl.Call(m, "GetNote", varargs...)
+	ret0, _ := ret[0].(*gen.GetNoteResponseResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSharedAttachList indicates an expected call of GetSharedAttachList.
-func (mr *MockNoteClientMockRecorder) GetSharedAttachList(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// GetNote indicates an expected call of GetNote.
+func (mr *MockNoteClientMockRecorder) GetNote(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharedAttachList", reflect.TypeOf((*MockNoteClient)(nil).GetSharedAttachList), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNote", reflect.TypeOf((*MockNoteClient)(nil).GetNote), varargs...)
 }
 
-// GetTags mocks base method.
-func (m *MockNoteClient) GetTags(ctx context.Context, in *gen.GetTagsRequest, opts ...grpc.CallOption) (*gen.GetTagsResponse, error) {
+// GetNoteGraph mocks base method.
+func (m *MockNoteClient) GetNoteGraph(ctx context.Context, in *gen.GetNoteGraphRequest, opts ...grpc.CallOption) (*gen.GetNoteGraphResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetTags", varargs...)
-	ret0, _ := ret[0].(*gen.GetTagsResponse)
+	ret := m.ctrl.Call(m, "GetNoteGraph", varargs...)
+	ret0, _ := ret[0].(*gen.GetNoteGraphResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTags indicates an expected call of GetTags.
-func (mr *MockNoteClientMockRecorder) GetTags(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// GetNoteGraph indicates an expected call of GetNoteGraph.
+func (mr *MockNoteClientMockRecorder) GetNoteGraph(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTags", reflect.TypeOf((*MockNoteClient)(nil).GetTags), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNoteGraph", reflect.TypeOf((*MockNoteClient)(nil).GetNoteGraph), varargs...)
 }
 
-// RememberTag mocks base method.
-func (m *MockNoteClient) RememberTag(ctx context.Context, in *gen.AllTagRequest, opts ...grpc.CallOption) (*gen.EmptyResponse, error) {
+// GetPublicNote mocks base method.
+func (m *MockNoteClient) GetPublicNote(ctx context.Context, in *gen.GetPublicNoteRequest, opts ...grpc.CallOption) (*gen.GetNoteResponseResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "RememberTag", varargs...)
-	ret0, _ := ret[0].(*gen.EmptyResponse)
+	ret := m.ctrl.Call(m, "GetPublicNote", varargs...)
+	ret0, _ := ret[0].(*gen.GetNoteResponseResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RememberTag indicates an expected call of RememberTag.
-func (mr *MockNoteClientMockRecorder) RememberTag(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// GetPublicNote indicates an expected call of GetPublicNote.
+func (mr *MockNoteClientMockRecorder) GetPublicNote(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RememberTag", reflect.TypeOf((*MockNoteClient)(nil).RememberTag), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicNote", reflect.TypeOf((*MockNoteClient)(nil).GetPublicNote), varargs...)
 }
 
-// SetHeader mocks base method.
-func (m *MockNoteClient) SetHeader(ctx context.Context, in *gen.SetHeaderRequest, opts ...grpc.CallOption) (*gen.GetNoteResponse, error) {
+// GetReceivedInvites mocks base method.
+func (m *MockNoteClient) GetReceivedInvites(ctx context.Context, in *gen.GetInvitesRequest, opts ...grpc.CallOption) (*gen.GetInvitesResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SetHeader", varargs...)
-	ret0, _ := ret[0].(*gen.GetNoteResponse)
+	ret := m.ctrl.Call(m, "GetReceivedInvites", varargs...)
+	ret0, _ := ret[0].(*gen.GetInvitesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SetHeader indicates an expected call of SetHeader.
-func (mr *MockNoteClientMockRecorder) SetHeader(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// GetReceivedInvites indicates an expected call of GetReceivedInvites.
+func (mr *MockNoteClientMockRecorder) GetReceivedInvites(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockNoteClient)(nil).SetHeader), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceivedInvites", reflect.TypeOf((*MockNoteClient)(nil).GetReceivedInvites), varargs...)
 }
 
-// SetIcon mocks base method.
-func (m *MockNoteClient) SetIcon(ctx context.Context, in *gen.SetIconRequest, opts ...grpc.CallOption) (*gen.GetNoteResponse, error) {
+// GetReminders mocks base method.
+func (m *MockNoteClient) GetReminders(ctx context.Context, in *gen.GetRemindersRequest, opts ...grpc.CallOption) (*gen.GetRemindersResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SetIcon", varargs...)
-	ret0, _ := ret[0].(*gen.GetNoteResponse)
+	ret := m.ctrl.Call(m, "GetReminders", varargs...)
+	ret0, _ := ret[0].(*gen.GetRemindersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SetIcon indicates an expected call of SetIcon.
-func (mr *MockNoteClientMockRecorder) SetIcon(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// GetReminders indicates an expected call of GetReminders.
+func (mr *MockNoteClientMockRecorder) GetReminders(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIcon", reflect.TypeOf((*MockNoteClient)(nil).SetIcon), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReminders", reflect.TypeOf((*MockNoteClient)(nil).GetReminders), varargs...)
 }
 
-// SetPrivate mocks base method.
-func (m *MockNoteClient) SetPrivate(ctx context.Context, in *gen.AccessModeRequest, opts ...grpc.CallOption) (*gen.GetNoteResponse, error) {
+// GetRevision mocks base method.
+func (m *MockNoteClient) GetRevision(ctx context.Context, in *gen.RevisionRequest, opts ...grpc.CallOption) (*gen.GetRevisionResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SetPrivate", varargs...)
-	ret0, _ := ret[0].(*gen.GetNoteResponse)
+	ret := m.ctrl.Call(m, "GetRevision", varargs...)
+	ret0, _ := ret[0].(*gen.GetRevisionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevision indicates an expected call of GetRevision.
+func (mr *MockNoteClientMockRecorder) GetRevision(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevision", reflect.TypeOf((*MockNoteClient)(nil).GetRevision), varargs...)
+}
+
+// GetRevisionDiff mocks base method.
+func (m *MockNoteClient) GetRevisionDiff(ctx context.Context, in *gen.GetRevisionDiffRequest, opts ...grpc.CallOption) (*gen.GetRevisionDiffResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRevisionDiff", varargs...)
+	ret0, _ := ret[0].(*gen.GetRevisionDiffResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisionDiff indicates an expected call of GetRevisionDiff.
+func (mr *MockNoteClientMockRecorder) GetRevisionDiff(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisionDiff", reflect.TypeOf((*MockNoteClient)(nil).GetRevisionDiff), varargs...)
+}
+
+// GetRevisions mocks base method.
+func (m *MockNoteClient) GetRevisions(ctx context.Context, in *gen.GetRevisionsRequest, opts ...grpc.CallOption) (*gen.GetRevisionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRevisions", varargs...)
+	ret0, _ := ret[0].(*gen.GetRevisionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisions indicates an expected call of GetRevisions.
+func (mr *MockNoteClientMockRecorder) GetRevisions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisions", reflect.TypeOf((*MockNoteClient)(nil).GetRevisions), varargs...)
+}
+
+// GetSentInvites mocks base method.
+func (m *MockNoteClient) GetSentInvites(ctx context.Context, in *gen.GetInvitesRequest, opts ...grpc.CallOption) (*gen.GetInvitesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSentInvites", varargs...)
+	ret0, _ := ret[0].(*gen.GetInvitesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSentInvites indicates an expected call of GetSentInvites.
+func (mr *MockNoteClientMockRecorder) GetSentInvites(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSentInvites", reflect.TypeOf((*MockNoteClient)(nil).GetSentInvites), varargs...)
+}
+
+// GetShareLinks mocks base method.
+func (m *MockNoteClient) GetShareLinks(ctx context.Context, in *gen.GetShareLinksRequest, opts ...grpc.CallOption) (*gen.GetShareLinksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetShareLinks", varargs...)
+	ret0, _ := ret[0].(*gen.GetShareLinksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShareLinks indicates an expected call of GetShareLinks.
+func (mr *MockNoteClientMockRecorder) GetShareLinks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShareLinks", reflect.TypeOf((*MockNoteClient)(nil).GetShareLinks), varargs...)
+}
+
+// GetSharedAttachList mocks base method.
+func (m *MockNoteClient) GetSharedAttachList(ctx context.Context, in *gen.GetSharedAttachListRequest, opts ...grpc.CallOption) (*gen.GetAttachListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSharedAttachList", varargs...)
+	ret0, _ := ret[0].(*gen.GetAttachListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSharedAttachList indicates an expected call of GetSharedAttachList.
+func (mr *MockNoteClientMockRecorder) GetSharedAttachList(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharedAttachList", reflect.TypeOf((*MockNoteClient)(nil).GetSharedAttachList), varargs...)
+}
+
+// GetTags mocks base method.
+func (m *MockNoteClient) GetTags(ctx context.Context, in *gen.GetTagsRequest, opts ...grpc.CallOption) (*gen.GetTagsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTags", varargs...)
+	ret0, _ := ret[0].(*gen.GetTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTags indicates an expected call of GetTags.
+func (mr *MockNoteClientMockRecorder) GetTags(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTags", reflect.TypeOf((*MockNoteClient)(nil).GetTags), varargs...)
+}
+
+// GetTemplates mocks base method.
+func (m *MockNoteClient) GetTemplates(ctx context.Context, in *gen.GetTemplatesRequest, opts ...grpc.CallOption) (*gen.GetTemplatesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTemplates", varargs...)
+	ret0, _ := ret[0].(*gen.GetTemplatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplates indicates an expected call of GetTemplates.
+func (mr *MockNoteClientMockRecorder) GetTemplates(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplates", reflect.TypeOf((*MockNoteClient)(nil).GetTemplates), varargs...)
+}
+
+// GetTrash mocks base method.
+func (m *MockNoteClient) GetTrash(ctx context.Context, in *gen.GetTrashRequest, opts ...grpc.CallOption) (*gen.GetTrashResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTrash", varargs...)
+	ret0, _ := ret[0].(*gen.GetTrashResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrash indicates an expected call of GetTrash.
+func (mr *MockNoteClientMockRecorder) GetTrash(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrash", reflect.TypeOf((*MockNoteClient)(nil).GetTrash), varargs...)
+}
+
+// GetUpcomingReminders mocks base method.
+func (m *MockNoteClient) GetUpcomingReminders(ctx context.Context, in *gen.GetUpcomingRemindersRequest, opts ...grpc.CallOption) (*gen.GetRemindersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUpcomingReminders", varargs...)
+	ret0, _ := ret[0].(*gen.GetRemindersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUpcomingReminders indicates an expected call of GetUpcomingReminders.
+func (mr *MockNoteClientMockRecorder) GetUpcomingReminders(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpcomingReminders", reflect.TypeOf((*MockNoteClient)(nil).GetUpcomingReminders), varargs...)
+}
+
+// LeaveNote mocks base method.
+func (m *MockNoteClient) LeaveNote(ctx context.Context, in *gen.LeaveNoteRequest, opts ...grpc.CallOption) (*gen.LeaveNoteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LeaveNote", varargs...)
+	ret0, _ := ret[0].(*gen.LeaveNoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LeaveNote indicates an expected call of LeaveNote.
+func (mr *MockNoteClientMockRecorder) LeaveNote(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveNote", reflect.TypeOf((*MockNoteClient)(nil).LeaveNote), varargs...)
+}
+
+// MergeTags mocks base method.
+func (m *MockNoteClient) MergeTags(ctx context.Context, in *gen.MergeTagsRequest, opts ...grpc.CallOption) (*gen.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MergeTags", varargs...)
+	ret0, _ := ret[0].(*gen.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeTags indicates an expected call of MergeTags.
+func (mr *MockNoteClientMockRecorder) MergeTags(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeTags", reflect.TypeOf((*MockNoteClient)(nil).MergeTags), varargs...)
+}
+
+// MoveNote mocks base method.
+func (m *MockNoteClient) MoveNote(ctx context.Context, in *gen.MoveNoteRequest, opts ...grpc.CallOption) (*gen.GetNoteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MoveNote", varargs...)
+	ret0, _ := ret[0].(*gen.GetNoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveNote indicates an expected call of MoveNote.
+func (mr *MockNoteClientMockRecorder) MoveNote(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveNote", reflect.TypeOf((*MockNoteClient)(nil).MoveNote), varargs...)
+}
+
+// ProposeTransfer mocks base method.
+func (m *MockNoteClient) ProposeTransfer(ctx context.Context, in *gen.ProposeTransferRequest, opts ...grpc.CallOption) (*gen.ProposeTransferResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ProposeTransfer", varargs...)
+	ret0, _ := ret[0].(*gen.ProposeTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProposeTransfer indicates an expected call of ProposeTransfer.
+func (mr *MockNoteClientMockRecorder) ProposeTransfer(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposeTransfer", reflect.TypeOf((*MockNoteClient)(nil).ProposeTransfer), varargs...)
+}
+
+// RememberTag mocks base method.
+func (m *MockNoteClient) RememberTag(ctx context.Context, in *gen.AllTagRequest, opts ...grpc.CallOption) (*gen.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RememberTag", varargs...)
+	ret0, _ := ret[0].(*gen.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RememberTag indicates an expected call of RememberTag.
+func (mr *MockNoteClientMockRecorder) RememberTag(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RememberTag", reflect.TypeOf((*MockNoteClient)(nil).RememberTag), varargs...)
+}
+
+// RemoveCollaborator mocks base method.
+func (m *MockNoteClient) RemoveCollaborator(ctx context.Context, in *gen.RemoveCollaboratorRequest, opts ...grpc.CallOption) (*gen.RemoveCollaboratorResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveCollaborator", varargs...)
+	ret0, _ := ret[0].(*gen.RemoveCollaboratorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveCollaborator indicates an expected call of RemoveCollaborator.
+func (mr *MockNoteClientMockRecorder) RemoveCollaborator(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCollaborator", reflect.TypeOf((*MockNoteClient)(nil).RemoveCollaborator), varargs...)
+}
+
+// ReorderNotes mocks base method.
+func (m *MockNoteClient) ReorderNotes(ctx context.Context, in *gen.ReorderNotesRequest, opts ...grpc.CallOption) (*gen.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReorderNotes", varargs...)
+	ret0, _ := ret[0].(*gen.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderNotes indicates an expected call of ReorderNotes.
+func (mr *MockNoteClientMockRecorder) ReorderNotes(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderNotes", reflect.TypeOf((*MockNoteClient)(nil).ReorderNotes), varargs...)
+}
+
+// RestoreNote mocks base method.
+func (m *MockNoteClient) RestoreNote(ctx context.Context, in *gen.TrashNoteRequest, opts ...grpc.CallOption) (*gen.GetNoteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreNote", varargs...)
+	ret0, _ := ret[0].(*gen.GetNoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreNote indicates an expected call of RestoreNote.
+func (mr *MockNoteClientMockRecorder) RestoreNote(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreNote", reflect.TypeOf((*MockNoteClient)(nil).RestoreNote), varargs...)
+}
+
+// RestoreRevision mocks base method.
+func (m *MockNoteClient) RestoreRevision(ctx context.Context, in *gen.RevisionRequest, opts ...grpc.CallOption) (*gen.GetNoteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreRevision", varargs...)
+	ret0, _ := ret[0].(*gen.GetNoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreRevision indicates an expected call of RestoreRevision.
+func (mr *MockNoteClientMockRecorder) RestoreRevision(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreRevision", reflect.TypeOf((*MockNoteClient)(nil).RestoreRevision), varargs...)
+}
+
+// RevokeInvite mocks base method.
+func (m *MockNoteClient) RevokeInvite(ctx context.Context, in *gen.InviteRequest, opts ...grpc.CallOption) (*gen.InviteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeInvite", varargs...)
+	ret0, _ := ret[0].(*gen.InviteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeInvite indicates an expected call of RevokeInvite.
+func (mr *MockNoteClientMockRecorder) RevokeInvite(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeInvite", reflect.TypeOf((*MockNoteClient)(nil).RevokeInvite), varargs...)
+}
+
+// RevokeShareLink mocks base method.
+func (m *MockNoteClient) RevokeShareLink(ctx context.Context, in *gen.RevokeShareLinkRequest, opts ...grpc.CallOption) (*gen.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeShareLink", varargs...)
+	ret0, _ := ret[0].(*gen.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeShareLink indicates an expected call of RevokeShareLink.
+func (mr *MockNoteClientMockRecorder) RevokeShareLink(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeShareLink", reflect.TypeOf((*MockNoteClient)(nil).RevokeShareLink), varargs...)
+}
+
+// SetCollaboratorRole mocks base method.
+func (m *MockNoteClient) SetCollaboratorRole(ctx context.Context, in *gen.SetCollaboratorRoleRequest, opts ...grpc.CallOption) (*gen.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetCollaboratorRole", varargs...)
+	ret0, _ := ret[0].(*gen.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetCollaboratorRole indicates an expected call of SetCollaboratorRole.
+func (mr *MockNoteClientMockRecorder) SetCollaboratorRole(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCollaboratorRole", reflect.TypeOf((*MockNoteClient)(nil).SetCollaboratorRole), varargs...)
+}
+
+// SetCommentResolved mocks base method.
+func (m *MockNoteClient) SetCommentResolved(ctx context.Context, in *gen.SetCommentResolvedRequest, opts ...grpc.CallOption) (*gen.CommentResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetCommentResolved", varargs...)
+	ret0, _ := ret[0].(*gen.CommentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetCommentResolved indicates an expected call of SetCommentResolved.
+func (mr *MockNoteClientMockRecorder) SetCommentResolved(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCommentResolved", reflect.TypeOf((*MockNoteClient)(nil).SetCommentResolved), varargs...)
+}
+
+// SetHeader mocks base method.
+func (m *MockNoteClient) SetHeader(ctx context.Context, in *gen.SetHeaderRequest, opts ...grpc.CallOption) (*gen.GetNoteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetHeader", varargs...)
+	ret0, _ := ret[0].(*gen.GetNoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockNoteClientMockRecorder) SetHeader(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockNoteClient)(nil).SetHeader), varargs...)
+}
+
+// SetIcon mocks base method.
+func (m *MockNoteClient) SetIcon(ctx context.Context, in *gen.SetIconRequest, opts ...grpc.CallOption) (*gen.GetNoteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetIcon", varargs...)
+	ret0, _ := ret[0].(*gen.GetNoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetIcon indicates an expected call of SetIcon.
+func (mr *MockNoteClientMockRecorder) SetIcon(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIcon", reflect.TypeOf((*MockNoteClient)(nil).SetIcon), varargs...)
+}
+
+// SetPrivate mocks base method.
+func (m *MockNoteClient) SetPrivate(ctx context.Context, in *gen.AccessModeRequest, opts ...grpc.CallOption) (*gen.GetNoteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetPrivate", varargs...)
+	ret0, _ := ret[0].(*gen.GetNoteResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -432,346 +1212,1011 @@ func (m *MockNoteClient) SetPrivate(ctx context.Context, in *gen.AccessModeReque
 // SetPrivate indicates an expected call of SetPrivate.
 func (mr *MockNoteClientMockRecorder) SetPrivate(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPrivate", reflect.TypeOf((*MockNoteClient)(nil).SetPrivate), varargs...)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPrivate", reflect.TypeOf((*MockNoteClient)(nil).SetPrivate), varargs...)
+}
+
+// SetPublic mocks base method.
+func (m *MockNoteClient) SetPublic(ctx context.Context, in *gen.AccessModeRequest, opts ...grpc.CallOption) (*gen.GetNoteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetPublic", varargs...)
+	ret0, _ := ret[0].(*gen.GetNoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPublic indicates an expected call of SetPublic.
+func (mr *MockNoteClientMockRecorder) SetPublic(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPublic", reflect.TypeOf((*MockNoteClient)(nil).SetPublic), varargs...)
+}
+
+// SetTagInfo mocks base method.
+func (m *MockNoteClient) SetTagInfo(ctx context.Context, in *gen.TagInfoRequest, opts ...grpc.CallOption) (*gen.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetTagInfo", varargs...)
+	ret0, _ := ret[0].(*gen.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTagInfo indicates an expected call of SetTagInfo.
+func (mr *MockNoteClientMockRecorder) SetTagInfo(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTagInfo", reflect.TypeOf((*MockNoteClient)(nil).SetTagInfo), varargs...)
+}
+
+// SetTemplate mocks base method.
+func (m *MockNoteClient) SetTemplate(ctx context.Context, in *gen.SetTemplateRequest, opts ...grpc.CallOption) (*gen.GetNoteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetTemplate", varargs...)
+	ret0, _ := ret[0].(*gen.GetNoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTemplate indicates an expected call of SetTemplate.
+func (mr *MockNoteClientMockRecorder) SetTemplate(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTemplate", reflect.TypeOf((*MockNoteClient)(nil).SetTemplate), varargs...)
+}
+
+// UnarchiveNote mocks base method.
+func (m *MockNoteClient) UnarchiveNote(ctx context.Context, in *gen.ChangeFlagRequest, opts ...grpc.CallOption) (*gen.GetNoteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnarchiveNote", varargs...)
+	ret0, _ := ret[0].(*gen.GetNoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnarchiveNote indicates an expected call of UnarchiveNote.
+func (mr *MockNoteClientMockRecorder) UnarchiveNote(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnarchiveNote", reflect.TypeOf((*MockNoteClient)(nil).UnarchiveNote), varargs...)
+}
+
+// UpdateComment mocks base method.
+func (m *MockNoteClient) UpdateComment(ctx context.Context, in *gen.UpdateCommentRequest, opts ...grpc.CallOption) (*gen.CommentResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateComment", varargs...)
+	ret0, _ := ret[0].(*gen.CommentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateComment indicates an expected call of UpdateComment.
+func (mr *MockNoteClientMockRecorder) UpdateComment(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateComment", reflect.TypeOf((*MockNoteClient)(nil).UpdateComment), varargs...)
+}
+
+// UpdateNote mocks base method.
+func (m *MockNoteClient) UpdateNote(ctx context.Context, in *gen.UpdateNoteRequest, opts ...grpc.CallOption) (*gen.UpdateNoteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateNote", varargs...)
+	ret0, _ := ret[0].(*gen.UpdateNoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNote indicates an expected call of UpdateNote.
+func (mr *MockNoteClientMockRecorder) UpdateNote(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNote", reflect.TypeOf((*MockNoteClient)(nil).UpdateNote), varargs...)
+}
+
+// UpdateTag mocks base method.
+func (m *MockNoteClient) UpdateTag(ctx context.Context, in *gen.UpdateTagRequest, opts ...grpc.CallOption) (*gen.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTag", varargs...)
+	ret0, _ := ret[0].(*gen.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTag indicates an expected call of UpdateTag.
+func (mr *MockNoteClientMockRecorder) UpdateTag(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTag", reflect.TypeOf((*MockNoteClient)(nil).UpdateTag), varargs...)
+}
+
+// MockNoteServer is a mock of NoteServer interface.
+type MockNoteServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockNoteServerMockRecorder
+}
+
+// MockNoteServerMockRecorder is the mock recorder for MockNoteServer.
+type MockNoteServerMockRecorder struct {
+	mock *MockNoteServer
+}
+
+// NewMockNoteServer creates a new mock instance.
+func NewMockNoteServer(ctrl *gomock.Controller) *MockNoteServer {
+	mock := &MockNoteServer{ctrl: ctrl}
+	mock.recorder = &MockNoteServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNoteServer) EXPECT() *MockNoteServerMockRecorder {
+	return m.recorder
+}
+
+// AcceptInvite mocks base method.
+func (m *MockNoteServer) AcceptInvite(arg0 context.Context, arg1 *gen.InviteRequest) (*gen.InviteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvite", arg0, arg1)
+	ret0, _ := ret[0].(*gen.InviteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptInvite indicates an expected call of AcceptInvite.
+func (mr *MockNoteServerMockRecorder) AcceptInvite(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvite", reflect.TypeOf((*MockNoteServer)(nil).AcceptInvite), arg0, arg1)
+}
+
+// AcceptTransfer mocks base method.
+func (m *MockNoteServer) AcceptTransfer(arg0 context.Context, arg1 *gen.AcceptTransferRequest) (*gen.AcceptTransferResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptTransfer", arg0, arg1)
+	ret0, _ := ret[0].(*gen.AcceptTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptTransfer indicates an expected call of AcceptTransfer.
+func (mr *MockNoteServerMockRecorder) AcceptTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptTransfer", reflect.TypeOf((*MockNoteServer)(nil).AcceptTransfer), arg0, arg1)
+}
+
+// AcknowledgeReminder mocks base method.
+func (m *MockNoteServer) AcknowledgeReminder(arg0 context.Context, arg1 *gen.ReminderRequest) (*gen.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcknowledgeReminder", arg0, arg1)
+	ret0, _ := ret[0].(*gen.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcknowledgeReminder indicates an expected call of AcknowledgeReminder.
+func (mr *MockNoteServerMockRecorder) AcknowledgeReminder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcknowledgeReminder", reflect.TypeOf((*MockNoteServer)(nil).AcknowledgeReminder), arg0, arg1)
+}
+
+// AddCollaborator mocks base method.
+func (m *MockNoteServer) AddCollaborator(arg0 context.Context, arg1 *gen.AddCollaboratorRequest) (*gen.AddCollaboratorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCollaborator", arg0, arg1)
+	ret0, _ := ret[0].(*gen.AddCollaboratorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCollaborator indicates an expected call of AddCollaborator.
+func (mr *MockNoteServerMockRecorder) AddCollaborator(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCollaborator", reflect.TypeOf((*MockNoteServer)(nil).AddCollaborator), arg0, arg1)
+}
+
+// AddComment mocks base method.
+func (m *MockNoteServer) AddComment(arg0 context.Context, arg1 *gen.AddCommentRequest) (*gen.CommentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddComment", arg0, arg1)
+	ret0, _ := ret[0].(*gen.CommentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddComment indicates an expected call of AddComment.
+func (mr *MockNoteServerMockRecorder) AddComment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddComment", reflect.TypeOf((*MockNoteServer)(nil).AddComment), arg0, arg1)
+}
+
+// AddFav mocks base method.
+func (m *MockNoteServer) AddFav(arg0 context.Context, arg1 *gen.ChangeFlagRequest) (*gen.GetNoteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFav", arg0, arg1)
+	ret0, _ := ret[0].(*gen.GetNoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddFav indicates an expected call of AddFav.
+func (mr *MockNoteServerMockRecorder) AddFav(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFav", reflect.TypeOf((*MockNoteServer)(nil).AddFav), arg0, arg1)
+}
+
+// AddNote mocks base method.
+func (m *MockNoteServer) AddNote(arg0 context.Context, arg1 *gen.AddNoteRequest) (*gen.AddNoteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddNote", arg0, arg1)
+	ret0, _ := ret[0].(*gen.AddNoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddNote indicates an expected call of AddNote.
+func (mr *MockNoteServerMockRecorder) AddNote(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNote", reflect.TypeOf((*MockNoteServer)(nil).AddNote), arg0, arg1)
+}
+
+// AddReminder mocks base method.
+func (m *MockNoteServer) AddReminder(arg0 context.Context, arg1 *gen.AddReminderRequest) (*gen.ReminderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReminder", arg0, arg1)
+	ret0, _ := ret[0].(*gen.ReminderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddReminder indicates an expected call of AddReminder.
+func (mr *MockNoteServerMockRecorder) AddReminder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReminder", reflect.TypeOf((*MockNoteServer)(nil).AddReminder), arg0, arg1)
+}
+
+// AddTag mocks base method.
+func (m *MockNoteServer) AddTag(arg0 context.Context, arg1 *gen.TagRequest) (*gen.GetNoteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTag", arg0, arg1)
+	ret0, _ := ret[0].(*gen.GetNoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTag indicates an expected call of AddTag.
+func (mr *MockNoteServerMockRecorder) AddTag(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTag", reflect.TypeOf((*MockNoteServer)(nil).AddTag), arg0, arg1)
+}
+
+// ArchiveNote mocks base method.
+func (m *MockNoteServer) ArchiveNote(arg0 context.Context, arg1 *gen.ChangeFlagRequest) (*gen.GetNoteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveNote", arg0, arg1)
+	ret0, _ := ret[0].(*gen.GetNoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveNote indicates an expected call of ArchiveNote.
+func (mr *MockNoteServerMockRecorder) ArchiveNote(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveNote", reflect.TypeOf((*MockNoteServer)(nil).ArchiveNote), arg0, arg1)
+}
+
+// BulkUpdate mocks base method.
+func (m *MockNoteServer) BulkUpdate(arg0 context.Context, arg1 *gen.BulkRequest) (*gen.BulkResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkUpdate", arg0, arg1)
+	ret0, _ := ret[0].(*gen.BulkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkUpdate indicates an expected call of BulkUpdate.
+func (mr *MockNoteServerMockRecorder) BulkUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpdate", reflect.TypeOf((*MockNoteServer)(nil).BulkUpdate), arg0, arg1)
+}
+
+// CheckPermissions mocks base method.
+func (m *MockNoteServer) CheckPermissions(arg0 context.Context, arg1 *gen.CheckPermissionsRequest) (*gen.CheckPermissionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckPermissions", arg0, arg1)
+	ret0, _ := ret[0].(*gen.CheckPermissionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckPermissions indicates an expected call of CheckPermissions.
+func (mr *MockNoteServerMockRecorder) CheckPermissions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPermissions", reflect.TypeOf((*MockNoteServer)(nil).CheckPermissions), arg0, arg1)
+}
+
+// CreateFromTemplate mocks base method.
+func (m *MockNoteServer) CreateFromTemplate(arg0 context.Context, arg1 *gen.CreateFromTemplateRequest) (*gen.GetNoteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFromTemplate", arg0, arg1)
+	ret0, _ := ret[0].(*gen.GetNoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFromTemplate indicates an expected call of CreateFromTemplate.
+func (mr *MockNoteServerMockRecorder) CreateFromTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFromTemplate", reflect.TypeOf((*MockNoteServer)(nil).CreateFromTemplate), arg0, arg1)
+}
+
+// CreateShareLink mocks base method.
+func (m *MockNoteServer) CreateShareLink(arg0 context.Context, arg1 *gen.CreateShareLinkRequest) (*gen.ShareLinkResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShareLink", arg0, arg1)
+	ret0, _ := ret[0].(*gen.ShareLinkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateShareLink indicates an expected call of CreateShareLink.
+func (mr *MockNoteServerMockRecorder) CreateShareLink(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShareLink", reflect.TypeOf((*MockNoteServer)(nil).CreateShareLink), arg0, arg1)
+}
+
+// CreateSubNote mocks base method.
+func (m *MockNoteServer) CreateSubNote(arg0 context.Context, arg1 *gen.CreateSubNoteRequest) (*gen.CreateSubNoteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubNote", arg0, arg1)
+	ret0, _ := ret[0].(*gen.CreateSubNoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSubNote indicates an expected call of CreateSubNote.
+func (mr *MockNoteServerMockRecorder) CreateSubNote(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubNote", reflect.TypeOf((*MockNoteServer)(nil).CreateSubNote), arg0, arg1)
+}
+
+// DeclineInvite mocks base method.
+func (m *MockNoteServer) DeclineInvite(arg0 context.Context, arg1 *gen.InviteRequest) (*gen.InviteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclineInvite", arg0, arg1)
+	ret0, _ := ret[0].(*gen.InviteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeclineInvite indicates an expected call of DeclineInvite.
+func (mr *MockNoteServerMockRecorder) DeclineInvite(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclineInvite", reflect.TypeOf((*MockNoteServer)(nil).DeclineInvite), arg0, arg1)
+}
+
+// DelFav mocks base method.
+func (m *MockNoteServer) DelFav(arg0 context.Context, arg1 *gen.ChangeFlagRequest) (*gen.GetNoteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DelFav", arg0, arg1)
+	ret0, _ := ret[0].(*gen.GetNoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DelFav indicates an expected call of DelFav.
+func (mr *MockNoteServerMockRecorder) DelFav(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelFav", reflect.TypeOf((*MockNoteServer)(nil).DelFav), arg0, arg1)
+}
+
+// DeleteComment mocks base method.
+func (m *MockNoteServer) DeleteComment(arg0 context.Context, arg1 *gen.CommentRequest) (*gen.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteComment", arg0, arg1)
+	ret0, _ := ret[0].(*gen.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteComment indicates an expected call of DeleteComment.
+func (mr *MockNoteServerMockRecorder) DeleteComment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockNoteServer)(nil).DeleteComment), arg0, arg1)
+}
+
+// DeleteNote mocks base method.
+func (m *MockNoteServer) DeleteNote(arg0 context.Context, arg1 *gen.DeleteNoteRequest) (*gen.DeleteNoteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNote", arg0, arg1)
+	ret0, _ := ret[0].(*gen.DeleteNoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNote indicates an expected call of DeleteNote.
+func (mr *MockNoteServerMockRecorder) DeleteNote(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNote", reflect.TypeOf((*MockNoteServer)(nil).DeleteNote), arg0, arg1)
+}
+
+// DeleteNotePermanently mocks base method.
+func (m *MockNoteServer) DeleteNotePermanently(arg0 context.Context, arg1 *gen.TrashNoteRequest) (*gen.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNotePermanently", arg0, arg1)
+	ret0, _ := ret[0].(*gen.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNotePermanently indicates an expected call of DeleteNotePermanently.
+func (mr *MockNoteServerMockRecorder) DeleteNotePermanently(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotePermanently", reflect.TypeOf((*MockNoteServer)(nil).DeleteNotePermanently), arg0, arg1)
+}
+
+// DeleteReminder mocks base method.
+func (m *MockNoteServer) DeleteReminder(arg0 context.Context, arg1 *gen.ReminderRequest) (*gen.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReminder", arg0, arg1)
+	ret0, _ := ret[0].(*gen.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteReminder indicates an expected call of DeleteReminder.
+func (mr *MockNoteServerMockRecorder) DeleteReminder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReminder", reflect.TypeOf((*MockNoteServer)(nil).DeleteReminder), arg0, arg1)
+}
+
+// DeleteTag mocks base method.
+func (m *MockNoteServer) DeleteTag(arg0 context.Context, arg1 *gen.TagRequest) (*gen.GetNoteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTag", arg0, arg1)
+	ret0, _ := ret[0].(*gen.GetNoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTag indicates an expected call of DeleteTag.
+func (mr *MockNoteServerMockRecorder) DeleteTag(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTag", reflect.TypeOf((*MockNoteServer)(nil).DeleteTag), arg0, arg1)
+}
+
+// DuplicateNote mocks base method.
+func (m *MockNoteServer) DuplicateNote(arg0 context.Context, arg1 *gen.DuplicateNoteRequest) (*gen.GetNoteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DuplicateNote", arg0, arg1)
+	ret0, _ := ret[0].(*gen.GetNoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DuplicateNote indicates an expected call of DuplicateNote.
+func (mr *MockNoteServerMockRecorder) DuplicateNote(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DuplicateNote", reflect.TypeOf((*MockNoteServer)(nil).DuplicateNote), arg0, arg1)
+}
+
+// ForgetTag mocks base method.
+func (m *MockNoteServer) ForgetTag(arg0 context.Context, arg1 *gen.AllTagRequest) (*gen.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForgetTag", arg0, arg1)
+	ret0, _ := ret[0].(*gen.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForgetTag indicates an expected call of ForgetTag.
+func (mr *MockNoteServerMockRecorder) ForgetTag(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForgetTag", reflect.TypeOf((*MockNoteServer)(nil).ForgetTag), arg0, arg1)
+}
+
+// GetAllNotes mocks base method.
+func (m *MockNoteServer) GetAllNotes(arg0 context.Context, arg1 *gen.GetAllRequest) (*gen.GetAllResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllNotes", arg0, arg1)
+	ret0, _ := ret[0].(*gen.GetAllResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllNotes indicates an expected call of GetAllNotes.
+func (mr *MockNoteServerMockRecorder) GetAllNotes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllNotes", reflect.TypeOf((*MockNoteServer)(nil).GetAllNotes), arg0, arg1)
+}
+
+// GetAttachList mocks base method.
+func (m *MockNoteServer) GetAttachList(arg0 context.Context, arg1 *gen.GetAttachListRequest) (*gen.GetAttachListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachList", arg0, arg1)
+	ret0, _ := ret[0].(*gen.GetAttachListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachList indicates an expected call of GetAttachList.
+func (mr *MockNoteServerMockRecorder) GetAttachList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachList", reflect.TypeOf((*MockNoteServer)(nil).GetAttachList), arg0, arg1)
+}
+
+// GetBacklinks mocks base method.
+func (m *MockNoteServer) GetBacklinks(arg0 context.Context, arg1 *gen.GetBacklinksRequest) (*gen.GetBacklinksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBacklinks", arg0, arg1)
+	ret0, _ := ret[0].(*gen.GetBacklinksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBacklinks indicates an expected call of GetBacklinks.
+func (mr *MockNoteServerMockRecorder) GetBacklinks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBacklinks", reflect.TypeOf((*MockNoteServer)(nil).GetBacklinks), arg0, arg1)
+}
+
+// GetComments mocks base method.
+func (m *MockNoteServer) GetComments(arg0 context.Context, arg1 *gen.GetCommentsRequest) (*gen.GetCommentsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComments", arg0, arg1)
+	ret0, _ := ret[0].(*gen.GetCommentsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComments indicates an expected call of GetComments.
+func (mr *MockNoteServerMockRecorder) GetComments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComments", reflect.TypeOf((*MockNoteServer)(nil).GetComments), arg0, arg1)
+}
+
+// GetNote mocks base method.
+func (m *MockNoteServer) GetNote(arg0 context.Context, arg1 *gen.GetNoteRequest) (*gen.GetNoteResponseResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNote", arg0, arg1)
+	ret0, _ := ret[0].(*gen.GetNoteResponseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNote indicates an expected call of GetNote.
+func (mr *MockNoteServerMockRecorder) GetNote(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNote", reflect.TypeOf((*MockNoteServer)(nil).GetNote), arg0, arg1)
+}
+
+// GetNoteGraph mocks base method.
+func (m *MockNoteServer) GetNoteGraph(arg0 context.Context, arg1 *gen.GetNoteGraphRequest) (*gen.GetNoteGraphResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNoteGraph", arg0, arg1)
+	ret0, _ := ret[0].(*gen.GetNoteGraphResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNoteGraph indicates an expected call of GetNoteGraph.
+func (mr *MockNoteServerMockRecorder) GetNoteGraph(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNoteGraph", reflect.TypeOf((*MockNoteServer)(nil).GetNoteGraph), arg0, arg1)
+}
+
+// GetPublicNote mocks base method.
+func (m *MockNoteServer) GetPublicNote(arg0 context.Context, arg1 *gen.GetPublicNoteRequest) (*gen.GetNoteResponseResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublicNote", arg0, arg1)
+	ret0, _ := ret[0].(*gen.GetNoteResponseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublicNote indicates an expected call of GetPublicNote.
+func (mr *MockNoteServerMockRecorder) GetPublicNote(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicNote", reflect.TypeOf((*MockNoteServer)(nil).GetPublicNote), arg0, arg1)
+}
+
+// GetReceivedInvites mocks base method.
+func (m *MockNoteServer) GetReceivedInvites(arg0 context.Context, arg1 *gen.GetInvitesRequest) (*gen.GetInvitesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReceivedInvites", arg0, arg1)
+	ret0, _ := ret[0].(*gen.GetInvitesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReceivedInvites indicates an expected call of GetReceivedInvites.
+func (mr *MockNoteServerMockRecorder) GetReceivedInvites(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceivedInvites", reflect.TypeOf((*MockNoteServer)(nil).GetReceivedInvites), arg0, arg1)
 }
 
-// SetPublic mocks base method.
-func (m *MockNoteClient) SetPublic(ctx context.Context, in *gen.AccessModeRequest, opts ...grpc.CallOption) (*gen.GetNoteResponse, error) {
+// GetReminders mocks base method.
+func (m *MockNoteServer) GetReminders(arg0 context.Context, arg1 *gen.GetRemindersRequest) (*gen.GetRemindersResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{ctx, in}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "SetPublic", varargs...)
-	ret0, _ := ret[0].(*gen.GetNoteResponse)
+	ret := m.ctrl.Call(m, "GetReminders", arg0, arg1)
+	ret0, _ := ret[0].(*gen.GetRemindersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SetPublic indicates an expected call of SetPublic.
-func (mr *MockNoteClientMockRecorder) SetPublic(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// GetReminders indicates an expected call of GetReminders.
+func (mr *MockNoteServerMockRecorder) GetReminders(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPublic", reflect.TypeOf((*MockNoteClient)(nil).SetPublic), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReminders", reflect.TypeOf((*MockNoteServer)(nil).GetReminders), arg0, arg1)
 }
 
-// UpdateNote mocks base method.
-func (m *MockNoteClient) UpdateNote(ctx context.Context, in *gen.UpdateNoteRequest, opts ...grpc.CallOption) (*gen.UpdateNoteResponse, error) {
+// GetRevision mocks base method.
+func (m *MockNoteServer) GetRevision(arg0 context.Context, arg1 *gen.RevisionRequest) (*gen.GetRevisionResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{ctx, in}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "UpdateNote", varargs...)
-	ret0, _ := ret[0].(*gen.UpdateNoteResponse)
+	ret := m.ctrl.Call(m, "GetRevision", arg0, arg1)
+	ret0, _ := ret[0].(*gen.GetRevisionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateNote indicates an expected call of UpdateNote.
-func (mr *MockNoteClientMockRecorder) UpdateNote(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// GetRevision indicates an expected call of GetRevision.
+func (mr *MockNoteServerMockRecorder) GetRevision(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNote", reflect.TypeOf((*MockNoteClient)(nil).UpdateNote), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevision", reflect.TypeOf((*MockNoteServer)(nil).GetRevision), arg0, arg1)
 }
 
-// UpdateTag mocks base method.
-func (m *MockNoteClient) UpdateTag(ctx context.Context, in *gen.UpdateTagRequest, opts ...grpc.CallOption) (*gen.EmptyResponse, error) {
+// GetRevisionDiff mocks base method.
+func (m *MockNoteServer) GetRevisionDiff(arg0 context.Context, arg1 *gen.GetRevisionDiffRequest) (*gen.GetRevisionDiffResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{ctx, in}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "UpdateTag", varargs...)
-	ret0, _ := ret[0].(*gen.EmptyResponse)
+	ret := m.ctrl.Call(m, "GetRevisionDiff", arg0, arg1)
+	ret0, _ := ret[0].(*gen.GetRevisionDiffResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateTag indicates an expected call of UpdateTag.
-func (mr *MockNoteClientMockRecorder) UpdateTag(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// GetRevisionDiff indicates an expected call of GetRevisionDiff.
+func (mr *MockNoteServerMockRecorder) GetRevisionDiff(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTag", reflect.TypeOf((*MockNoteClient)(nil).UpdateTag), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisionDiff", reflect.TypeOf((*MockNoteServer)(nil).GetRevisionDiff), arg0, arg1)
 }
 
-// MockNoteServer is a mock of NoteServer interface.
-type MockNoteServer struct {
-	ctrl     *gomock.Controller
-	recorder *MockNoteServerMockRecorder
+// GetRevisions mocks base method.
+func (m *MockNoteServer) GetRevisions(arg0 context.Context, arg1 *gen.GetRevisionsRequest) (*gen.GetRevisionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisions", arg0, arg1)
+	ret0, _ := ret[0].(*gen.GetRevisionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockNoteServerMockRecorder is the mock recorder for MockNoteServer.
-type MockNoteServerMockRecorder struct {
-	mock *MockNoteServer
+// GetRevisions indicates an expected call of GetRevisions.
+func (mr *MockNoteServerMockRecorder) GetRevisions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisions", reflect.TypeOf((*MockNoteServer)(nil).GetRevisions), arg0, arg1)
 }
 
-// NewMockNoteServer creates a new mock instance.
-func NewMockNoteServer(ctrl *gomock.Controller) *MockNoteServer {
-	mock := &MockNoteServer{ctrl: ctrl}
-	mock.recorder = &MockNoteServerMockRecorder{mock}
-	return mock
+// GetSentInvites mocks base method.
+func (m *MockNoteServer) GetSentInvites(arg0 context.Context, arg1 *gen.GetInvitesRequest) (*gen.GetInvitesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSentInvites", arg0, arg1)
+	ret0, _ := ret[0].(*gen.GetInvitesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockNoteServer) EXPECT() *MockNoteServerMockRecorder {
-	return m.recorder
+// GetSentInvites indicates an expected call of GetSentInvites.
+func (mr *MockNoteServerMockRecorder) GetSentInvites(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSentInvites", reflect.TypeOf((*MockNoteServer)(nil).GetSentInvites), arg0, arg1)
 }
 
-// AddCollaborator mocks base method.
-func (m *MockNoteServer) AddCollaborator(arg0 context.Context, arg1 *gen.AddCollaboratorRequest) (*gen.AddCollaboratorResponse, error) {
+// GetShareLinks mocks base method.
+func (m *MockNoteServer) GetShareLinks(arg0 context.Context, arg1 *gen.GetShareLinksRequest) (*gen.GetShareLinksResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddCollaborator", arg0, arg1)
-	ret0, _ := ret[0].(*gen.AddCollaboratorResponse)
+	ret := m.ctrl.Call(m, "GetShareLinks", arg0, arg1)
+	ret0, _ := ret[0].(*gen.GetShareLinksResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AddCollaborator indicates an expected call of AddCollaborator.
-func (mr *MockNoteServerMockRecorder) AddCollaborator(arg0, arg1 interface{}) *gomock.Call {
+// GetShareLinks indicates an expected call of GetShareLinks.
+func (mr *MockNoteServerMockRecorder) GetShareLinks(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCollaborator", reflect.TypeOf((*MockNoteServer)(nil).AddCollaborator), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShareLinks", reflect.TypeOf((*MockNoteServer)(nil).GetShareLinks), arg0, arg1)
 }
 
-// AddFav mocks base method.
-func (m *MockNoteServer) AddFav(arg0 context.Context, arg1 *gen.ChangeFlagRequest) (*gen.GetNoteResponse, error) {
+// GetSharedAttachList mocks base method.
+func (m *MockNoteServer) GetSharedAttachList(arg0 context.Context, arg1 *gen.GetSharedAttachListRequest) (*gen.GetAttachListResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddFav", arg0, arg1)
-	ret0, _ := ret[0].(*gen.GetNoteResponse)
+	ret := m.ctrl.Call(m, "GetSharedAttachList", arg0, arg1)
+	ret0, _ := ret[0].(*gen.GetAttachListResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AddFav indicates an expected call of AddFav.
-func (mr *MockNoteServerMockRecorder) AddFav(arg0, arg1 interface{}) *gomock.Call {
+// GetSharedAttachList indicates an expected call of GetSharedAttachList.
+func (mr *MockNoteServerMockRecorder) GetSharedAttachList(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFav", reflect.TypeOf((*MockNoteServer)(nil).AddFav), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharedAttachList", reflect.TypeOf((*MockNoteServer)(nil).GetSharedAttachList), arg0, arg1)
 }
 
-// AddNote mocks base method.
-func (m *MockNoteServer) AddNote(arg0 context.Context, arg1 *gen.AddNoteRequest) (*gen.AddNoteResponse, error) {
+// GetTags mocks base method.
+func (m *MockNoteServer) GetTags(arg0 context.Context, arg1 *gen.GetTagsRequest) (*gen.GetTagsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddNote", arg0, arg1)
-	ret0, _ := ret[0].(*gen.AddNoteResponse)
+	ret := m.ctrl.Call(m, "GetTags", arg0, arg1)
+	ret0, _ := ret[0].(*gen.GetTagsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AddNote indicates an expected call of AddNote.
-func (mr *MockNoteServerMockRecorder) AddNote(arg0, arg1 interface{}) *gomock.Call {
+// GetTags indicates an expected call of GetTags.
+func (mr *MockNoteServerMockRecorder) GetTags(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNote", reflect.TypeOf((*MockNoteServer)(nil).AddNote), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTags", reflect.TypeOf((*MockNoteServer)(nil).GetTags), arg0, arg1)
 }
 
-// AddTag mocks base method.
-func (m *MockNoteServer) AddTag(arg0 context.Context, arg1 *gen.TagRequest) (*gen.GetNoteResponse, error) {
+// GetTemplates mocks base method.
+func (m *MockNoteServer) GetTemplates(arg0 context.Context, arg1 *gen.GetTemplatesRequest) (*gen.GetTemplatesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddTag", arg0, arg1)
-	ret0, _ := ret[0].(*gen.GetNoteResponse)
+	ret := m.ctrl.Call(m, "GetTemplates", arg0, arg1)
+	ret0, _ := ret[0].(*gen.GetTemplatesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AddTag indicates an expected call of AddTag.
-func (mr *MockNoteServerMockRecorder) AddTag(arg0, arg1 interface{}) *gomock.Call {
+// GetTemplates indicates an expected call of GetTemplates.
+func (mr *MockNoteServerMockRecorder) GetTemplates(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTag", reflect.TypeOf((*MockNoteServer)(nil).AddTag), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplates", reflect.TypeOf((*MockNoteServer)(nil).GetTemplates), arg0, arg1)
 }
 
-// CheckPermissions mocks base method.
-func (m *MockNoteServer) CheckPermissions(arg0 context.Context, arg1 *gen.CheckPermissionsRequest) (*gen.CheckPermissionsResponse, error) {
+// GetTrash mocks base method.
+func (m *MockNoteServer) GetTrash(arg0 context.Context, arg1 *gen.GetTrashRequest) (*gen.GetTrashResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckPermissions", arg0, arg1)
-	ret0, _ := ret[0].(*gen.CheckPermissionsResponse)
+	ret := m.ctrl.Call(m, "GetTrash", arg0, arg1)
+	ret0, _ := ret[0].(*gen.GetTrashResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CheckPermissions indicates an expected call of CheckPermissions.
-func (mr *MockNoteServerMockRecorder) CheckPermissions(arg0, arg1 interface{}) *gomock.Call {
+// GetTrash indicates an expected call of GetTrash.
+func (mr *MockNoteServerMockRecorder) GetTrash(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPermissions", reflect.TypeOf((*MockNoteServer)(nil).CheckPermissions), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrash", reflect.TypeOf((*MockNoteServer)(nil).GetTrash), arg0, arg1)
 }
 
-// CreateSubNote mocks base method.
-func (m *MockNoteServer) CreateSubNote(arg0 context.Context, arg1 *gen.CreateSubNoteRequest) (*gen.CreateSubNoteResponse, error) {
+// GetUpcomingReminders mocks base method.
+func (m *MockNoteServer) GetUpcomingReminders(arg0 context.Context, arg1 *gen.GetUpcomingRemindersRequest) (*gen.GetRemindersResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSubNote", arg0, arg1)
-	ret0, _ := ret[0].(*gen.CreateSubNoteResponse)
+	ret := m.ctrl.Call(m, "GetUpcomingReminders", arg0, arg1)
+	ret0, _ := ret[0].(*gen.GetRemindersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateSubNote indicates an expected call of CreateSubNote.
-func (mr *MockNoteServerMockRecorder) CreateSubNote(arg0, arg1 interface{}) *gomock.Call {
+// GetUpcomingReminders indicates an expected call of GetUpcomingReminders.
+func (mr *MockNoteServerMockRecorder) GetUpcomingReminders(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubNote", reflect.TypeOf((*MockNoteServer)(nil).CreateSubNote), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpcomingReminders", reflect.TypeOf((*MockNoteServer)(nil).GetUpcomingReminders), arg0, arg1)
 }
 
-// DelFav mocks base method.
-func (m *MockNoteServer) DelFav(arg0 context.Context, arg1 *gen.ChangeFlagRequest) (*gen.GetNoteResponse, error) {
+// LeaveNote mocks base method.
+func (m *MockNoteServer) LeaveNote(arg0 context.Context, arg1 *gen.LeaveNoteRequest) (*gen.LeaveNoteResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DelFav", arg0, arg1)
-	ret0, _ := ret[0].(*gen.GetNoteResponse)
+	ret := m.ctrl.Call(m, "LeaveNote", arg0, arg1)
+	ret0, _ := ret[0].(*gen.LeaveNoteResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DelFav indicates an expected call of DelFav.
-func (mr *MockNoteServerMockRecorder) DelFav(arg0, arg1 interface{}) *gomock.Call {
+// LeaveNote indicates an expected call of LeaveNote.
+func (mr *MockNoteServerMockRecorder) LeaveNote(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelFav", reflect.TypeOf((*MockNoteServer)(nil).DelFav), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveNote", reflect.TypeOf((*MockNoteServer)(nil).LeaveNote), arg0, arg1)
 }
 
-// DeleteNote mocks base method.
-func (m *MockNoteServer) DeleteNote(arg0 context.Context, arg1 *gen.DeleteNoteRequest) (*gen.DeleteNoteResponse, error) {
+// MergeTags mocks base method.
+func (m *MockNoteServer) MergeTags(arg0 context.Context, arg1 *gen.MergeTagsRequest) (*gen.EmptyResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteNote", arg0, arg1)
-	ret0, _ := ret[0].(*gen.DeleteNoteResponse)
+	ret := m.ctrl.Call(m, "MergeTags", arg0, arg1)
+	ret0, _ := ret[0].(*gen.EmptyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteNote indicates an expected call of DeleteNote.
-func (mr *MockNoteServerMockRecorder) DeleteNote(arg0, arg1 interface{}) *gomock.Call {
+// MergeTags indicates an expected call of MergeTags.
+func (mr *MockNoteServerMockRecorder) MergeTags(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNote", reflect.TypeOf((*MockNoteServer)(nil).DeleteNote), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeTags", reflect.TypeOf((*MockNoteServer)(nil).MergeTags), arg0, arg1)
 }
 
-// DeleteTag mocks base method.
-func (m *MockNoteServer) DeleteTag(arg0 context.Context, arg1 *gen.TagRequest) (*gen.GetNoteResponse, error) {
+// MoveNote mocks base method.
+func (m *MockNoteServer) MoveNote(arg0 context.Context, arg1 *gen.MoveNoteRequest) (*gen.GetNoteResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTag", arg0, arg1)
+	ret := m.ctrl.Call(m, "MoveNote", arg0, arg1)
 	ret0, _ := ret[0].(*gen.GetNoteResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteTag indicates an expected call of DeleteTag.
-func (mr *MockNoteServerMockRecorder) DeleteTag(arg0, arg1 interface{}) *gomock.Call {
+// MoveNote indicates an expected call of MoveNote.
+func (mr *MockNoteServerMockRecorder) MoveNote(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTag", reflect.TypeOf((*MockNoteServer)(nil).DeleteTag), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveNote", reflect.TypeOf((*MockNoteServer)(nil).MoveNote), arg0, arg1)
 }
 
-// ForgetTag mocks base method.
-func (m *MockNoteServer) ForgetTag(arg0 context.Context, arg1 *gen.AllTagRequest) (*gen.EmptyResponse, error) {
+// ProposeTransfer mocks base method.
+func (m *MockNoteServer) ProposeTransfer(arg0 context.Context, arg1 *gen.ProposeTransferRequest) (*gen.ProposeTransferResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ForgetTag", arg0, arg1)
+	ret := m.ctrl.Call(m, "ProposeTransfer", arg0, arg1)
+	ret0, _ := ret[0].(*gen.ProposeTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProposeTransfer indicates an expected call of ProposeTransfer.
+func (mr *MockNoteServerMockRecorder) ProposeTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposeTransfer", reflect.TypeOf((*MockNoteServer)(nil).ProposeTransfer), arg0, arg1)
+}
+
+// RememberTag mocks base method.
+func (m *MockNoteServer) RememberTag(arg0 context.Context, arg1 *gen.AllTagRequest) (*gen.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RememberTag", arg0, arg1)
 	ret0, _ := ret[0].(*gen.EmptyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ForgetTag indicates an expected call of ForgetTag.
-func (mr *MockNoteServerMockRecorder) ForgetTag(arg0, arg1 interface{}) *gomock.Call {
+// RememberTag indicates an expected call of RememberTag.
+func (mr *MockNoteServerMockRecorder) RememberTag(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForgetTag", reflect.TypeOf((*MockNoteServer)(nil).ForgetTag), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RememberTag", reflect.TypeOf((*MockNoteServer)(nil).RememberTag), arg0, arg1)
 }
 
-// GetAllNotes mocks base method.
-func (m *MockNoteServer) GetAllNotes(arg0 context.Context, arg1 *gen.GetAllRequest) (*gen.GetAllResponse, error) {
+// RemoveCollaborator mocks base method.
+func (m *MockNoteServer) RemoveCollaborator(arg0 context.Context, arg1 *gen.RemoveCollaboratorRequest) (*gen.RemoveCollaboratorResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllNotes", arg0, arg1)
-	ret0, _ := ret[0].(*gen.GetAllResponse)
+	ret := m.ctrl.Call(m, "RemoveCollaborator", arg0, arg1)
+	ret0, _ := ret[0].(*gen.RemoveCollaboratorResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAllNotes indicates an expected call of GetAllNotes.
-func (mr *MockNoteServerMockRecorder) GetAllNotes(arg0, arg1 interface{}) *gomock.Call {
+// RemoveCollaborator indicates an expected call of RemoveCollaborator.
+func (mr *MockNoteServerMockRecorder) RemoveCollaborator(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllNotes", reflect.TypeOf((*MockNoteServer)(nil).GetAllNotes), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCollaborator", reflect.TypeOf((*MockNoteServer)(nil).RemoveCollaborator), arg0, arg1)
 }
 
-// GetAttachList mocks base method.
-func (m *MockNoteServer) GetAttachList(arg0 context.Context, arg1 *gen.GetAttachListRequest) (*gen.GetAttachListResponse, error) {
+// ReorderNotes mocks base method.
+func (m *MockNoteServer) ReorderNotes(arg0 context.Context, arg1 *gen.ReorderNotesRequest) (*gen.EmptyResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAttachList", arg0, arg1)
-	ret0, _ := ret[0].(*gen.GetAttachListResponse)
+	ret := m.ctrl.Call(m, "ReorderNotes", arg0, arg1)
+	ret0, _ := ret[0].(*gen.EmptyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAttachList indicates an expected call of GetAttachList.
-func (mr *MockNoteServerMockRecorder) GetAttachList(arg0, arg1 interface{}) *gomock.Call {
+// ReorderNotes indicates an expected call of ReorderNotes.
+func (mr *MockNoteServerMockRecorder) ReorderNotes(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachList", reflect.TypeOf((*MockNoteServer)(nil).GetAttachList), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderNotes", reflect.TypeOf((*MockNoteServer)(nil).ReorderNotes), arg0, arg1)
 }
 
-// GetNote mocks base method.
-func (m *MockNoteServer) GetNote(arg0 context.Context, arg1 *gen.GetNoteRequest) (*gen.GetNoteResponseResponse, error) {
+// RestoreNote mocks base method.
+func (m *MockNoteServer) RestoreNote(arg0 context.Context, arg1 *gen.TrashNoteRequest) (*gen.GetNoteResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNote", arg0, arg1)
-	ret0, _ := ret[0].(*gen.GetNoteResponseResponse)
+	ret := m.ctrl.Call(m, "RestoreNote", arg0, arg1)
+	ret0, _ := ret[0].(*gen.GetNoteResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetNote indicates an expected call of GetNote.
-func (mr *MockNoteServerMockRecorder) GetNote(arg0, arg1 interface{}) *gomock.Call {
+// RestoreNote indicates an expected call of RestoreNote.
+func (mr *MockNoteServerMockRecorder) RestoreNote(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNote", reflect.TypeOf((*MockNoteServer)(nil).GetNote), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreNote", reflect.TypeOf((*MockNoteServer)(nil).RestoreNote), arg0, arg1)
 }
 
-// GetPublicNote mocks base method.
-func (m *MockNoteServer) GetPublicNote(arg0 context.Context, arg1 *gen.GetPublicNoteRequest) (*gen.GetNoteResponseResponse, error) {
+// RestoreRevision mocks base method.
+func (m *MockNoteServer) RestoreRevision(arg0 context.Context, arg1 *gen.RevisionRequest) (*gen.GetNoteResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPublicNote", arg0, arg1)
-	ret0, _ := ret[0].(*gen.GetNoteResponseResponse)
+	ret := m.ctrl.Call(m, "RestoreRevision", arg0, arg1)
+	ret0, _ := ret[0].(*gen.GetNoteResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPublicNote indicates an expected call of GetPublicNote.
-func (mr *MockNoteServerMockRecorder) GetPublicNote(arg0, arg1 interface{}) *gomock.Call {
+// RestoreRevision indicates an expected call of RestoreRevision.
+func (mr *MockNoteServerMockRecorder) RestoreRevision(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicNote", reflect.TypeOf((*MockNoteServer)(nil).GetPublicNote), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreRevision", reflect.TypeOf((*MockNoteServer)(nil).RestoreRevision), arg0, arg1)
 }
 
-// GetSharedAttachList mocks base method.
-func (m *MockNoteServer) GetSharedAttachList(arg0 context.Context, arg1 *gen.GetSharedAttachListRequest) (*gen.GetAttachListResponse, error) {
+// RevokeInvite mocks base method.
+func (m *MockNoteServer) RevokeInvite(arg0 context.Context, arg1 *gen.InviteRequest) (*gen.InviteResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSharedAttachList", arg0, arg1)
-	ret0, _ := ret[0].(*gen.GetAttachListResponse)
+	ret := m.ctrl.Call(m, "RevokeInvite", arg0, arg1)
+	ret0, _ := ret[0].(*gen.InviteResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSharedAttachList indicates an expected call of GetSharedAttachList.
-func (mr *MockNoteServerMockRecorder) GetSharedAttachList(arg0, arg1 interface{}) *gomock.Call {
+// RevokeInvite indicates an expected call of RevokeInvite.
+func (mr *MockNoteServerMockRecorder) RevokeInvite(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharedAttachList", reflect.TypeOf((*MockNoteServer)(nil).GetSharedAttachList), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeInvite", reflect.TypeOf((*MockNoteServer)(nil).RevokeInvite), arg0, arg1)
 }
 
-// GetTags mocks base method.
-func (m *MockNoteServer) GetTags(arg0 context.Context, arg1 *gen.GetTagsRequest) (*gen.GetTagsResponse, error) {
+// RevokeShareLink mocks base method.
+func (m *MockNoteServer) RevokeShareLink(arg0 context.Context, arg1 *gen.RevokeShareLinkRequest) (*gen.EmptyResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTags", arg0, arg1)
-	ret0, _ := ret[0].(*gen.GetTagsResponse)
+	ret := m.ctrl.Call(m, "RevokeShareLink", arg0, arg1)
+	ret0, _ := ret[0].(*gen.EmptyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTags indicates an expected call of GetTags.
-func (mr *MockNoteServerMockRecorder) GetTags(arg0, arg1 interface{}) *gomock.Call {
+// RevokeShareLink indicates an expected call of RevokeShareLink.
+func (mr *MockNoteServerMockRecorder) RevokeShareLink(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTags", reflect.TypeOf((*MockNoteServer)(nil).GetTags), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeShareLink", reflect.TypeOf((*MockNoteServer)(nil).RevokeShareLink), arg0, arg1)
 }
 
-// RememberTag mocks base method.
-func (m *MockNoteServer) RememberTag(arg0 context.Context, arg1 *gen.AllTagRequest) (*gen.EmptyResponse, error) {
+// SetCollaboratorRole mocks base method.
+func (m *MockNoteServer) SetCollaboratorRole(arg0 context.Context, arg1 *gen.SetCollaboratorRoleRequest) (*gen.EmptyResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RememberTag", arg0, arg1)
+	ret := m.ctrl.Call(m, "SetCollaboratorRole", arg0, arg1)
 	ret0, _ := ret[0].(*gen.EmptyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RememberTag indicates an expected call of RememberTag.
-func (mr *MockNoteServerMockRecorder) RememberTag(arg0, arg1 interface{}) *gomock.Call {
+// SetCollaboratorRole indicates an expected call of SetCollaboratorRole.
+func (mr *MockNoteServerMockRecorder) SetCollaboratorRole(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RememberTag", reflect.TypeOf((*MockNoteServer)(nil).RememberTag), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCollaboratorRole", reflect.TypeOf((*MockNoteServer)(nil).SetCollaboratorRole), arg0, arg1)
+}
+
+// SetCommentResolved mocks base method.
+func (m *MockNoteServer) SetCommentResolved(arg0 context.Context, arg1 *gen.SetCommentResolvedRequest) (*gen.CommentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCommentResolved", arg0, arg1)
+	ret0, _ := ret[0].(*gen.CommentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetCommentResolved indicates an expected call of SetCommentResolved.
+func (mr *MockNoteServerMockRecorder) SetCommentResolved(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCommentResolved", reflect.TypeOf((*MockNoteServer)(nil).SetCommentResolved), arg0, arg1)
 }
 
 // SetHeader mocks base method.
@@ -834,6 +2279,66 @@ func (mr *MockNoteServerMockRecorder) SetPublic(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPublic", reflect.TypeOf((*MockNoteServer)(nil).SetPublic), arg0, arg1)
 }
 
+// SetTagInfo mocks base method.
+func (m *MockNoteServer) SetTagInfo(arg0 context.Context, arg1 *gen.TagInfoRequest) (*gen.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTagInfo", arg0, arg1)
+	ret0, _ := ret[0].(*gen.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTagInfo indicates an expected call of SetTagInfo.
+func (mr *MockNoteServerMockRecorder) SetTagInfo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTagInfo", reflect.TypeOf((*MockNoteServer)(nil).SetTagInfo), arg0, arg1)
+}
+
+// SetTemplate mocks base method.
+func (m *MockNoteServer) SetTemplate(arg0 context.Context, arg1 *gen.SetTemplateRequest) (*gen.GetNoteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTemplate", arg0, arg1)
+	ret0, _ := ret[0].(*gen.GetNoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTemplate indicates an expected call of SetTemplate.
+func (mr *MockNoteServerMockRecorder) SetTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTemplate", reflect.TypeOf((*MockNoteServer)(nil).SetTemplate), arg0, arg1)
+}
+
+// UnarchiveNote mocks base method.
+func (m *MockNoteServer) UnarchiveNote(arg0 context.Context, arg1 *gen.ChangeFlagRequest) (*gen.GetNoteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnarchiveNote", arg0, arg1)
+	ret0, _ := ret[0].(*gen.GetNoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnarchiveNote indicates an expected call of UnarchiveNote.
+func (mr *MockNoteServerMockRecorder) UnarchiveNote(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnarchiveNote", reflect.TypeOf((*MockNoteServer)(nil).UnarchiveNote), arg0, arg1)
+}
+
+// UpdateComment mocks base method.
+func (m *MockNoteServer) UpdateComment(arg0 context.Context, arg1 *gen.UpdateCommentRequest) (*gen.CommentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateComment", arg0, arg1)
+	ret0, _ := ret[0].(*gen.CommentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateComment indicates an expected call of UpdateComment.
+func (mr *MockNoteServerMockRecorder) UpdateComment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateComment", reflect.TypeOf((*MockNoteServer)(nil).UpdateComment), arg0, arg1)
+}
+
 // UpdateNote mocks base method.
 func (m *MockNoteServer) UpdateNote(arg0 context.Context, arg1 *gen.UpdateNoteRequest) (*gen.UpdateNoteResponse, error) {
 	m.ctrl.T.Helper()
@@ -29,7 +29,25 @@ type NoteClient interface {
 	UpdateNote(ctx context.Context, in *UpdateNoteRequest, opts ...grpc.CallOption) (*UpdateNoteResponse, error)
 	DeleteNote(ctx context.Context, in *DeleteNoteRequest, opts ...grpc.CallOption) (*DeleteNoteResponse, error)
 	CreateSubNote(ctx context.Context, in *CreateSubNoteRequest, opts ...grpc.CallOption) (*CreateSubNoteResponse, error)
+	MoveNote(ctx context.Context, in *MoveNoteRequest, opts ...grpc.CallOption) (*GetNoteResponse, error)
+	ReorderNotes(ctx context.Context, in *ReorderNotesRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	DuplicateNote(ctx context.Context, in *DuplicateNoteRequest, opts ...grpc.CallOption) (*GetNoteResponse, error)
+	GetBacklinks(ctx context.Context, in *GetBacklinksRequest, opts ...grpc.CallOption) (*GetBacklinksResponse, error)
+	GetNoteGraph(ctx context.Context, in *GetNoteGraphRequest, opts ...grpc.CallOption) (*GetNoteGraphResponse, error)
+	SetTemplate(ctx context.Context, in *SetTemplateRequest, opts ...grpc.CallOption) (*GetNoteResponse, error)
+	GetTemplates(ctx context.Context, in *GetTemplatesRequest, opts ...grpc.CallOption) (*GetTemplatesResponse, error)
+	CreateFromTemplate(ctx context.Context, in *CreateFromTemplateRequest, opts ...grpc.CallOption) (*GetNoteResponse, error)
 	AddCollaborator(ctx context.Context, in *AddCollaboratorRequest, opts ...grpc.CallOption) (*AddCollaboratorResponse, error)
+	SetCollaboratorRole(ctx context.Context, in *SetCollaboratorRoleRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	RemoveCollaborator(ctx context.Context, in *RemoveCollaboratorRequest, opts ...grpc.CallOption) (*RemoveCollaboratorResponse, error)
+	LeaveNote(ctx context.Context, in *LeaveNoteRequest, opts ...grpc.CallOption) (*LeaveNoteResponse, error)
+	GetSentInvites(ctx context.Context, in *GetInvitesRequest, opts ...grpc.CallOption) (*GetInvitesResponse, error)
+	GetReceivedInvites(ctx context.Context, in *GetInvitesRequest, opts ...grpc.CallOption) (*GetInvitesResponse, error)
+	AcceptInvite(ctx context.Context, in *InviteRequest, opts ...grpc.CallOption) (*InviteResponse, error)
+	DeclineInvite(ctx context.Context, in *InviteRequest, opts ...grpc.CallOption) (*InviteResponse, error)
+	RevokeInvite(ctx context.Context, in *InviteRequest, opts ...grpc.CallOption) (*InviteResponse, error)
+	ProposeTransfer(ctx context.Context, in *ProposeTransferRequest, opts ...grpc.CallOption) (*ProposeTransferResponse, error)
+	AcceptTransfer(ctx context.Context, in *AcceptTransferRequest, opts ...grpc.CallOption) (*AcceptTransferResponse, error)
 	AddTag(ctx context.Context, in *TagRequest, opts ...grpc.CallOption) (*GetNoteResponse, error)
 	DeleteTag(ctx context.Context, in *TagRequest, opts ...grpc.CallOption) (*GetNoteResponse, error)
 	GetTags(ctx context.Context, in *GetTagsRequest, opts ...grpc.CallOption) (*GetTagsResponse, error)
@@ -37,14 +55,40 @@ type NoteClient interface {
 	RememberTag(ctx context.Context, in *AllTagRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 	ForgetTag(ctx context.Context, in *AllTagRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 	UpdateTag(ctx context.Context, in *UpdateTagRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	MergeTags(ctx context.Context, in *MergeTagsRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	SetTagInfo(ctx context.Context, in *TagInfoRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 	SetIcon(ctx context.Context, in *SetIconRequest, opts ...grpc.CallOption) (*GetNoteResponse, error)
 	SetHeader(ctx context.Context, in *SetHeaderRequest, opts ...grpc.CallOption) (*GetNoteResponse, error)
 	AddFav(ctx context.Context, in *ChangeFlagRequest, opts ...grpc.CallOption) (*GetNoteResponse, error)
 	DelFav(ctx context.Context, in *ChangeFlagRequest, opts ...grpc.CallOption) (*GetNoteResponse, error)
+	ArchiveNote(ctx context.Context, in *ChangeFlagRequest, opts ...grpc.CallOption) (*GetNoteResponse, error)
+	UnarchiveNote(ctx context.Context, in *ChangeFlagRequest, opts ...grpc.CallOption) (*GetNoteResponse, error)
+	// устарели: доступ выдается через CreateShareLink/RevokeShareLink
 	SetPublic(ctx context.Context, in *AccessModeRequest, opts ...grpc.CallOption) (*GetNoteResponse, error)
 	SetPrivate(ctx context.Context, in *AccessModeRequest, opts ...grpc.CallOption) (*GetNoteResponse, error)
+	BulkUpdate(ctx context.Context, in *BulkRequest, opts ...grpc.CallOption) (*BulkResponse, error)
+	CreateShareLink(ctx context.Context, in *CreateShareLinkRequest, opts ...grpc.CallOption) (*ShareLinkResponse, error)
+	GetShareLinks(ctx context.Context, in *GetShareLinksRequest, opts ...grpc.CallOption) (*GetShareLinksResponse, error)
+	RevokeShareLink(ctx context.Context, in *RevokeShareLinkRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	GetComments(ctx context.Context, in *GetCommentsRequest, opts ...grpc.CallOption) (*GetCommentsResponse, error)
+	AddComment(ctx context.Context, in *AddCommentRequest, opts ...grpc.CallOption) (*CommentResponse, error)
+	UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*CommentResponse, error)
+	DeleteComment(ctx context.Context, in *CommentRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	SetCommentResolved(ctx context.Context, in *SetCommentResolvedRequest, opts ...grpc.CallOption) (*CommentResponse, error)
+	AddReminder(ctx context.Context, in *AddReminderRequest, opts ...grpc.CallOption) (*ReminderResponse, error)
+	GetReminders(ctx context.Context, in *GetRemindersRequest, opts ...grpc.CallOption) (*GetRemindersResponse, error)
+	GetUpcomingReminders(ctx context.Context, in *GetUpcomingRemindersRequest, opts ...grpc.CallOption) (*GetRemindersResponse, error)
+	DeleteReminder(ctx context.Context, in *ReminderRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	AcknowledgeReminder(ctx context.Context, in *ReminderRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 	GetAttachList(ctx context.Context, in *GetAttachListRequest, opts ...grpc.CallOption) (*GetAttachListResponse, error)
 	GetSharedAttachList(ctx context.Context, in *GetSharedAttachListRequest, opts ...grpc.CallOption) (*GetAttachListResponse, error)
+	GetRevisions(ctx context.Context, in *GetRevisionsRequest, opts ...grpc.CallOption) (*GetRevisionsResponse, error)
+	GetRevision(ctx context.Context, in *RevisionRequest, opts ...grpc.CallOption) (*GetRevisionResponse, error)
+	GetRevisionDiff(ctx context.Context, in *GetRevisionDiffRequest, opts ...grpc.CallOption) (*GetRevisionDiffResponse, error)
+	RestoreRevision(ctx context.Context, in *RevisionRequest, opts ...grpc.CallOption) (*GetNoteResponse, error)
+	GetTrash(ctx context.Context, in *GetTrashRequest, opts ...grpc.CallOption) (*GetTrashResponse, error)
+	RestoreNote(ctx context.Context, in *TrashNoteRequest, opts ...grpc.CallOption) (*GetNoteResponse, error)
+	DeleteNotePermanently(ctx context.Context, in *TrashNoteRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 }
 
 type noteClient struct {
@@ -118,6 +162,78 @@ func (c *noteClient) CreateSubNote(ctx context.Context, in *CreateSubNoteRequest
 	return out, nil
 }
 
+func (c *noteClient) MoveNote(ctx context.Context, in *MoveNoteRequest, opts ...grpc.CallOption) (*GetNoteResponse, error) {
+	out := new(GetNoteResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/MoveNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteClient) ReorderNotes(ctx context.Context, in *ReorderNotesRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/ReorderNotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteClient) DuplicateNote(ctx context.Context, in *DuplicateNoteRequest, opts ...grpc.CallOption) (*GetNoteResponse, error) {
+	out := new(GetNoteResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/DuplicateNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteClient) GetBacklinks(ctx context.Context, in *GetBacklinksRequest, opts ...grpc.CallOption) (*GetBacklinksResponse, error) {
+	out := new(GetBacklinksResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/GetBacklinks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteClient) GetNoteGraph(ctx context.Context, in *GetNoteGraphRequest, opts ...grpc.CallOption) (*GetNoteGraphResponse, error) {
+	out := new(GetNoteGraphResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/GetNoteGraph", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteClient) SetTemplate(ctx context.Context, in *SetTemplateRequest, opts ...grpc.CallOption) (*GetNoteResponse, error) {
+	out := new(GetNoteResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/SetTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteClient) GetTemplates(ctx context.Context, in *GetTemplatesRequest, opts ...grpc.CallOption) (*GetTemplatesResponse, error) {
+	out := new(GetTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/GetTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteClient) CreateFromTemplate(ctx context.Context, in *CreateFromTemplateRequest, opts ...grpc.CallOption) (*GetNoteResponse, error) {
+	out := new(GetNoteResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/CreateFromTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *noteClient) AddCollaborator(ctx context.Context, in *AddCollaboratorRequest, opts ...grpc.CallOption) (*AddCollaboratorResponse, error) {
 	out := new(AddCollaboratorResponse)
 	err := c.cc.Invoke(ctx, "/note.Note/AddCollaborator", in, out, opts...)
@@ -127,6 +243,96 @@ func (c *noteClient) AddCollaborator(ctx context.Context, in *AddCollaboratorReq
 	return out, nil
 }
 
+func (c *noteClient) SetCollaboratorRole(ctx context.Context, in *SetCollaboratorRoleRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/SetCollaboratorRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteClient) RemoveCollaborator(ctx context.Context, in *RemoveCollaboratorRequest, opts ...grpc.CallOption) (*RemoveCollaboratorResponse, error) {
+	out := new(RemoveCollaboratorResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/RemoveCollaborator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteClient) LeaveNote(ctx context.Context, in *LeaveNoteRequest, opts ...grpc.CallOption) (*LeaveNoteResponse, error) {
+	out := new(LeaveNoteResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/LeaveNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteClient) GetSentInvites(ctx context.Context, in *GetInvitesRequest, opts ...grpc.CallOption) (*GetInvitesResponse, error) {
+	out := new(GetInvitesResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/GetSentInvites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteClient) GetReceivedInvites(ctx context.Context, in *GetInvitesRequest, opts ...grpc.CallOption) (*GetInvitesResponse, error) {
+	out := new(GetInvitesResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/GetReceivedInvites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteClient) AcceptInvite(ctx context.Context, in *InviteRequest, opts ...grpc.CallOption) (*InviteResponse, error) {
+	out := new(InviteResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/AcceptInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteClient) DeclineInvite(ctx context.Context, in *InviteRequest, opts ...grpc.CallOption) (*InviteResponse, error) {
+	out := new(InviteResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/DeclineInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteClient) RevokeInvite(ctx context.Context, in *InviteRequest, opts ...grpc.CallOption) (*InviteResponse, error) {
+	out := new(InviteResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/RevokeInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteClient) ProposeTransfer(ctx context.Context, in *ProposeTransferRequest, opts ...grpc.CallOption) (*ProposeTransferResponse, error) {
+	out := new(ProposeTransferResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/ProposeTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteClient) AcceptTransfer(ctx context.Context, in *AcceptTransferRequest, opts ...grpc.CallOption) (*AcceptTransferResponse, error) {
+	out := new(AcceptTransferResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/AcceptTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *noteClient) AddTag(ctx context.Context, in *TagRequest, opts ...grpc.CallOption) (*GetNoteResponse, error) {
 	out := new(GetNoteResponse)
 	err := c.cc.Invoke(ctx, "/note.Note/AddTag", in, out, opts...)
@@ -190,6 +396,24 @@ func (c *noteClient) UpdateTag(ctx context.Context, in *UpdateTagRequest, opts .
 	return out, nil
 }
 
+func (c *noteClient) MergeTags(ctx context.Context, in *MergeTagsRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/MergeTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteClient) SetTagInfo(ctx context.Context, in *TagInfoRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/SetTagInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *noteClient) SetIcon(ctx context.Context, in *SetIconRequest, opts ...grpc.CallOption) (*GetNoteResponse, error) {
 	out := new(GetNoteResponse)
 	err := c.cc.Invoke(ctx, "/note.Note/SetIcon", in, out, opts...)
@@ -226,6 +450,24 @@ func (c *noteClient) DelFav(ctx context.Context, in *ChangeFlagRequest, opts ...
 	return out, nil
 }
 
+func (c *noteClient) ArchiveNote(ctx context.Context, in *ChangeFlagRequest, opts ...grpc.CallOption) (*GetNoteResponse, error) {
+	out := new(GetNoteResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/ArchiveNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteClient) UnarchiveNote(ctx context.Context, in *ChangeFlagRequest, opts ...grpc.CallOption) (*GetNoteResponse, error) {
+	out := new(GetNoteResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/UnarchiveNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *noteClient) SetPublic(ctx context.Context, in *AccessModeRequest, opts ...grpc.CallOption) (*GetNoteResponse, error) {
 	out := new(GetNoteResponse)
 	err := c.cc.Invoke(ctx, "/note.Note/SetPublic", in, out, opts...)
@@ -244,90 +486,377 @@ func (c *noteClient) SetPrivate(ctx context.Context, in *AccessModeRequest, opts
 	return out, nil
 }
 
-func (c *noteClient) GetAttachList(ctx context.Context, in *GetAttachListRequest, opts ...grpc.CallOption) (*GetAttachListResponse, error) {
-	out := new(GetAttachListResponse)
-	err := c.cc.Invoke(ctx, "/note.Note/GetAttachList", in, out, opts...)
+func (c *noteClient) BulkUpdate(ctx context.Context, in *BulkRequest, opts ...grpc.CallOption) (*BulkResponse, error) {
+	out := new(BulkResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/BulkUpdate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *noteClient) GetSharedAttachList(ctx context.Context, in *GetSharedAttachListRequest, opts ...grpc.CallOption) (*GetAttachListResponse, error) {
-	out := new(GetAttachListResponse)
-	err := c.cc.Invoke(ctx, "/note.Note/GetSharedAttachList", in, out, opts...)
+func (c *noteClient) CreateShareLink(ctx context.Context, in *CreateShareLinkRequest, opts ...grpc.CallOption) (*ShareLinkResponse, error) {
+	out := new(ShareLinkResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/CreateShareLink", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// NoteServer is the server API for Note service.
-// All implementations must embed UnimplementedNoteServer
-// for forward compatibility
-type NoteServer interface {
-	GetAllNotes(context.Context, *GetAllRequest) (*GetAllResponse, error)
-	GetNote(context.Context, *GetNoteRequest) (*GetNoteResponseResponse, error)
-	GetPublicNote(context.Context, *GetPublicNoteRequest) (*GetNoteResponseResponse, error)
-	AddNote(context.Context, *AddNoteRequest) (*AddNoteResponse, error)
-	UpdateNote(context.Context, *UpdateNoteRequest) (*UpdateNoteResponse, error)
-	DeleteNote(context.Context, *DeleteNoteRequest) (*DeleteNoteResponse, error)
-	CreateSubNote(context.Context, *CreateSubNoteRequest) (*CreateSubNoteResponse, error)
-	AddCollaborator(context.Context, *AddCollaboratorRequest) (*AddCollaboratorResponse, error)
-	AddTag(context.Context, *TagRequest) (*GetNoteResponse, error)
-	DeleteTag(context.Context, *TagRequest) (*GetNoteResponse, error)
-	GetTags(context.Context, *GetTagsRequest) (*GetTagsResponse, error)
-	CheckPermissions(context.Context, *CheckPermissionsRequest) (*CheckPermissionsResponse, error)
-	RememberTag(context.Context, *AllTagRequest) (*EmptyResponse, error)
-	ForgetTag(context.Context, *AllTagRequest) (*EmptyResponse, error)
-	UpdateTag(context.Context, *UpdateTagRequest) (*EmptyResponse, error)
-	SetIcon(context.Context, *SetIconRequest) (*GetNoteResponse, error)
-	SetHeader(context.Context, *SetHeaderRequest) (*GetNoteResponse, error)
-	AddFav(context.Context, *ChangeFlagRequest) (*GetNoteResponse, error)
-	DelFav(context.Context, *ChangeFlagRequest) (*GetNoteResponse, error)
-	SetPublic(context.Context, *AccessModeRequest) (*GetNoteResponse, error)
-	SetPrivate(context.Context, *AccessModeRequest) (*GetNoteResponse, error)
-	GetAttachList(context.Context, *GetAttachListRequest) (*GetAttachListResponse, error)
-	GetSharedAttachList(context.Context, *GetSharedAttachListRequest) (*GetAttachListResponse, error)
-	mustEmbedUnimplementedNoteServer()
+func (c *noteClient) GetShareLinks(ctx context.Context, in *GetShareLinksRequest, opts ...grpc.CallOption) (*GetShareLinksResponse, error) {
+	out := new(GetShareLinksResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/GetShareLinks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedNoteServer must be embedded to have forward compatible implementations.
-type UnimplementedNoteServer struct {
+func (c *noteClient) RevokeShareLink(ctx context.Context, in *RevokeShareLinkRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/RevokeShareLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedNoteServer) GetAllNotes(context.Context, *GetAllRequest) (*GetAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllNotes not implemented")
-}
-func (UnimplementedNoteServer) GetNote(context.Context, *GetNoteRequest) (*GetNoteResponseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetNote not implemented")
+func (c *noteClient) GetComments(ctx context.Context, in *GetCommentsRequest, opts ...grpc.CallOption) (*GetCommentsResponse, error) {
+	out := new(GetCommentsResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/GetComments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedNoteServer) GetPublicNote(context.Context, *GetPublicNoteRequest) (*GetNoteResponseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPublicNote not implemented")
+
+func (c *noteClient) AddComment(ctx context.Context, in *AddCommentRequest, opts ...grpc.CallOption) (*CommentResponse, error) {
+	out := new(CommentResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/AddComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedNoteServer) AddNote(context.Context, *AddNoteRequest) (*AddNoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddNote not implemented")
+
+func (c *noteClient) UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*CommentResponse, error) {
+	out := new(CommentResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/UpdateComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedNoteServer) UpdateNote(context.Context, *UpdateNoteRequest) (*UpdateNoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateNote not implemented")
+
+func (c *noteClient) DeleteComment(ctx context.Context, in *CommentRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/DeleteComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedNoteServer) DeleteNote(context.Context, *DeleteNoteRequest) (*DeleteNoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteNote not implemented")
+
+func (c *noteClient) SetCommentResolved(ctx context.Context, in *SetCommentResolvedRequest, opts ...grpc.CallOption) (*CommentResponse, error) {
+	out := new(CommentResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/SetCommentResolved", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedNoteServer) CreateSubNote(context.Context, *CreateSubNoteRequest) (*CreateSubNoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateSubNote not implemented")
+
+func (c *noteClient) AddReminder(ctx context.Context, in *AddReminderRequest, opts ...grpc.CallOption) (*ReminderResponse, error) {
+	out := new(ReminderResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/AddReminder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedNoteServer) AddCollaborator(context.Context, *AddCollaboratorRequest) (*AddCollaboratorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddCollaborator not implemented")
+
+func (c *noteClient) GetReminders(ctx context.Context, in *GetRemindersRequest, opts ...grpc.CallOption) (*GetRemindersResponse, error) {
+	out := new(GetRemindersResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/GetReminders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedNoteServer) AddTag(context.Context, *TagRequest) (*GetNoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddTag not implemented")
+
+func (c *noteClient) GetUpcomingReminders(ctx context.Context, in *GetUpcomingRemindersRequest, opts ...grpc.CallOption) (*GetRemindersResponse, error) {
+	out := new(GetRemindersResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/GetUpcomingReminders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedNoteServer) DeleteTag(context.Context, *TagRequest) (*GetNoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTag not implemented")
+
+func (c *noteClient) DeleteReminder(ctx context.Context, in *ReminderRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/DeleteReminder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedNoteServer) GetTags(context.Context, *GetTagsRequest) (*GetTagsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTags not implemented")
+
+func (c *noteClient) AcknowledgeReminder(ctx context.Context, in *ReminderRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/AcknowledgeReminder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteClient) GetAttachList(ctx context.Context, in *GetAttachListRequest, opts ...grpc.CallOption) (*GetAttachListResponse, error) {
+	out := new(GetAttachListResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/GetAttachList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteClient) GetSharedAttachList(ctx context.Context, in *GetSharedAttachListRequest, opts ...grpc.CallOption) (*GetAttachListResponse, error) {
+	out := new(GetAttachListResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/GetSharedAttachList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteClient) GetRevisions(ctx context.Context, in *GetRevisionsRequest, opts ...grpc.CallOption) (*GetRevisionsResponse, error) {
+	out := new(GetRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/GetRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteClient) GetRevision(ctx context.Context, in *RevisionRequest, opts ...grpc.CallOption) (*GetRevisionResponse, error) {
+	out := new(GetRevisionResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/GetRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteClient) GetRevisionDiff(ctx context.Context, in *GetRevisionDiffRequest, opts ...grpc.CallOption) (*GetRevisionDiffResponse, error) {
+	out := new(GetRevisionDiffResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/GetRevisionDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteClient) RestoreRevision(ctx context.Context, in *RevisionRequest, opts ...grpc.CallOption) (*GetNoteResponse, error) {
+	out := new(GetNoteResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/RestoreRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteClient) GetTrash(ctx context.Context, in *GetTrashRequest, opts ...grpc.CallOption) (*GetTrashResponse, error) {
+	out := new(GetTrashResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/GetTrash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteClient) RestoreNote(ctx context.Context, in *TrashNoteRequest, opts ...grpc.CallOption) (*GetNoteResponse, error) {
+	out := new(GetNoteResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/RestoreNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteClient) DeleteNotePermanently(ctx context.Context, in *TrashNoteRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/note.Note/DeleteNotePermanently", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NoteServer is the server API for Note service.
+// All implementations must embed UnimplementedNoteServer
+// for forward compatibility
+type NoteServer interface {
+	GetAllNotes(context.Context, *GetAllRequest) (*GetAllResponse, error)
+	GetNote(context.Context, *GetNoteRequest) (*GetNoteResponseResponse, error)
+	GetPublicNote(context.Context, *GetPublicNoteRequest) (*GetNoteResponseResponse, error)
+	AddNote(context.Context, *AddNoteRequest) (*AddNoteResponse, error)
+	UpdateNote(context.Context, *UpdateNoteRequest) (*UpdateNoteResponse, error)
+	DeleteNote(context.Context, *DeleteNoteRequest) (*DeleteNoteResponse, error)
+	CreateSubNote(context.Context, *CreateSubNoteRequest) (*CreateSubNoteResponse, error)
+	MoveNote(context.Context, *MoveNoteRequest) (*GetNoteResponse, error)
+	ReorderNotes(context.Context, *ReorderNotesRequest) (*EmptyResponse, error)
+	DuplicateNote(context.Context, *DuplicateNoteRequest) (*GetNoteResponse, error)
+	GetBacklinks(context.Context, *GetBacklinksRequest) (*GetBacklinksResponse, error)
+	GetNoteGraph(context.Context, *GetNoteGraphRequest) (*GetNoteGraphResponse, error)
+	SetTemplate(context.Context, *SetTemplateRequest) (*GetNoteResponse, error)
+	GetTemplates(context.Context, *GetTemplatesRequest) (*GetTemplatesResponse, error)
+	CreateFromTemplate(context.Context, *CreateFromTemplateRequest) (*GetNoteResponse, error)
+	AddCollaborator(context.Context, *AddCollaboratorRequest) (*AddCollaboratorResponse, error)
+	SetCollaboratorRole(context.Context, *SetCollaboratorRoleRequest) (*EmptyResponse, error)
+	RemoveCollaborator(context.Context, *RemoveCollaboratorRequest) (*RemoveCollaboratorResponse, error)
+	LeaveNote(context.Context, *LeaveNoteRequest) (*LeaveNoteResponse, error)
+	GetSentInvites(context.Context, *GetInvitesRequest) (*GetInvitesResponse, error)
+	GetReceivedInvites(context.Context, *GetInvitesRequest) (*GetInvitesResponse, error)
+	AcceptInvite(context.Context, *InviteRequest) (*InviteResponse, error)
+	DeclineInvite(context.Context, *InviteRequest) (*InviteResponse, error)
+	RevokeInvite(context.Context, *InviteRequest) (*InviteResponse, error)
+	ProposeTransfer(context.Context, *ProposeTransferRequest) (*ProposeTransferResponse, error)
+	AcceptTransfer(context.Context, *AcceptTransferRequest) (*AcceptTransferResponse, error)
+	AddTag(context.Context, *TagRequest) (*GetNoteResponse, error)
+	DeleteTag(context.Context, *TagRequest) (*GetNoteResponse, error)
+	GetTags(context.Context, *GetTagsRequest) (*GetTagsResponse, error)
+	CheckPermissions(context.Context, *CheckPermissionsRequest) (*CheckPermissionsResponse, error)
+	RememberTag(context.Context, *AllTagRequest) (*EmptyResponse, error)
+	ForgetTag(context.Context, *AllTagRequest) (*EmptyResponse, error)
+	UpdateTag(context.Context, *UpdateTagRequest) (*EmptyResponse, error)
+	MergeTags(context.Context, *MergeTagsRequest) (*EmptyResponse, error)
+	SetTagInfo(context.Context, *TagInfoRequest) (*EmptyResponse, error)
+	SetIcon(context.Context, *SetIconRequest) (*GetNoteResponse, error)
+	SetHeader(context.Context, *SetHeaderRequest) (*GetNoteResponse, error)
+	AddFav(context.Context, *ChangeFlagRequest) (*GetNoteResponse, error)
+	DelFav(context.Context, *ChangeFlagRequest) (*GetNoteResponse, error)
+	ArchiveNote(context.Context, *ChangeFlagRequest) (*GetNoteResponse, error)
+	UnarchiveNote(context.Context, *ChangeFlagRequest) (*GetNoteResponse, error)
+	// устарели: доступ выдается через CreateShareLink/RevokeShareLink
+	SetPublic(context.Context, *AccessModeRequest) (*GetNoteResponse, error)
+	SetPrivate(context.Context, *AccessModeRequest) (*GetNoteResponse, error)
+	BulkUpdate(context.Context, *BulkRequest) (*BulkResponse, error)
+	CreateShareLink(context.Context, *CreateShareLinkRequest) (*ShareLinkResponse, error)
+	GetShareLinks(context.Context, *GetShareLinksRequest) (*GetShareLinksResponse, error)
+	RevokeShareLink(context.Context, *RevokeShareLinkRequest) (*EmptyResponse, error)
+	GetComments(context.Context, *GetCommentsRequest) (*GetCommentsResponse, error)
+	AddComment(context.Context, *AddCommentRequest) (*CommentResponse, error)
+	UpdateComment(context.Context, *UpdateCommentRequest) (*CommentResponse, error)
+	DeleteComment(context.Context, *CommentRequest) (*EmptyResponse, error)
+	SetCommentResolved(context.Context, *SetCommentResolvedRequest) (*CommentResponse, error)
+	AddReminder(context.Context, *AddReminderRequest) (*ReminderResponse, error)
+	GetReminders(context.Context, *GetRemindersRequest) (*GetRemindersResponse, error)
+	GetUpcomingReminders(context.Context, *GetUpcomingRemindersRequest) (*GetRemindersResponse, error)
+	DeleteReminder(context.Context, *ReminderRequest) (*EmptyResponse, error)
+	AcknowledgeReminder(context.Context, *ReminderRequest) (*EmptyResponse, error)
+	GetAttachList(context.Context, *GetAttachListRequest) (*GetAttachListResponse, error)
+	GetSharedAttachList(context.Context, *GetSharedAttachListRequest) (*GetAttachListResponse, error)
+	GetRevisions(context.Context, *GetRevisionsRequest) (*GetRevisionsResponse, error)
+	GetRevision(context.Context, *RevisionRequest) (*GetRevisionResponse, error)
+	GetRevisionDiff(context.Context, *GetRevisionDiffRequest) (*GetRevisionDiffResponse, error)
+	RestoreRevision(context.Context, *RevisionRequest) (*GetNoteResponse, error)
+	GetTrash(context.Context, *GetTrashRequest) (*GetTrashResponse, error)
+	RestoreNote(context.Context, *TrashNoteRequest) (*GetNoteResponse, error)
+	DeleteNotePermanently(context.Context, *TrashNoteRequest) (*EmptyResponse, error)
+	mustEmbedUnimplementedNoteServer()
+}
+
+// UnimplementedNoteServer must be embedded to have forward compatible implementations.
+type UnimplementedNoteServer struct {
+}
+
+func (UnimplementedNoteServer) GetAllNotes(context.Context, *GetAllRequest) (*GetAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllNotes not implemented")
+}
+func (UnimplementedNoteServer) GetNote(context.Context, *GetNoteRequest) (*GetNoteResponseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNote not implemented")
+}
+func (UnimplementedNoteServer) GetPublicNote(context.Context, *GetPublicNoteRequest) (*GetNoteResponseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicNote not implemented")
+}
+func (UnimplementedNoteServer) AddNote(context.Context, *AddNoteRequest) (*AddNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddNote not implemented")
+}
+func (UnimplementedNoteServer) UpdateNote(context.Context, *UpdateNoteRequest) (*UpdateNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNote not implemented")
+}
+func (UnimplementedNoteServer) DeleteNote(context.Context, *DeleteNoteRequest) (*DeleteNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNote not implemented")
+}
+func (UnimplementedNoteServer) CreateSubNote(context.Context, *CreateSubNoteRequest) (*CreateSubNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSubNote not implemented")
+}
+func (UnimplementedNoteServer) MoveNote(context.Context, *MoveNoteRequest) (*GetNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveNote not implemented")
+}
+func (UnimplementedNoteServer) ReorderNotes(context.Context, *ReorderNotesRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderNotes not implemented")
+}
+func (UnimplementedNoteServer) DuplicateNote(context.Context, *DuplicateNoteRequest) (*GetNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DuplicateNote not implemented")
+}
+func (UnimplementedNoteServer) GetBacklinks(context.Context, *GetBacklinksRequest) (*GetBacklinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBacklinks not implemented")
+}
+func (UnimplementedNoteServer) GetNoteGraph(context.Context, *GetNoteGraphRequest) (*GetNoteGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNoteGraph not implemented")
+}
+func (UnimplementedNoteServer) SetTemplate(context.Context, *SetTemplateRequest) (*GetNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTemplate not implemented")
+}
+func (UnimplementedNoteServer) GetTemplates(context.Context, *GetTemplatesRequest) (*GetTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTemplates not implemented")
+}
+func (UnimplementedNoteServer) CreateFromTemplate(context.Context, *CreateFromTemplateRequest) (*GetNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFromTemplate not implemented")
+}
+func (UnimplementedNoteServer) AddCollaborator(context.Context, *AddCollaboratorRequest) (*AddCollaboratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCollaborator not implemented")
+}
+func (UnimplementedNoteServer) SetCollaboratorRole(context.Context, *SetCollaboratorRoleRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCollaboratorRole not implemented")
+}
+func (UnimplementedNoteServer) RemoveCollaborator(context.Context, *RemoveCollaboratorRequest) (*RemoveCollaboratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCollaborator not implemented")
+}
+func (UnimplementedNoteServer) LeaveNote(context.Context, *LeaveNoteRequest) (*LeaveNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveNote not implemented")
+}
+func (UnimplementedNoteServer) GetSentInvites(context.Context, *GetInvitesRequest) (*GetInvitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSentInvites not implemented")
+}
+func (UnimplementedNoteServer) GetReceivedInvites(context.Context, *GetInvitesRequest) (*GetInvitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReceivedInvites not implemented")
+}
+func (UnimplementedNoteServer) AcceptInvite(context.Context, *InviteRequest) (*InviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptInvite not implemented")
+}
+func (UnimplementedNoteServer) DeclineInvite(context.Context, *InviteRequest) (*InviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineInvite not implemented")
+}
+func (UnimplementedNoteServer) RevokeInvite(context.Context, *InviteRequest) (*InviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInvite not implemented")
+}
+func (UnimplementedNoteServer) ProposeTransfer(context.Context, *ProposeTransferRequest) (*ProposeTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeTransfer not implemented")
+}
+func (UnimplementedNoteServer) AcceptTransfer(context.Context, *AcceptTransferRequest) (*AcceptTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptTransfer not implemented")
+}
+func (UnimplementedNoteServer) AddTag(context.Context, *TagRequest) (*GetNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTag not implemented")
+}
+func (UnimplementedNoteServer) DeleteTag(context.Context, *TagRequest) (*GetNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTag not implemented")
+}
+func (UnimplementedNoteServer) GetTags(context.Context, *GetTagsRequest) (*GetTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTags not implemented")
 }
 func (UnimplementedNoteServer) CheckPermissions(context.Context, *CheckPermissionsRequest) (*CheckPermissionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckPermissions not implemented")
@@ -341,6 +870,12 @@ func (UnimplementedNoteServer) ForgetTag(context.Context, *AllTagRequest) (*Empt
 func (UnimplementedNoteServer) UpdateTag(context.Context, *UpdateTagRequest) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateTag not implemented")
 }
+func (UnimplementedNoteServer) MergeTags(context.Context, *MergeTagsRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeTags not implemented")
+}
+func (UnimplementedNoteServer) SetTagInfo(context.Context, *TagInfoRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTagInfo not implemented")
+}
 func (UnimplementedNoteServer) SetIcon(context.Context, *SetIconRequest) (*GetNoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetIcon not implemented")
 }
@@ -353,441 +888,1284 @@ func (UnimplementedNoteServer) AddFav(context.Context, *ChangeFlagRequest) (*Get
 func (UnimplementedNoteServer) DelFav(context.Context, *ChangeFlagRequest) (*GetNoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelFav not implemented")
 }
+func (UnimplementedNoteServer) ArchiveNote(context.Context, *ChangeFlagRequest) (*GetNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveNote not implemented")
+}
+func (UnimplementedNoteServer) UnarchiveNote(context.Context, *ChangeFlagRequest) (*GetNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnarchiveNote not implemented")
+}
 func (UnimplementedNoteServer) SetPublic(context.Context, *AccessModeRequest) (*GetNoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetPublic not implemented")
 }
 func (UnimplementedNoteServer) SetPrivate(context.Context, *AccessModeRequest) (*GetNoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetPrivate not implemented")
 }
+func (UnimplementedNoteServer) BulkUpdate(context.Context, *BulkRequest) (*BulkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpdate not implemented")
+}
+func (UnimplementedNoteServer) CreateShareLink(context.Context, *CreateShareLinkRequest) (*ShareLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShareLink not implemented")
+}
+func (UnimplementedNoteServer) GetShareLinks(context.Context, *GetShareLinksRequest) (*GetShareLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShareLinks not implemented")
+}
+func (UnimplementedNoteServer) RevokeShareLink(context.Context, *RevokeShareLinkRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeShareLink not implemented")
+}
+func (UnimplementedNoteServer) GetComments(context.Context, *GetCommentsRequest) (*GetCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComments not implemented")
+}
+func (UnimplementedNoteServer) AddComment(context.Context, *AddCommentRequest) (*CommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddComment not implemented")
+}
+func (UnimplementedNoteServer) UpdateComment(context.Context, *UpdateCommentRequest) (*CommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateComment not implemented")
+}
+func (UnimplementedNoteServer) DeleteComment(context.Context, *CommentRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedNoteServer) SetCommentResolved(context.Context, *SetCommentResolvedRequest) (*CommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCommentResolved not implemented")
+}
+func (UnimplementedNoteServer) AddReminder(context.Context, *AddReminderRequest) (*ReminderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddReminder not implemented")
+}
+func (UnimplementedNoteServer) GetReminders(context.Context, *GetRemindersRequest) (*GetRemindersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReminders not implemented")
+}
+func (UnimplementedNoteServer) GetUpcomingReminders(context.Context, *GetUpcomingRemindersRequest) (*GetRemindersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUpcomingReminders not implemented")
+}
+func (UnimplementedNoteServer) DeleteReminder(context.Context, *ReminderRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReminder not implemented")
+}
+func (UnimplementedNoteServer) AcknowledgeReminder(context.Context, *ReminderRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeReminder not implemented")
+}
 func (UnimplementedNoteServer) GetAttachList(context.Context, *GetAttachListRequest) (*GetAttachListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAttachList not implemented")
 }
 func (UnimplementedNoteServer) GetSharedAttachList(context.Context, *GetSharedAttachListRequest) (*GetAttachListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSharedAttachList not implemented")
 }
-func (UnimplementedNoteServer) mustEmbedUnimplementedNoteServer() {}
+func (UnimplementedNoteServer) GetRevisions(context.Context, *GetRevisionsRequest) (*GetRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevisions not implemented")
+}
+func (UnimplementedNoteServer) GetRevision(context.Context, *RevisionRequest) (*GetRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevision not implemented")
+}
+func (UnimplementedNoteServer) GetRevisionDiff(context.Context, *GetRevisionDiffRequest) (*GetRevisionDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevisionDiff not implemented")
+}
+func (UnimplementedNoteServer) RestoreRevision(context.Context, *RevisionRequest) (*GetNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreRevision not implemented")
+}
+func (UnimplementedNoteServer) GetTrash(context.Context, *GetTrashRequest) (*GetTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrash not implemented")
+}
+func (UnimplementedNoteServer) RestoreNote(context.Context, *TrashNoteRequest) (*GetNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreNote not implemented")
+}
+func (UnimplementedNoteServer) DeleteNotePermanently(context.Context, *TrashNoteRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNotePermanently not implemented")
+}
+func (UnimplementedNoteServer) mustEmbedUnimplementedNoteServer() {}
+
+// UnsafeNoteServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NoteServer will
+// result in compilation errors.
+type UnsafeNoteServer interface {
+	mustEmbedUnimplementedNoteServer()
+}
+
+func RegisterNoteServer(s grpc.ServiceRegistrar, srv NoteServer) {
+	s.RegisterService(&Note_ServiceDesc, srv)
+}
+
+func _Note_GetAllNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).GetAllNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/GetAllNotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).GetAllNotes(ctx, req.(*GetAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_GetNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).GetNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/GetNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).GetNote(ctx, req.(*GetNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_GetPublicNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).GetPublicNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/GetPublicNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).GetPublicNote(ctx, req.(*GetPublicNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_AddNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).AddNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/AddNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).AddNote(ctx, req.(*AddNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_UpdateNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).UpdateNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/UpdateNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).UpdateNote(ctx, req.(*UpdateNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_DeleteNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).DeleteNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/DeleteNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).DeleteNote(ctx, req.(*DeleteNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_CreateSubNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSubNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).CreateSubNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/CreateSubNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).CreateSubNote(ctx, req.(*CreateSubNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_MoveNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).MoveNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/MoveNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).MoveNote(ctx, req.(*MoveNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_ReorderNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).ReorderNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/ReorderNotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).ReorderNotes(ctx, req.(*ReorderNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_DuplicateNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DuplicateNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).DuplicateNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/DuplicateNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).DuplicateNote(ctx, req.(*DuplicateNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_GetBacklinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBacklinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).GetBacklinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/GetBacklinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).GetBacklinks(ctx, req.(*GetBacklinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_GetNoteGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNoteGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).GetNoteGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/GetNoteGraph",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).GetNoteGraph(ctx, req.(*GetNoteGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_SetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).SetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/SetTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).SetTemplate(ctx, req.(*SetTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_GetTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).GetTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/GetTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).GetTemplates(ctx, req.(*GetTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_CreateFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).CreateFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/CreateFromTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).CreateFromTemplate(ctx, req.(*CreateFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_AddCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCollaboratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).AddCollaborator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/AddCollaborator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).AddCollaborator(ctx, req.(*AddCollaboratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_SetCollaboratorRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCollaboratorRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).SetCollaboratorRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/SetCollaboratorRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).SetCollaboratorRole(ctx, req.(*SetCollaboratorRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_RemoveCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCollaboratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).RemoveCollaborator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/RemoveCollaborator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).RemoveCollaborator(ctx, req.(*RemoveCollaboratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_LeaveNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).LeaveNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/LeaveNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).LeaveNote(ctx, req.(*LeaveNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_GetSentInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).GetSentInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/GetSentInvites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).GetSentInvites(ctx, req.(*GetInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_GetReceivedInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).GetReceivedInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/GetReceivedInvites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).GetReceivedInvites(ctx, req.(*GetInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_AcceptInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).AcceptInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/AcceptInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).AcceptInvite(ctx, req.(*InviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_DeclineInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).DeclineInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/DeclineInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).DeclineInvite(ctx, req.(*InviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_RevokeInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).RevokeInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/RevokeInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).RevokeInvite(ctx, req.(*InviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_ProposeTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposeTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).ProposeTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/ProposeTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).ProposeTransfer(ctx, req.(*ProposeTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_AcceptTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).AcceptTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/AcceptTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).AcceptTransfer(ctx, req.(*AcceptTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_AddTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).AddTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/AddTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).AddTag(ctx, req.(*TagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).DeleteTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/DeleteTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).DeleteTag(ctx, req.(*TagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_GetTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).GetTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/GetTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).GetTags(ctx, req.(*GetTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_CheckPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).CheckPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/CheckPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).CheckPermissions(ctx, req.(*CheckPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_RememberTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).RememberTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/RememberTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).RememberTag(ctx, req.(*AllTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_ForgetTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).ForgetTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/ForgetTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).ForgetTag(ctx, req.(*AllTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_UpdateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).UpdateTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/UpdateTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).UpdateTag(ctx, req.(*UpdateTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_MergeTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).MergeTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/MergeTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).MergeTags(ctx, req.(*MergeTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_SetTagInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).SetTagInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/SetTagInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).SetTagInfo(ctx, req.(*TagInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_SetIcon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetIconRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).SetIcon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/SetIcon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).SetIcon(ctx, req.(*SetIconRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_SetHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetHeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).SetHeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/SetHeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).SetHeader(ctx, req.(*SetHeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_AddFav_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeFlagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).AddFav(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/AddFav",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).AddFav(ctx, req.(*ChangeFlagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_DelFav_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeFlagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).DelFav(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/DelFav",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).DelFav(ctx, req.(*ChangeFlagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_ArchiveNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeFlagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).ArchiveNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/ArchiveNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).ArchiveNote(ctx, req.(*ChangeFlagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Note_UnarchiveNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeFlagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).UnarchiveNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/UnarchiveNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).UnarchiveNote(ctx, req.(*ChangeFlagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// UnsafeNoteServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to NoteServer will
-// result in compilation errors.
-type UnsafeNoteServer interface {
-	mustEmbedUnimplementedNoteServer()
+func _Note_SetPublic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccessModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).SetPublic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/SetPublic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).SetPublic(ctx, req.(*AccessModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterNoteServer(s grpc.ServiceRegistrar, srv NoteServer) {
-	s.RegisterService(&Note_ServiceDesc, srv)
+func _Note_SetPrivate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccessModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteServer).SetPrivate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/note.Note/SetPrivate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteServer).SetPrivate(ctx, req.(*AccessModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Note_GetAllNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllRequest)
+func _Note_BulkUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NoteServer).GetAllNotes(ctx, in)
+		return srv.(NoteServer).BulkUpdate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/note.Note/GetAllNotes",
+		FullMethod: "/note.Note/BulkUpdate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NoteServer).GetAllNotes(ctx, req.(*GetAllRequest))
+		return srv.(NoteServer).BulkUpdate(ctx, req.(*BulkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Note_GetNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetNoteRequest)
+func _Note_CreateShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShareLinkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NoteServer).GetNote(ctx, in)
+		return srv.(NoteServer).CreateShareLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/note.Note/GetNote",
+		FullMethod: "/note.Note/CreateShareLink",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NoteServer).GetNote(ctx, req.(*GetNoteRequest))
+		return srv.(NoteServer).CreateShareLink(ctx, req.(*CreateShareLinkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Note_GetPublicNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPublicNoteRequest)
+func _Note_GetShareLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShareLinksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NoteServer).GetPublicNote(ctx, in)
+		return srv.(NoteServer).GetShareLinks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/note.Note/GetPublicNote",
+		FullMethod: "/note.Note/GetShareLinks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NoteServer).GetPublicNote(ctx, req.(*GetPublicNoteRequest))
+		return srv.(NoteServer).GetShareLinks(ctx, req.(*GetShareLinksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Note_AddNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddNoteRequest)
+func _Note_RevokeShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeShareLinkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NoteServer).AddNote(ctx, in)
+		return srv.(NoteServer).RevokeShareLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/note.Note/AddNote",
+		FullMethod: "/note.Note/RevokeShareLink",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NoteServer).AddNote(ctx, req.(*AddNoteRequest))
+		return srv.(NoteServer).RevokeShareLink(ctx, req.(*RevokeShareLinkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Note_UpdateNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateNoteRequest)
+func _Note_GetComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NoteServer).UpdateNote(ctx, in)
+		return srv.(NoteServer).GetComments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/note.Note/UpdateNote",
+		FullMethod: "/note.Note/GetComments",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NoteServer).UpdateNote(ctx, req.(*UpdateNoteRequest))
+		return srv.(NoteServer).GetComments(ctx, req.(*GetCommentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Note_DeleteNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteNoteRequest)
+func _Note_AddComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NoteServer).DeleteNote(ctx, in)
+		return srv.(NoteServer).AddComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/note.Note/DeleteNote",
+		FullMethod: "/note.Note/AddComment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NoteServer).DeleteNote(ctx, req.(*DeleteNoteRequest))
+		return srv.(NoteServer).AddComment(ctx, req.(*AddCommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Note_CreateSubNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateSubNoteRequest)
+func _Note_UpdateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NoteServer).CreateSubNote(ctx, in)
+		return srv.(NoteServer).UpdateComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/note.Note/CreateSubNote",
+		FullMethod: "/note.Note/UpdateComment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NoteServer).CreateSubNote(ctx, req.(*CreateSubNoteRequest))
+		return srv.(NoteServer).UpdateComment(ctx, req.(*UpdateCommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Note_AddCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddCollaboratorRequest)
+func _Note_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NoteServer).AddCollaborator(ctx, in)
+		return srv.(NoteServer).DeleteComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/note.Note/AddCollaborator",
+		FullMethod: "/note.Note/DeleteComment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NoteServer).AddCollaborator(ctx, req.(*AddCollaboratorRequest))
+		return srv.(NoteServer).DeleteComment(ctx, req.(*CommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Note_AddTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TagRequest)
+func _Note_SetCommentResolved_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCommentResolvedRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NoteServer).AddTag(ctx, in)
+		return srv.(NoteServer).SetCommentResolved(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/note.Note/AddTag",
+		FullMethod: "/note.Note/SetCommentResolved",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NoteServer).AddTag(ctx, req.(*TagRequest))
+		return srv.(NoteServer).SetCommentResolved(ctx, req.(*SetCommentResolvedRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Note_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TagRequest)
+func _Note_AddReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddReminderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NoteServer).DeleteTag(ctx, in)
+		return srv.(NoteServer).AddReminder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/note.Note/DeleteTag",
+		FullMethod: "/note.Note/AddReminder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NoteServer).DeleteTag(ctx, req.(*TagRequest))
+		return srv.(NoteServer).AddReminder(ctx, req.(*AddReminderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Note_GetTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTagsRequest)
+func _Note_GetReminders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRemindersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NoteServer).GetTags(ctx, in)
+		return srv.(NoteServer).GetReminders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/note.Note/GetTags",
+		FullMethod: "/note.Note/GetReminders",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NoteServer).GetTags(ctx, req.(*GetTagsRequest))
+		return srv.(NoteServer).GetReminders(ctx, req.(*GetRemindersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Note_CheckPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckPermissionsRequest)
+func _Note_GetUpcomingReminders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUpcomingRemindersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NoteServer).CheckPermissions(ctx, in)
+		return srv.(NoteServer).GetUpcomingReminders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/note.Note/CheckPermissions",
+		FullMethod: "/note.Note/GetUpcomingReminders",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NoteServer).CheckPermissions(ctx, req.(*CheckPermissionsRequest))
+		return srv.(NoteServer).GetUpcomingReminders(ctx, req.(*GetUpcomingRemindersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Note_RememberTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AllTagRequest)
+func _Note_DeleteReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReminderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NoteServer).RememberTag(ctx, in)
+		return srv.(NoteServer).DeleteReminder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/note.Note/RememberTag",
+		FullMethod: "/note.Note/DeleteReminder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NoteServer).RememberTag(ctx, req.(*AllTagRequest))
+		return srv.(NoteServer).DeleteReminder(ctx, req.(*ReminderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Note_ForgetTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AllTagRequest)
+func _Note_AcknowledgeReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReminderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NoteServer).ForgetTag(ctx, in)
+		return srv.(NoteServer).AcknowledgeReminder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/note.Note/ForgetTag",
+		FullMethod: "/note.Note/AcknowledgeReminder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NoteServer).ForgetTag(ctx, req.(*AllTagRequest))
+		return srv.(NoteServer).AcknowledgeReminder(ctx, req.(*ReminderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Note_UpdateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTagRequest)
+func _Note_GetAttachList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttachListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NoteServer).UpdateTag(ctx, in)
+		return srv.(NoteServer).GetAttachList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/note.Note/UpdateTag",
+		FullMethod: "/note.Note/GetAttachList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NoteServer).UpdateTag(ctx, req.(*UpdateTagRequest))
+		return srv.(NoteServer).GetAttachList(ctx, req.(*GetAttachListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Note_SetIcon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetIconRequest)
+func _Note_GetSharedAttachList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedAttachListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NoteServer).SetIcon(ctx, in)
+		return srv.(NoteServer).GetSharedAttachList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/note.Note/SetIcon",
+		FullMethod: "/note.Note/GetSharedAttachList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NoteServer).SetIcon(ctx, req.(*SetIconRequest))
+		return srv.(NoteServer).GetSharedAttachList(ctx, req.(*GetSharedAttachListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Note_SetHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetHeaderRequest)
+func _Note_GetRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevisionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NoteServer).SetHeader(ctx, in)
+		return srv.(NoteServer).GetRevisions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/note.Note/SetHeader",
+		FullMethod: "/note.Note/GetRevisions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NoteServer).SetHeader(ctx, req.(*SetHeaderRequest))
+		return srv.(NoteServer).GetRevisions(ctx, req.(*GetRevisionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Note_AddFav_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangeFlagRequest)
+func _Note_GetRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevisionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NoteServer).AddFav(ctx, in)
+		return srv.(NoteServer).GetRevision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/note.Note/AddFav",
+		FullMethod: "/note.Note/GetRevision",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NoteServer).AddFav(ctx, req.(*ChangeFlagRequest))
+		return srv.(NoteServer).GetRevision(ctx, req.(*RevisionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Note_DelFav_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangeFlagRequest)
+func _Note_GetRevisionDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevisionDiffRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NoteServer).DelFav(ctx, in)
+		return srv.(NoteServer).GetRevisionDiff(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/note.Note/DelFav",
+		FullMethod: "/note.Note/GetRevisionDiff",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NoteServer).DelFav(ctx, req.(*ChangeFlagRequest))
+		return srv.(NoteServer).GetRevisionDiff(ctx, req.(*GetRevisionDiffRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Note_SetPublic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AccessModeRequest)
+func _Note_RestoreRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevisionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NoteServer).SetPublic(ctx, in)
+		return srv.(NoteServer).RestoreRevision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/note.Note/SetPublic",
+		FullMethod: "/note.Note/RestoreRevision",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NoteServer).SetPublic(ctx, req.(*AccessModeRequest))
+		return srv.(NoteServer).RestoreRevision(ctx, req.(*RevisionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Note_SetPrivate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AccessModeRequest)
+func _Note_GetTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrashRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NoteServer).SetPrivate(ctx, in)
+		return srv.(NoteServer).GetTrash(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/note.Note/SetPrivate",
+		FullMethod: "/note.Note/GetTrash",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NoteServer).SetPrivate(ctx, req.(*AccessModeRequest))
+		return srv.(NoteServer).GetTrash(ctx, req.(*GetTrashRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Note_GetAttachList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAttachListRequest)
+func _Note_RestoreNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrashNoteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NoteServer).GetAttachList(ctx, in)
+		return srv.(NoteServer).RestoreNote(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/note.Note/GetAttachList",
+		FullMethod: "/note.Note/RestoreNote",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NoteServer).GetAttachList(ctx, req.(*GetAttachListRequest))
+		return srv.(NoteServer).RestoreNote(ctx, req.(*TrashNoteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Note_GetSharedAttachList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSharedAttachListRequest)
+func _Note_DeleteNotePermanently_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrashNoteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NoteServer).GetSharedAttachList(ctx, in)
+		return srv.(NoteServer).DeleteNotePermanently(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/note.Note/GetSharedAttachList",
+		FullMethod: "/note.Note/DeleteNotePermanently",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NoteServer).GetSharedAttachList(ctx, req.(*GetSharedAttachListRequest))
+		return srv.(NoteServer).DeleteNotePermanently(ctx, req.(*TrashNoteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -827,10 +2205,82 @@ var Note_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateSubNote",
 			Handler:    _Note_CreateSubNote_Handler,
 		},
+		{
+			MethodName: "MoveNote",
+			Handler:    _Note_MoveNote_Handler,
+		},
+		{
+			MethodName: "ReorderNotes",
+			Handler:    _Note_ReorderNotes_Handler,
+		},
+		{
+			MethodName: "DuplicateNote",
+			Handler:    _Note_DuplicateNote_Handler,
+		},
+		{
+			MethodName: "GetBacklinks",
+			Handler:    _Note_GetBacklinks_Handler,
+		},
+		{
+			MethodName: "GetNoteGraph",
+			Handler:    _Note_GetNoteGraph_Handler,
+		},
+		{
+			MethodName: "SetTemplate",
+			Handler:    _Note_SetTemplate_Handler,
+		},
+		{
+			MethodName: "GetTemplates",
+			Handler:    _Note_GetTemplates_Handler,
+		},
+		{
+			MethodName: "CreateFromTemplate",
+			Handler:    _Note_CreateFromTemplate_Handler,
+		},
 		{
 			MethodName: "AddCollaborator",
 			Handler:    _Note_AddCollaborator_Handler,
 		},
+		{
+			MethodName: "SetCollaboratorRole",
+			Handler:    _Note_SetCollaboratorRole_Handler,
+		},
+		{
+			MethodName: "RemoveCollaborator",
+			Handler:    _Note_RemoveCollaborator_Handler,
+		},
+		{
+			MethodName: "LeaveNote",
+			Handler:    _Note_LeaveNote_Handler,
+		},
+		{
+			MethodName: "GetSentInvites",
+			Handler:    _Note_GetSentInvites_Handler,
+		},
+		{
+			MethodName: "GetReceivedInvites",
+			Handler:    _Note_GetReceivedInvites_Handler,
+		},
+		{
+			MethodName: "AcceptInvite",
+			Handler:    _Note_AcceptInvite_Handler,
+		},
+		{
+			MethodName: "DeclineInvite",
+			Handler:    _Note_DeclineInvite_Handler,
+		},
+		{
+			MethodName: "RevokeInvite",
+			Handler:    _Note_RevokeInvite_Handler,
+		},
+		{
+			MethodName: "ProposeTransfer",
+			Handler:    _Note_ProposeTransfer_Handler,
+		},
+		{
+			MethodName: "AcceptTransfer",
+			Handler:    _Note_AcceptTransfer_Handler,
+		},
 		{
 			MethodName: "AddTag",
 			Handler:    _Note_AddTag_Handler,
@@ -859,6 +2309,14 @@ var Note_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateTag",
 			Handler:    _Note_UpdateTag_Handler,
 		},
+		{
+			MethodName: "MergeTags",
+			Handler:    _Note_MergeTags_Handler,
+		},
+		{
+			MethodName: "SetTagInfo",
+			Handler:    _Note_SetTagInfo_Handler,
+		},
 		{
 			MethodName: "SetIcon",
 			Handler:    _Note_SetIcon_Handler,
@@ -875,6 +2333,14 @@ var Note_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DelFav",
 			Handler:    _Note_DelFav_Handler,
 		},
+		{
+			MethodName: "ArchiveNote",
+			Handler:    _Note_ArchiveNote_Handler,
+		},
+		{
+			MethodName: "UnarchiveNote",
+			Handler:    _Note_UnarchiveNote_Handler,
+		},
 		{
 			MethodName: "SetPublic",
 			Handler:    _Note_SetPublic_Handler,
@@ -883,6 +2349,62 @@ var Note_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetPrivate",
 			Handler:    _Note_SetPrivate_Handler,
 		},
+		{
+			MethodName: "BulkUpdate",
+			Handler:    _Note_BulkUpdate_Handler,
+		},
+		{
+			MethodName: "CreateShareLink",
+			Handler:    _Note_CreateShareLink_Handler,
+		},
+		{
+			MethodName: "GetShareLinks",
+			Handler:    _Note_GetShareLinks_Handler,
+		},
+		{
+			MethodName: "RevokeShareLink",
+			Handler:    _Note_RevokeShareLink_Handler,
+		},
+		{
+			MethodName: "GetComments",
+			Handler:    _Note_GetComments_Handler,
+		},
+		{
+			MethodName: "AddComment",
+			Handler:    _Note_AddComment_Handler,
+		},
+		{
+			MethodName: "UpdateComment",
+			Handler:    _Note_UpdateComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _Note_DeleteComment_Handler,
+		},
+		{
+			MethodName: "SetCommentResolved",
+			Handler:    _Note_SetCommentResolved_Handler,
+		},
+		{
+			MethodName: "AddReminder",
+			Handler:    _Note_AddReminder_Handler,
+		},
+		{
+			MethodName: "GetReminders",
+			Handler:    _Note_GetReminders_Handler,
+		},
+		{
+			MethodName: "GetUpcomingReminders",
+			Handler:    _Note_GetUpcomingReminders_Handler,
+		},
+		{
+			MethodName: "DeleteReminder",
+			Handler:    _Note_DeleteReminder_Handler,
+		},
+		{
+			MethodName: "AcknowledgeReminder",
+			Handler:    _Note_AcknowledgeReminder_Handler,
+		},
 		{
 			MethodName: "GetAttachList",
 			Handler:    _Note_GetAttachList_Handler,
@@ -891,6 +2413,34 @@ var Note_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSharedAttachList",
 			Handler:    _Note_GetSharedAttachList_Handler,
 		},
+		{
+			MethodName: "GetRevisions",
+			Handler:    _Note_GetRevisions_Handler,
+		},
+		{
+			MethodName: "GetRevision",
+			Handler:    _Note_GetRevision_Handler,
+		},
+		{
+			MethodName: "GetRevisionDiff",
+			Handler:    _Note_GetRevisionDiff_Handler,
+		},
+		{
+			MethodName: "RestoreRevision",
+			Handler:    _Note_RestoreRevision_Handler,
+		},
+		{
+			MethodName: "GetTrash",
+			Handler:    _Note_GetTrash_Handler,
+		},
+		{
+			MethodName: "RestoreNote",
+			Handler:    _Note_RestoreNote_Handler,
+		},
+		{
+			MethodName: "DeleteNotePermanently",
+			Handler:    _Note_DeleteNotePermanently_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "note.proto",
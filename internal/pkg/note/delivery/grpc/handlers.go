@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"time"
 
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/models"
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/note"
@@ -12,6 +13,8 @@ import (
 	"github.com/satori/uuid"
 )
 
+const TimeLayout = "2006-01-02 15:04:05 -0700 UTC"
+
 type GrpcNoteHandler struct {
 	generatedNote.NoteServer
 	uc note.NoteUsecase
@@ -49,6 +52,8 @@ func getNote(note models.Note) *generatedNote.NoteModel {
 		Header:        note.Header,
 		Favorite:      note.Favorite,
 		Public:        note.Public,
+		Archived:      note.Archived,
+		Version:       note.Version,
 	}
 }
 
@@ -82,6 +87,8 @@ func getNoteResponse(note models.NoteResponse) *generatedNote.NoteResponseModel
 		Public:        note.Public,
 		Username:      note.Username,
 		ImagePath:     note.ImagePath,
+		Archived:      note.Archived,
+		Version:       note.Version,
 	}
 }
 
@@ -94,14 +101,45 @@ func (h *GrpcNoteHandler) GetTags(ctx context.Context, in *generatedNote.GetTags
 		return nil, err
 	}
 
+	names := make([]string, len(tags))
+	info := make([]*generatedNote.TagModel, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Name
+		info[i] = &generatedNote.TagModel{
+			Name:        tag.Name,
+			Color:       tag.Color,
+			Emoji:       tag.Emoji,
+			Description: tag.Description,
+			NotesCount:  tag.NotesCount,
+		}
+	}
+
+	logger.Info("success")
+	return &generatedNote.GetTagsResponse{Tags: names, TagsInfo: info}, nil
+}
+
+func (h *GrpcNoteHandler) SetTagInfo(ctx context.Context, in *generatedNote.TagInfoRequest) (*generatedNote.EmptyResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	tag := models.Tag{
+		Name:        in.Tag.GetName(),
+		Color:       in.Tag.GetColor(),
+		Emoji:       in.Tag.GetEmoji(),
+		Description: in.Tag.GetDescription(),
+	}
+	if err := h.uc.SetTagInfo(ctx, tag, uuid.FromStringOrNil(in.UserId)); err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
 	logger.Info("success")
-	return &generatedNote.GetTagsResponse{Tags: tags}, nil
+	return &generatedNote.EmptyResponse{}, nil
 }
 
 func (h *GrpcNoteHandler) AddTag(ctx context.Context, in *generatedNote.TagRequest) (*generatedNote.GetNoteResponse, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	result, err := h.uc.AddTag(ctx, in.TagName, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.UserId))
+	result, err := h.uc.AddTag(ctx, in.TagName, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.UserId), in.Version)
 	if err != nil {
 		logger.Error(err.Error())
 		return nil, err
@@ -114,7 +152,7 @@ func (h *GrpcNoteHandler) AddTag(ctx context.Context, in *generatedNote.TagReque
 func (h *GrpcNoteHandler) DeleteTag(ctx context.Context, in *generatedNote.TagRequest) (*generatedNote.GetNoteResponse, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	result, err := h.uc.DeleteTag(ctx, in.TagName, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.UserId))
+	result, err := h.uc.DeleteTag(ctx, in.TagName, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.UserId), in.Version)
 	if err != nil {
 		logger.Error(err.Error())
 		return nil, err
@@ -147,6 +185,18 @@ func (h *GrpcNoteHandler) UpdateTag(ctx context.Context, in *generatedNote.Updat
 	return &generatedNote.EmptyResponse{}, nil
 }
 
+func (h *GrpcNoteHandler) MergeTags(ctx context.Context, in *generatedNote.MergeTagsRequest) (*generatedNote.EmptyResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	if err := h.uc.MergeTags(ctx, in.Tags, in.NewTag, uuid.FromStringOrNil(in.UserId)); err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.EmptyResponse{}, nil
+}
+
 func (h *GrpcNoteHandler) ForgetTag(ctx context.Context, in *generatedNote.AllTagRequest) (*generatedNote.EmptyResponse, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
@@ -162,22 +212,206 @@ func (h *GrpcNoteHandler) ForgetTag(ctx context.Context, in *generatedNote.AllTa
 func (h *GrpcNoteHandler) AddCollaborator(ctx context.Context, in *generatedNote.AddCollaboratorRequest) (*generatedNote.AddCollaboratorResponse, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	title, err := h.uc.AddCollaborator(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.UserId), uuid.FromStringOrNil(in.GuestId))
+	invite, err := h.uc.AddCollaborator(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.UserId), uuid.FromStringOrNil(in.GuestId), in.Role)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.AddCollaboratorResponse{
+		Title:    invite.NoteTitle,
+		InviteId: invite.Id.String(),
+	}, nil
+}
+
+func getInvite(invite models.Invite) *generatedNote.InviteModel {
+	return &generatedNote.InviteModel{
+		Id:        invite.Id.String(),
+		NoteId:    invite.NoteId.String(),
+		NoteTitle: invite.NoteTitle,
+		InviterId: invite.InviterId.String(),
+		Inviter:   invite.Inviter,
+		InviteeId: invite.InviteeId.String(),
+		Invitee:   invite.Invitee,
+		Role:      invite.Role,
+		Created:   invite.Created.UTC().String(),
+		ExpiresAt: invite.ExpiresAt.UTC().String(),
+	}
+}
+
+func getInvites(invites []models.Invite) []*generatedNote.InviteModel {
+	result := make([]*generatedNote.InviteModel, len(invites))
+	for i, invite := range invites {
+		result[i] = getInvite(invite)
+	}
+	return result
+}
+
+func (h *GrpcNoteHandler) GetSentInvites(ctx context.Context, in *generatedNote.GetInvitesRequest) (*generatedNote.GetInvitesResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	invites, err := h.uc.GetSentInvites(ctx, uuid.FromStringOrNil(in.UserId))
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.GetInvitesResponse{Invites: getInvites(invites)}, nil
+}
+
+func (h *GrpcNoteHandler) GetReceivedInvites(ctx context.Context, in *generatedNote.GetInvitesRequest) (*generatedNote.GetInvitesResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	invites, err := h.uc.GetReceivedInvites(ctx, uuid.FromStringOrNil(in.UserId))
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.GetInvitesResponse{Invites: getInvites(invites)}, nil
+}
+
+func (h *GrpcNoteHandler) AcceptInvite(ctx context.Context, in *generatedNote.InviteRequest) (*generatedNote.InviteResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	invite, err := h.uc.AcceptInvite(ctx, uuid.FromStringOrNil(in.InviteId), uuid.FromStringOrNil(in.UserId))
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.InviteResponse{Invite: getInvite(invite)}, nil
+}
+
+func (h *GrpcNoteHandler) DeclineInvite(ctx context.Context, in *generatedNote.InviteRequest) (*generatedNote.InviteResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	invite, err := h.uc.DeclineInvite(ctx, uuid.FromStringOrNil(in.InviteId), uuid.FromStringOrNil(in.UserId))
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.InviteResponse{Invite: getInvite(invite)}, nil
+}
+
+func (h *GrpcNoteHandler) RevokeInvite(ctx context.Context, in *generatedNote.InviteRequest) (*generatedNote.InviteResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	invite, err := h.uc.RevokeInvite(ctx, uuid.FromStringOrNil(in.InviteId), uuid.FromStringOrNil(in.UserId))
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.InviteResponse{Invite: getInvite(invite)}, nil
+}
+
+func (h *GrpcNoteHandler) SetCollaboratorRole(ctx context.Context, in *generatedNote.SetCollaboratorRoleRequest) (*generatedNote.EmptyResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	err := h.uc.SetCollaboratorRole(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.UserId), uuid.FromStringOrNil(in.GuestId), in.Role)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.EmptyResponse{}, nil
+}
+
+func getIds(ids []uuid.UUID) []string {
+	result := make([]string, len(ids))
+	for i, id := range ids {
+		result[i] = id.String()
+	}
+	return result
+}
+
+func (h *GrpcNoteHandler) RemoveCollaborator(ctx context.Context, in *generatedNote.RemoveCollaboratorRequest) (*generatedNote.RemoveCollaboratorResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	title, removedFrom, err := h.uc.RemoveCollaborator(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.UserId), uuid.FromStringOrNil(in.GuestId))
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.RemoveCollaboratorResponse{
+		Title:   title,
+		NoteIds: getIds(removedFrom),
+	}, nil
+}
+
+func (h *GrpcNoteHandler) LeaveNote(ctx context.Context, in *generatedNote.LeaveNoteRequest) (*generatedNote.LeaveNoteResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	removedFrom, err := h.uc.LeaveNote(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.UserId))
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.LeaveNoteResponse{
+		NoteIds: getIds(removedFrom),
+	}, nil
+}
+
+func (h *GrpcNoteHandler) ProposeTransfer(ctx context.Context, in *generatedNote.ProposeTransferRequest) (*generatedNote.ProposeTransferResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	title, err := h.uc.ProposeTransfer(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.UserId), uuid.FromStringOrNil(in.NewOwnerId))
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.ProposeTransferResponse{
+		Title: title,
+	}, nil
+}
+
+func (h *GrpcNoteHandler) AcceptTransfer(ctx context.Context, in *generatedNote.AcceptTransferRequest) (*generatedNote.AcceptTransferResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	transfer, err := h.uc.AcceptTransfer(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.UserId))
 	if err != nil {
 		logger.Error(err.Error())
 		return nil, err
 	}
 
 	logger.Info("success")
-	return &generatedNote.AddCollaboratorResponse{Title: title}, nil
+	return &generatedNote.AcceptTransferResponse{
+		NoteId: transfer.NoteId.String(),
+		FromId: transfer.FromId.String(),
+		ToId:   transfer.ToId.String(),
+	}, nil
 }
 
 func (h *GrpcNoteHandler) GetAllNotes(ctx context.Context, in *generatedNote.GetAllRequest) (*generatedNote.GetAllResponse, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	result, err := h.uc.GetAllNotes(ctx, uuid.FromStringOrNil(in.UserId), in.Count, in.Offset, in.Title, in.Tags)
+	tags, err := models.ParseTagFilter(in.Tags, in.TagMode)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	result, nextCursor, err := h.uc.GetAllNotes(ctx, uuid.FromStringOrNil(in.UserId), in.Count, in.Offset, in.Title, tags, in.Archived, in.Sort, in.Cursor)
 	if err != nil {
 		logger.Error(err.Error())
+		if err.Error() == note.ErrInvalidCursor {
+			return nil, err
+		}
 		return nil, errors.New("not found")
 	}
 
@@ -188,7 +422,8 @@ func (h *GrpcNoteHandler) GetAllNotes(ctx context.Context, in *generatedNote.Get
 
 	logger.Info("success")
 	return &generatedNote.GetAllResponse{
-		Notes: protoNotes,
+		Notes:      protoNotes,
+		NextCursor: nextCursor,
 	}, nil
 }
 
@@ -210,10 +445,10 @@ func (h *GrpcNoteHandler) GetNote(ctx context.Context, in *generatedNote.GetNote
 func (h *GrpcNoteHandler) GetPublicNote(ctx context.Context, in *generatedNote.GetPublicNoteRequest) (*generatedNote.GetNoteResponseResponse, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	result, err := h.uc.GetPublicNote(ctx, uuid.FromStringOrNil(in.NoteId))
+	result, err := h.uc.GetPublicNote(ctx, uuid.FromStringOrNil(in.NoteId), in.Token, in.Password)
 	if err != nil {
 		logger.Error(err.Error())
-		return nil, errors.New("not found")
+		return nil, err
 	}
 
 	logger.Info("success")
@@ -240,10 +475,10 @@ func (h *GrpcNoteHandler) AddNote(ctx context.Context, in *generatedNote.AddNote
 func (h *GrpcNoteHandler) UpdateNote(ctx context.Context, in *generatedNote.UpdateNoteRequest) (*generatedNote.UpdateNoteResponse, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	result, err := h.uc.UpdateNote(ctx, uuid.FromStringOrNil(in.Id), uuid.FromStringOrNil(in.UserId), in.Data)
+	result, err := h.uc.UpdateNote(ctx, uuid.FromStringOrNil(in.Id), uuid.FromStringOrNil(in.UserId), in.Data, in.Version)
 	if err != nil {
 		logger.Error(err.Error())
-		return nil, errors.New("not found")
+		return nil, err
 	}
 
 	logger.Info("success")
@@ -280,10 +515,112 @@ func (h *GrpcNoteHandler) CreateSubNote(ctx context.Context, in *generatedNote.C
 	}, nil
 }
 
+func (h *GrpcNoteHandler) MoveNote(ctx context.Context, in *generatedNote.MoveNoteRequest) (*generatedNote.GetNoteResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	response, err := h.uc.MoveNote(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.ParentId), uuid.FromStringOrNil(in.UserId))
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.GetNoteResponse{
+		Note: getNote(response),
+	}, nil
+}
+
+func (h *GrpcNoteHandler) ReorderNotes(ctx context.Context, in *generatedNote.ReorderNotesRequest) (*generatedNote.EmptyResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	notes := make([]uuid.UUID, len(in.Notes))
+	for i, noteID := range in.Notes {
+		notes[i] = uuid.FromStringOrNil(noteID)
+	}
+
+	if err := h.uc.ReorderNotes(ctx, uuid.FromStringOrNil(in.UserId), uuid.FromStringOrNil(in.ParentId), notes); err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.EmptyResponse{}, nil
+}
+
+func (h *GrpcNoteHandler) DuplicateNote(ctx context.Context, in *generatedNote.DuplicateNoteRequest) (*generatedNote.GetNoteResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	response, err := h.uc.DuplicateNote(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.UserId), in.WithSubnotes)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.GetNoteResponse{
+		Note: getNote(response),
+	}, nil
+}
+
+func (h *GrpcNoteHandler) GetBacklinks(ctx context.Context, in *generatedNote.GetBacklinksRequest) (*generatedNote.GetBacklinksResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	backlinks, err := h.uc.GetBacklinks(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.UserId))
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	result := make([]*generatedNote.NoteLinkModel, len(backlinks))
+	for i, link := range backlinks {
+		result[i] = getNoteLink(link)
+	}
+
+	logger.Info("success")
+	return &generatedNote.GetBacklinksResponse{
+		Notes: result,
+	}, nil
+}
+
+func (h *GrpcNoteHandler) GetNoteGraph(ctx context.Context, in *generatedNote.GetNoteGraphRequest) (*generatedNote.GetNoteGraphResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	graph, err := h.uc.GetNoteGraph(ctx, uuid.FromStringOrNil(in.UserId))
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	result := &generatedNote.GetNoteGraphResponse{
+		Nodes: make([]*generatedNote.NoteLinkModel, len(graph.Nodes)),
+		Edges: make([]*generatedNote.NoteEdgeModel, len(graph.Edges)),
+	}
+	for i, node := range graph.Nodes {
+		result.Nodes[i] = getNoteLink(node)
+	}
+	for i, edge := range graph.Edges {
+		result.Edges[i] = &generatedNote.NoteEdgeModel{
+			Source: edge.Source.String(),
+			Target: edge.Target.String(),
+		}
+	}
+
+	logger.Info("success")
+	return result, nil
+}
+
+func getNoteLink(link models.NoteLink) *generatedNote.NoteLinkModel {
+	return &generatedNote.NoteLinkModel{
+		Id:    link.Id.String(),
+		Title: link.Title,
+		Icon:  link.Icon,
+	}
+}
+
 func (h *GrpcNoteHandler) SetIcon(ctx context.Context, in *generatedNote.SetIconRequest) (*generatedNote.GetNoteResponse, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	response, err := h.uc.SetIcon(ctx, uuid.FromStringOrNil(in.NoteId), in.Icon, uuid.FromStringOrNil(in.UserId))
+	response, err := h.uc.SetIcon(ctx, uuid.FromStringOrNil(in.NoteId), in.Icon, uuid.FromStringOrNil(in.UserId), in.Version)
 	if err != nil {
 		logger.Error(err.Error())
 		return nil, err
@@ -298,7 +635,7 @@ func (h *GrpcNoteHandler) SetIcon(ctx context.Context, in *generatedNote.SetIcon
 func (h *GrpcNoteHandler) SetHeader(ctx context.Context, in *generatedNote.SetHeaderRequest) (*generatedNote.GetNoteResponse, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	response, err := h.uc.SetHeader(ctx, uuid.FromStringOrNil(in.NoteId), in.Header, uuid.FromStringOrNil(in.UserId))
+	response, err := h.uc.SetHeader(ctx, uuid.FromStringOrNil(in.NoteId), in.Header, uuid.FromStringOrNil(in.UserId), in.Version)
 	if err != nil {
 		logger.Error(err.Error())
 		return nil, err
@@ -342,77 +679,604 @@ func (h *GrpcNoteHandler) DelFav(ctx context.Context, in *generatedNote.ChangeFl
 	}, nil
 }
 
-func (h *GrpcNoteHandler) SetPublic(ctx context.Context, in *generatedNote.AccessModeRequest) (*generatedNote.GetNoteResponse, error) {
+func (h *GrpcNoteHandler) ArchiveNote(ctx context.Context, in *generatedNote.ChangeFlagRequest) (*generatedNote.GetNoteResponse, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	response, err := h.uc.SetPublic(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.UserId))
+	result, err := h.uc.ArchiveNote(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.UserId))
 	if err != nil {
 		logger.Error(err.Error())
 		return nil, err
 	}
+	protoNote := getNote(result)
 
 	logger.Info("success")
 	return &generatedNote.GetNoteResponse{
-		Note: getNote(response),
+		Note: protoNote,
 	}, nil
 }
 
-func (h *GrpcNoteHandler) SetPrivate(ctx context.Context, in *generatedNote.AccessModeRequest) (*generatedNote.GetNoteResponse, error) {
+func (h *GrpcNoteHandler) UnarchiveNote(ctx context.Context, in *generatedNote.ChangeFlagRequest) (*generatedNote.GetNoteResponse, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	response, err := h.uc.SetPrivate(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.UserId))
+	result, err := h.uc.UnarchiveNote(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.UserId))
 	if err != nil {
 		logger.Error(err.Error())
 		return nil, err
 	}
+	protoNote := getNote(result)
 
 	logger.Info("success")
 	return &generatedNote.GetNoteResponse{
-		Note: getNote(response),
+		Note: protoNote,
 	}, nil
 }
 
-func (h *GrpcNoteHandler) GetAttachList(ctx context.Context, in *generatedNote.GetAttachListRequest) (*generatedNote.GetAttachListResponse, error) {
+func (h *GrpcNoteHandler) SetPublic(ctx context.Context, in *generatedNote.AccessModeRequest) (*generatedNote.GetNoteResponse, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	response, err := h.uc.GetAttachList(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.UserId))
+	response, err := h.uc.SetPublic(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.UserId))
 	if err != nil {
 		logger.Error(err.Error())
 		return nil, err
 	}
 
 	logger.Info("success")
-	return &generatedNote.GetAttachListResponse{
-		Paths: response,
+	return &generatedNote.GetNoteResponse{
+		Note: getNote(response),
 	}, nil
 }
 
-func (h *GrpcNoteHandler) GetSharedAttachList(ctx context.Context, in *generatedNote.GetSharedAttachListRequest) (*generatedNote.GetAttachListResponse, error) {
+func (h *GrpcNoteHandler) SetPrivate(ctx context.Context, in *generatedNote.AccessModeRequest) (*generatedNote.GetNoteResponse, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	response, err := h.uc.GetSharedAttachList(ctx, uuid.FromStringOrNil(in.NoteId))
+	response, err := h.uc.SetPrivate(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.UserId))
 	if err != nil {
 		logger.Error(err.Error())
 		return nil, err
 	}
 
 	logger.Info("success")
-	return &generatedNote.GetAttachListResponse{
-		Paths: response,
+	return &generatedNote.GetNoteResponse{
+		Note: getNote(response),
 	}, nil
 }
 
-func (h *GrpcNoteHandler) CheckPermissions(ctx context.Context, in *generatedNote.CheckPermissionsRequest) (*generatedNote.CheckPermissionsResponse, error) {
+func (h *GrpcNoteHandler) BulkUpdate(ctx context.Context, in *generatedNote.BulkRequest) (*generatedNote.BulkResponse, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	response, err := h.uc.CheckPermissions(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.UserId))
+	payload := models.BulkRequest{
+		Notes:      make([]uuid.UUID, len(in.NoteIds)),
+		Operations: make([]models.BulkOperation, len(in.Operations)),
+	}
+	for i, noteID := range in.NoteIds {
+		payload.Notes[i] = uuid.FromStringOrNil(noteID)
+	}
+	for i, operation := range in.Operations {
+		payload.Operations[i] = models.BulkOperation{Type: operation.Type, Value: operation.Value}
+	}
+
+	if err := payload.Validate(); err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	results, err := h.uc.BulkUpdate(ctx, payload.Notes, payload.Operations, uuid.FromStringOrNil(in.UserId))
 	if err != nil {
 		logger.Error(err.Error())
 		return nil, err
 	}
 
+	response := &generatedNote.BulkResponse{
+		Results: make([]*generatedNote.BulkResultModel, len(results)),
+	}
+	for i, result := range results {
+		response.Results[i] = &generatedNote.BulkResultModel{
+			NoteId:  result.NoteID.String(),
+			Success: result.Success,
+			Error:   result.Error,
+		}
+	}
+
 	logger.Info("success")
-	return &generatedNote.CheckPermissionsResponse{
-		Result: response,
+	return response, nil
+}
+
+func getShareLink(link models.ShareLink) *generatedNote.ShareLinkModel {
+	result := &generatedNote.ShareLinkModel{
+		Id:             link.Id.String(),
+		Token:          link.Token,
+		NoteId:         link.NoteId.String(),
+		CreatorId:      link.CreatorId.String(),
+		HasPassword:    link.PasswordHash != "",
+		IncludeSubtree: link.IncludeSubtree,
+		Created:        link.Created.UTC().String(),
+	}
+	if link.ExpiresAt != nil {
+		result.ExpiresAt = link.ExpiresAt.UTC().String()
+	}
+	return result
+}
+
+func (h *GrpcNoteHandler) CreateShareLink(ctx context.Context, in *generatedNote.CreateShareLinkRequest) (*generatedNote.ShareLinkResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	var expiresAt *time.Time
+	if in.ExpiresAt != "" {
+		parsed, err := time.Parse(TimeLayout, in.ExpiresAt)
+		if err != nil {
+			logger.Error(err.Error())
+			return nil, err
+		}
+		expiresAt = &parsed
+	}
+
+	link, err := h.uc.CreateShareLink(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.UserId), in.Password, expiresAt, in.IncludeSubtree)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.ShareLinkResponse{
+		Link: getShareLink(link),
+	}, nil
+}
+
+func (h *GrpcNoteHandler) GetShareLinks(ctx context.Context, in *generatedNote.GetShareLinksRequest) (*generatedNote.GetShareLinksResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	links, err := h.uc.GetShareLinks(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.UserId))
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	result := make([]*generatedNote.ShareLinkModel, len(links))
+	for i, link := range links {
+		result[i] = getShareLink(link)
+	}
+
+	logger.Info("success")
+	return &generatedNote.GetShareLinksResponse{
+		Links: result,
+	}, nil
+}
+
+func (h *GrpcNoteHandler) RevokeShareLink(ctx context.Context, in *generatedNote.RevokeShareLinkRequest) (*generatedNote.EmptyResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	if err := h.uc.RevokeShareLink(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.LinkId), uuid.FromStringOrNil(in.UserId)); err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.EmptyResponse{}, nil
+}
+
+func getComment(comment models.Comment) *generatedNote.CommentModel {
+	return &generatedNote.CommentModel{
+		Id:       comment.Id.String(),
+		NoteId:   comment.NoteId.String(),
+		Parent:   comment.Parent.String(),
+		AuthorId: comment.AuthorId.String(),
+		Author:   comment.Author,
+		BlockId:  comment.BlockId,
+		Text:     comment.Text,
+		Created:  comment.Created.UTC().String(),
+		Updated:  comment.Updated.UTC().String(),
+		Resolved: comment.Resolved,
+		Deleted:  comment.Deleted,
+	}
+}
+
+func (h *GrpcNoteHandler) GetComments(ctx context.Context, in *generatedNote.GetCommentsRequest) (*generatedNote.GetCommentsResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	comments, err := h.uc.GetComments(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.UserId))
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	result := make([]*generatedNote.CommentModel, len(comments))
+	for i, comment := range comments {
+		result[i] = getComment(comment)
+	}
+
+	logger.Info("success")
+	return &generatedNote.GetCommentsResponse{
+		Comments: result,
+	}, nil
+}
+
+func (h *GrpcNoteHandler) AddComment(ctx context.Context, in *generatedNote.AddCommentRequest) (*generatedNote.CommentResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	comment, err := h.uc.AddComment(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.UserId), uuid.FromStringOrNil(in.ParentId), in.BlockId, in.Text)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.CommentResponse{
+		Comment: getComment(comment),
+	}, nil
+}
+
+func (h *GrpcNoteHandler) UpdateComment(ctx context.Context, in *generatedNote.UpdateCommentRequest) (*generatedNote.CommentResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	comment, err := h.uc.UpdateComment(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.CommentId), uuid.FromStringOrNil(in.UserId), in.Text)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.CommentResponse{
+		Comment: getComment(comment),
+	}, nil
+}
+
+func (h *GrpcNoteHandler) DeleteComment(ctx context.Context, in *generatedNote.CommentRequest) (*generatedNote.EmptyResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	if err := h.uc.DeleteComment(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.CommentId), uuid.FromStringOrNil(in.UserId)); err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.EmptyResponse{}, nil
+}
+
+func (h *GrpcNoteHandler) SetCommentResolved(ctx context.Context, in *generatedNote.SetCommentResolvedRequest) (*generatedNote.CommentResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	comment, err := h.uc.SetCommentResolved(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.CommentId), uuid.FromStringOrNil(in.UserId), in.Resolved)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.CommentResponse{
+		Comment: getComment(comment),
+	}, nil
+}
+
+func getReminder(reminder models.Reminder) *generatedNote.ReminderModel {
+	return &generatedNote.ReminderModel{
+		Id:        reminder.Id.String(),
+		NoteId:    reminder.NoteId.String(),
+		NoteTitle: reminder.NoteTitle,
+		UserId:    reminder.UserId.String(),
+		RemindAt:  reminder.RemindAt.UTC().String(),
+		Repeat:    reminder.Repeat,
+		Fired:     reminder.Fired,
+		Created:   reminder.Created.UTC().String(),
+	}
+}
+
+func getReminders(reminders []models.Reminder) []*generatedNote.ReminderModel {
+	result := make([]*generatedNote.ReminderModel, len(reminders))
+	for i, reminder := range reminders {
+		result[i] = getReminder(reminder)
+	}
+	return result
+}
+
+func (h *GrpcNoteHandler) AddReminder(ctx context.Context, in *generatedNote.AddReminderRequest) (*generatedNote.ReminderResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	remindAt, err := time.Parse(TimeLayout, in.RemindAt)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	reminder, err := h.uc.AddReminder(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.UserId), remindAt, in.Repeat)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.ReminderResponse{
+		Reminder: getReminder(reminder),
+	}, nil
+}
+
+func (h *GrpcNoteHandler) GetReminders(ctx context.Context, in *generatedNote.GetRemindersRequest) (*generatedNote.GetRemindersResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	reminders, err := h.uc.GetReminders(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.UserId))
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.GetRemindersResponse{
+		Reminders: getReminders(reminders),
+	}, nil
+}
+
+func (h *GrpcNoteHandler) GetUpcomingReminders(ctx context.Context, in *generatedNote.GetUpcomingRemindersRequest) (*generatedNote.GetRemindersResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	reminders, err := h.uc.GetUpcomingReminders(ctx, uuid.FromStringOrNil(in.UserId), in.Count, in.Offset)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.GetRemindersResponse{
+		Reminders: getReminders(reminders),
+	}, nil
+}
+
+func (h *GrpcNoteHandler) DeleteReminder(ctx context.Context, in *generatedNote.ReminderRequest) (*generatedNote.EmptyResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	if err := h.uc.DeleteReminder(ctx, uuid.FromStringOrNil(in.ReminderId), uuid.FromStringOrNil(in.UserId)); err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.EmptyResponse{}, nil
+}
+
+func (h *GrpcNoteHandler) AcknowledgeReminder(ctx context.Context, in *generatedNote.ReminderRequest) (*generatedNote.EmptyResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	if err := h.uc.AcknowledgeReminder(ctx, uuid.FromStringOrNil(in.ReminderId), uuid.FromStringOrNil(in.UserId)); err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.EmptyResponse{}, nil
+}
+
+func (h *GrpcNoteHandler) GetAttachList(ctx context.Context, in *generatedNote.GetAttachListRequest) (*generatedNote.GetAttachListResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	response, err := h.uc.GetAttachList(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.UserId))
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.GetAttachListResponse{
+		Paths: response,
+	}, nil
+}
+
+func (h *GrpcNoteHandler) GetSharedAttachList(ctx context.Context, in *generatedNote.GetSharedAttachListRequest) (*generatedNote.GetAttachListResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	response, err := h.uc.GetSharedAttachList(ctx, uuid.FromStringOrNil(in.NoteId), in.Token, in.Password)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.GetAttachListResponse{
+		Paths: response,
+	}, nil
+}
+
+func (h *GrpcNoteHandler) CheckPermissions(ctx context.Context, in *generatedNote.CheckPermissionsRequest) (*generatedNote.CheckPermissionsResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	response, err := h.uc.CheckPermissions(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.UserId))
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.CheckPermissionsResponse{
+		Result: true,
+		Role:   response,
+	}, nil
+}
+
+func getRevision(revision models.NoteRevision) *generatedNote.RevisionModel {
+	return &generatedNote.RevisionModel{
+		Id:       revision.Id.String(),
+		NoteId:   revision.NoteId.String(),
+		Created:  revision.Created.UTC().String(),
+		AuthorId: revision.AuthorId.String(),
+		Username: revision.Username,
+		Data:     revision.Data,
+	}
+}
+
+func (h *GrpcNoteHandler) GetRevisions(ctx context.Context, in *generatedNote.GetRevisionsRequest) (*generatedNote.GetRevisionsResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	result, err := h.uc.GetRevisions(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.UserId), in.Count, in.Offset)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	protoRevisions := make([]*generatedNote.RevisionModel, len(result))
+	for i, item := range result {
+		protoRevisions[i] = getRevision(item)
+	}
+
+	logger.Info("success")
+	return &generatedNote.GetRevisionsResponse{
+		Revisions: protoRevisions,
+	}, nil
+}
+
+func (h *GrpcNoteHandler) GetRevision(ctx context.Context, in *generatedNote.RevisionRequest) (*generatedNote.GetRevisionResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	result, err := h.uc.GetRevision(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.RevisionId), uuid.FromStringOrNil(in.UserId))
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.GetRevisionResponse{
+		Revision: getRevision(result),
+	}, nil
+}
+
+func (h *GrpcNoteHandler) GetRevisionDiff(ctx context.Context, in *generatedNote.GetRevisionDiffRequest) (*generatedNote.GetRevisionDiffResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	result, err := h.uc.GetRevisionDiff(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.FromId), uuid.FromStringOrNil(in.ToId), uuid.FromStringOrNil(in.UserId))
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	blocks := make([]*generatedNote.BlockChangeModel, len(result.Blocks))
+	for i, block := range result.Blocks {
+		blocks[i] = &generatedNote.BlockChangeModel{
+			Type:  block.Type,
+			Block: block.Block,
+		}
+	}
+
+	logger.Info("success")
+	return &generatedNote.GetRevisionDiffResponse{
+		From:     result.From.String(),
+		To:       result.To.String(),
+		OldTitle: result.OldTitle,
+		NewTitle: result.NewTitle,
+		Blocks:   blocks,
+	}, nil
+}
+
+func (h *GrpcNoteHandler) RestoreRevision(ctx context.Context, in *generatedNote.RevisionRequest) (*generatedNote.GetNoteResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	result, err := h.uc.RestoreRevision(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.RevisionId), uuid.FromStringOrNil(in.UserId))
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.GetNoteResponse{
+		Note: getNote(result),
+	}, nil
+}
+
+func (h *GrpcNoteHandler) GetTrash(ctx context.Context, in *generatedNote.GetTrashRequest) (*generatedNote.GetTrashResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	result, err := h.uc.GetTrash(ctx, uuid.FromStringOrNil(in.UserId), in.Count, in.Offset)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	protoNotes := make([]*generatedNote.TrashedNoteModel, len(result))
+	for i, item := range result {
+		protoNotes[i] = &generatedNote.TrashedNoteModel{
+			Note:      getNote(item.Note),
+			DeletedAt: item.DeletedAt.String(),
+		}
+	}
+
+	logger.Info("success")
+	return &generatedNote.GetTrashResponse{
+		Notes: protoNotes,
+	}, nil
+}
+
+func (h *GrpcNoteHandler) RestoreNote(ctx context.Context, in *generatedNote.TrashNoteRequest) (*generatedNote.GetNoteResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	result, err := h.uc.RestoreNote(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.UserId))
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.GetNoteResponse{
+		Note: getNote(result),
+	}, nil
+}
+
+func (h *GrpcNoteHandler) DeleteNotePermanently(ctx context.Context, in *generatedNote.TrashNoteRequest) (*generatedNote.EmptyResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	if err := h.uc.DeleteNotePermanently(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.UserId)); err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.EmptyResponse{}, nil
+}
+
+func (h *GrpcNoteHandler) SetTemplate(ctx context.Context, in *generatedNote.SetTemplateRequest) (*generatedNote.GetNoteResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	result, err := h.uc.SetTemplate(ctx, uuid.FromStringOrNil(in.NoteId), uuid.FromStringOrNil(in.UserId), in.Template)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.GetNoteResponse{
+		Note: getNote(result),
+	}, nil
+}
+
+func (h *GrpcNoteHandler) GetTemplates(ctx context.Context, in *generatedNote.GetTemplatesRequest) (*generatedNote.GetTemplatesResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	result, err := h.uc.GetTemplates(ctx, uuid.FromStringOrNil(in.UserId))
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	protoTemplates := make([]*generatedNote.TemplateModel, len(result))
+	for i, item := range result {
+		protoTemplates[i] = &generatedNote.TemplateModel{
+			Id:     item.Id.String(),
+			Data:   item.Data,
+			System: item.System,
+		}
+	}
+
+	logger.Info("success")
+	return &generatedNote.GetTemplatesResponse{
+		Templates: protoTemplates,
+	}, nil
+}
+
+func (h *GrpcNoteHandler) CreateFromTemplate(ctx context.Context, in *generatedNote.CreateFromTemplateRequest) (*generatedNote.GetNoteResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	result, err := h.uc.CreateFromTemplate(ctx, uuid.FromStringOrNil(in.TemplateId), uuid.FromStringOrNil(in.ParentId), uuid.FromStringOrNil(in.UserId), in.Username)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return &generatedNote.GetNoteResponse{
+		Note: getNote(result),
 	}, nil
 }
@@ -11,6 +11,7 @@ import (
 
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/models"
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/config"
+	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/note"
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/note/delivery/grpc/gen"
 	generatedNote "github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/note/delivery/grpc/gen"
 	mock_note "github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/note/mocks"
@@ -79,7 +80,7 @@ func TestNoteHandler_GetAllNotes(t *testing.T) {
 			ctx := context.WithValue(req.Context(), config.PayloadContextKey, models.JwtPayload{Id: tt.id, Username: tt.username})
 
 			if tt.name == successTestName {
-				mockUsecase.EXPECT().GetAllNotes(ctx, tt.id, int64(10), int64(0), "", gomock.Any()).Return([]models.NoteResponse{
+				mockUsecase.EXPECT().GetAllNotes(ctx, tt.id, int64(10), int64(0), "", gomock.Any(), models.ArchiveFilterActive, models.SortUpdated, "").Return([]models.NoteResponse{
 					{
 						Note: models.Note{
 							Id:            uuid.FromStringOrNil("c80e3ea8-0813-4731-b6ee-b41604c56f95"),
@@ -105,20 +106,22 @@ func TestNoteHandler_GetAllNotes(t *testing.T) {
 							Collaborators: []uuid.UUID{},
 						},
 					},
-				}, nil)
+				}, "", nil)
 			}
 			if tt.name == "Test Error" {
-				mockUsecase.EXPECT().GetAllNotes(ctx, tt.id, int64(10), int64(0), "", gomock.Any()).Return([]models.NoteResponse{}, errors.New("error"))
+				mockUsecase.EXPECT().GetAllNotes(ctx, tt.id, int64(10), int64(0), "", gomock.Any(), models.ArchiveFilterActive, models.SortUpdated, "").Return([]models.NoteResponse{}, "", errors.New("error"))
 
 			}
 			req = req.WithContext(ctx)
 
 			h := NewGrpcNoteHandler(mockUsecase)
 			got, err := h.GetAllNotes(req.Context(), &gen.GetAllRequest{
-				UserId: tt.id.String(),
-				Count:  10,
-				Offset: 0,
-				Title:  "",
+				UserId:   tt.id.String(),
+				Count:    10,
+				Offset:   0,
+				Title:    "",
+				Archived: models.ArchiveFilterActive,
+				Sort:     models.SortUpdated,
 			})
 
 			if (err != nil) != tt.wantErr {
@@ -333,10 +336,14 @@ func TestGrpcNoteHandler_GetTags(t *testing.T) {
 			requestBody: &gen.GetTagsRequest{UserId: uuid.NewV4().String()},
 			wantErr:     false,
 			mocker: func(userId uuid.UUID, mock *mock_note.MockNoteUsecase) {
-				mock.EXPECT().GetTags(gomock.Any(), userId).Return([]string{"first", "second"}, nil)
+				mock.EXPECT().GetTags(gomock.Any(), userId).Return([]models.Tag{{Name: "first", NotesCount: 3}, {Name: "second", Color: "#ffffff"}}, nil)
 			},
 			want: &gen.GetTagsResponse{
 				Tags: []string{"first", "second"},
+				TagsInfo: []*gen.TagModel{
+					{Name: "first", NotesCount: 3},
+					{Name: "second", Color: "#ffffff"},
+				},
 			},
 		},
 		{
@@ -344,7 +351,7 @@ func TestGrpcNoteHandler_GetTags(t *testing.T) {
 			requestBody: &gen.GetTagsRequest{UserId: uuid.NewV4().String()},
 			wantErr:     true,
 			mocker: func(userId uuid.UUID, mock *mock_note.MockNoteUsecase) {
-				mock.EXPECT().GetTags(gomock.Any(), userId).Return([]string{}, errors.New("error"))
+				mock.EXPECT().GetTags(gomock.Any(), userId).Return([]models.Tag{}, errors.New("error"))
 			},
 			want: nil,
 		},
@@ -406,7 +413,7 @@ func TestGrpcNoteHandler_AddTag(t *testing.T) {
 			wantErr: false,
 			mocker: func(req *gen.TagRequest, mock *mock_note.MockNoteUsecase) {
 				mock.EXPECT().AddTag(gomock.Any(), req.TagName,
-					uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId)).Return(models.Note{
+					uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId), req.Version).Return(models.Note{
 					Id:      noteId,
 					OwnerId: userId,
 					Tags:    []string{"tag"},
@@ -424,7 +431,7 @@ func TestGrpcNoteHandler_AddTag(t *testing.T) {
 			wantErr: true,
 			mocker: func(req *gen.TagRequest, mock *mock_note.MockNoteUsecase) {
 				mock.EXPECT().AddTag(gomock.Any(), req.TagName,
-					uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId)).Return(models.Note{}, errors.New("error"))
+					uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId), req.Version).Return(models.Note{}, errors.New("error"))
 			},
 		},
 	}
@@ -481,7 +488,7 @@ func TestGrpcNoteHandler_DeleteTag(t *testing.T) {
 			wantErr: false,
 			mocker: func(req *gen.TagRequest, mock *mock_note.MockNoteUsecase) {
 				mock.EXPECT().DeleteTag(gomock.Any(), req.TagName,
-					uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId)).Return(models.Note{
+					uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId), req.Version).Return(models.Note{
 					Id:      noteId,
 					OwnerId: userId,
 					Tags:    []string{},
@@ -499,7 +506,7 @@ func TestGrpcNoteHandler_DeleteTag(t *testing.T) {
 			wantErr: true,
 			mocker: func(req *gen.TagRequest, mock *mock_note.MockNoteUsecase) {
 				mock.EXPECT().DeleteTag(gomock.Any(), req.TagName,
-					uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId)).Return(models.Note{}, errors.New("error"))
+					uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId), req.Version).Return(models.Note{}, errors.New("error"))
 			},
 		},
 	}
@@ -527,6 +534,7 @@ func TestGrpcNoteHandler_AddCollaborator(t *testing.T) {
 	noteId := uuid.NewV4()
 	userId := uuid.NewV4()
 	guestId := uuid.NewV4()
+	inviteId := uuid.NewV4()
 
 	tests := []struct {
 		name        string
@@ -541,11 +549,18 @@ func TestGrpcNoteHandler_AddCollaborator(t *testing.T) {
 				NoteId:  noteId.String(),
 				UserId:  userId.String(),
 				GuestId: guestId.String(),
+				Role:    models.RoleViewer,
+			},
+			want: &gen.AddCollaboratorResponse{
+				Title:    "title",
+				InviteId: inviteId.String(),
 			},
-			want:    &gen.AddCollaboratorResponse{},
 			wantErr: false,
 			mocker: func(req *gen.AddCollaboratorRequest, mock *mock_note.MockNoteUsecase) {
-				mock.EXPECT().AddCollaborator(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId), uuid.FromStringOrNil(req.GuestId)).Return("", nil)
+				mock.EXPECT().AddCollaborator(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId), uuid.FromStringOrNil(req.GuestId), req.Role).Return(models.Invite{
+					Id:        inviteId,
+					NoteTitle: "title",
+				}, nil)
 			},
 		},
 		{
@@ -554,11 +569,12 @@ func TestGrpcNoteHandler_AddCollaborator(t *testing.T) {
 				NoteId:  noteId.String(),
 				UserId:  userId.String(),
 				GuestId: guestId.String(),
+				Role:    models.RoleViewer,
 			},
 			want:    nil,
 			wantErr: true,
 			mocker: func(req *gen.AddCollaboratorRequest, mock *mock_note.MockNoteUsecase) {
-				mock.EXPECT().AddCollaborator(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId), uuid.FromStringOrNil(req.GuestId)).Return("", errors.New("error"))
+				mock.EXPECT().AddCollaborator(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId), uuid.FromStringOrNil(req.GuestId), req.Role).Return(models.Invite{}, errors.New("error"))
 			},
 		},
 	}
@@ -582,6 +598,147 @@ func TestGrpcNoteHandler_AddCollaborator(t *testing.T) {
 	}
 }
 
+func TestGrpcNoteHandler_AcceptInvite(t *testing.T) {
+	inviteId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	ownerId := uuid.NewV4()
+	userId := uuid.NewV4()
+	created := time.Now().UTC()
+
+	invite := models.Invite{
+		Id:        inviteId,
+		NoteId:    noteId,
+		NoteTitle: "title",
+		InviterId: ownerId,
+		Inviter:   "owner",
+		InviteeId: userId,
+		Invitee:   "user",
+		Role:      models.RoleEditor,
+		Created:   created,
+		ExpiresAt: created,
+	}
+
+	tests := []struct {
+		name    string
+		mocker  func(mock *mock_note.MockNoteUsecase)
+		want    *gen.InviteResponse
+		wantErr bool
+	}{
+		{
+			name: "Test_AcceptInvite_Success",
+			mocker: func(mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().AcceptInvite(gomock.Any(), inviteId, userId).Return(invite, nil)
+			},
+			want: &gen.InviteResponse{
+				Invite: &gen.InviteModel{
+					Id:        inviteId.String(),
+					NoteId:    noteId.String(),
+					NoteTitle: "title",
+					InviterId: ownerId.String(),
+					Inviter:   "owner",
+					InviteeId: userId.String(),
+					Invitee:   "user",
+					Role:      models.RoleEditor,
+					Created:   created.String(),
+					ExpiresAt: created.String(),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test_AcceptInvite_Fail",
+			mocker: func(mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().AcceptInvite(gomock.Any(), inviteId, userId).Return(models.Invite{}, errors.New("error"))
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(mockUsecase)
+
+			got, err := h.AcceptInvite(context.Background(), &gen.InviteRequest{
+				InviteId: inviteId.String(),
+				UserId:   userId.String(),
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GrpcNoteHandler.AcceptInvite() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGrpcNoteHandler_GetReceivedInvites(t *testing.T) {
+	inviteId := uuid.NewV4()
+	userId := uuid.NewV4()
+	created := time.Now().UTC()
+
+	tests := []struct {
+		name    string
+		mocker  func(mock *mock_note.MockNoteUsecase)
+		want    *gen.GetInvitesResponse
+		wantErr bool
+	}{
+		{
+			name: "Test_GetReceivedInvites_Success",
+			mocker: func(mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().GetReceivedInvites(gomock.Any(), userId).Return([]models.Invite{{
+					Id:        inviteId,
+					InviteeId: userId,
+					Created:   created,
+					ExpiresAt: created,
+				}}, nil)
+			},
+			want: &gen.GetInvitesResponse{
+				Invites: []*gen.InviteModel{{
+					Id:        inviteId.String(),
+					NoteId:    uuid.UUID{}.String(),
+					InviterId: uuid.UUID{}.String(),
+					InviteeId: userId.String(),
+					Created:   created.String(),
+					ExpiresAt: created.String(),
+				}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test_GetReceivedInvites_Fail",
+			mocker: func(mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().GetReceivedInvites(gomock.Any(), userId).Return(nil, errors.New("error"))
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(mockUsecase)
+
+			got, err := h.GetReceivedInvites(context.Background(), &gen.GetInvitesRequest{
+				UserId: userId.String(),
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GrpcNoteHandler.GetReceivedInvites() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestGrpcNoteHandler_DeleteNote(t *testing.T) {
 	noteId := uuid.NewV4()
 	userId := uuid.NewV4()
@@ -750,7 +907,7 @@ func TestGrpcNoteHandler_UpdateNote(t *testing.T) {
 			},
 			wantErr: false,
 			mocker: func(req *gen.UpdateNoteRequest, mock *mock_note.MockNoteUsecase) {
-				mock.EXPECT().UpdateNote(gomock.Any(), uuid.FromStringOrNil(req.Id), uuid.FromStringOrNil(req.UserId), req.Data).Return(
+				mock.EXPECT().UpdateNote(gomock.Any(), uuid.FromStringOrNil(req.Id), uuid.FromStringOrNil(req.UserId), req.Data, req.Version).Return(
 					models.Note{
 						Id:      noteId,
 						OwnerId: userId,
@@ -768,10 +925,25 @@ func TestGrpcNoteHandler_UpdateNote(t *testing.T) {
 			want:    nil,
 			wantErr: true,
 			mocker: func(req *gen.UpdateNoteRequest, mock *mock_note.MockNoteUsecase) {
-				mock.EXPECT().UpdateNote(gomock.Any(), uuid.FromStringOrNil(req.Id), uuid.FromStringOrNil(req.UserId), req.Data).Return(
+				mock.EXPECT().UpdateNote(gomock.Any(), uuid.FromStringOrNil(req.Id), uuid.FromStringOrNil(req.UserId), req.Data, req.Version).Return(
 					models.Note{}, errors.New("error"))
 			},
 		},
+		{
+			name: "Test_UpdateNote_VersionConflict",
+			requestBody: &gen.UpdateNoteRequest{
+				Data:    "",
+				Id:      noteId.String(),
+				UserId:  userId.String(),
+				Version: 2,
+			},
+			want:    nil,
+			wantErr: true,
+			mocker: func(req *gen.UpdateNoteRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().UpdateNote(gomock.Any(), uuid.FromStringOrNil(req.Id), uuid.FromStringOrNil(req.UserId), req.Data, req.Version).Return(
+					models.Note{}, errors.New(note.ErrVersionConflict))
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -788,47 +960,52 @@ func TestGrpcNoteHandler_UpdateNote(t *testing.T) {
 				t.Errorf("GrpcNoteHandler.UpdateNote() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			if tt.name == "Test_UpdateNote_VersionConflict" {
+				assert.EqualError(t, err, note.ErrVersionConflict)
+			}
 			assert.Equal(t, tt.want, got)
 		})
 	}
 }
 
-func TestGrpcNoteHandler_CheckPermissions(t *testing.T) {
+func TestGrpcNoteHandler_SetCollaboratorRole(t *testing.T) {
 	noteId := uuid.NewV4()
 	userId := uuid.NewV4()
+	guestId := uuid.NewV4()
 
 	tests := []struct {
 		name        string
-		requestBody *gen.CheckPermissionsRequest
-		mocker      func(req *gen.CheckPermissionsRequest, mock *mock_note.MockNoteUsecase)
-		want        *gen.CheckPermissionsResponse
+		requestBody *gen.SetCollaboratorRoleRequest
+		mocker      func(req *gen.SetCollaboratorRoleRequest, mock *mock_note.MockNoteUsecase)
+		want        *gen.EmptyResponse
 		wantErr     bool
 	}{
 		{
-			name: "Test_CheckPermission_Success",
-			requestBody: &gen.CheckPermissionsRequest{
-				NoteId: noteId.String(),
-				UserId: userId.String(),
-			},
-			want: &gen.CheckPermissionsResponse{
-				Result: true,
+			name: "Test_SetCollaboratorRole_Success",
+			requestBody: &gen.SetCollaboratorRoleRequest{
+				NoteId:  noteId.String(),
+				UserId:  userId.String(),
+				GuestId: guestId.String(),
+				Role:    models.RoleCommenter,
 			},
+			want:    &gen.EmptyResponse{},
 			wantErr: false,
-			mocker: func(req *gen.CheckPermissionsRequest, mock *mock_note.MockNoteUsecase) {
-				mock.EXPECT().CheckPermissions(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId)).Return(true, nil)
+			mocker: func(req *gen.SetCollaboratorRoleRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().SetCollaboratorRole(gomock.Any(), noteId, userId, guestId, req.Role).Return(nil)
 			},
 		},
-
 		{
-			name: "Test_CheckPermission_Success",
-			requestBody: &gen.CheckPermissionsRequest{
-				NoteId: noteId.String(),
-				UserId: userId.String(),
+			name: "Test_SetCollaboratorRole_Fail",
+			requestBody: &gen.SetCollaboratorRoleRequest{
+				NoteId:  noteId.String(),
+				UserId:  userId.String(),
+				GuestId: guestId.String(),
+				Role:    models.RoleCommenter,
 			},
 			want:    nil,
 			wantErr: true,
-			mocker: func(req *gen.CheckPermissionsRequest, mock *mock_note.MockNoteUsecase) {
-				mock.EXPECT().CheckPermissions(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId)).Return(false, errors.New("error"))
+			mocker: func(req *gen.SetCollaboratorRoleRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().SetCollaboratorRole(gomock.Any(), noteId, userId, guestId, req.Role).Return(errors.New("error"))
 			},
 		},
 	}
@@ -842,9 +1019,9 @@ func TestGrpcNoteHandler_CheckPermissions(t *testing.T) {
 			h := NewGrpcNoteHandler(mockUsecase)
 			tt.mocker(tt.requestBody, mockUsecase)
 
-			got, err := h.CheckPermissions(ctx, tt.requestBody)
+			got, err := h.SetCollaboratorRole(ctx, tt.requestBody)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("GrpcNoteHandler.CheckPermission() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("GrpcNoteHandler.SetCollaboratorRole() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			assert.Equal(t, tt.want, got)
@@ -852,38 +1029,36 @@ func TestGrpcNoteHandler_CheckPermissions(t *testing.T) {
 	}
 }
 
-func TestGrpcNoteHandler_RememberTag(t *testing.T) {
+func TestGrpcNoteHandler_RemoveCollaborator(t *testing.T) {
+	noteId := uuid.NewV4()
 	userId := uuid.NewV4()
+	guestId := uuid.NewV4()
+	childId := uuid.NewV4()
 
 	tests := []struct {
-		name        string
-		requestBody *gen.AllTagRequest
-		mocker      func(req *gen.AllTagRequest, mock *mock_note.MockNoteUsecase)
-		wantErr     bool
+		name    string
+		mocker  func(mock *mock_note.MockNoteUsecase)
+		want    *gen.RemoveCollaboratorResponse
+		wantErr bool
 	}{
 		{
-			name: "Test_RememberTag_Success",
-			requestBody: &gen.AllTagRequest{
-				TagName: "tag",
-				UserId:  userId.String(),
+			name: "Test_RemoveCollaborator_Success",
+			mocker: func(mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().RemoveCollaborator(gomock.Any(), noteId, userId, guestId).Return("title", []uuid.UUID{noteId, childId}, nil)
 			},
-
-			wantErr: false,
-			mocker: func(req *gen.AllTagRequest, mock *mock_note.MockNoteUsecase) {
-				mock.EXPECT().RememberTag(gomock.Any(), req.TagName, uuid.FromStringOrNil(req.UserId)).Return(nil)
+			want: &gen.RemoveCollaboratorResponse{
+				Title:   "title",
+				NoteIds: []string{noteId.String(), childId.String()},
 			},
+			wantErr: false,
 		},
 		{
-			name: "Test_RememberTag_Fail",
-			requestBody: &gen.AllTagRequest{
-				TagName: "tag",
-				UserId:  userId.String(),
+			name: "Test_RemoveCollaborator_Fail",
+			mocker: func(mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().RemoveCollaborator(gomock.Any(), noteId, userId, guestId).Return("", nil, errors.New("error"))
 			},
+			want:    nil,
 			wantErr: true,
-			mocker: func(req *gen.AllTagRequest, mock *mock_note.MockNoteUsecase) {
-				mock.EXPECT().RememberTag(gomock.Any(), req.TagName,
-					uuid.FromStringOrNil(req.UserId)).Return(errors.New("error"))
-			},
 		},
 	}
 	for _, tt := range tests {
@@ -891,54 +1066,51 @@ func TestGrpcNoteHandler_RememberTag(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
 			defer ctrl.Finish()
-			ctx := context.Background()
 
 			h := NewGrpcNoteHandler(mockUsecase)
-			tt.mocker(tt.requestBody, mockUsecase)
+			tt.mocker(mockUsecase)
 
-			_, err := h.RememberTag(ctx, tt.requestBody)
+			got, err := h.RemoveCollaborator(context.Background(), &gen.RemoveCollaboratorRequest{
+				NoteId:  noteId.String(),
+				UserId:  userId.String(),
+				GuestId: guestId.String(),
+			})
 			if (err != nil) != tt.wantErr {
-				t.Errorf("GrpcNoteHandler.RememberTag() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("GrpcNoteHandler.RemoveCollaborator() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			assert.Equal(t, tt.want, got)
 		})
 	}
 }
 
-func TestGrpcNoteHandler_UpdateTag(t *testing.T) {
+func TestGrpcNoteHandler_LeaveNote(t *testing.T) {
+	noteId := uuid.NewV4()
 	userId := uuid.NewV4()
 
 	tests := []struct {
-		name        string
-		requestBody *gen.UpdateTagRequest
-		mocker      func(req *gen.UpdateTagRequest, mock *mock_note.MockNoteUsecase)
-		wantErr     bool
+		name    string
+		mocker  func(mock *mock_note.MockNoteUsecase)
+		want    *gen.LeaveNoteResponse
+		wantErr bool
 	}{
 		{
-			name: "Test_UpdateTag_Success",
-			requestBody: &gen.UpdateTagRequest{
-				OldTag: "old",
-				NewTag: "new",
-				UserId: userId.String(),
+			name: "Test_LeaveNote_Success",
+			mocker: func(mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().LeaveNote(gomock.Any(), noteId, userId).Return([]uuid.UUID{noteId}, nil)
 			},
-
-			wantErr: false,
-			mocker: func(req *gen.UpdateTagRequest, mock *mock_note.MockNoteUsecase) {
-				mock.EXPECT().UpdateTag(gomock.Any(), req.OldTag, req.NewTag, uuid.FromStringOrNil(req.UserId)).Return(nil)
+			want: &gen.LeaveNoteResponse{
+				NoteIds: []string{noteId.String()},
 			},
+			wantErr: false,
 		},
 		{
-			name: "Test_UpdateTag_Fail",
-			requestBody: &gen.UpdateTagRequest{
-				OldTag: "old",
-				NewTag: "new",
-				UserId: userId.String(),
+			name: "Test_LeaveNote_Fail",
+			mocker: func(mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().LeaveNote(gomock.Any(), noteId, userId).Return(nil, errors.New("error"))
 			},
+			want:    nil,
 			wantErr: true,
-			mocker: func(req *gen.UpdateTagRequest, mock *mock_note.MockNoteUsecase) {
-				mock.EXPECT().UpdateTag(gomock.Any(), req.OldTag, req.NewTag,
-					uuid.FromStringOrNil(req.UserId)).Return(errors.New("error"))
-			},
 		},
 	}
 	for _, tt := range tests {
@@ -946,52 +1118,51 @@ func TestGrpcNoteHandler_UpdateTag(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
 			defer ctrl.Finish()
-			ctx := context.Background()
 
 			h := NewGrpcNoteHandler(mockUsecase)
-			tt.mocker(tt.requestBody, mockUsecase)
+			tt.mocker(mockUsecase)
 
-			_, err := h.UpdateTag(ctx, tt.requestBody)
+			got, err := h.LeaveNote(context.Background(), &gen.LeaveNoteRequest{
+				NoteId: noteId.String(),
+				UserId: userId.String(),
+			})
 			if (err != nil) != tt.wantErr {
-				t.Errorf("GrpcNoteHandler.UpdateTag() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("GrpcNoteHandler.LeaveNote() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			assert.Equal(t, tt.want, got)
 		})
 	}
 }
 
-func TestGrpcNoteHandler_ForgetTag(t *testing.T) {
+func TestGrpcNoteHandler_ProposeTransfer(t *testing.T) {
+	noteId := uuid.NewV4()
 	userId := uuid.NewV4()
+	newOwnerId := uuid.NewV4()
 
 	tests := []struct {
-		name        string
-		requestBody *gen.AllTagRequest
-		mocker      func(req *gen.AllTagRequest, mock *mock_note.MockNoteUsecase)
-		wantErr     bool
+		name    string
+		mocker  func(mock *mock_note.MockNoteUsecase)
+		want    *gen.ProposeTransferResponse
+		wantErr bool
 	}{
 		{
-			name: "Test_ForgetTag_Success",
-			requestBody: &gen.AllTagRequest{
-				TagName: "tag",
-				UserId:  userId.String(),
+			name: "Test_ProposeTransfer_Success",
+			mocker: func(mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().ProposeTransfer(gomock.Any(), noteId, userId, newOwnerId).Return("title", nil)
 			},
-
-			wantErr: false,
-			mocker: func(req *gen.AllTagRequest, mock *mock_note.MockNoteUsecase) {
-				mock.EXPECT().ForgetTag(gomock.Any(), req.TagName, uuid.FromStringOrNil(req.UserId)).Return(nil)
+			want: &gen.ProposeTransferResponse{
+				Title: "title",
 			},
+			wantErr: false,
 		},
 		{
-			name: "Test_ForgetTag_Fail",
-			requestBody: &gen.AllTagRequest{
-				TagName: "tag",
-				UserId:  userId.String(),
+			name: "Test_ProposeTransfer_Fail",
+			mocker: func(mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().ProposeTransfer(gomock.Any(), noteId, userId, newOwnerId).Return("", errors.New("error"))
 			},
+			want:    nil,
 			wantErr: true,
-			mocker: func(req *gen.AllTagRequest, mock *mock_note.MockNoteUsecase) {
-				mock.EXPECT().ForgetTag(gomock.Any(), req.TagName,
-					uuid.FromStringOrNil(req.UserId)).Return(errors.New("error"))
-			},
 		},
 	}
 	for _, tt := range tests {
@@ -999,53 +1170,58 @@ func TestGrpcNoteHandler_ForgetTag(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
 			defer ctrl.Finish()
-			ctx := context.Background()
 
 			h := NewGrpcNoteHandler(mockUsecase)
-			tt.mocker(tt.requestBody, mockUsecase)
+			tt.mocker(mockUsecase)
 
-			_, err := h.ForgetTag(ctx, tt.requestBody)
+			got, err := h.ProposeTransfer(context.Background(), &gen.ProposeTransferRequest{
+				NoteId:     noteId.String(),
+				UserId:     userId.String(),
+				NewOwnerId: newOwnerId.String(),
+			})
 			if (err != nil) != tt.wantErr {
-				t.Errorf("GrpcNoteHandler.ForgetTag() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("GrpcNoteHandler.ProposeTransfer() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			assert.Equal(t, tt.want, got)
 		})
 	}
 }
 
-func TestGrpcNoteHandler_SetIcon(t *testing.T) {
-	userId := uuid.NewV4()
+func TestGrpcNoteHandler_AcceptTransfer(t *testing.T) {
 	noteId := uuid.NewV4()
+	oldOwnerId := uuid.NewV4()
+	userId := uuid.NewV4()
+
 	tests := []struct {
-		name        string
-		requestBody *gen.SetIconRequest
-		mocker      func(req *gen.SetIconRequest, mock *mock_note.MockNoteUsecase)
-		wantErr     bool
+		name    string
+		mocker  func(mock *mock_note.MockNoteUsecase)
+		want    *gen.AcceptTransferResponse
+		wantErr bool
 	}{
 		{
-			name: "Test_SetIcon_Success",
-			requestBody: &gen.SetIconRequest{
-				Icon:   "icon",
+			name: "Test_AcceptTransfer_Success",
+			mocker: func(mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().AcceptTransfer(gomock.Any(), noteId, userId).Return(models.OwnershipTransfer{
+					NoteId: noteId,
+					FromId: oldOwnerId,
+					ToId:   userId,
+				}, nil)
+			},
+			want: &gen.AcceptTransferResponse{
 				NoteId: noteId.String(),
-				UserId: userId.String(),
+				FromId: oldOwnerId.String(),
+				ToId:   userId.String(),
 			},
-
 			wantErr: false,
-			mocker: func(req *gen.SetIconRequest, mock *mock_note.MockNoteUsecase) {
-				mock.EXPECT().SetIcon(gomock.Any(), uuid.FromStringOrNil(req.NoteId), req.Icon, uuid.FromStringOrNil(req.UserId)).Return(models.Note{}, nil)
-			},
 		},
 		{
-			name: "Test_SetIcon_Fail",
-			requestBody: &gen.SetIconRequest{
-				Icon:   "icon",
-				NoteId: noteId.String(),
-				UserId: userId.String(),
+			name: "Test_AcceptTransfer_Fail",
+			mocker: func(mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().AcceptTransfer(gomock.Any(), noteId, userId).Return(models.OwnershipTransfer{}, errors.New("error"))
 			},
+			want:    nil,
 			wantErr: true,
-			mocker: func(req *gen.SetIconRequest, mock *mock_note.MockNoteUsecase) {
-				mock.EXPECT().SetIcon(gomock.Any(), uuid.FromStringOrNil(req.NoteId), req.Icon, uuid.FromStringOrNil(req.UserId)).Return(models.Note{}, errors.New("error"))
-			},
 		},
 	}
 	for _, tt := range tests {
@@ -1053,52 +1229,1732 @@ func TestGrpcNoteHandler_SetIcon(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
 			defer ctrl.Finish()
-			ctx := context.Background()
 
 			h := NewGrpcNoteHandler(mockUsecase)
-			tt.mocker(tt.requestBody, mockUsecase)
+			tt.mocker(mockUsecase)
 
-			_, err := h.SetIcon(ctx, tt.requestBody)
+			got, err := h.AcceptTransfer(context.Background(), &gen.AcceptTransferRequest{
+				NoteId: noteId.String(),
+				UserId: userId.String(),
+			})
 			if (err != nil) != tt.wantErr {
-				t.Errorf("GrpcNoteHandler.SetIcon() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("GrpcNoteHandler.AcceptTransfer() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			assert.Equal(t, tt.want, got)
 		})
 	}
 }
 
-func TestGrpcNoteHandler_SetHeader(t *testing.T) {
-	userId := uuid.NewV4()
+func TestGrpcNoteHandler_CheckPermissions(t *testing.T) {
+	noteId := uuid.NewV4()
+	userId := uuid.NewV4()
+
+	tests := []struct {
+		name        string
+		requestBody *gen.CheckPermissionsRequest
+		mocker      func(req *gen.CheckPermissionsRequest, mock *mock_note.MockNoteUsecase)
+		want        *gen.CheckPermissionsResponse
+		wantErr     bool
+	}{
+		{
+			name: "Test_CheckPermission_Success",
+			requestBody: &gen.CheckPermissionsRequest{
+				NoteId: noteId.String(),
+				UserId: userId.String(),
+			},
+			want: &gen.CheckPermissionsResponse{
+				Result: true,
+				Role:   models.RoleEditor,
+			},
+			wantErr: false,
+			mocker: func(req *gen.CheckPermissionsRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().CheckPermissions(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId)).Return(models.RoleEditor, nil)
+			},
+		},
+
+		{
+			name: "Test_CheckPermission_Success",
+			requestBody: &gen.CheckPermissionsRequest{
+				NoteId: noteId.String(),
+				UserId: userId.String(),
+			},
+			want:    nil,
+			wantErr: true,
+			mocker: func(req *gen.CheckPermissionsRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().CheckPermissions(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId)).Return("", errors.New("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(tt.requestBody, mockUsecase)
+
+			got, err := h.CheckPermissions(ctx, tt.requestBody)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GrpcNoteHandler.CheckPermission() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGrpcNoteHandler_MergeTags(t *testing.T) {
+	userId := uuid.NewV4()
+	request := &gen.MergeTagsRequest{Tags: []string{"first", "second"}, NewTag: "target", UserId: userId.String()}
+
+	tests := []struct {
+		name    string
+		mocker  func(mock *mock_note.MockNoteUsecase)
+		wantErr bool
+	}{
+		{
+			name: "Test_MergeTags_Success",
+			mocker: func(mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().MergeTags(gomock.Any(), request.Tags, request.NewTag, userId).Return(nil)
+			},
+		},
+		{
+			name: "Test_MergeTags_Fail",
+			mocker: func(mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().MergeTags(gomock.Any(), request.Tags, request.NewTag, userId).Return(errors.New("error"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(mockUsecase)
+
+			_, err := h.MergeTags(context.Background(), request)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestGrpcNoteHandler_SetTagInfo(t *testing.T) {
+	userId := uuid.NewV4()
+	request := &gen.TagInfoRequest{
+		UserId: userId.String(),
+		Tag:    &gen.TagModel{Name: "work", Color: "#ffffff", Emoji: "💼", Description: "job"},
+	}
+	tag := models.Tag{Name: "work", Color: "#ffffff", Emoji: "💼", Description: "job"}
+
+	tests := []struct {
+		name    string
+		mocker  func(mock *mock_note.MockNoteUsecase)
+		wantErr bool
+	}{
+		{
+			name: "Test_SetTagInfo_Success",
+			mocker: func(mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().SetTagInfo(gomock.Any(), tag, userId).Return(nil)
+			},
+		},
+		{
+			name: "Test_SetTagInfo_Fail",
+			mocker: func(mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().SetTagInfo(gomock.Any(), tag, userId).Return(errors.New("error"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(mockUsecase)
+
+			_, err := h.SetTagInfo(context.Background(), request)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestGrpcNoteHandler_RememberTag(t *testing.T) {
+	userId := uuid.NewV4()
+
+	tests := []struct {
+		name        string
+		requestBody *gen.AllTagRequest
+		mocker      func(req *gen.AllTagRequest, mock *mock_note.MockNoteUsecase)
+		wantErr     bool
+	}{
+		{
+			name: "Test_RememberTag_Success",
+			requestBody: &gen.AllTagRequest{
+				TagName: "tag",
+				UserId:  userId.String(),
+			},
+
+			wantErr: false,
+			mocker: func(req *gen.AllTagRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().RememberTag(gomock.Any(), req.TagName, uuid.FromStringOrNil(req.UserId)).Return(nil)
+			},
+		},
+		{
+			name: "Test_RememberTag_Fail",
+			requestBody: &gen.AllTagRequest{
+				TagName: "tag",
+				UserId:  userId.String(),
+			},
+			wantErr: true,
+			mocker: func(req *gen.AllTagRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().RememberTag(gomock.Any(), req.TagName,
+					uuid.FromStringOrNil(req.UserId)).Return(errors.New("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(tt.requestBody, mockUsecase)
+
+			_, err := h.RememberTag(ctx, tt.requestBody)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GrpcNoteHandler.RememberTag() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestGrpcNoteHandler_UpdateTag(t *testing.T) {
+	userId := uuid.NewV4()
+
+	tests := []struct {
+		name        string
+		requestBody *gen.UpdateTagRequest
+		mocker      func(req *gen.UpdateTagRequest, mock *mock_note.MockNoteUsecase)
+		wantErr     bool
+	}{
+		{
+			name: "Test_UpdateTag_Success",
+			requestBody: &gen.UpdateTagRequest{
+				OldTag: "old",
+				NewTag: "new",
+				UserId: userId.String(),
+			},
+
+			wantErr: false,
+			mocker: func(req *gen.UpdateTagRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().UpdateTag(gomock.Any(), req.OldTag, req.NewTag, uuid.FromStringOrNil(req.UserId)).Return(nil)
+			},
+		},
+		{
+			name: "Test_UpdateTag_Fail",
+			requestBody: &gen.UpdateTagRequest{
+				OldTag: "old",
+				NewTag: "new",
+				UserId: userId.String(),
+			},
+			wantErr: true,
+			mocker: func(req *gen.UpdateTagRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().UpdateTag(gomock.Any(), req.OldTag, req.NewTag,
+					uuid.FromStringOrNil(req.UserId)).Return(errors.New("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(tt.requestBody, mockUsecase)
+
+			_, err := h.UpdateTag(ctx, tt.requestBody)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GrpcNoteHandler.UpdateTag() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestGrpcNoteHandler_ForgetTag(t *testing.T) {
+	userId := uuid.NewV4()
+
+	tests := []struct {
+		name        string
+		requestBody *gen.AllTagRequest
+		mocker      func(req *gen.AllTagRequest, mock *mock_note.MockNoteUsecase)
+		wantErr     bool
+	}{
+		{
+			name: "Test_ForgetTag_Success",
+			requestBody: &gen.AllTagRequest{
+				TagName: "tag",
+				UserId:  userId.String(),
+			},
+
+			wantErr: false,
+			mocker: func(req *gen.AllTagRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().ForgetTag(gomock.Any(), req.TagName, uuid.FromStringOrNil(req.UserId)).Return(nil)
+			},
+		},
+		{
+			name: "Test_ForgetTag_Fail",
+			requestBody: &gen.AllTagRequest{
+				TagName: "tag",
+				UserId:  userId.String(),
+			},
+			wantErr: true,
+			mocker: func(req *gen.AllTagRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().ForgetTag(gomock.Any(), req.TagName,
+					uuid.FromStringOrNil(req.UserId)).Return(errors.New("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(tt.requestBody, mockUsecase)
+
+			_, err := h.ForgetTag(ctx, tt.requestBody)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GrpcNoteHandler.ForgetTag() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestGrpcNoteHandler_MoveNote(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	parentId := uuid.NewV4()
+	tests := []struct {
+		name        string
+		requestBody *gen.MoveNoteRequest
+		mocker      func(req *gen.MoveNoteRequest, mock *mock_note.MockNoteUsecase)
+		wantErr     bool
+	}{
+		{
+			name: "Test_MoveNote_Success",
+			requestBody: &gen.MoveNoteRequest{
+				NoteId:   noteId.String(),
+				ParentId: parentId.String(),
+				UserId:   userId.String(),
+			},
+			wantErr: false,
+			mocker: func(req *gen.MoveNoteRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().MoveNote(gomock.Any(), noteId, parentId, userId).Return(models.Note{}, nil)
+			},
+		},
+		{
+			name: "Test_MoveNote_Fail",
+			requestBody: &gen.MoveNoteRequest{
+				NoteId:   noteId.String(),
+				ParentId: parentId.String(),
+				UserId:   userId.String(),
+			},
+			wantErr: true,
+			mocker: func(req *gen.MoveNoteRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().MoveNote(gomock.Any(), noteId, parentId, userId).Return(models.Note{}, errors.New("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(tt.requestBody, mockUsecase)
+
+			_, err := h.MoveNote(ctx, tt.requestBody)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GrpcNoteHandler.MoveNote() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestGrpcNoteHandler_DuplicateNote(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	tests := []struct {
+		name        string
+		requestBody *gen.DuplicateNoteRequest
+		mocker      func(req *gen.DuplicateNoteRequest, mock *mock_note.MockNoteUsecase)
+		wantErr     bool
+	}{
+		{
+			name: "Test_DuplicateNote_Success",
+			requestBody: &gen.DuplicateNoteRequest{
+				NoteId:       noteId.String(),
+				UserId:       userId.String(),
+				WithSubnotes: true,
+			},
+			wantErr: false,
+			mocker: func(req *gen.DuplicateNoteRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().DuplicateNote(gomock.Any(), noteId, userId, true).Return(models.Note{}, nil)
+			},
+		},
+		{
+			name: "Test_DuplicateNote_Fail",
+			requestBody: &gen.DuplicateNoteRequest{
+				NoteId: noteId.String(),
+				UserId: userId.String(),
+			},
+			wantErr: true,
+			mocker: func(req *gen.DuplicateNoteRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().DuplicateNote(gomock.Any(), noteId, userId, false).Return(models.Note{}, errors.New("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(tt.requestBody, mockUsecase)
+
+			_, err := h.DuplicateNote(ctx, tt.requestBody)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GrpcNoteHandler.DuplicateNote() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestGrpcNoteHandler_SetIcon(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	tests := []struct {
+		name        string
+		requestBody *gen.SetIconRequest
+		mocker      func(req *gen.SetIconRequest, mock *mock_note.MockNoteUsecase)
+		wantErr     bool
+	}{
+		{
+			name: "Test_SetIcon_Success",
+			requestBody: &gen.SetIconRequest{
+				Icon:   "icon",
+				NoteId: noteId.String(),
+				UserId: userId.String(),
+			},
+
+			wantErr: false,
+			mocker: func(req *gen.SetIconRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().SetIcon(gomock.Any(), uuid.FromStringOrNil(req.NoteId), req.Icon, uuid.FromStringOrNil(req.UserId), req.Version).Return(models.Note{}, nil)
+			},
+		},
+		{
+			name: "Test_SetIcon_Fail",
+			requestBody: &gen.SetIconRequest{
+				Icon:   "icon",
+				NoteId: noteId.String(),
+				UserId: userId.String(),
+			},
+			wantErr: true,
+			mocker: func(req *gen.SetIconRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().SetIcon(gomock.Any(), uuid.FromStringOrNil(req.NoteId), req.Icon, uuid.FromStringOrNil(req.UserId), req.Version).Return(models.Note{}, errors.New("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(tt.requestBody, mockUsecase)
+
+			_, err := h.SetIcon(ctx, tt.requestBody)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GrpcNoteHandler.SetIcon() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestGrpcNoteHandler_SetHeader(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	tests := []struct {
+		name        string
+		requestBody *gen.SetHeaderRequest
+		mocker      func(req *gen.SetHeaderRequest, mock *mock_note.MockNoteUsecase)
+		wantErr     bool
+	}{
+		{
+			name: "Test_SetHeader_Success",
+			requestBody: &gen.SetHeaderRequest{
+				Header: "header",
+				NoteId: noteId.String(),
+				UserId: userId.String(),
+			},
+
+			wantErr: false,
+			mocker: func(req *gen.SetHeaderRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().SetHeader(gomock.Any(), uuid.FromStringOrNil(req.NoteId), req.Header, uuid.FromStringOrNil(req.UserId), req.Version).Return(models.Note{}, nil)
+			},
+		},
+		{
+			name: "Test_SetHeader_Fail",
+			requestBody: &gen.SetHeaderRequest{
+				Header: "header",
+				NoteId: noteId.String(),
+				UserId: userId.String(),
+			},
+			wantErr: true,
+			mocker: func(req *gen.SetHeaderRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().SetHeader(gomock.Any(), uuid.FromStringOrNil(req.NoteId), req.Header, uuid.FromStringOrNil(req.UserId), req.Version).Return(models.Note{}, errors.New("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(tt.requestBody, mockUsecase)
+
+			_, err := h.SetHeader(ctx, tt.requestBody)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GrpcNoteHandler.SetHeader() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestGrpcNoteHandler_AddFav(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	tests := []struct {
+		name        string
+		requestBody *gen.ChangeFlagRequest
+		mocker      func(req *gen.ChangeFlagRequest, mock *mock_note.MockNoteUsecase)
+		wantErr     bool
+	}{
+		{
+			name: "Test_AddFav_Success",
+			requestBody: &gen.ChangeFlagRequest{
+				NoteId: noteId.String(),
+				UserId: userId.String(),
+			},
+
+			wantErr: false,
+			mocker: func(req *gen.ChangeFlagRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().AddFav(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId)).Return(models.Note{}, nil)
+			},
+		},
+		{
+			name: "Test_AddFav_Fail",
+			requestBody: &gen.ChangeFlagRequest{
+				NoteId: noteId.String(),
+				UserId: userId.String(),
+			},
+			wantErr: true,
+			mocker: func(req *gen.ChangeFlagRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().AddFav(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId)).Return(models.Note{}, errors.New("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(tt.requestBody, mockUsecase)
+
+			_, err := h.AddFav(ctx, tt.requestBody)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GrpcNoteHandler.AddFav() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestGrpcNoteHandler_DelFav(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	tests := []struct {
+		name        string
+		requestBody *gen.ChangeFlagRequest
+		mocker      func(req *gen.ChangeFlagRequest, mock *mock_note.MockNoteUsecase)
+		wantErr     bool
+	}{
+		{
+			name: "Test_DelFav_Success",
+			requestBody: &gen.ChangeFlagRequest{
+				NoteId: noteId.String(),
+				UserId: userId.String(),
+			},
+
+			wantErr: false,
+			mocker: func(req *gen.ChangeFlagRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().DelFav(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId)).Return(models.Note{}, nil)
+			},
+		},
+		{
+			name: "Test_DelFav_Fail",
+			requestBody: &gen.ChangeFlagRequest{
+				NoteId: noteId.String(),
+				UserId: userId.String(),
+			},
+			wantErr: true,
+			mocker: func(req *gen.ChangeFlagRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().DelFav(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId)).Return(models.Note{}, errors.New("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(tt.requestBody, mockUsecase)
+
+			_, err := h.DelFav(ctx, tt.requestBody)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GrpcNoteHandler.DelFav() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestGrpcNoteHandler_SetPublic(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	tests := []struct {
+		name        string
+		requestBody *gen.AccessModeRequest
+		mocker      func(req *gen.AccessModeRequest, mock *mock_note.MockNoteUsecase)
+		wantErr     bool
+	}{
+		{
+			name: "Test_SetPublic_Success",
+			requestBody: &gen.AccessModeRequest{
+				NoteId: noteId.String(),
+				UserId: userId.String(),
+			},
+
+			wantErr: false,
+			mocker: func(req *gen.AccessModeRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().SetPublic(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId)).Return(models.Note{}, nil)
+			},
+		},
+		{
+			name: "Test_SetPublic_Fail",
+			requestBody: &gen.AccessModeRequest{
+				NoteId: noteId.String(),
+				UserId: userId.String(),
+			},
+			wantErr: true,
+			mocker: func(req *gen.AccessModeRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().SetPublic(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId)).Return(models.Note{}, errors.New("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(tt.requestBody, mockUsecase)
+
+			_, err := h.SetPublic(ctx, tt.requestBody)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GrpcNoteHandler.SetPublic() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestGrpcNoteHandler_SetPrivate(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	tests := []struct {
+		name        string
+		requestBody *gen.AccessModeRequest
+		mocker      func(req *gen.AccessModeRequest, mock *mock_note.MockNoteUsecase)
+		wantErr     bool
+	}{
+		{
+			name: "Test_SetPrivate_Success",
+			requestBody: &gen.AccessModeRequest{
+				NoteId: noteId.String(),
+				UserId: userId.String(),
+			},
+
+			wantErr: false,
+			mocker: func(req *gen.AccessModeRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().SetPrivate(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId)).Return(models.Note{}, nil)
+			},
+		},
+		{
+			name: "Test_SetPrivate_Fail",
+			requestBody: &gen.AccessModeRequest{
+				NoteId: noteId.String(),
+				UserId: userId.String(),
+			},
+			wantErr: true,
+			mocker: func(req *gen.AccessModeRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().SetPrivate(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId)).Return(models.Note{}, errors.New("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(tt.requestBody, mockUsecase)
+
+			_, err := h.SetPrivate(ctx, tt.requestBody)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GrpcNoteHandler.SetPrivate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestGrpcNoteHandler_CreateShareLink(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	expiresAt := time.Now().UTC().Add(time.Hour).Truncate(time.Second)
+	tests := []struct {
+		name        string
+		requestBody *gen.CreateShareLinkRequest
+		mocker      func(req *gen.CreateShareLinkRequest, mock *mock_note.MockNoteUsecase)
+		wantErr     bool
+	}{
+		{
+			name: "Test_CreateShareLink_Success",
+			requestBody: &gen.CreateShareLinkRequest{
+				NoteId:         noteId.String(),
+				UserId:         userId.String(),
+				Password:       "password",
+				ExpiresAt:      expiresAt.String(),
+				IncludeSubtree: true,
+			},
+			wantErr: false,
+			mocker: func(req *gen.CreateShareLinkRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().CreateShareLink(gomock.Any(), noteId, userId, req.Password, gomock.Any(), true).DoAndReturn(
+					func(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, password string, expires *time.Time, includeSubtree bool) (models.ShareLink, error) {
+						assert.True(t, expiresAt.Equal(*expires))
+						return models.ShareLink{NoteId: noteID, PasswordHash: "hash", ExpiresAt: expires}, nil
+					})
+			},
+		},
+		{
+			name: "Test_CreateShareLink_BadTime",
+			requestBody: &gen.CreateShareLinkRequest{
+				NoteId:    noteId.String(),
+				UserId:    userId.String(),
+				ExpiresAt: "time",
+			},
+			wantErr: true,
+			mocker: func(req *gen.CreateShareLinkRequest, mock *mock_note.MockNoteUsecase) {
+			},
+		},
+		{
+			name: "Test_CreateShareLink_Fail",
+			requestBody: &gen.CreateShareLinkRequest{
+				NoteId: noteId.String(),
+				UserId: userId.String(),
+			},
+			wantErr: true,
+			mocker: func(req *gen.CreateShareLinkRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().CreateShareLink(gomock.Any(), noteId, userId, "", nil, false).Return(models.ShareLink{}, errors.New("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(tt.requestBody, mockUsecase)
+
+			got, err := h.CreateShareLink(ctx, tt.requestBody)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GrpcNoteHandler.CreateShareLink() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				assert.True(t, got.Link.HasPassword)
+				assert.Equal(t, expiresAt.String(), got.Link.ExpiresAt)
+			}
+		})
+	}
+}
+
+func TestGrpcNoteHandler_RevokeShareLink(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	linkId := uuid.NewV4()
+	tests := []struct {
+		name        string
+		requestBody *gen.RevokeShareLinkRequest
+		mocker      func(req *gen.RevokeShareLinkRequest, mock *mock_note.MockNoteUsecase)
+		wantErr     bool
+	}{
+		{
+			name: "Test_RevokeShareLink_Success",
+			requestBody: &gen.RevokeShareLinkRequest{
+				NoteId: noteId.String(),
+				LinkId: linkId.String(),
+				UserId: userId.String(),
+			},
+			wantErr: false,
+			mocker: func(req *gen.RevokeShareLinkRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().RevokeShareLink(gomock.Any(), noteId, linkId, userId).Return(nil)
+			},
+		},
+		{
+			name: "Test_RevokeShareLink_Fail",
+			requestBody: &gen.RevokeShareLinkRequest{
+				NoteId: noteId.String(),
+				LinkId: linkId.String(),
+				UserId: userId.String(),
+			},
+			wantErr: true,
+			mocker: func(req *gen.RevokeShareLinkRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().RevokeShareLink(gomock.Any(), noteId, linkId, userId).Return(errors.New("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(tt.requestBody, mockUsecase)
+
+			_, err := h.RevokeShareLink(ctx, tt.requestBody)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GrpcNoteHandler.RevokeShareLink() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestGrpcNoteHandler_GetAttachList(t *testing.T) {
+	noteId := uuid.NewV4()
+	userId := uuid.NewV4()
+
+	type args struct {
+		ctx context.Context
+		in  *generatedNote.GetAttachListRequest
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *generatedNote.GetAttachListResponse
+		mocker  func(req *gen.GetAttachListRequest, mock *mock_note.MockNoteUsecase)
+		wantErr bool
+	}{
+		{
+			name: "Test_GetAttachList_Success",
+			args: args{
+				ctx: context.Background(),
+				in: &generatedNote.GetAttachListRequest{
+					NoteId: noteId.String(),
+					UserId: userId.String(),
+				},
+			},
+			want: &generatedNote.GetAttachListResponse{
+				Paths: []string{"1", "2"},
+			},
+			wantErr: false,
+			mocker: func(req *generatedNote.GetAttachListRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().GetAttachList(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId)).Return([]string{"1", "2"}, nil)
+			},
+		},
+		{
+			name: "Test_GetAttachList_Fail",
+			args: args{
+				ctx: context.Background(),
+				in: &generatedNote.GetAttachListRequest{
+					NoteId: noteId.String(),
+					UserId: userId.String(),
+				},
+			},
+			want:    nil,
+			wantErr: true,
+			mocker: func(req *generatedNote.GetAttachListRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().GetAttachList(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId)).Return([]string{}, errors.New("err"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(tt.args.in, mockUsecase)
+
+			got, err := h.GetAttachList(tt.args.ctx, tt.args.in)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GrpcNoteHandler.GetAttachList() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GrpcNoteHandler.GetAttachList() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGrpcNoteHandler_GetSharedAttachList(t *testing.T) {
+	noteId := uuid.NewV4()
+
+	type args struct {
+		ctx context.Context
+		in  *generatedNote.GetSharedAttachListRequest
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *generatedNote.GetAttachListResponse
+		mocker  func(req *gen.GetSharedAttachListRequest, mock *mock_note.MockNoteUsecase)
+		wantErr bool
+	}{
+		{
+			name: "Test_GetSharedAttachList_Success",
+			args: args{
+				ctx: context.Background(),
+				in: &generatedNote.GetSharedAttachListRequest{
+					NoteId:   noteId.String(),
+					Token:    "token",
+					Password: "password",
+				},
+			},
+			want: &generatedNote.GetAttachListResponse{
+				Paths: []string{"1", "2"},
+			},
+			wantErr: false,
+			mocker: func(req *generatedNote.GetSharedAttachListRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().GetSharedAttachList(gomock.Any(), uuid.FromStringOrNil(req.NoteId), req.Token, req.Password).Return([]string{"1", "2"}, nil)
+			},
+		},
+		{
+			name: "Test_GetSharedAttachList_Fail",
+			args: args{
+				ctx: context.Background(),
+				in: &generatedNote.GetSharedAttachListRequest{
+					NoteId: noteId.String(),
+				},
+			},
+			want:    nil,
+			wantErr: true,
+			mocker: func(req *generatedNote.GetSharedAttachListRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().GetSharedAttachList(gomock.Any(), uuid.FromStringOrNil(req.NoteId), req.Token, req.Password).Return([]string{}, errors.New("err"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(tt.args.in, mockUsecase)
+
+			got, err := h.GetSharedAttachList(tt.args.ctx, tt.args.in)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GrpcNoteHandler.GetSharedAttachList() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GrpcNoteHandler.GetSharedAttachList() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGrpcNoteHandler_GetRevisions(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	tests := []struct {
+		name        string
+		requestBody *gen.GetRevisionsRequest
+		mocker      func(req *gen.GetRevisionsRequest, mock *mock_note.MockNoteUsecase)
+		wantErr     bool
+		wantLen     int
+	}{
+		{
+			name: "Test_GetRevisions_Success",
+			requestBody: &gen.GetRevisionsRequest{
+				NoteId: noteId.String(),
+				UserId: userId.String(),
+				Count:  10,
+				Offset: 0,
+			},
+			wantErr: false,
+			wantLen: 2,
+			mocker: func(req *gen.GetRevisionsRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().GetRevisions(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId), req.Count, req.Offset).Return([]models.NoteRevision{{}, {}}, nil)
+			},
+		},
+		{
+			name: "Test_GetRevisions_Fail",
+			requestBody: &gen.GetRevisionsRequest{
+				NoteId: noteId.String(),
+				UserId: userId.String(),
+				Count:  10,
+				Offset: 0,
+			},
+			wantErr: true,
+			mocker: func(req *gen.GetRevisionsRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().GetRevisions(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId), req.Count, req.Offset).Return(nil, errors.New("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(tt.requestBody, mockUsecase)
+
+			got, err := h.GetRevisions(ctx, tt.requestBody)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GrpcNoteHandler.GetRevisions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				assert.Equal(t, tt.wantLen, len(got.Revisions))
+			}
+		})
+	}
+}
+
+func TestGrpcNoteHandler_GetRevision(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	revisionId := uuid.NewV4()
+	tests := []struct {
+		name        string
+		requestBody *gen.RevisionRequest
+		mocker      func(req *gen.RevisionRequest, mock *mock_note.MockNoteUsecase)
+		wantErr     bool
+	}{
+		{
+			name: "Test_GetRevision_Success",
+			requestBody: &gen.RevisionRequest{
+				NoteId:     noteId.String(),
+				RevisionId: revisionId.String(),
+				UserId:     userId.String(),
+			},
+			wantErr: false,
+			mocker: func(req *gen.RevisionRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().GetRevision(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.RevisionId), uuid.FromStringOrNil(req.UserId)).Return(models.NoteRevision{Id: revisionId}, nil)
+			},
+		},
+		{
+			name: "Test_GetRevision_Fail",
+			requestBody: &gen.RevisionRequest{
+				NoteId:     noteId.String(),
+				RevisionId: revisionId.String(),
+				UserId:     userId.String(),
+			},
+			wantErr: true,
+			mocker: func(req *gen.RevisionRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().GetRevision(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.RevisionId), uuid.FromStringOrNil(req.UserId)).Return(models.NoteRevision{}, errors.New("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(tt.requestBody, mockUsecase)
+
+			got, err := h.GetRevision(ctx, tt.requestBody)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GrpcNoteHandler.GetRevision() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				assert.Equal(t, revisionId.String(), got.Revision.Id)
+			}
+		})
+	}
+}
+
+func TestGrpcNoteHandler_GetRevisionDiff(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	fromId := uuid.NewV4()
+	toId := uuid.NewV4()
+	tests := []struct {
+		name        string
+		requestBody *gen.GetRevisionDiffRequest
+		mocker      func(req *gen.GetRevisionDiffRequest, mock *mock_note.MockNoteUsecase)
+		wantErr     bool
+	}{
+		{
+			name: "Test_GetRevisionDiff_Success",
+			requestBody: &gen.GetRevisionDiffRequest{
+				NoteId: noteId.String(),
+				FromId: fromId.String(),
+				ToId:   toId.String(),
+				UserId: userId.String(),
+			},
+			wantErr: false,
+			mocker: func(req *gen.GetRevisionDiffRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().GetRevisionDiff(gomock.Any(), noteId, fromId, toId, userId).Return(models.RevisionDiff{
+					From:   fromId,
+					To:     toId,
+					Blocks: []models.BlockChange{{Type: models.BlockAdded, Block: "{}"}},
+				}, nil)
+			},
+		},
+		{
+			name: "Test_GetRevisionDiff_Fail",
+			requestBody: &gen.GetRevisionDiffRequest{
+				NoteId: noteId.String(),
+				FromId: fromId.String(),
+				ToId:   toId.String(),
+				UserId: userId.String(),
+			},
+			wantErr: true,
+			mocker: func(req *gen.GetRevisionDiffRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().GetRevisionDiff(gomock.Any(), noteId, fromId, toId, userId).Return(models.RevisionDiff{}, errors.New("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(tt.requestBody, mockUsecase)
+
+			got, err := h.GetRevisionDiff(ctx, tt.requestBody)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GrpcNoteHandler.GetRevisionDiff() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				assert.Equal(t, 1, len(got.Blocks))
+				assert.Equal(t, models.BlockAdded, got.Blocks[0].Type)
+			}
+		})
+	}
+}
+
+func TestGrpcNoteHandler_RestoreRevision(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	revisionId := uuid.NewV4()
+	tests := []struct {
+		name        string
+		requestBody *gen.RevisionRequest
+		mocker      func(req *gen.RevisionRequest, mock *mock_note.MockNoteUsecase)
+		wantErr     bool
+	}{
+		{
+			name: "Test_RestoreRevision_Success",
+			requestBody: &gen.RevisionRequest{
+				NoteId:     noteId.String(),
+				RevisionId: revisionId.String(),
+				UserId:     userId.String(),
+			},
+			wantErr: false,
+			mocker: func(req *gen.RevisionRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().RestoreRevision(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.RevisionId), uuid.FromStringOrNil(req.UserId)).Return(models.Note{}, nil)
+			},
+		},
+		{
+			name: "Test_RestoreRevision_Fail",
+			requestBody: &gen.RevisionRequest{
+				NoteId:     noteId.String(),
+				RevisionId: revisionId.String(),
+				UserId:     userId.String(),
+			},
+			wantErr: true,
+			mocker: func(req *gen.RevisionRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().RestoreRevision(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.RevisionId), uuid.FromStringOrNil(req.UserId)).Return(models.Note{}, errors.New("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(tt.requestBody, mockUsecase)
+
+			_, err := h.RestoreRevision(ctx, tt.requestBody)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GrpcNoteHandler.RestoreRevision() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestGrpcNoteHandler_GetTrash(t *testing.T) {
+	userId := uuid.NewV4()
+	tests := []struct {
+		name        string
+		requestBody *gen.GetTrashRequest
+		mocker      func(req *gen.GetTrashRequest, mock *mock_note.MockNoteUsecase)
+		wantErr     bool
+	}{
+		{
+			name: "Test_GetTrash_Success",
+			requestBody: &gen.GetTrashRequest{
+				UserId: userId.String(),
+				Count:  10,
+				Offset: 0,
+			},
+			wantErr: false,
+			mocker: func(req *gen.GetTrashRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().GetTrash(gomock.Any(), userId, req.Count, req.Offset).Return([]models.TrashedNote{{Note: models.Note{OwnerId: userId}}}, nil)
+			},
+		},
+		{
+			name: "Test_GetTrash_Fail",
+			requestBody: &gen.GetTrashRequest{
+				UserId: userId.String(),
+				Count:  10,
+				Offset: 0,
+			},
+			wantErr: true,
+			mocker: func(req *gen.GetTrashRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().GetTrash(gomock.Any(), userId, req.Count, req.Offset).Return(nil, errors.New("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(tt.requestBody, mockUsecase)
+
+			got, err := h.GetTrash(ctx, tt.requestBody)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GrpcNoteHandler.GetTrash() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				assert.Equal(t, 1, len(got.Notes))
+				assert.Equal(t, userId.String(), got.Notes[0].Note.OwnerId)
+			}
+		})
+	}
+}
+
+func TestGrpcNoteHandler_RestoreNote(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	tests := []struct {
+		name        string
+		requestBody *gen.TrashNoteRequest
+		mocker      func(req *gen.TrashNoteRequest, mock *mock_note.MockNoteUsecase)
+		wantErr     bool
+	}{
+		{
+			name: "Test_RestoreNote_Success",
+			requestBody: &gen.TrashNoteRequest{
+				NoteId: noteId.String(),
+				UserId: userId.String(),
+			},
+			wantErr: false,
+			mocker: func(req *gen.TrashNoteRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().RestoreNote(gomock.Any(), noteId, userId).Return(models.Note{Id: noteId}, nil)
+			},
+		},
+		{
+			name: "Test_RestoreNote_Fail",
+			requestBody: &gen.TrashNoteRequest{
+				NoteId: noteId.String(),
+				UserId: userId.String(),
+			},
+			wantErr: true,
+			mocker: func(req *gen.TrashNoteRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().RestoreNote(gomock.Any(), noteId, userId).Return(models.Note{}, errors.New("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(tt.requestBody, mockUsecase)
+
+			_, err := h.RestoreNote(ctx, tt.requestBody)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GrpcNoteHandler.RestoreNote() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestGrpcNoteHandler_DeleteNotePermanently(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	tests := []struct {
+		name        string
+		requestBody *gen.TrashNoteRequest
+		mocker      func(req *gen.TrashNoteRequest, mock *mock_note.MockNoteUsecase)
+		wantErr     bool
+	}{
+		{
+			name: "Test_DeleteNotePermanently_Success",
+			requestBody: &gen.TrashNoteRequest{
+				NoteId: noteId.String(),
+				UserId: userId.String(),
+			},
+			wantErr: false,
+			mocker: func(req *gen.TrashNoteRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().DeleteNotePermanently(gomock.Any(), noteId, userId).Return(nil)
+			},
+		},
+		{
+			name: "Test_DeleteNotePermanently_Fail",
+			requestBody: &gen.TrashNoteRequest{
+				NoteId: noteId.String(),
+				UserId: userId.String(),
+			},
+			wantErr: true,
+			mocker: func(req *gen.TrashNoteRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().DeleteNotePermanently(gomock.Any(), noteId, userId).Return(errors.New("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(tt.requestBody, mockUsecase)
+
+			_, err := h.DeleteNotePermanently(ctx, tt.requestBody)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GrpcNoteHandler.DeleteNotePermanently() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestGrpcNoteHandler_SetTemplate(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	tests := []struct {
+		name        string
+		requestBody *gen.SetTemplateRequest
+		mocker      func(req *gen.SetTemplateRequest, mock *mock_note.MockNoteUsecase)
+		wantErr     bool
+	}{
+		{
+			name: "Test_SetTemplate_Success",
+			requestBody: &gen.SetTemplateRequest{
+				NoteId:   noteId.String(),
+				UserId:   userId.String(),
+				Template: true,
+			},
+			wantErr: false,
+			mocker: func(req *gen.SetTemplateRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().SetTemplate(gomock.Any(), noteId, userId, true).Return(models.Note{}, nil)
+			},
+		},
+		{
+			name: "Test_SetTemplate_Fail",
+			requestBody: &gen.SetTemplateRequest{
+				NoteId: noteId.String(),
+				UserId: userId.String(),
+			},
+			wantErr: true,
+			mocker: func(req *gen.SetTemplateRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().SetTemplate(gomock.Any(), noteId, userId, false).Return(models.Note{}, errors.New("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(tt.requestBody, mockUsecase)
+
+			_, err := h.SetTemplate(ctx, tt.requestBody)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GrpcNoteHandler.SetTemplate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestGrpcNoteHandler_GetTemplates(t *testing.T) {
+	userId := uuid.NewV4()
+	templateId := uuid.NewV4()
+	tests := []struct {
+		name        string
+		requestBody *gen.GetTemplatesRequest
+		mocker      func(req *gen.GetTemplatesRequest, mock *mock_note.MockNoteUsecase)
+		want        *gen.GetTemplatesResponse
+		wantErr     bool
+	}{
+		{
+			name: "Test_GetTemplates_Success",
+			requestBody: &gen.GetTemplatesRequest{
+				UserId: userId.String(),
+			},
+			wantErr: false,
+			mocker: func(req *gen.GetTemplatesRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().GetTemplates(gomock.Any(), userId).Return([]models.Template{{Id: templateId, Data: "{}", System: true}}, nil)
+			},
+			want: &gen.GetTemplatesResponse{
+				Templates: []*gen.TemplateModel{{Id: templateId.String(), Data: "{}", System: true}},
+			},
+		},
+		{
+			name: "Test_GetTemplates_Fail",
+			requestBody: &gen.GetTemplatesRequest{
+				UserId: userId.String(),
+			},
+			wantErr: true,
+			mocker: func(req *gen.GetTemplatesRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().GetTemplates(gomock.Any(), userId).Return(nil, errors.New("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(tt.requestBody, mockUsecase)
+
+			got, err := h.GetTemplates(ctx, tt.requestBody)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GrpcNoteHandler.GetTemplates() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestGrpcNoteHandler_CreateFromTemplate(t *testing.T) {
+	userId := uuid.NewV4()
+	templateId := uuid.NewV4()
+	parentId := uuid.NewV4()
+	tests := []struct {
+		name        string
+		requestBody *gen.CreateFromTemplateRequest
+		mocker      func(req *gen.CreateFromTemplateRequest, mock *mock_note.MockNoteUsecase)
+		wantErr     bool
+	}{
+		{
+			name: "Test_CreateFromTemplate_Success",
+			requestBody: &gen.CreateFromTemplateRequest{
+				TemplateId: templateId.String(),
+				ParentId:   parentId.String(),
+				UserId:     userId.String(),
+				Username:   "username",
+			},
+			wantErr: false,
+			mocker: func(req *gen.CreateFromTemplateRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().CreateFromTemplate(gomock.Any(), templateId, parentId, userId, "username").Return(models.Note{}, nil)
+			},
+		},
+		{
+			name: "Test_CreateFromTemplate_Fail",
+			requestBody: &gen.CreateFromTemplateRequest{
+				TemplateId: templateId.String(),
+				UserId:     userId.String(),
+				Username:   "username",
+			},
+			wantErr: true,
+			mocker: func(req *gen.CreateFromTemplateRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().CreateFromTemplate(gomock.Any(), templateId, uuid.UUID{}, userId, "username").Return(models.Note{}, errors.New("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(tt.requestBody, mockUsecase)
+
+			_, err := h.CreateFromTemplate(ctx, tt.requestBody)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GrpcNoteHandler.CreateFromTemplate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestGrpcNoteHandler_AddComment(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	tests := []struct {
+		name        string
+		requestBody *gen.AddCommentRequest
+		mocker      func(req *gen.AddCommentRequest, mock *mock_note.MockNoteUsecase)
+		wantErr     bool
+	}{
+		{
+			name: "Test_AddComment_Success",
+			requestBody: &gen.AddCommentRequest{
+				NoteId:  noteId.String(),
+				UserId:  userId.String(),
+				BlockId: "block",
+				Text:    "text",
+			},
+			wantErr: false,
+			mocker: func(req *gen.AddCommentRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().AddComment(gomock.Any(), noteId, userId, uuid.Nil, "block", "text").Return(models.Comment{
+					Id:       uuid.NewV4(),
+					NoteId:   noteId,
+					AuthorId: userId,
+					Text:     "text",
+				}, nil)
+			},
+		},
+		{
+			name: "Test_AddComment_Fail",
+			requestBody: &gen.AddCommentRequest{
+				NoteId: noteId.String(),
+				UserId: userId.String(),
+				Text:   "text",
+			},
+			wantErr: true,
+			mocker: func(req *gen.AddCommentRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().AddComment(gomock.Any(), noteId, userId, uuid.Nil, "", "text").Return(models.Comment{}, errors.New("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(tt.requestBody, mockUsecase)
+
+			_, err := h.AddComment(ctx, tt.requestBody)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GrpcNoteHandler.AddComment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestGrpcNoteHandler_SetCommentResolved(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	commentId := uuid.NewV4()
+	tests := []struct {
+		name        string
+		requestBody *gen.SetCommentResolvedRequest
+		mocker      func(req *gen.SetCommentResolvedRequest, mock *mock_note.MockNoteUsecase)
+		wantErr     bool
+	}{
+		{
+			name: "Test_SetCommentResolved_Success",
+			requestBody: &gen.SetCommentResolvedRequest{
+				NoteId:    noteId.String(),
+				CommentId: commentId.String(),
+				UserId:    userId.String(),
+				Resolved:  true,
+			},
+			wantErr: false,
+			mocker: func(req *gen.SetCommentResolvedRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().SetCommentResolved(gomock.Any(), noteId, commentId, userId, true).Return(models.Comment{Id: commentId, NoteId: noteId, Resolved: true}, nil)
+			},
+		},
+		{
+			name: "Test_SetCommentResolved_Fail",
+			requestBody: &gen.SetCommentResolvedRequest{
+				NoteId:    noteId.String(),
+				CommentId: commentId.String(),
+				UserId:    userId.String(),
+			},
+			wantErr: true,
+			mocker: func(req *gen.SetCommentResolvedRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().SetCommentResolved(gomock.Any(), noteId, commentId, userId, false).Return(models.Comment{}, errors.New("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(tt.requestBody, mockUsecase)
+
+			_, err := h.SetCommentResolved(ctx, tt.requestBody)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GrpcNoteHandler.SetCommentResolved() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestGrpcNoteHandler_AddReminder(t *testing.T) {
+	userId := uuid.NewV4()
 	noteId := uuid.NewV4()
+	remindAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
 	tests := []struct {
 		name        string
-		requestBody *gen.SetHeaderRequest
-		mocker      func(req *gen.SetHeaderRequest, mock *mock_note.MockNoteUsecase)
+		requestBody *gen.AddReminderRequest
+		mocker      func(req *gen.AddReminderRequest, mock *mock_note.MockNoteUsecase)
 		wantErr     bool
 	}{
 		{
-			name: "Test_SetHeader_Success",
-			requestBody: &gen.SetHeaderRequest{
-				Header: "header",
-				NoteId: noteId.String(),
-				UserId: userId.String(),
+			name: "Test_AddReminder_Success",
+			requestBody: &gen.AddReminderRequest{
+				NoteId:   noteId.String(),
+				UserId:   userId.String(),
+				RemindAt: remindAt.String(),
+				Repeat:   models.RepeatWeekly,
 			},
-
 			wantErr: false,
-			mocker: func(req *gen.SetHeaderRequest, mock *mock_note.MockNoteUsecase) {
-				mock.EXPECT().SetHeader(gomock.Any(), uuid.FromStringOrNil(req.NoteId), req.Header, uuid.FromStringOrNil(req.UserId)).Return(models.Note{}, nil)
+			mocker: func(req *gen.AddReminderRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().AddReminder(gomock.Any(), noteId, userId, gomock.Any(), models.RepeatWeekly).Return(models.Reminder{
+					Id:       uuid.NewV4(),
+					NoteId:   noteId,
+					UserId:   userId,
+					RemindAt: remindAt,
+					Repeat:   models.RepeatWeekly,
+				}, nil)
 			},
 		},
 		{
-			name: "Test_SetHeader_Fail",
-			requestBody: &gen.SetHeaderRequest{
-				Header: "header",
-				NoteId: noteId.String(),
-				UserId: userId.String(),
+			name: "Test_AddReminder_BadTime",
+			requestBody: &gen.AddReminderRequest{
+				NoteId:   noteId.String(),
+				UserId:   userId.String(),
+				RemindAt: "tomorrow",
 			},
 			wantErr: true,
-			mocker: func(req *gen.SetHeaderRequest, mock *mock_note.MockNoteUsecase) {
-				mock.EXPECT().SetHeader(gomock.Any(), uuid.FromStringOrNil(req.NoteId), req.Header, uuid.FromStringOrNil(req.UserId)).Return(models.Note{}, errors.New("error"))
+			mocker: func(req *gen.AddReminderRequest, mock *mock_note.MockNoteUsecase) {
+			},
+		},
+		{
+			name: "Test_AddReminder_Fail",
+			requestBody: &gen.AddReminderRequest{
+				NoteId:   noteId.String(),
+				UserId:   userId.String(),
+				RemindAt: remindAt.String(),
+			},
+			wantErr: true,
+			mocker: func(req *gen.AddReminderRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().AddReminder(gomock.Any(), noteId, userId, gomock.Any(), "").Return(models.Reminder{}, errors.New("error"))
 			},
 		},
 	}
@@ -1112,45 +2968,44 @@ func TestGrpcNoteHandler_SetHeader(t *testing.T) {
 			h := NewGrpcNoteHandler(mockUsecase)
 			tt.mocker(tt.requestBody, mockUsecase)
 
-			_, err := h.SetHeader(ctx, tt.requestBody)
+			_, err := h.AddReminder(ctx, tt.requestBody)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("GrpcNoteHandler.SetHeader() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("GrpcNoteHandler.AddReminder() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 		})
 	}
 }
 
-func TestGrpcNoteHandler_AddFav(t *testing.T) {
+func TestGrpcNoteHandler_AcknowledgeReminder(t *testing.T) {
 	userId := uuid.NewV4()
-	noteId := uuid.NewV4()
+	reminderId := uuid.NewV4()
 	tests := []struct {
 		name        string
-		requestBody *gen.ChangeFlagRequest
-		mocker      func(req *gen.ChangeFlagRequest, mock *mock_note.MockNoteUsecase)
+		requestBody *gen.ReminderRequest
+		mocker      func(req *gen.ReminderRequest, mock *mock_note.MockNoteUsecase)
 		wantErr     bool
 	}{
 		{
-			name: "Test_AddFav_Success",
-			requestBody: &gen.ChangeFlagRequest{
-				NoteId: noteId.String(),
-				UserId: userId.String(),
+			name: "Test_AcknowledgeReminder_Success",
+			requestBody: &gen.ReminderRequest{
+				ReminderId: reminderId.String(),
+				UserId:     userId.String(),
 			},
-
 			wantErr: false,
-			mocker: func(req *gen.ChangeFlagRequest, mock *mock_note.MockNoteUsecase) {
-				mock.EXPECT().AddFav(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId)).Return(models.Note{}, nil)
+			mocker: func(req *gen.ReminderRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().AcknowledgeReminder(gomock.Any(), reminderId, userId).Return(nil)
 			},
 		},
 		{
-			name: "Test_AddFav_Fail",
-			requestBody: &gen.ChangeFlagRequest{
-				NoteId: noteId.String(),
-				UserId: userId.String(),
+			name: "Test_AcknowledgeReminder_Fail",
+			requestBody: &gen.ReminderRequest{
+				ReminderId: reminderId.String(),
+				UserId:     userId.String(),
 			},
 			wantErr: true,
-			mocker: func(req *gen.ChangeFlagRequest, mock *mock_note.MockNoteUsecase) {
-				mock.EXPECT().AddFav(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId)).Return(models.Note{}, errors.New("error"))
+			mocker: func(req *gen.ReminderRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().AcknowledgeReminder(gomock.Any(), reminderId, userId).Return(errors.New("error"))
 			},
 		},
 	}
@@ -1164,16 +3019,16 @@ func TestGrpcNoteHandler_AddFav(t *testing.T) {
 			h := NewGrpcNoteHandler(mockUsecase)
 			tt.mocker(tt.requestBody, mockUsecase)
 
-			_, err := h.AddFav(ctx, tt.requestBody)
+			_, err := h.AcknowledgeReminder(ctx, tt.requestBody)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("GrpcNoteHandler.AddFav() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("GrpcNoteHandler.AcknowledgeReminder() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 		})
 	}
 }
 
-func TestGrpcNoteHandler_DelFav(t *testing.T) {
+func TestGrpcNoteHandler_ArchiveNote(t *testing.T) {
 	userId := uuid.NewV4()
 	noteId := uuid.NewV4()
 	tests := []struct {
@@ -1183,26 +3038,25 @@ func TestGrpcNoteHandler_DelFav(t *testing.T) {
 		wantErr     bool
 	}{
 		{
-			name: "Test_DelFav_Success",
+			name: "Test_ArchiveNote_Success",
 			requestBody: &gen.ChangeFlagRequest{
 				NoteId: noteId.String(),
 				UserId: userId.String(),
 			},
-
 			wantErr: false,
 			mocker: func(req *gen.ChangeFlagRequest, mock *mock_note.MockNoteUsecase) {
-				mock.EXPECT().DelFav(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId)).Return(models.Note{}, nil)
+				mock.EXPECT().ArchiveNote(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId)).Return(models.Note{Archived: true}, nil)
 			},
 		},
 		{
-			name: "Test_DelFav_Fail",
+			name: "Test_ArchiveNote_Fail",
 			requestBody: &gen.ChangeFlagRequest{
 				NoteId: noteId.String(),
 				UserId: userId.String(),
 			},
 			wantErr: true,
 			mocker: func(req *gen.ChangeFlagRequest, mock *mock_note.MockNoteUsecase) {
-				mock.EXPECT().DelFav(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId)).Return(models.Note{}, errors.New("error"))
+				mock.EXPECT().ArchiveNote(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId)).Return(models.Note{}, errors.New("error"))
 			},
 		},
 	}
@@ -1216,45 +3070,50 @@ func TestGrpcNoteHandler_DelFav(t *testing.T) {
 			h := NewGrpcNoteHandler(mockUsecase)
 			tt.mocker(tt.requestBody, mockUsecase)
 
-			_, err := h.DelFav(ctx, tt.requestBody)
+			got, err := h.ArchiveNote(ctx, tt.requestBody)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("GrpcNoteHandler.DelFav() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("GrpcNoteHandler.ArchiveNote() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			if !tt.wantErr {
+				assert.True(t, got.Note.Archived)
+			}
 		})
 	}
 }
 
-func TestGrpcNoteHandler_SetPublic(t *testing.T) {
+func TestGrpcNoteHandler_ReorderNotes(t *testing.T) {
 	userId := uuid.NewV4()
-	noteId := uuid.NewV4()
+	first := uuid.NewV4()
+	second := uuid.NewV4()
 	tests := []struct {
 		name        string
-		requestBody *gen.AccessModeRequest
-		mocker      func(req *gen.AccessModeRequest, mock *mock_note.MockNoteUsecase)
+		requestBody *gen.ReorderNotesRequest
+		mocker      func(req *gen.ReorderNotesRequest, mock *mock_note.MockNoteUsecase)
 		wantErr     bool
 	}{
 		{
-			name: "Test_SetPublic_Success",
-			requestBody: &gen.AccessModeRequest{
-				NoteId: noteId.String(),
-				UserId: userId.String(),
+			name: "Test_ReorderNotes_Success",
+			requestBody: &gen.ReorderNotesRequest{
+				UserId:   userId.String(),
+				ParentId: uuid.UUID{}.String(),
+				Notes:    []string{second.String(), first.String()},
 			},
-
 			wantErr: false,
-			mocker: func(req *gen.AccessModeRequest, mock *mock_note.MockNoteUsecase) {
-				mock.EXPECT().SetPublic(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId)).Return(models.Note{}, nil)
+			mocker: func(req *gen.ReorderNotesRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().ReorderNotes(gomock.Any(), userId, uuid.UUID{}, []uuid.UUID{second, first}).Return(nil)
 			},
 		},
 		{
-			name: "Test_SetPublic_Fail",
-			requestBody: &gen.AccessModeRequest{
-				NoteId: noteId.String(),
-				UserId: userId.String(),
+			name: "Test_ReorderNotes_Fail",
+			requestBody: &gen.ReorderNotesRequest{
+				UserId:   userId.String(),
+				ParentId: uuid.UUID{}.String(),
+				Notes:    []string{first.String()},
 			},
 			wantErr: true,
-			mocker: func(req *gen.AccessModeRequest, mock *mock_note.MockNoteUsecase) {
-				mock.EXPECT().SetPublic(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId)).Return(models.Note{}, errors.New("error"))
+			mocker: func(req *gen.ReorderNotesRequest, mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().ReorderNotes(gomock.Any(), userId, uuid.UUID{}, []uuid.UUID{first}).Return(errors.New("error"))
 			},
 		},
 	}
@@ -1268,46 +3127,37 @@ func TestGrpcNoteHandler_SetPublic(t *testing.T) {
 			h := NewGrpcNoteHandler(mockUsecase)
 			tt.mocker(tt.requestBody, mockUsecase)
 
-			_, err := h.SetPublic(ctx, tt.requestBody)
+			_, err := h.ReorderNotes(ctx, tt.requestBody)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("GrpcNoteHandler.SetPublic() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("GrpcNoteHandler.ReorderNotes() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 		})
 	}
 }
 
-func TestGrpcNoteHandler_SetPrivate(t *testing.T) {
+func TestGrpcNoteHandler_GetAllNotesCursor(t *testing.T) {
 	userId := uuid.NewV4()
-	noteId := uuid.NewV4()
+
 	tests := []struct {
-		name        string
-		requestBody *gen.AccessModeRequest
-		mocker      func(req *gen.AccessModeRequest, mock *mock_note.MockNoteUsecase)
-		wantErr     bool
+		name       string
+		mocker     func(mock *mock_note.MockNoteUsecase)
+		wantCursor string
+		wantErr    bool
 	}{
 		{
-			name: "Test_SetPrivate_Success",
-			requestBody: &gen.AccessModeRequest{
-				NoteId: noteId.String(),
-				UserId: userId.String(),
-			},
-
-			wantErr: false,
-			mocker: func(req *gen.AccessModeRequest, mock *mock_note.MockNoteUsecase) {
-				mock.EXPECT().SetPrivate(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId)).Return(models.Note{}, nil)
+			name: "Test_GetAllNotesCursor_Success",
+			mocker: func(mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().GetAllNotes(gomock.Any(), userId, int64(10), int64(0), "", gomock.Any(), models.ArchiveFilterActive, models.SortUpdated, "cursor").Return([]models.NoteResponse{}, "next", nil)
 			},
+			wantCursor: "next",
 		},
 		{
-			name: "Test_SetPrivate_Fail",
-			requestBody: &gen.AccessModeRequest{
-				NoteId: noteId.String(),
-				UserId: userId.String(),
+			name: "Test_GetAllNotesCursor_Invalid",
+			mocker: func(mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().GetAllNotes(gomock.Any(), userId, int64(10), int64(0), "", gomock.Any(), models.ArchiveFilterActive, models.SortUpdated, "cursor").Return(nil, "", errors.New(note.ErrInvalidCursor))
 			},
 			wantErr: true,
-			mocker: func(req *gen.AccessModeRequest, mock *mock_note.MockNoteUsecase) {
-				mock.EXPECT().SetPrivate(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId)).Return(models.Note{}, errors.New("error"))
-			},
 		},
 	}
 	for _, tt := range tests {
@@ -1315,66 +3165,122 @@ func TestGrpcNoteHandler_SetPrivate(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
 			defer ctrl.Finish()
-			ctx := context.Background()
 
 			h := NewGrpcNoteHandler(mockUsecase)
-			tt.mocker(tt.requestBody, mockUsecase)
+			tt.mocker(mockUsecase)
 
-			_, err := h.SetPrivate(ctx, tt.requestBody)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("GrpcNoteHandler.SetPrivate() error = %v, wantErr %v", err, tt.wantErr)
+			got, err := h.GetAllNotes(context.Background(), &gen.GetAllRequest{
+				UserId:   userId.String(),
+				Count:    10,
+				Archived: models.ArchiveFilterActive,
+				Sort:     models.SortUpdated,
+				Cursor:   "cursor",
+			})
+			if tt.wantErr {
+				assert.Equal(t, note.ErrInvalidCursor, err.Error())
 				return
 			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.wantCursor, got.NextCursor)
 		})
 	}
 }
 
-func TestGrpcNoteHandler_GetAttachList(t *testing.T) {
-	noteId := uuid.NewV4()
+func TestGrpcNoteHandler_GetAllNotesTagFilter(t *testing.T) {
 	userId := uuid.NewV4()
 
-	type args struct {
-		ctx context.Context
-		in  *generatedNote.GetAttachListRequest
-	}
 	tests := []struct {
 		name    string
-		args    args
-		want    *generatedNote.GetAttachListResponse
-		mocker  func(req *gen.GetAttachListRequest, mock *mock_note.MockNoteUsecase)
+		mode    string
+		mocker  func(mock *mock_note.MockNoteUsecase)
 		wantErr bool
 	}{
 		{
-			name: "Test_GetAttachList_Success",
-			args: args{
-				ctx: context.Background(),
-				in: &generatedNote.GetAttachListRequest{
-					NoteId: noteId.String(),
-					UserId: userId.String(),
-				},
+			name: "Test_GetAllNotesTagFilter_Success",
+			mode: models.TagModeNone,
+			mocker: func(mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().GetAllNotes(gomock.Any(), userId, int64(10), int64(0), "", models.TagFilter{Mode: models.TagModeNone, Included: []string{"first"}, Excluded: []string{"second"}}, models.ArchiveFilterActive, models.SortUpdated, "").Return([]models.NoteResponse{}, "", nil)
 			},
-			want: &generatedNote.GetAttachListResponse{
-				Paths: []string{"1", "2"},
+		},
+		{
+			name:    "Test_GetAllNotesTagFilter_InvalidMode",
+			mode:    "some",
+			mocker:  func(mock *mock_note.MockNoteUsecase) {},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(mockUsecase)
+
+			_, err := h.GetAllNotes(context.Background(), &gen.GetAllRequest{
+				UserId:   userId.String(),
+				Count:    10,
+				Tags:     []string{"first", "-second"},
+				TagMode:  tt.mode,
+				Archived: models.ArchiveFilterActive,
+				Sort:     models.SortUpdated,
+			})
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestGrpcNoteHandler_BulkUpdate(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	operations := []models.BulkOperation{{Type: models.BulkAddTag, Value: "work"}}
+
+	tests := []struct {
+		name    string
+		request *gen.BulkRequest
+		mocker  func(mock *mock_note.MockNoteUsecase)
+		want    *gen.BulkResponse
+		wantErr bool
+	}{
+		{
+			name: "Test_BulkUpdate_Success",
+			request: &gen.BulkRequest{
+				NoteIds:    []string{noteId.String()},
+				Operations: []*gen.BulkOperationModel{{Type: models.BulkAddTag, Value: "work"}},
+				UserId:     userId.String(),
+			},
+			mocker: func(mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().BulkUpdate(gomock.Any(), []uuid.UUID{noteId}, operations, userId).Return([]models.BulkResult{
+					{NoteID: noteId, Error: note.ErrTooManyTags},
+				}, nil)
 			},
-			wantErr: false,
-			mocker: func(req *generatedNote.GetAttachListRequest, mock *mock_note.MockNoteUsecase) {
-				mock.EXPECT().GetAttachList(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId)).Return([]string{"1", "2"}, nil)
+			want: &gen.BulkResponse{
+				Results: []*gen.BulkResultModel{{NoteId: noteId.String(), Error: note.ErrTooManyTags}},
 			},
 		},
 		{
-			name: "Test_GetAttachList_Fail",
-			args: args{
-				ctx: context.Background(),
-				in: &generatedNote.GetAttachListRequest{
-					NoteId: noteId.String(),
-					UserId: userId.String(),
-				},
+			name: "Test_BulkUpdate_InvalidOperation",
+			request: &gen.BulkRequest{
+				NoteIds:    []string{noteId.String()},
+				Operations: []*gen.BulkOperationModel{{Type: "rename"}},
+				UserId:     userId.String(),
 			},
-			want:    nil,
+			mocker:  func(mock *mock_note.MockNoteUsecase) {},
 			wantErr: true,
-			mocker: func(req *generatedNote.GetAttachListRequest, mock *mock_note.MockNoteUsecase) {
-				mock.EXPECT().GetAttachList(gomock.Any(), uuid.FromStringOrNil(req.NoteId), uuid.FromStringOrNil(req.UserId)).Return([]string{}, errors.New("err"))
+		},
+		{
+			name: "Test_BulkUpdate_Fail",
+			request: &gen.BulkRequest{
+				NoteIds:    []string{noteId.String()},
+				Operations: []*gen.BulkOperationModel{{Type: models.BulkAddTag, Value: "work"}},
+				UserId:     userId.String(),
 			},
+			mocker: func(mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().BulkUpdate(gomock.Any(), []uuid.UUID{noteId}, operations, userId).Return(nil, errors.New("error"))
+			},
+			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
@@ -1384,63 +3290,43 @@ func TestGrpcNoteHandler_GetAttachList(t *testing.T) {
 			defer ctrl.Finish()
 
 			h := NewGrpcNoteHandler(mockUsecase)
-			tt.mocker(tt.args.in, mockUsecase)
+			tt.mocker(mockUsecase)
 
-			got, err := h.GetAttachList(tt.args.ctx, tt.args.in)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("GrpcNoteHandler.GetAttachList() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
-			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("GrpcNoteHandler.GetAttachList() = %v, want %v", got, tt.want)
+			got, err := h.BulkUpdate(context.Background(), tt.request)
+			assert.Equal(t, tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Equal(t, tt.want, got)
 			}
 		})
 	}
 }
 
-func TestGrpcNoteHandler_GetSharedAttachList(t *testing.T) {
+func TestGrpcNoteHandler_GetBacklinks(t *testing.T) {
 	noteId := uuid.NewV4()
+	userId := uuid.NewV4()
+	sourceId := uuid.NewV4()
 
-	type args struct {
-		ctx context.Context
-		in  *generatedNote.GetSharedAttachListRequest
-	}
 	tests := []struct {
 		name    string
-		args    args
-		want    *generatedNote.GetAttachListResponse
-		mocker  func(req *gen.GetSharedAttachListRequest, mock *mock_note.MockNoteUsecase)
+		mocker  func(mock *mock_note.MockNoteUsecase)
+		want    *gen.GetBacklinksResponse
 		wantErr bool
 	}{
 		{
-			name: "Test_GetSharedAttachList_Success",
-			args: args{
-				ctx: context.Background(),
-				in: &generatedNote.GetSharedAttachListRequest{
-					NoteId: noteId.String(),
-				},
-			},
-			want: &generatedNote.GetAttachListResponse{
-				Paths: []string{"1", "2"},
+			name: "Test_GetBacklinks_Success",
+			mocker: func(mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().GetBacklinks(gomock.Any(), noteId, userId).Return([]models.NoteLink{{Id: sourceId, Title: "source"}}, nil)
 			},
-			wantErr: false,
-			mocker: func(req *generatedNote.GetSharedAttachListRequest, mock *mock_note.MockNoteUsecase) {
-				mock.EXPECT().GetSharedAttachList(gomock.Any(), uuid.FromStringOrNil(req.NoteId)).Return([]string{"1", "2"}, nil)
+			want: &gen.GetBacklinksResponse{
+				Notes: []*gen.NoteLinkModel{{Id: sourceId.String(), Title: "source"}},
 			},
 		},
 		{
-			name: "Test_GetSharedAttachList_Fail",
-			args: args{
-				ctx: context.Background(),
-				in: &generatedNote.GetSharedAttachListRequest{
-					NoteId: noteId.String(),
-				},
+			name: "Test_GetBacklinks_Fail",
+			mocker: func(mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().GetBacklinks(gomock.Any(), noteId, userId).Return(nil, errors.New("not found"))
 			},
-			want:    nil,
 			wantErr: true,
-			mocker: func(req *generatedNote.GetSharedAttachListRequest, mock *mock_note.MockNoteUsecase) {
-				mock.EXPECT().GetSharedAttachList(gomock.Any(), uuid.FromStringOrNil(req.NoteId)).Return([]string{}, errors.New("err"))
-			},
 		},
 	}
 	for _, tt := range tests {
@@ -1450,15 +3336,62 @@ func TestGrpcNoteHandler_GetSharedAttachList(t *testing.T) {
 			defer ctrl.Finish()
 
 			h := NewGrpcNoteHandler(mockUsecase)
-			tt.mocker(tt.args.in, mockUsecase)
+			tt.mocker(mockUsecase)
 
-			got, err := h.GetSharedAttachList(tt.args.ctx, tt.args.in)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("GrpcNoteHandler.GetSharedAttachList() error = %v, wantErr %v", err, tt.wantErr)
-				return
+			got, err := h.GetBacklinks(context.Background(), &gen.GetBacklinksRequest{NoteId: noteId.String(), UserId: userId.String()})
+			assert.Equal(t, tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Equal(t, tt.want, got)
 			}
-			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("GrpcNoteHandler.GetSharedAttachList() = %v, want %v", got, tt.want)
+		})
+	}
+}
+
+func TestGrpcNoteHandler_GetNoteGraph(t *testing.T) {
+	userId := uuid.NewV4()
+	first := uuid.NewV4()
+	second := uuid.NewV4()
+
+	tests := []struct {
+		name    string
+		mocker  func(mock *mock_note.MockNoteUsecase)
+		want    *gen.GetNoteGraphResponse
+		wantErr bool
+	}{
+		{
+			name: "Test_GetNoteGraph_Success",
+			mocker: func(mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().GetNoteGraph(gomock.Any(), userId).Return(models.NoteGraph{
+					Nodes: []models.NoteLink{{Id: first, Title: "first"}, {Id: second, Title: "second"}},
+					Edges: []models.NoteEdge{{Source: first, Target: second}},
+				}, nil)
+			},
+			want: &gen.GetNoteGraphResponse{
+				Nodes: []*gen.NoteLinkModel{{Id: first.String(), Title: "first"}, {Id: second.String(), Title: "second"}},
+				Edges: []*gen.NoteEdgeModel{{Source: first.String(), Target: second.String()}},
+			},
+		},
+		{
+			name: "Test_GetNoteGraph_Fail",
+			mocker: func(mock *mock_note.MockNoteUsecase) {
+				mock.EXPECT().GetNoteGraph(gomock.Any(), userId).Return(models.NoteGraph{}, errors.New("error"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
+			defer ctrl.Finish()
+
+			h := NewGrpcNoteHandler(mockUsecase)
+			tt.mocker(mockUsecase)
+
+			got, err := h.GetNoteGraph(context.Background(), &gen.GetNoteGraphRequest{UserId: userId.String()})
+			assert.Equal(t, tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Equal(t, tt.want, got)
 			}
 		})
 	}
@@ -8,6 +8,7 @@ import (
 	"log/slog"
 	"net/http"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -101,6 +102,8 @@ func getNote(note *gen.NoteModel) (models.Note, error) {
 		Header:        note.Header,
 		Favorite:      note.Favorite,
 		Public:        note.Public,
+		Archived:      note.Archived,
+		Version:       note.Version,
 	}, nil
 }
 
@@ -146,6 +149,8 @@ func getNoteResponse(note *gen.NoteResponseModel) (models.NoteResponse, error) {
 			Header:        note.Header,
 			Favorite:      note.Favorite,
 			Public:        note.Public,
+			Archived:      note.Archived,
+			Version:       note.Version,
 		},
 		OwnerInfo: models.OwnerInfo{
 			Username:  note.Username,
@@ -154,6 +159,226 @@ func getNoteResponse(note *gen.NoteResponseModel) (models.NoteResponse, error) {
 	}, nil
 }
 
+func getRevision(revision *gen.RevisionModel) (models.NoteRevision, error) {
+	if revision == nil {
+		return models.NoteRevision{}, errors.New("not found")
+	}
+	created, err := time.Parse(TimeLayout, revision.Created)
+	if err != nil {
+		return models.NoteRevision{}, err
+	}
+
+	return models.NoteRevision{
+		Id:       uuid.FromStringOrNil(revision.Id),
+		NoteId:   uuid.FromStringOrNil(revision.NoteId),
+		Created:  created,
+		AuthorId: uuid.FromStringOrNil(revision.AuthorId),
+		Username: revision.Username,
+		Data:     revision.Data,
+	}, nil
+}
+
+func getInvite(invite *gen.InviteModel) (models.Invite, error) {
+	if invite == nil {
+		return models.Invite{}, errors.New("not found")
+	}
+	created, err := time.Parse(TimeLayout, invite.Created)
+	if err != nil {
+		return models.Invite{}, err
+	}
+	expiresAt, err := time.Parse(TimeLayout, invite.ExpiresAt)
+	if err != nil {
+		return models.Invite{}, err
+	}
+
+	return models.Invite{
+		Id:        uuid.FromStringOrNil(invite.Id),
+		NoteId:    uuid.FromStringOrNil(invite.NoteId),
+		NoteTitle: invite.NoteTitle,
+		InviterId: uuid.FromStringOrNil(invite.InviterId),
+		Inviter:   invite.Inviter,
+		InviteeId: uuid.FromStringOrNil(invite.InviteeId),
+		Invitee:   invite.Invitee,
+		Role:      invite.Role,
+		Created:   created,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func getIds(ids []string) []uuid.UUID {
+	result := make([]uuid.UUID, len(ids))
+	for i, id := range ids {
+		result[i] = uuid.FromStringOrNil(id)
+	}
+	return result
+}
+
+func isForbidden(err error) bool {
+	return strings.TrimPrefix(err.Error(), RpcErrorPrefix) == note.ErrNotEnoughRights
+}
+
+func isVersionConflict(err error) bool {
+	return strings.TrimPrefix(err.Error(), RpcErrorPrefix) == note.ErrVersionConflict
+}
+
+// getExpectedVersion - версия из заголовка If-Match ("3", W/"3" или 3), без заголовка или с * проверка не выполняется
+func getExpectedVersion(r *http.Request) (int64, error) {
+	header := strings.TrimSpace(r.Header.Get("If-Match"))
+	if header == "" || header == "*" {
+		return 0, nil
+	}
+
+	version, err := strconv.ParseInt(strings.Trim(strings.TrimPrefix(header, "W/"), `"`), 10, 64)
+	if err != nil || version <= 0 {
+		return 0, errors.New("incorrect If-Match header")
+	}
+	return version, nil
+}
+
+func setETag(w http.ResponseWriter, version int64) {
+	if version > 0 {
+		w.Header().Set("ETag", strconv.Quote(strconv.FormatInt(version, 10)))
+	}
+}
+
+// writeVersionConflict - ответ 409 с актуальной версией заметки
+func (h *NoteHandler) writeVersionConflict(w http.ResponseWriter, r *http.Request, logger *slog.Logger, noteID string, userID string) {
+	log.LogHandlerError(logger, http.StatusConflict, note.ErrVersionConflict)
+
+	protoNote, err := h.client.GetNote(r.Context(), &gen.GetNoteRequest{
+		Id:     noteID,
+		UserId: userID,
+	})
+	if err != nil {
+		responses.WriteErrorMessage(w, http.StatusConflict, errors.New(note.ErrVersionConflict))
+		return
+	}
+
+	currentNote, err := getNoteResponse(protoNote.Note)
+	if err != nil {
+		responses.WriteErrorMessage(w, http.StatusConflict, errors.New(note.ErrVersionConflict))
+		return
+	}
+
+	setETag(w, currentNote.Version)
+	if err := responses.WriteResponseData(w, currentNote, http.StatusConflict); err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
+	}
+}
+
+func getShareLink(link *gen.ShareLinkModel) (models.ShareLink, error) {
+	if link == nil {
+		return models.ShareLink{}, errors.New("not found")
+	}
+	created, err := time.Parse(TimeLayout, link.Created)
+	if err != nil {
+		return models.ShareLink{}, err
+	}
+
+	result := models.ShareLink{
+		Id:             uuid.FromStringOrNil(link.Id),
+		Token:          link.Token,
+		NoteId:         uuid.FromStringOrNil(link.NoteId),
+		CreatorId:      uuid.FromStringOrNil(link.CreatorId),
+		HasPassword:    link.HasPassword,
+		IncludeSubtree: link.IncludeSubtree,
+		Created:        created,
+	}
+	if link.ExpiresAt != "" {
+		expiresAt, err := time.Parse(TimeLayout, link.ExpiresAt)
+		if err != nil {
+			return models.ShareLink{}, err
+		}
+		result.ExpiresAt = &expiresAt
+	}
+
+	return result, nil
+}
+
+func getShareLinkErrorStatus(err error) int {
+	switch strings.TrimPrefix(err.Error(), RpcErrorPrefix) {
+	case note.ErrShareLinkExpired:
+		return http.StatusGone
+	case note.ErrWrongPassword:
+		return http.StatusForbidden
+	default:
+		return http.StatusNotFound
+	}
+}
+
+func getComment(comment *gen.CommentModel) (models.Comment, error) {
+	if comment == nil {
+		return models.Comment{}, errors.New("not found")
+	}
+	created, err := time.Parse(TimeLayout, comment.Created)
+	if err != nil {
+		return models.Comment{}, err
+	}
+	updated, err := time.Parse(TimeLayout, comment.Updated)
+	if err != nil {
+		return models.Comment{}, err
+	}
+
+	return models.Comment{
+		Id:       uuid.FromStringOrNil(comment.Id),
+		NoteId:   uuid.FromStringOrNil(comment.NoteId),
+		Parent:   uuid.FromStringOrNil(comment.Parent),
+		AuthorId: uuid.FromStringOrNil(comment.AuthorId),
+		Author:   comment.Author,
+		BlockId:  comment.BlockId,
+		Text:     comment.Text,
+		Created:  created,
+		Updated:  updated,
+		Resolved: comment.Resolved,
+		Deleted:  comment.Deleted,
+	}, nil
+}
+
+func getNoteLink(link *gen.NoteLinkModel) models.NoteLink {
+	return models.NoteLink{
+		Id:    uuid.FromStringOrNil(link.Id),
+		Title: link.Title,
+		Icon:  link.Icon,
+	}
+}
+
+func getReminder(reminder *gen.ReminderModel) (models.Reminder, error) {
+	if reminder == nil {
+		return models.Reminder{}, errors.New("not found")
+	}
+	remindAt, err := time.Parse(TimeLayout, reminder.RemindAt)
+	if err != nil {
+		return models.Reminder{}, err
+	}
+	created, err := time.Parse(TimeLayout, reminder.Created)
+	if err != nil {
+		return models.Reminder{}, err
+	}
+
+	return models.Reminder{
+		Id:        uuid.FromStringOrNil(reminder.Id),
+		NoteId:    uuid.FromStringOrNil(reminder.NoteId),
+		NoteTitle: reminder.NoteTitle,
+		UserId:    uuid.FromStringOrNil(reminder.UserId),
+		RemindAt:  remindAt,
+		Repeat:    reminder.Repeat,
+		Fired:     reminder.Fired,
+		Created:   created,
+	}, nil
+}
+
+func getReminders(reminders []*gen.ReminderModel) ([]models.Reminder, error) {
+	result := make([]models.Reminder, len(reminders))
+	for i, item := range reminders {
+		reminder, err := getReminder(item)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = reminder
+	}
+	return result, nil
+}
+
 // GetAllNotes godoc
 // @Summary		Get all notes
 // @Description	Get a list of notes of current user
@@ -163,7 +388,12 @@ func getNoteResponse(note *gen.NoteResponseModel) (models.NoteResponse, error) {
 // @Param		count	query		int							false	"notes count"
 // @Param		offset	query		int							false	"notes offset"
 // @Param		title	query		string						false	"notes title substring"
-// @Success		200		{object}	[]models.NoteForSwagger		true	"notes"
+// @Param		archived	query	string						false	"active (default), archived or all"
+// @Param		sort	query		string						false	"manual, updated (default), created, title or owner"
+// @Param		cursor	query		string						false	"next_cursor from the previous page"
+// @Param		tags	query		string						false	"tags separated by |, -tag excludes a tag"
+// @Param		tag_mode	query	string						false	"all (default), any or none"
+// @Success		200		{object}	models.NotesPage			true	"notes"
 // @Failure		400		{object}	responses.ErrorResponse		true	"error"
 // @Failure		401
 // @Router		/api/note/get_all [get]
@@ -180,10 +410,37 @@ func (h *NoteHandler) GetAllNotes(w http.ResponseWriter, r *http.Request) {
 	titleSubstr := r.URL.Query().Get("title")
 	tagsString := r.URL.Query().Get("tags")
 
+	archived := r.URL.Query().Get("archived")
+	if archived == "" {
+		archived = models.ArchiveFilterActive
+	}
+	if archived != models.ArchiveFilterActive && archived != models.ArchiveFilterArchived && archived != models.ArchiveFilterAll {
+		log.LogHandlerError(logger, http.StatusBadRequest, "invalid archived filter: "+archived)
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("invalid parameters"))
+		return
+	}
+
+	sort := r.URL.Query().Get("sort")
+	if sort == "" {
+		sort = models.SortUpdated
+	}
+	if !slices.Contains([]string{models.SortManual, models.SortUpdated, models.SortCreated, models.SortTitle, models.SortOwner}, sort) {
+		log.LogHandlerError(logger, http.StatusBadRequest, "invalid sort: "+sort)
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("invalid parameters"))
+		return
+	}
+
 	tagsArray := slices.DeleteFunc(strings.Split(tagsString, "|"), func(e string) bool {
 		return e == ""
 	})
 
+	tagMode := r.URL.Query().Get("tag_mode")
+	if _, err := models.ParseTagFilter(tagsArray, tagMode); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error()+": "+tagMode)
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("invalid parameters"))
+		return
+	}
+
 	payload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
 	if !ok {
 		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
@@ -192,11 +449,15 @@ func (h *NoteHandler) GetAllNotes(w http.ResponseWriter, r *http.Request) {
 	}
 
 	protoData, err := h.client.GetAllNotes(r.Context(), &gen.GetAllRequest{
-		Count:  int64(count),
-		Offset: int64(offset),
-		Title:  titleSubstr,
-		UserId: payload.Id.String(),
-		Tags:   tagsArray,
+		Count:    int64(count),
+		Offset:   int64(offset),
+		Title:    titleSubstr,
+		UserId:   payload.Id.String(),
+		Tags:     tagsArray,
+		Archived: archived,
+		Sort:     sort,
+		Cursor:   r.URL.Query().Get("cursor"),
+		TagMode:  tagMode,
 	})
 	if err != nil {
 		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
@@ -213,7 +474,11 @@ func (h *NoteHandler) GetAllNotes(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 	}
-	if err := responses.WriteResponseData(w, data, http.StatusOK); err != nil {
+	page := models.NotesPage{
+		Notes:      data,
+		NextCursor: protoData.NextCursor,
+	}
+	if err := responses.WriteResponseData(w, page, http.StatusOK); err != nil {
 		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
 		w.WriteHeader(http.StatusInternalServerError)
 		return
@@ -229,6 +494,7 @@ func (h *NoteHandler) GetAllNotes(w http.ResponseWriter, r *http.Request) {
 // @ID			get-note
 // @Param		id		path		string					true	"note id"
 // @Success		200		{object}	models.NoteForSwagger	true	"note"
+// @Header		200		{string}	ETag					"note version"
 // @Failure		400		{object}	responses.ErrorResponse	true	"incorrect id"
 // @Failure		401
 // @Failure		404		{object}	responses.ErrorResponse	true	"note not found"
@@ -268,6 +534,7 @@ func (h *NoteHandler) GetNote(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	setETag(w, resultNote.Version)
 	if err := responses.WriteResponseData(w, resultNote, http.StatusOK); err != nil {
 		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
 		w.WriteHeader(http.StatusInternalServerError)
@@ -289,11 +556,14 @@ func (h *NoteHandler) GetPublicNote(w http.ResponseWriter, r *http.Request) {
 	}
 
 	protoNote, err := h.client.GetPublicNote(r.Context(), &gen.GetPublicNoteRequest{
-		NoteId: noteIdString,
+		NoteId:   noteIdString,
+		Token:    r.URL.Query().Get("token"),
+		Password: r.Header.Get("X-Share-Password"),
 	})
 	if err != nil {
-		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
-		responses.WriteErrorMessage(w, http.StatusNotFound, err)
+		status := getShareLinkErrorStatus(err)
+		log.LogHandlerError(logger, status, err.Error())
+		responses.WriteErrorMessage(w, status, errors.New(strings.TrimPrefix(err.Error(), RpcErrorPrefix)))
 		return
 	}
 
@@ -383,9 +653,11 @@ func (h *NoteHandler) AddNote(w http.ResponseWriter, r *http.Request) {
 // @Produce		json
 // @Param		id			path		string								true	"note id"
 // @Param    	payload		body    	models.UpsertNoteRequestForSwagger	true  	"note data"
+// @Param		If-Match	header		string								false	"expected note version"
 // @Success		200			{object}	models.NoteForSwagger				true	"note"
 // @Failure		400			{object}	responses.ErrorResponse				true	"error"
 // @Failure		401
+// @Failure		409			{object}	models.NoteForSwagger				true	"current note"
 // @Router		/api/note/{id}/edit [post]
 func (h *NoteHandler) UpdateNote(w http.ResponseWriter, r *http.Request) {
 	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
@@ -419,12 +691,30 @@ func (h *NoteHandler) UpdateNote(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	version, err := getExpectedVersion(r)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, err)
+		return
+	}
+
 	protoNote, err := h.client.UpdateNote(r.Context(), &gen.UpdateNoteRequest{
-		Data:   string(noteData),
-		Id:     noteIdString,
-		UserId: jwtPayload.Id.String(),
+		Data:    string(noteData),
+		Id:      noteIdString,
+		UserId:  jwtPayload.Id.String(),
+		Version: version,
 	})
 	if err != nil {
+		if isVersionConflict(err) {
+			h.writeVersionConflict(w, r, logger, noteIdString, jwtPayload.Id.String())
+			return
+		}
+		if isForbidden(err) {
+			log.LogHandlerError(logger, http.StatusForbidden, err.Error())
+			responses.WriteErrorMessage(w, http.StatusForbidden, errors.New(note.ErrNotEnoughRights))
+			return
+		}
+
 		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
 		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note not found"))
 		return
@@ -436,6 +726,8 @@ func (h *NoteHandler) UpdateNote(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
+
+	setETag(w, resultNote.Version)
 	if err := responses.WriteResponseData(w, resultNote, http.StatusOK); err != nil {
 		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
 		w.WriteHeader(http.StatusInternalServerError)
@@ -549,6 +841,12 @@ func (h *NoteHandler) CreateSubNote(w http.ResponseWriter, r *http.Request) {
 		ParentId: noteIdString,
 	})
 	if err != nil {
+		if isForbidden(err) {
+			log.LogHandlerError(logger, http.StatusForbidden, err.Error())
+			responses.WriteErrorMessage(w, http.StatusForbidden, errors.New(note.ErrNotEnoughRights))
+			return
+		}
+
 		if err.Error()[len(RpcErrorPrefix):] == note.ErrTooManySubnotes {
 			log.LogHandlerError(logger, http.StatusConflict, err.Error())
 			responses.WriteErrorMessage(w, http.StatusConflict, err)
@@ -581,7 +879,7 @@ func (h *NoteHandler) CreateSubNote(w http.ResponseWriter, r *http.Request) {
 	log.LogHandlerInfo(logger, http.StatusOK, "success")
 }
 
-func (h *NoteHandler) SubscribeOnUpdates(w http.ResponseWriter, r *http.Request) {
+func (h *NoteHandler) MoveNote(w http.ResponseWriter, r *http.Request) {
 	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
 
 	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
@@ -592,69 +890,65 @@ func (h *NoteHandler) SubscribeOnUpdates(w http.ResponseWriter, r *http.Request)
 	}
 
 	noteIdString := mux.Vars(r)["id"]
-	noteID, err := uuid.FromString(noteIdString)
+	_, err := uuid.FromString(noteIdString)
 	if err != nil {
 		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
 		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
 		return
 	}
 
-	response, err := h.client.CheckPermissions(r.Context(), &gen.CheckPermissionsRequest{
-		NoteId: noteIdString,
-		UserId: jwtPayload.Id.String(),
-	})
-	if err != nil {
-		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
-		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("not found"))
-		return
-	}
-	if !response.Result {
-		log.LogHandlerError(logger, http.StatusNotFound, "not owner and not collaborator")
-		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("not found"))
+	payload := models.MoveNoteRequest{}
+	if err := responses.GetRequestData(r, &payload); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, responses.ParseBodyError+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("incorrect data format"))
 		return
 	}
 
-	upgrader.Subprotocols = []string{r.Header.Get("Sec-WebSocket-Protocol")}
-	connection, err := upgrader.Upgrade(w, r, nil)
+	movedNote, err := h.client.MoveNote(r.Context(), &gen.MoveNoteRequest{
+		NoteId:   noteIdString,
+		ParentId: payload.Parent.String(),
+		UserId:   jwtPayload.Id.String(),
+	})
 	if err != nil {
-		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
-		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("fail to upgrade to websocket"))
-		return
-	}
-
-	logger.Info("connection upgraded: ", slog.Any("noteID", noteID))
-
-	h.hub.AddClient(r.Context(), noteID, hub.NewCustomClient(connection))
+		if err.Error()[len(RpcErrorPrefix):] == note.ErrTooManySubnotes {
+			log.LogHandlerError(logger, http.StatusConflict, err.Error())
+			responses.WriteErrorMessage(w, http.StatusConflict, err)
+			return
+		}
 
-	logger.Info("client disconnected: ", slog.Any("noteID", noteID))
-}
+		if err.Error()[len(RpcErrorPrefix):] == note.ErrTooDeep {
+			log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+			responses.WriteErrorMessage(w, http.StatusNotFound, err)
+			return
+		}
 
-func (h *NoteHandler) SubscribeOnInvites(w http.ResponseWriter, r *http.Request) {
-	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+		if err.Error()[len(RpcErrorPrefix):] == note.ErrCyclicMove {
+			log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+			responses.WriteErrorMessage(w, http.StatusBadRequest, err)
+			return
+		}
 
-	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
-	if !ok {
-		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
-		w.WriteHeader(http.StatusUnauthorized)
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("note not found"))
 		return
 	}
 
-	upgrader.Subprotocols = []string{r.Header.Get("Sec-WebSocket-Protocol")}
-	connection, err := upgrader.Upgrade(w, r, nil)
+	resultNote, err := getNote(movedNote.Note)
 	if err != nil {
-		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
-		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("fail to upgrade to websocket"))
+		log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if err := responses.WriteResponseData(w, resultNote, http.StatusOK); err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
 
-	logger.Info("connection upgraded: ", slog.Any("userID", jwtPayload.Id))
-
-	h.hub.AddClientMain(r.Context(), jwtPayload.Id, hub.NewCustomClient(connection))
-
-	logger.Info("client disconnected: ", slog.Any("userID", jwtPayload.Id))
+	log.LogHandlerInfo(logger, http.StatusOK, "success")
 }
 
-func (h *NoteHandler) AddCollaborator(w http.ResponseWriter, r *http.Request) {
+func (h *NoteHandler) ReorderNotes(w http.ResponseWriter, r *http.Request) {
 	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
 
 	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
@@ -664,70 +958,52 @@ func (h *NoteHandler) AddCollaborator(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	noteIdString := mux.Vars(r)["id"]
-	noteID, err := uuid.FromString(noteIdString)
-	if err != nil {
-		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
-		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
-		return
-	}
-
-	payload := models.AddCollaboratorRequest{}
+	payload := models.ReorderNotesRequest{}
 	if err := responses.GetRequestData(r, &payload); err != nil {
 		log.LogHandlerError(logger, http.StatusBadRequest, responses.ParseBodyError+err.Error())
 		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("incorrect data format"))
 		return
 	}
 
-	guest, err := h.authClient.GetUserByUsername(r.Context(), &authGen.GetUserByUsernameRequest{Username: payload.Username})
-	if err != nil {
-		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
-		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("user not found"))
+	if err := payload.Validate(); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, err)
 		return
 	}
 
-	if jwtPayload.Id == uuid.FromStringOrNil(guest.Id) {
-		log.LogHandlerError(logger, http.StatusBadRequest, "tried to invite self to note")
-		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("вы реально думали, что можно вот так просто взять и пригласить в заметку самого себя?"))
-		return
+	notes := make([]string, len(payload.Notes))
+	for i, noteID := range payload.Notes {
+		notes[i] = noteID.String()
 	}
 
-	result, err := h.client.AddCollaborator(r.Context(), &gen.AddCollaboratorRequest{
-		NoteId:  noteIdString,
-		UserId:  jwtPayload.Id.String(),
-		GuestId: guest.Id,
+	_, err := h.client.ReorderNotes(r.Context(), &gen.ReorderNotesRequest{
+		UserId:   jwtPayload.Id.String(),
+		ParentId: payload.Parent.String(),
+		Notes:    notes,
 	})
 	if err != nil {
-		if err.Error()[len(RpcErrorPrefix):] == note.ErrAlreadyCollaborator {
-			log.LogHandlerError(logger, http.StatusConflict, err.Error())
-			responses.WriteErrorMessage(w, http.StatusConflict, err)
+		if isForbidden(err) {
+			log.LogHandlerError(logger, http.StatusForbidden, err.Error())
+			responses.WriteErrorMessage(w, http.StatusForbidden, errors.New(note.ErrNotEnoughRights))
 			return
 		}
 
-		if err.Error()[len(RpcErrorPrefix):] == note.ErrTooManyCollaborators {
-			log.LogHandlerError(logger, http.StatusExpectationFailed, err.Error())
-			responses.WriteErrorMessage(w, http.StatusExpectationFailed, err)
+		if strings.TrimPrefix(err.Error(), RpcErrorPrefix) == note.ErrWrongParent {
+			log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+			responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New(note.ErrWrongParent))
 			return
 		}
 
 		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
-		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("not found"))
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("note not found"))
 		return
 	}
 
-	h.hub.WriteToCacheMain(r.Context(), uuid.FromStringOrNil(guest.Id), models.InviteMessage{
-		Type:      "invite",
-		NoteId:    noteID,
-		NoteTitle: result.Title,
-		Owner:     jwtPayload.Username,
-		Created:   time.Now().UTC(),
-	})
-
 	w.WriteHeader(http.StatusNoContent)
 	log.LogHandlerInfo(logger, http.StatusNoContent, "success")
 }
 
-func (h *NoteHandler) AddTag(w http.ResponseWriter, r *http.Request) {
+func (h *NoteHandler) BulkUpdate(w http.ResponseWriter, r *http.Request) {
 	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
 
 	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
@@ -737,53 +1013,56 @@ func (h *NoteHandler) AddTag(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	noteIdString := mux.Vars(r)["id"]
-	_, err := uuid.FromString(noteIdString)
-	if err != nil {
-		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
-		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
-		return
-	}
-
-	var tagName models.TagRequest
-	if err := responses.GetRequestData(r, &tagName); err != nil {
+	payload := models.BulkRequest{}
+	if err := responses.GetRequestData(r, &payload); err != nil {
 		log.LogHandlerError(logger, http.StatusBadRequest, responses.ParseBodyError+err.Error())
 		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("incorrect data format"))
 		return
 	}
 
-	tagName.Sanitize()
-	if err = tagName.Validate(); err != nil {
+	payload.Sanitize()
+	if err := payload.Validate(); err != nil {
 		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
-		w.WriteHeader(http.StatusBadRequest)
+		responses.WriteErrorMessage(w, http.StatusBadRequest, err)
 		return
 	}
 
-	currentNote, err := h.client.AddTag(r.Context(), &gen.TagRequest{
-		TagName: tagName.TagName,
-		NoteId:  noteIdString,
-		UserId:  jwtPayload.Id.String(),
-	})
-	if err != nil {
-		if err.Error()[len(RpcErrorPrefix):] == note.ErrTooManyTags {
-			log.LogHandlerError(logger, http.StatusConflict, err.Error())
-			responses.WriteErrorMessage(w, http.StatusConflict, err)
-			return
+	notes := make([]string, len(payload.Notes))
+	for i, noteID := range payload.Notes {
+		notes[i] = noteID.String()
+	}
+
+	operations := make([]*gen.BulkOperationModel, len(payload.Operations))
+	for i, operation := range payload.Operations {
+		operations[i] = &gen.BulkOperationModel{
+			Type:  operation.Type,
+			Value: operation.Value,
 		}
+	}
 
+	response, err := h.client.BulkUpdate(r.Context(), &gen.BulkRequest{
+		NoteIds:    notes,
+		Operations: operations,
+		UserId:     jwtPayload.Id.String(),
+	})
+	if err != nil {
 		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
 		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
 
-	resultNote, err := getNote(currentNote.Note)
-	if err != nil {
-		log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
-		w.WriteHeader(http.StatusInternalServerError)
-		return
+	result := models.BulkResponse{
+		Results: make([]models.BulkResult, len(response.Results)),
+	}
+	for i, item := range response.Results {
+		result.Results[i] = models.BulkResult{
+			NoteID:  uuid.FromStringOrNil(item.NoteId),
+			Success: item.Success,
+			Error:   item.Error,
+		}
 	}
 
-	if err := responses.WriteResponseData(w, resultNote, http.StatusOK); err != nil {
+	if err := responses.WriteResponseData(w, result, http.StatusOK); err != nil {
 		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
 		w.WriteHeader(http.StatusInternalServerError)
 		return
@@ -792,7 +1071,7 @@ func (h *NoteHandler) AddTag(w http.ResponseWriter, r *http.Request) {
 	log.LogHandlerInfo(logger, http.StatusOK, "success")
 }
 
-func (h *NoteHandler) DeleteTag(w http.ResponseWriter, r *http.Request) {
+func (h *NoteHandler) DuplicateNote(w http.ResponseWriter, r *http.Request) {
 	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
 
 	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
@@ -805,36 +1084,34 @@ func (h *NoteHandler) DeleteTag(w http.ResponseWriter, r *http.Request) {
 	noteIdString := mux.Vars(r)["id"]
 	_, err := uuid.FromString(noteIdString)
 	if err != nil {
-		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
 		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
 		return
 	}
 
-	var tagName models.TagRequest
-	if err := responses.GetRequestData(r, &tagName); err != nil {
-		log.LogHandlerError(logger, http.StatusBadRequest, responses.ParseBodyError+err.Error())
-		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("incorrect data format"))
-		return
-	}
-
-	responseNote, err := h.client.DeleteTag(r.Context(), &gen.TagRequest{
-		TagName: tagName.TagName,
-		NoteId:  noteIdString,
-		UserId:  jwtPayload.Id.String(),
+	copiedNote, err := h.client.DuplicateNote(r.Context(), &gen.DuplicateNoteRequest{
+		NoteId:       noteIdString,
+		UserId:       jwtPayload.Id.String(),
+		WithSubnotes: r.URL.Query().Get("with_subnotes") == "true",
 	})
 	if err != nil {
-		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
-		w.WriteHeader(http.StatusBadRequest)
+		if err.Error()[len(RpcErrorPrefix):] == note.ErrTooManySubnotes {
+			log.LogHandlerError(logger, http.StatusConflict, err.Error())
+			responses.WriteErrorMessage(w, http.StatusConflict, err)
+			return
+		}
+
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("note not found"))
 		return
 	}
 
-	resultNote, err := getNote(responseNote.Note)
+	resultNote, err := getNote(copiedNote.Note)
 	if err != nil {
 		log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
-
 	if err := responses.WriteResponseData(w, resultNote, http.StatusOK); err != nil {
 		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
 		w.WriteHeader(http.StatusInternalServerError)
@@ -844,7 +1121,7 @@ func (h *NoteHandler) DeleteTag(w http.ResponseWriter, r *http.Request) {
 	log.LogHandlerInfo(logger, http.StatusOK, "success")
 }
 
-func (h *NoteHandler) RememberTag(w http.ResponseWriter, r *http.Request) {
+func (h *NoteHandler) GetBacklinks(w http.ResponseWriter, r *http.Request) {
 	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
 
 	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
@@ -854,28 +1131,44 @@ func (h *NoteHandler) RememberTag(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var tagName models.TagRequest
-	if err := responses.GetRequestData(r, &tagName); err != nil {
-		log.LogHandlerError(logger, http.StatusBadRequest, responses.ParseBodyError+err.Error())
-		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("incorrect data format"))
+	noteIdString := mux.Vars(r)["id"]
+	if _, err := uuid.FromString(noteIdString); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
 		return
 	}
 
-	_, err := h.client.RememberTag(r.Context(), &gen.AllTagRequest{
-		TagName: tagName.TagName,
-		UserId:  jwtPayload.Id.String(),
+	result, err := h.client.GetBacklinks(r.Context(), &gen.GetBacklinksRequest{
+		NoteId: noteIdString,
+		UserId: jwtPayload.Id.String(),
 	})
 	if err != nil {
-		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
-		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("tag already exists"))
+		if isForbidden(err) {
+			log.LogHandlerError(logger, http.StatusForbidden, err.Error())
+			responses.WriteErrorMessage(w, http.StatusForbidden, errors.New(note.ErrNotEnoughRights))
+			return
+		}
+
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("note not found"))
 		return
 	}
 
-	log.LogHandlerInfo(logger, http.StatusNoContent, "success")
-	w.WriteHeader(http.StatusNoContent)
+	backlinks := make([]models.NoteLink, len(result.Notes))
+	for i, item := range result.Notes {
+		backlinks[i] = getNoteLink(item)
+	}
+
+	if err := responses.WriteResponseData(w, backlinks, http.StatusOK); err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	log.LogHandlerInfo(logger, http.StatusOK, "success")
 }
 
-func (h *NoteHandler) ForgetTag(w http.ResponseWriter, r *http.Request) {
+func (h *NoteHandler) GetNoteGraph(w http.ResponseWriter, r *http.Request) {
 	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
 
 	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
@@ -885,16 +1178,8 @@ func (h *NoteHandler) ForgetTag(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var tagName models.TagRequest
-	if err := responses.GetRequestData(r, &tagName); err != nil {
-		log.LogHandlerError(logger, http.StatusBadRequest, responses.ParseBodyError+err.Error())
-		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("incorrect data format"))
-		return
-	}
-
-	_, err := h.client.ForgetTag(r.Context(), &gen.AllTagRequest{
-		TagName: tagName.TagName,
-		UserId:  jwtPayload.Id.String(),
+	result, err := h.client.GetNoteGraph(r.Context(), &gen.GetNoteGraphRequest{
+		UserId: jwtPayload.Id.String(),
 	})
 	if err != nil {
 		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
@@ -902,11 +1187,30 @@ func (h *NoteHandler) ForgetTag(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	log.LogHandlerInfo(logger, http.StatusNoContent, "success")
-	w.WriteHeader(http.StatusNoContent)
+	graph := models.NoteGraph{
+		Nodes: make([]models.NoteLink, len(result.Nodes)),
+		Edges: make([]models.NoteEdge, len(result.Edges)),
+	}
+	for i, item := range result.Nodes {
+		graph.Nodes[i] = getNoteLink(item)
+	}
+	for i, item := range result.Edges {
+		graph.Edges[i] = models.NoteEdge{
+			Source: uuid.FromStringOrNil(item.Source),
+			Target: uuid.FromStringOrNil(item.Target),
+		}
+	}
+
+	if err := responses.WriteResponseData(w, graph, http.StatusOK); err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	log.LogHandlerInfo(logger, http.StatusOK, "success")
 }
 
-func (h *NoteHandler) UpdateTag(w http.ResponseWriter, r *http.Request) {
+func (h *NoteHandler) SubscribeOnUpdates(w http.ResponseWriter, r *http.Request) {
 	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
 
 	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
@@ -916,35 +1220,81 @@ func (h *NoteHandler) UpdateTag(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var tagName models.UpdateTagRequest
-	if err := responses.GetRequestData(r, &tagName); err != nil {
-		log.LogHandlerError(logger, http.StatusBadRequest, responses.ParseBodyError+err.Error())
-		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("incorrect data format"))
+	noteIdString := mux.Vars(r)["id"]
+	noteID, err := uuid.FromString(noteIdString)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
 		return
 	}
-	tagName.Sanitize()
-	if err := tagName.Validate(); err != nil {
+
+	response, err := h.client.CheckPermissions(r.Context(), &gen.CheckPermissionsRequest{
+		NoteId: noteIdString,
+		UserId: jwtPayload.Id.String(),
+	})
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+	if !response.Result {
+		log.LogHandlerError(logger, http.StatusNotFound, "not owner and not collaborator")
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+
+	upgrader.Subprotocols = []string{r.Header.Get("Sec-WebSocket-Protocol")}
+	connection, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
 		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
-		w.WriteHeader(http.StatusBadRequest)
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("fail to upgrade to websocket"))
 		return
 	}
 
-	_, err := h.client.UpdateTag(r.Context(), &gen.UpdateTagRequest{
-		OldTag: tagName.OldTag,
-		NewTag: tagName.NewTag,
-		UserId: jwtPayload.Id.String(),
-	})
+	logger.Info("connection upgraded: ", slog.Any("noteID", noteID))
+
+	client := hub.NewCustomClient(connection, jwtPayload)
+	client.SetAccess(response.Role)
+
+	// аватар нужен только для отображения участников, без него подключение не прерывается
+	user, err := h.authClient.GetUserByUsername(r.Context(), &authGen.GetUserByUsernameRequest{Username: jwtPayload.Username})
+	if err != nil {
+		logger.Error(err.Error())
+	} else {
+		client.ImagePath = user.ImagePath
+	}
+
+	h.hub.AddClient(r.Context(), noteID, client)
+
+	logger.Info("client disconnected: ", slog.Any("noteID", noteID))
+}
+
+func (h *NoteHandler) SubscribeOnInvites(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	upgrader.Subprotocols = []string{r.Header.Get("Sec-WebSocket-Protocol")}
+	connection, err := upgrader.Upgrade(w, r, nil)
 	if err != nil {
 		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
-		w.WriteHeader(http.StatusBadRequest)
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("fail to upgrade to websocket"))
 		return
 	}
 
-	log.LogHandlerInfo(logger, http.StatusNoContent, "success")
-	w.WriteHeader(http.StatusNoContent)
+	logger.Info("connection upgraded: ", slog.Any("userID", jwtPayload.Id))
+
+	h.hub.AddClientMain(r.Context(), jwtPayload.Id, hub.NewCustomClient(connection, jwtPayload))
+
+	logger.Info("client disconnected: ", slog.Any("userID", jwtPayload.Id))
 }
 
-func (h *NoteHandler) SetIcon(w http.ResponseWriter, r *http.Request) {
+func (h *NoteHandler) AddCollaborator(w http.ResponseWriter, r *http.Request) {
 	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
 
 	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
@@ -955,39 +1305,89 @@ func (h *NoteHandler) SetIcon(w http.ResponseWriter, r *http.Request) {
 	}
 
 	noteIdString := mux.Vars(r)["id"]
-	_, err := uuid.FromString(noteIdString)
+	noteID, err := uuid.FromString(noteIdString)
 	if err != nil {
-		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
 		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
 		return
 	}
 
-	var iconRequest models.SetIconRequest
-	if err := responses.GetRequestData(r, &iconRequest); err != nil {
+	payload := models.AddCollaboratorRequest{}
+	if err := responses.GetRequestData(r, &payload); err != nil {
 		log.LogHandlerError(logger, http.StatusBadRequest, responses.ParseBodyError+err.Error())
 		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("incorrect data format"))
 		return
 	}
 
-	currentNote, err := h.client.SetIcon(r.Context(), &gen.SetIconRequest{
-		NoteId: noteIdString,
-		Icon:   iconRequest.Icon,
-		UserId: jwtPayload.Id.String(),
-	})
-	if err != nil {
+	if err := payload.Validate(); err != nil {
 		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
-		w.WriteHeader(http.StatusBadRequest)
+		responses.WriteErrorMessage(w, http.StatusBadRequest, err)
 		return
 	}
 
-	resultNote, err := getNote(currentNote.Note)
+	guest, err := h.authClient.GetUserByUsername(r.Context(), &authGen.GetUserByUsernameRequest{Username: payload.Username})
 	if err != nil {
-		log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
-		w.WriteHeader(http.StatusInternalServerError)
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("user not found"))
 		return
 	}
 
-	if err := responses.WriteResponseData(w, resultNote, http.StatusOK); err != nil {
+	if jwtPayload.Id == uuid.FromStringOrNil(guest.Id) {
+		log.LogHandlerError(logger, http.StatusBadRequest, "tried to invite self to note")
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("вы реально думали, что можно вот так просто взять и пригласить в заметку самого себя?"))
+		return
+	}
+
+	result, err := h.client.AddCollaborator(r.Context(), &gen.AddCollaboratorRequest{
+		NoteId:  noteIdString,
+		UserId:  jwtPayload.Id.String(),
+		GuestId: guest.Id,
+		Role:    payload.Role,
+	})
+	if err != nil {
+		if err.Error()[len(RpcErrorPrefix):] == note.ErrAlreadyCollaborator {
+			log.LogHandlerError(logger, http.StatusConflict, err.Error())
+			responses.WriteErrorMessage(w, http.StatusConflict, err)
+			return
+		}
+
+		if err.Error()[len(RpcErrorPrefix):] == note.ErrTooManyCollaborators {
+			log.LogHandlerError(logger, http.StatusExpectationFailed, err.Error())
+			responses.WriteErrorMessage(w, http.StatusExpectationFailed, err)
+			return
+		}
+
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+
+	h.hub.WriteToCacheMain(r.Context(), uuid.FromStringOrNil(guest.Id), models.InviteMessage{
+		Type:      "invite",
+		NoteId:    noteID,
+		NoteTitle: result.Title,
+		Owner:     jwtPayload.Username,
+		Created:   time.Now().UTC(),
+		InviteId:  uuid.FromStringOrNil(result.InviteId),
+	})
+
+	w.WriteHeader(http.StatusNoContent)
+	log.LogHandlerInfo(logger, http.StatusNoContent, "success")
+}
+
+func writeInvites(w http.ResponseWriter, logger *slog.Logger, protoInvites []*gen.InviteModel) {
+	data := make([]models.Invite, len(protoInvites))
+	for i, protoInvite := range protoInvites {
+		invite, err := getInvite(protoInvite)
+		if err != nil {
+			log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		data[i] = invite
+	}
+
+	if err := responses.WriteResponseData(w, data, http.StatusOK); err != nil {
 		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
 		w.WriteHeader(http.StatusInternalServerError)
 		return
@@ -996,7 +1396,7 @@ func (h *NoteHandler) SetIcon(w http.ResponseWriter, r *http.Request) {
 	log.LogHandlerInfo(logger, http.StatusOK, "success")
 }
 
-func (h *NoteHandler) SetHeader(w http.ResponseWriter, r *http.Request) {
+func (h *NoteHandler) GetSentInvites(w http.ResponseWriter, r *http.Request) {
 	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
 
 	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
@@ -1006,33 +1406,2306 @@ func (h *NoteHandler) SetHeader(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	noteIdString := mux.Vars(r)["id"]
-	_, err := uuid.FromString(noteIdString)
+	result, err := h.client.GetSentInvites(r.Context(), &gen.GetInvitesRequest{
+		UserId: jwtPayload.Id.String(),
+	})
 	if err != nil {
-		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
-		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
+		log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
 
-	var headerRequest models.SetHeaderRequest
-	if err := responses.GetRequestData(r, &headerRequest); err != nil {
-		log.LogHandlerError(logger, http.StatusBadRequest, responses.ParseBodyError+err.Error())
+	writeInvites(w, logger, result.Invites)
+}
+
+func (h *NoteHandler) GetReceivedInvites(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	result, err := h.client.GetReceivedInvites(r.Context(), &gen.GetInvitesRequest{
+		UserId: jwtPayload.Id.String(),
+	})
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	writeInvites(w, logger, result.Invites)
+}
+
+func (h *NoteHandler) AcceptInvite(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	inviteIdString := mux.Vars(r)["id"]
+	if _, err := uuid.FromString(inviteIdString); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("invite id must be a type of uuid"))
+		return
+	}
+
+	result, err := h.client.AcceptInvite(r.Context(), &gen.InviteRequest{
+		InviteId: inviteIdString,
+		UserId:   jwtPayload.Id.String(),
+	})
+	if err != nil {
+		if err.Error()[len(RpcErrorPrefix):] == note.ErrInviteExpired {
+			log.LogHandlerError(logger, http.StatusGone, err.Error())
+			responses.WriteErrorMessage(w, http.StatusGone, err)
+			return
+		}
+
+		if err.Error()[len(RpcErrorPrefix):] == note.ErrAlreadyCollaborator {
+			log.LogHandlerError(logger, http.StatusConflict, err.Error())
+			responses.WriteErrorMessage(w, http.StatusConflict, err)
+			return
+		}
+
+		if err.Error()[len(RpcErrorPrefix):] == note.ErrTooManyCollaborators {
+			log.LogHandlerError(logger, http.StatusExpectationFailed, err.Error())
+			responses.WriteErrorMessage(w, http.StatusExpectationFailed, err)
+			return
+		}
+
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+
+	h.hub.WriteToCacheMain(r.Context(), uuid.FromStringOrNil(result.Invite.InviterId), models.InviteMessage{
+		Type:      "invite_accepted",
+		NoteId:    uuid.FromStringOrNil(result.Invite.NoteId),
+		NoteTitle: result.Invite.NoteTitle,
+		Owner:     jwtPayload.Username,
+		Created:   time.Now().UTC(),
+		InviteId:  uuid.FromStringOrNil(result.Invite.Id),
+	})
+
+	w.WriteHeader(http.StatusNoContent)
+	log.LogHandlerInfo(logger, http.StatusNoContent, "success")
+}
+
+func (h *NoteHandler) DeclineInvite(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	inviteIdString := mux.Vars(r)["id"]
+	if _, err := uuid.FromString(inviteIdString); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("invite id must be a type of uuid"))
+		return
+	}
+
+	result, err := h.client.DeclineInvite(r.Context(), &gen.InviteRequest{
+		InviteId: inviteIdString,
+		UserId:   jwtPayload.Id.String(),
+	})
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+
+	h.hub.WriteToCacheMain(r.Context(), uuid.FromStringOrNil(result.Invite.InviterId), models.InviteMessage{
+		Type:      "invite_declined",
+		NoteId:    uuid.FromStringOrNil(result.Invite.NoteId),
+		NoteTitle: result.Invite.NoteTitle,
+		Owner:     jwtPayload.Username,
+		Created:   time.Now().UTC(),
+		InviteId:  uuid.FromStringOrNil(result.Invite.Id),
+	})
+
+	w.WriteHeader(http.StatusNoContent)
+	log.LogHandlerInfo(logger, http.StatusNoContent, "success")
+}
+
+func (h *NoteHandler) RevokeInvite(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	inviteIdString := mux.Vars(r)["id"]
+	if _, err := uuid.FromString(inviteIdString); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("invite id must be a type of uuid"))
+		return
+	}
+
+	result, err := h.client.RevokeInvite(r.Context(), &gen.InviteRequest{
+		InviteId: inviteIdString,
+		UserId:   jwtPayload.Id.String(),
+	})
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+
+	h.hub.WriteToCacheMain(r.Context(), uuid.FromStringOrNil(result.Invite.InviteeId), models.InviteMessage{
+		Type:      "invite_revoked",
+		NoteId:    uuid.FromStringOrNil(result.Invite.NoteId),
+		NoteTitle: result.Invite.NoteTitle,
+		Owner:     jwtPayload.Username,
+		Created:   time.Now().UTC(),
+		InviteId:  uuid.FromStringOrNil(result.Invite.Id),
+	})
+
+	w.WriteHeader(http.StatusNoContent)
+	log.LogHandlerInfo(logger, http.StatusNoContent, "success")
+}
+
+func (h *NoteHandler) SetCollaboratorRole(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	noteIdString := mux.Vars(r)["id"]
+	if _, err := uuid.FromString(noteIdString); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
+		return
+	}
+
+	payload := models.SetRoleRequest{}
+	if err := responses.GetRequestData(r, &payload); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, responses.ParseBodyError+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("incorrect data format"))
+		return
+	}
+
+	if err := payload.Validate(); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, err)
+		return
+	}
+
+	guest, err := h.authClient.GetUserByUsername(r.Context(), &authGen.GetUserByUsernameRequest{Username: payload.Username})
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("user not found"))
+		return
+	}
+
+	_, err = h.client.SetCollaboratorRole(r.Context(), &gen.SetCollaboratorRoleRequest{
+		NoteId:  noteIdString,
+		UserId:  jwtPayload.Id.String(),
+		GuestId: guest.Id,
+		Role:    payload.Role,
+	})
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+
+	// открытые сокеты соавтора сразу получают новую роль, иначе понижение действовало бы только после AccessTtl
+	h.hub.RefreshAccess(r.Context(), uuid.FromStringOrNil(guest.Id))
+
+	w.WriteHeader(http.StatusNoContent)
+	log.LogHandlerInfo(logger, http.StatusNoContent, "success")
+}
+
+func (h *NoteHandler) RemoveCollaborator(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	noteIdString := mux.Vars(r)["id"]
+	noteID, err := uuid.FromString(noteIdString)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
+		return
+	}
+
+	payload := models.RemoveCollaboratorRequest{}
+	if err := responses.GetRequestData(r, &payload); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, responses.ParseBodyError+err.Error())
 		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("incorrect data format"))
 		return
 	}
 
-	currentNote, err := h.client.SetHeader(r.Context(), &gen.SetHeaderRequest{
+	guest, err := h.authClient.GetUserByUsername(r.Context(), &authGen.GetUserByUsernameRequest{Username: payload.Username})
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("user not found"))
+		return
+	}
+	guestID := uuid.FromStringOrNil(guest.Id)
+
+	result, err := h.client.RemoveCollaborator(r.Context(), &gen.RemoveCollaboratorRequest{
+		NoteId:  noteIdString,
+		UserId:  jwtPayload.Id.String(),
+		GuestId: guest.Id,
+	})
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+
+	h.hub.DisconnectUser(r.Context(), guestID, getIds(result.NoteIds))
+	h.hub.WriteToCacheMain(r.Context(), guestID, models.InviteMessage{
+		Type:      "removed",
+		NoteId:    noteID,
+		NoteTitle: result.Title,
+		Owner:     jwtPayload.Username,
+		Created:   time.Now().UTC(),
+	})
+
+	w.WriteHeader(http.StatusNoContent)
+	log.LogHandlerInfo(logger, http.StatusNoContent, "success")
+}
+
+func (h *NoteHandler) LeaveNote(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	noteIdString := mux.Vars(r)["id"]
+	if _, err := uuid.FromString(noteIdString); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
+		return
+	}
+
+	result, err := h.client.LeaveNote(r.Context(), &gen.LeaveNoteRequest{
+		NoteId: noteIdString,
+		UserId: jwtPayload.Id.String(),
+	})
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+
+	h.hub.DisconnectUser(r.Context(), jwtPayload.Id, getIds(result.NoteIds))
+
+	w.WriteHeader(http.StatusNoContent)
+	log.LogHandlerInfo(logger, http.StatusNoContent, "success")
+}
+
+func (h *NoteHandler) ProposeTransfer(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	noteIdString := mux.Vars(r)["id"]
+	noteID, err := uuid.FromString(noteIdString)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
+		return
+	}
+
+	payload := models.TransferOwnershipRequest{}
+	if err := responses.GetRequestData(r, &payload); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, responses.ParseBodyError+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("incorrect data format"))
+		return
+	}
+
+	newOwner, err := h.authClient.GetUserByUsername(r.Context(), &authGen.GetUserByUsernameRequest{Username: payload.Username})
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("user not found"))
+		return
+	}
+
+	result, err := h.client.ProposeTransfer(r.Context(), &gen.ProposeTransferRequest{
+		NoteId:     noteIdString,
+		UserId:     jwtPayload.Id.String(),
+		NewOwnerId: newOwner.Id,
+	})
+	if err != nil {
+		if err.Error()[len(RpcErrorPrefix):] == note.ErrAlreadyOwner {
+			log.LogHandlerError(logger, http.StatusConflict, err.Error())
+			responses.WriteErrorMessage(w, http.StatusConflict, err)
+			return
+		}
+
+		if err.Error()[len(RpcErrorPrefix):] == note.ErrTooManyCollaborators {
+			log.LogHandlerError(logger, http.StatusExpectationFailed, err.Error())
+			responses.WriteErrorMessage(w, http.StatusExpectationFailed, err)
+			return
+		}
+
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+
+	h.hub.WriteToCacheMain(r.Context(), uuid.FromStringOrNil(newOwner.Id), models.InviteMessage{
+		Type:      "transfer",
+		NoteId:    noteID,
+		NoteTitle: result.Title,
+		Owner:     jwtPayload.Username,
+		Created:   time.Now().UTC(),
+	})
+
+	w.WriteHeader(http.StatusNoContent)
+	log.LogHandlerInfo(logger, http.StatusNoContent, "success")
+}
+
+func (h *NoteHandler) AcceptTransfer(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	noteIdString := mux.Vars(r)["id"]
+	noteID, err := uuid.FromString(noteIdString)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
+		return
+	}
+
+	result, err := h.client.AcceptTransfer(r.Context(), &gen.AcceptTransferRequest{
+		NoteId: noteIdString,
+		UserId: jwtPayload.Id.String(),
+	})
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+
+	h.hub.WriteToCacheMain(r.Context(), uuid.FromStringOrNil(result.FromId), models.InviteMessage{
+		Type:    "transfer_accepted",
+		NoteId:  noteID,
+		Owner:   jwtPayload.Username,
+		Created: time.Now().UTC(),
+	})
+
+	w.WriteHeader(http.StatusNoContent)
+	log.LogHandlerInfo(logger, http.StatusNoContent, "success")
+}
+
+func (h *NoteHandler) AddTag(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	noteIdString := mux.Vars(r)["id"]
+	_, err := uuid.FromString(noteIdString)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
+		return
+	}
+
+	var tagName models.TagRequest
+	if err := responses.GetRequestData(r, &tagName); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, responses.ParseBodyError+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("incorrect data format"))
+		return
+	}
+
+	tagName.Sanitize()
+	if err = tagName.Validate(); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	version, err := getExpectedVersion(r)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, err)
+		return
+	}
+
+	currentNote, err := h.client.AddTag(r.Context(), &gen.TagRequest{
+		TagName: tagName.TagName,
+		NoteId:  noteIdString,
+		UserId:  jwtPayload.Id.String(),
+		Version: version,
+	})
+	if err != nil {
+		if isVersionConflict(err) {
+			h.writeVersionConflict(w, r, logger, noteIdString, jwtPayload.Id.String())
+			return
+		}
+		if err.Error()[len(RpcErrorPrefix):] == note.ErrTooManyTags {
+			log.LogHandlerError(logger, http.StatusConflict, err.Error())
+			responses.WriteErrorMessage(w, http.StatusConflict, err)
+			return
+		}
+
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	resultNote, err := getNote(currentNote.Note)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	setETag(w, resultNote.Version)
+	if err := responses.WriteResponseData(w, resultNote, http.StatusOK); err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	log.LogHandlerInfo(logger, http.StatusOK, "success")
+}
+
+func (h *NoteHandler) DeleteTag(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	noteIdString := mux.Vars(r)["id"]
+	_, err := uuid.FromString(noteIdString)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
+		return
+	}
+
+	var tagName models.TagRequest
+	if err := responses.GetRequestData(r, &tagName); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, responses.ParseBodyError+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("incorrect data format"))
+		return
+	}
+
+	version, err := getExpectedVersion(r)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, err)
+		return
+	}
+
+	responseNote, err := h.client.DeleteTag(r.Context(), &gen.TagRequest{
+		TagName: tagName.TagName,
+		NoteId:  noteIdString,
+		UserId:  jwtPayload.Id.String(),
+		Version: version,
+	})
+	if err != nil {
+		if isVersionConflict(err) {
+			h.writeVersionConflict(w, r, logger, noteIdString, jwtPayload.Id.String())
+			return
+		}
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	resultNote, err := getNote(responseNote.Note)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	setETag(w, resultNote.Version)
+	if err := responses.WriteResponseData(w, resultNote, http.StatusOK); err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	log.LogHandlerInfo(logger, http.StatusOK, "success")
+}
+
+func (h *NoteHandler) RememberTag(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var tagName models.TagRequest
+	if err := responses.GetRequestData(r, &tagName); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, responses.ParseBodyError+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("incorrect data format"))
+		return
+	}
+
+	_, err := h.client.RememberTag(r.Context(), &gen.AllTagRequest{
+		TagName: tagName.TagName,
+		UserId:  jwtPayload.Id.String(),
+	})
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("tag already exists"))
+		return
+	}
+
+	log.LogHandlerInfo(logger, http.StatusNoContent, "success")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *NoteHandler) ForgetTag(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var tagName models.TagRequest
+	if err := responses.GetRequestData(r, &tagName); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, responses.ParseBodyError+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("incorrect data format"))
+		return
+	}
+
+	_, err := h.client.ForgetTag(r.Context(), &gen.AllTagRequest{
+		TagName: tagName.TagName,
+		UserId:  jwtPayload.Id.String(),
+	})
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	log.LogHandlerInfo(logger, http.StatusNoContent, "success")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *NoteHandler) UpdateTag(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var tagName models.UpdateTagRequest
+	if err := responses.GetRequestData(r, &tagName); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, responses.ParseBodyError+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("incorrect data format"))
+		return
+	}
+	tagName.Sanitize()
+	if err := tagName.Validate(); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	_, err := h.client.UpdateTag(r.Context(), &gen.UpdateTagRequest{
+		OldTag: tagName.OldTag,
+		NewTag: tagName.NewTag,
+		UserId: jwtPayload.Id.String(),
+	})
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	log.LogHandlerInfo(logger, http.StatusNoContent, "success")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *NoteHandler) SetIcon(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	noteIdString := mux.Vars(r)["id"]
+	_, err := uuid.FromString(noteIdString)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
+		return
+	}
+
+	var iconRequest models.SetIconRequest
+	if err := responses.GetRequestData(r, &iconRequest); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, responses.ParseBodyError+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("incorrect data format"))
+		return
+	}
+
+	version, err := getExpectedVersion(r)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, err)
+		return
+	}
+
+	currentNote, err := h.client.SetIcon(r.Context(), &gen.SetIconRequest{
+		NoteId:  noteIdString,
+		Icon:    iconRequest.Icon,
+		UserId:  jwtPayload.Id.String(),
+		Version: version,
+	})
+	if err != nil {
+		if isVersionConflict(err) {
+			h.writeVersionConflict(w, r, logger, noteIdString, jwtPayload.Id.String())
+			return
+		}
+		if isForbidden(err) {
+			log.LogHandlerError(logger, http.StatusForbidden, err.Error())
+			responses.WriteErrorMessage(w, http.StatusForbidden, errors.New(note.ErrNotEnoughRights))
+			return
+		}
+
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	resultNote, err := getNote(currentNote.Note)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	setETag(w, resultNote.Version)
+	if err := responses.WriteResponseData(w, resultNote, http.StatusOK); err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	log.LogHandlerInfo(logger, http.StatusOK, "success")
+}
+
+func (h *NoteHandler) SetHeader(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	noteIdString := mux.Vars(r)["id"]
+	_, err := uuid.FromString(noteIdString)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
+		return
+	}
+
+	var headerRequest models.SetHeaderRequest
+	if err := responses.GetRequestData(r, &headerRequest); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, responses.ParseBodyError+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("incorrect data format"))
+		return
+	}
+
+	version, err := getExpectedVersion(r)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, err)
+		return
+	}
+
+	currentNote, err := h.client.SetHeader(r.Context(), &gen.SetHeaderRequest{
+		NoteId:  noteIdString,
+		Header:  headerRequest.Header,
+		UserId:  jwtPayload.Id.String(),
+		Version: version,
+	})
+	if err != nil {
+		if isVersionConflict(err) {
+			h.writeVersionConflict(w, r, logger, noteIdString, jwtPayload.Id.String())
+			return
+		}
+		if isForbidden(err) {
+			log.LogHandlerError(logger, http.StatusForbidden, err.Error())
+			responses.WriteErrorMessage(w, http.StatusForbidden, errors.New(note.ErrNotEnoughRights))
+			return
+		}
+
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	resultNote, err := getNote(currentNote.Note)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	setETag(w, resultNote.Version)
+	if err := responses.WriteResponseData(w, resultNote, http.StatusOK); err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	log.LogHandlerInfo(logger, http.StatusOK, "success")
+}
+
+func (h *NoteHandler) AddFavorite(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	noteIdString := mux.Vars(r)["id"]
+	_, err := uuid.FromString(noteIdString)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
+		return
+	}
+
+	protoNote, err := h.client.AddFav(r.Context(), &gen.ChangeFlagRequest{
+
+		NoteId: noteIdString,
+		UserId: jwtPayload.Id.String(),
+	})
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	resultNote, err := getNote(protoNote.Note)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if err := responses.WriteResponseData(w, resultNote, http.StatusOK); err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	log.LogHandlerInfo(logger, http.StatusOK, "success")
+}
+
+func (h *NoteHandler) DeleteFavorite(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	noteIdString := mux.Vars(r)["id"]
+	_, err := uuid.FromString(noteIdString)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
+		return
+	}
+
+	protoNote, err := h.client.DelFav(r.Context(), &gen.ChangeFlagRequest{
+		NoteId: noteIdString,
+		UserId: jwtPayload.Id.String(),
+	})
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	resultNote, err := getNote(protoNote.Note)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if err := responses.WriteResponseData(w, resultNote, http.StatusOK); err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	log.LogHandlerInfo(logger, http.StatusOK, "success")
+}
+
+func (h *NoteHandler) ArchiveNote(w http.ResponseWriter, r *http.Request) {
+	h.setArchived(w, r, true)
+}
+
+func (h *NoteHandler) UnarchiveNote(w http.ResponseWriter, r *http.Request) {
+	h.setArchived(w, r, false)
+}
+
+func (h *NoteHandler) setArchived(w http.ResponseWriter, r *http.Request, archived bool) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	noteIdString := mux.Vars(r)["id"]
+	if _, err := uuid.FromString(noteIdString); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
+		return
+	}
+
+	request := &gen.ChangeFlagRequest{
+		NoteId: noteIdString,
+		UserId: jwtPayload.Id.String(),
+	}
+
+	var protoNote *gen.GetNoteResponse
+	var err error
+	if archived {
+		protoNote, err = h.client.ArchiveNote(r.Context(), request)
+	} else {
+		protoNote, err = h.client.UnarchiveNote(r.Context(), request)
+	}
+	if err != nil {
+		if isForbidden(err) {
+			log.LogHandlerError(logger, http.StatusForbidden, err.Error())
+			responses.WriteErrorMessage(w, http.StatusForbidden, errors.New(note.ErrNotEnoughRights))
+			return
+		}
+
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+
+	resultNote, err := getNote(protoNote.Note)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if err := responses.WriteResponseData(w, resultNote, http.StatusOK); err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	log.LogHandlerInfo(logger, http.StatusOK, "success")
+}
+
+func (h *NoteHandler) GetTags(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	result, err := h.client.GetTags(r.Context(), &gen.GetTagsRequest{
+		UserId: jwtPayload.Id.String(),
+	})
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	tags := make([]string, len(result.Tags))
+	copy(tags, result.Tags)
+	info := make([]models.Tag, len(result.TagsInfo))
+	for i, tag := range result.TagsInfo {
+		info[i] = models.Tag{
+			Name:        tag.Name,
+			Color:       tag.Color,
+			Emoji:       tag.Emoji,
+			Description: tag.Description,
+			NotesCount:  tag.NotesCount,
+		}
+	}
+	var response interface{} = models.GetTagsResponse{Tags: tags, Info: info}
+	if r.URL.Query().Get("tree") == "true" {
+		response = models.GetTagTreeResponse{Tags: models.BuildTagTree(info)}
+	}
+	if err := responses.WriteResponseData(w, response, http.StatusOK); err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	log.LogHandlerInfo(logger, http.StatusOK, "success")
+}
+
+func (h *NoteHandler) MergeTags(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var payload models.MergeTagsRequest
+	if err := responses.GetRequestData(r, &payload); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, responses.ParseBodyError+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("incorrect data format"))
+		return
+	}
+	payload.Sanitize()
+	if err := payload.Validate(); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, err)
+		return
+	}
+
+	_, err := h.client.MergeTags(r.Context(), &gen.MergeTagsRequest{
+		Tags:   payload.Tags,
+		NewTag: payload.NewTag,
+		UserId: jwtPayload.Id.String(),
+	})
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	log.LogHandlerInfo(logger, http.StatusNoContent, "success")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *NoteHandler) SetTagInfo(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var payload models.TagInfoRequest
+	if err := responses.GetRequestData(r, &payload); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, responses.ParseBodyError+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("incorrect data format"))
+		return
+	}
+	payload.Sanitize()
+	if err := payload.Validate(); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, err)
+		return
+	}
+
+	_, err := h.client.SetTagInfo(r.Context(), &gen.TagInfoRequest{
+		UserId: jwtPayload.Id.String(),
+		Tag: &gen.TagModel{
+			Name:        payload.TagName,
+			Color:       payload.Color,
+			Emoji:       payload.Emoji,
+			Description: payload.Description,
+		},
+	})
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	log.LogHandlerInfo(logger, http.StatusNoContent, "success")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// SetPublic - устаревший эндпоинт, выдает открытую ссылку доступа; вместо него используются /note/{id}/share_links
+func (h *NoteHandler) SetPublic(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	w.Header().Set("Deprecation", "true")
+	w.Header().Set("Link", "</api/note/"+mux.Vars(r)["id"]+"/share_links>; rel=\"successor-version\"")
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	noteIdString := mux.Vars(r)["id"]
+	_, err := uuid.FromString(noteIdString)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
+		return
+	}
+
+	currentNote, err := h.client.SetPublic(r.Context(), &gen.AccessModeRequest{
+		NoteId: noteIdString,
+		UserId: jwtPayload.Id.String(),
+	})
+	if err != nil {
+		if isForbidden(err) {
+			log.LogHandlerError(logger, http.StatusForbidden, err.Error())
+			responses.WriteErrorMessage(w, http.StatusForbidden, errors.New(note.ErrNotEnoughRights))
+			return
+		}
+
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	resultNote, err := getNote(currentNote.Note)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if err := responses.WriteResponseData(w, resultNote, http.StatusOK); err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	log.LogHandlerInfo(logger, http.StatusOK, "success")
+}
+
+func (h *NoteHandler) CreateShareLink(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	noteIdString := mux.Vars(r)["id"]
+	if _, err := uuid.FromString(noteIdString); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
+		return
+	}
+
+	payload := models.CreateShareLinkRequest{}
+	if err := responses.GetRequestData(r, &payload); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, responses.ParseBodyError+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("incorrect data format"))
+		return
+	}
+
+	if err := payload.Validate(); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, err)
+		return
+	}
+
+	expiresAt := ""
+	if payload.ExpiresAt != nil {
+		expiresAt = payload.ExpiresAt.UTC().String()
+	}
+
+	result, err := h.client.CreateShareLink(r.Context(), &gen.CreateShareLinkRequest{
+		NoteId:         noteIdString,
+		UserId:         jwtPayload.Id.String(),
+		Password:       payload.Password,
+		ExpiresAt:      expiresAt,
+		IncludeSubtree: payload.IncludeSubtree,
+	})
+	if err != nil {
+		if isForbidden(err) {
+			log.LogHandlerError(logger, http.StatusForbidden, err.Error())
+			responses.WriteErrorMessage(w, http.StatusForbidden, errors.New(note.ErrNotEnoughRights))
+			return
+		}
+
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("note not found"))
+		return
+	}
+
+	link, err := getShareLink(result.Link)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if err := responses.WriteResponseData(w, link, http.StatusOK); err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	log.LogHandlerInfo(logger, http.StatusOK, "success")
+}
+
+func (h *NoteHandler) GetShareLinks(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	noteIdString := mux.Vars(r)["id"]
+	if _, err := uuid.FromString(noteIdString); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
+		return
+	}
+
+	result, err := h.client.GetShareLinks(r.Context(), &gen.GetShareLinksRequest{
+		NoteId: noteIdString,
+		UserId: jwtPayload.Id.String(),
+	})
+	if err != nil {
+		if isForbidden(err) {
+			log.LogHandlerError(logger, http.StatusForbidden, err.Error())
+			responses.WriteErrorMessage(w, http.StatusForbidden, errors.New(note.ErrNotEnoughRights))
+			return
+		}
+
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("note not found"))
+		return
+	}
+
+	data := make([]models.ShareLink, len(result.Links))
+	for i, protoLink := range result.Links {
+		data[i], err = getShareLink(protoLink)
+		if err != nil {
+			log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+
+	if err := responses.WriteResponseData(w, data, http.StatusOK); err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	log.LogHandlerInfo(logger, http.StatusOK, "success")
+}
+
+func (h *NoteHandler) RevokeShareLink(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	noteIdString := mux.Vars(r)["id"]
+	if _, err := uuid.FromString(noteIdString); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
+		return
+	}
+
+	linkIdString := mux.Vars(r)["link_id"]
+	if _, err := uuid.FromString(linkIdString); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("link id must be a type of uuid"))
+		return
+	}
+
+	_, err := h.client.RevokeShareLink(r.Context(), &gen.RevokeShareLinkRequest{
+		NoteId: noteIdString,
+		LinkId: linkIdString,
+		UserId: jwtPayload.Id.String(),
+	})
+	if err != nil {
+		if isForbidden(err) {
+			log.LogHandlerError(logger, http.StatusForbidden, err.Error())
+			responses.WriteErrorMessage(w, http.StatusForbidden, errors.New(note.ErrNotEnoughRights))
+			return
+		}
+
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	log.LogHandlerInfo(logger, http.StatusNoContent, "success")
+}
+
+func (h *NoteHandler) GetComments(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	noteIdString := mux.Vars(r)["id"]
+	if _, err := uuid.FromString(noteIdString); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
+		return
+	}
+
+	result, err := h.client.GetComments(r.Context(), &gen.GetCommentsRequest{
+		NoteId: noteIdString,
+		UserId: jwtPayload.Id.String(),
+	})
+	if err != nil {
+		if isForbidden(err) {
+			log.LogHandlerError(logger, http.StatusForbidden, err.Error())
+			responses.WriteErrorMessage(w, http.StatusForbidden, errors.New(note.ErrNotEnoughRights))
+			return
+		}
+
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("note not found"))
+		return
+	}
+
+	comments := make([]models.Comment, len(result.Comments))
+	for i, item := range result.Comments {
+		comment, err := getComment(item)
+		if err != nil {
+			log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		comments[i] = comment
+	}
+
+	if err := responses.WriteResponseData(w, comments, http.StatusOK); err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	log.LogHandlerInfo(logger, http.StatusOK, "success")
+}
+
+func (h *NoteHandler) AddComment(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	noteIdString := mux.Vars(r)["id"]
+	if _, err := uuid.FromString(noteIdString); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
+		return
+	}
+
+	payload := models.AddCommentRequest{}
+	if err := responses.GetRequestData(r, &payload); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, responses.ParseBodyError+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("incorrect data format"))
+		return
+	}
+
+	if err := payload.Validate(); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, err)
+		return
+	}
+	payload.Sanitize()
+
+	result, err := h.client.AddComment(r.Context(), &gen.AddCommentRequest{
+		NoteId:   noteIdString,
+		UserId:   jwtPayload.Id.String(),
+		ParentId: payload.ParentId.String(),
+		BlockId:  payload.BlockId,
+		Text:     payload.Text,
+	})
+	if err != nil {
+		if isForbidden(err) {
+			log.LogHandlerError(logger, http.StatusForbidden, err.Error())
+			responses.WriteErrorMessage(w, http.StatusForbidden, errors.New(note.ErrNotEnoughRights))
+			return
+		}
+
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+
+	comment, err := getComment(result.Comment)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	h.hub.BroadcastComment(r.Context(), models.CommentMessage{
+		Type:    "comment_added",
+		NoteId:  comment.NoteId,
+		Comment: comment,
+	})
+
+	if err := responses.WriteResponseData(w, comment, http.StatusOK); err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	log.LogHandlerInfo(logger, http.StatusOK, "success")
+}
+
+func (h *NoteHandler) UpdateComment(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	noteIdString := mux.Vars(r)["id"]
+	if _, err := uuid.FromString(noteIdString); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
+		return
+	}
+
+	commentIdString := mux.Vars(r)["comment_id"]
+	if _, err := uuid.FromString(commentIdString); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("comment id must be a type of uuid"))
+		return
+	}
+
+	payload := models.UpdateCommentRequest{}
+	if err := responses.GetRequestData(r, &payload); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, responses.ParseBodyError+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("incorrect data format"))
+		return
+	}
+
+	if err := payload.Validate(); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, err)
+		return
+	}
+	payload.Sanitize()
+
+	result, err := h.client.UpdateComment(r.Context(), &gen.UpdateCommentRequest{
+		NoteId:    noteIdString,
+		CommentId: commentIdString,
+		UserId:    jwtPayload.Id.String(),
+		Text:      payload.Text,
+	})
+	if err != nil {
+		if isForbidden(err) {
+			log.LogHandlerError(logger, http.StatusForbidden, err.Error())
+			responses.WriteErrorMessage(w, http.StatusForbidden, errors.New(note.ErrNotEnoughRights))
+			return
+		}
+
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+
+	comment, err := getComment(result.Comment)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	h.hub.BroadcastComment(r.Context(), models.CommentMessage{
+		Type:    "comment_updated",
+		NoteId:  comment.NoteId,
+		Comment: comment,
+	})
+
+	if err := responses.WriteResponseData(w, comment, http.StatusOK); err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	log.LogHandlerInfo(logger, http.StatusOK, "success")
+}
+
+func (h *NoteHandler) DeleteComment(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	noteIdString := mux.Vars(r)["id"]
+	if _, err := uuid.FromString(noteIdString); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
+		return
+	}
+
+	commentIdString := mux.Vars(r)["comment_id"]
+	if _, err := uuid.FromString(commentIdString); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("comment id must be a type of uuid"))
+		return
+	}
+
+	_, err := h.client.DeleteComment(r.Context(), &gen.CommentRequest{
+		NoteId:    noteIdString,
+		CommentId: commentIdString,
+		UserId:    jwtPayload.Id.String(),
+	})
+	if err != nil {
+		if isForbidden(err) {
+			log.LogHandlerError(logger, http.StatusForbidden, err.Error())
+			responses.WriteErrorMessage(w, http.StatusForbidden, errors.New(note.ErrNotEnoughRights))
+			return
+		}
+
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+
+	h.hub.BroadcastComment(r.Context(), models.CommentMessage{
+		Type:   "comment_deleted",
+		NoteId: uuid.FromStringOrNil(noteIdString),
+		Comment: models.Comment{
+			Id:     uuid.FromStringOrNil(commentIdString),
+			NoteId: uuid.FromStringOrNil(noteIdString),
+		},
+	})
+
+	w.WriteHeader(http.StatusNoContent)
+	log.LogHandlerInfo(logger, http.StatusNoContent, "success")
+}
+
+func (h *NoteHandler) ResolveComment(w http.ResponseWriter, r *http.Request) {
+	h.setCommentResolved(w, r, true)
+}
+
+func (h *NoteHandler) UnresolveComment(w http.ResponseWriter, r *http.Request) {
+	h.setCommentResolved(w, r, false)
+}
+
+func (h *NoteHandler) setCommentResolved(w http.ResponseWriter, r *http.Request, resolved bool) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	noteIdString := mux.Vars(r)["id"]
+	if _, err := uuid.FromString(noteIdString); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
+		return
+	}
+
+	commentIdString := mux.Vars(r)["comment_id"]
+	if _, err := uuid.FromString(commentIdString); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("comment id must be a type of uuid"))
+		return
+	}
+
+	result, err := h.client.SetCommentResolved(r.Context(), &gen.SetCommentResolvedRequest{
+		NoteId:    noteIdString,
+		CommentId: commentIdString,
+		UserId:    jwtPayload.Id.String(),
+		Resolved:  resolved,
+	})
+	if err != nil {
+		if isForbidden(err) {
+			log.LogHandlerError(logger, http.StatusForbidden, err.Error())
+			responses.WriteErrorMessage(w, http.StatusForbidden, errors.New(note.ErrNotEnoughRights))
+			return
+		}
+
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+
+	comment, err := getComment(result.Comment)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	messageType := "comment_unresolved"
+	if resolved {
+		messageType = "comment_resolved"
+	}
+	h.hub.BroadcastComment(r.Context(), models.CommentMessage{
+		Type:    messageType,
+		NoteId:  comment.NoteId,
+		Comment: comment,
+	})
+
+	if err := responses.WriteResponseData(w, comment, http.StatusOK); err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	log.LogHandlerInfo(logger, http.StatusOK, "success")
+}
+
+func (h *NoteHandler) AddReminder(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	noteIdString := mux.Vars(r)["id"]
+	if _, err := uuid.FromString(noteIdString); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
+		return
+	}
+
+	payload := models.AddReminderRequest{}
+	if err := responses.GetRequestData(r, &payload); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, responses.ParseBodyError+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("incorrect data format"))
+		return
+	}
+
+	if err := payload.Validate(); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, err)
+		return
+	}
+
+	result, err := h.client.AddReminder(r.Context(), &gen.AddReminderRequest{
+		NoteId:   noteIdString,
+		UserId:   jwtPayload.Id.String(),
+		RemindAt: payload.RemindAt.UTC().String(),
+		Repeat:   payload.Repeat,
+	})
+	if err != nil {
+		if isForbidden(err) {
+			log.LogHandlerError(logger, http.StatusForbidden, err.Error())
+			responses.WriteErrorMessage(w, http.StatusForbidden, errors.New(note.ErrNotEnoughRights))
+			return
+		}
+
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("note not found"))
+		return
+	}
+
+	reminder, err := getReminder(result.Reminder)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if err := responses.WriteResponseData(w, reminder, http.StatusOK); err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	log.LogHandlerInfo(logger, http.StatusOK, "success")
+}
+
+func (h *NoteHandler) GetReminders(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	noteIdString := mux.Vars(r)["id"]
+	if _, err := uuid.FromString(noteIdString); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
+		return
+	}
+
+	result, err := h.client.GetReminders(r.Context(), &gen.GetRemindersRequest{
+		NoteId: noteIdString,
+		UserId: jwtPayload.Id.String(),
+	})
+	if err != nil {
+		if isForbidden(err) {
+			log.LogHandlerError(logger, http.StatusForbidden, err.Error())
+			responses.WriteErrorMessage(w, http.StatusForbidden, errors.New(note.ErrNotEnoughRights))
+			return
+		}
+
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("note not found"))
+		return
+	}
+
+	reminders, err := getReminders(result.Reminders)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if err := responses.WriteResponseData(w, reminders, http.StatusOK); err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	log.LogHandlerInfo(logger, http.StatusOK, "success")
+}
+
+func (h *NoteHandler) GetUpcomingReminders(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	count, offset, err := paging.GetParams(r)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("invalid parameters"))
+		return
+	}
+
+	result, err := h.client.GetUpcomingReminders(r.Context(), &gen.GetUpcomingRemindersRequest{
+		UserId: jwtPayload.Id.String(),
+		Count:  int64(count),
+		Offset: int64(offset),
+	})
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	reminders, err := getReminders(result.Reminders)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if err := responses.WriteResponseData(w, reminders, http.StatusOK); err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	log.LogHandlerInfo(logger, http.StatusOK, "success")
+}
+
+func (h *NoteHandler) DeleteReminder(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	reminderIdString := mux.Vars(r)["id"]
+	if _, err := uuid.FromString(reminderIdString); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("reminder id must be a type of uuid"))
+		return
+	}
+
+	_, err := h.client.DeleteReminder(r.Context(), &gen.ReminderRequest{
+		ReminderId: reminderIdString,
+		UserId:     jwtPayload.Id.String(),
+	})
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	log.LogHandlerInfo(logger, http.StatusNoContent, "success")
+}
+
+func (h *NoteHandler) AcknowledgeReminder(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	reminderIdString := mux.Vars(r)["id"]
+	if _, err := uuid.FromString(reminderIdString); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("reminder id must be a type of uuid"))
+		return
+	}
+
+	_, err := h.client.AcknowledgeReminder(r.Context(), &gen.ReminderRequest{
+		ReminderId: reminderIdString,
+		UserId:     jwtPayload.Id.String(),
+	})
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	log.LogHandlerInfo(logger, http.StatusNoContent, "success")
+}
+
+// SetPrivate - устаревший эндпоинт, отзывает все ссылки доступа; вместо него используются /note/{id}/share_links
+func (h *NoteHandler) SetPrivate(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	w.Header().Set("Deprecation", "true")
+	w.Header().Set("Link", "</api/note/"+mux.Vars(r)["id"]+"/share_links>; rel=\"successor-version\"")
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	noteIdString := mux.Vars(r)["id"]
+	_, err := uuid.FromString(noteIdString)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
+		return
+	}
+
+	currentNote, err := h.client.SetPrivate(r.Context(), &gen.AccessModeRequest{
+		NoteId: noteIdString,
+		UserId: jwtPayload.Id.String(),
+	})
+	if err != nil {
+		if isForbidden(err) {
+			log.LogHandlerError(logger, http.StatusForbidden, err.Error())
+			responses.WriteErrorMessage(w, http.StatusForbidden, errors.New(note.ErrNotEnoughRights))
+			return
+		}
+
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	resultNote, err := getNote(currentNote.Note)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if err := responses.WriteResponseData(w, resultNote, http.StatusOK); err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	log.LogHandlerInfo(logger, http.StatusOK, "success")
+}
+
+func (h *NoteHandler) ExportToPDF(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	payload, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrReadBody+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("can`t read request body"))
+		return
+	}
+
+	resultPDF, noteTitle, _, _, err := exportpdf.GeneratePDF(string(payload))
+	if err != nil {
+		if err.Error() == ErrInputHTML {
+			log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+			responses.WriteErrorMessage(w, http.StatusBadRequest, err)
+			return
+		}
+
+		if err.Error() == ErrParsingHTML {
+			log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
+			responses.WriteErrorMessage(w, http.StatusInternalServerError, err)
+			return
+		}
+
+		log.LogHandlerInfo(logger, http.StatusUnavailableForLegalReasons, err.Error())
+		responses.WriteErrorMessage(w, http.StatusUnavailableForLegalReasons, errors.New("прости, но эта заметка слишком сложная для конвертации в PDF. Мы усиленно работаем, чтобы решить эту проблему"))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s.pdf", noteTitle))
+	_, _ = w.Write(resultPDF)
+	w.WriteHeader(http.StatusOK)
+	log.LogHandlerInfo(logger, http.StatusOK, "success")
+}
+
+func (h *NoteHandler) ExportZip(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	noteIdString := mux.Vars(r)["id"]
+	_, err := uuid.FromString(noteIdString)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
+		return
+	}
+
+	payload, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrReadBody+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("can`t read request body"))
+		return
+	}
+
+	resultPDF, noteTitle, picturesOrder, filenames, err := exportpdf.GeneratePDF(string(payload))
+	if err != nil {
+		if err.Error() == ErrInputHTML {
+			log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+			responses.WriteErrorMessage(w, http.StatusBadRequest, err)
+			return
+		}
+
+		if err.Error() == ErrParsingHTML {
+			log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
+			responses.WriteErrorMessage(w, http.StatusInternalServerError, err)
+			return
+		}
+
+		log.LogHandlerInfo(logger, http.StatusUnavailableForLegalReasons, err.Error())
+		responses.WriteErrorMessage(w, http.StatusUnavailableForLegalReasons, errors.New("прости, но эта заметка слишком сложная для конвертации в PDF. Мы усиленно работаем, чтобы решить эту проблему"))
+		return
+	}
+
+	result, _ := h.client.GetAttachList(r.Context(), &gen.GetAttachListRequest{
 		NoteId: noteIdString,
-		Header: headerRequest.Header,
 		UserId: jwtPayload.Id.String(),
 	})
+
+	YouNoteZip, err := zipper.CreateYouNoteZip(noteTitle+".pdf", resultPDF, result.Paths, jwtPayload.Username, picturesOrder, filenames)
+	if err != nil {
+		log.LogHandlerInfo(logger, http.StatusInternalServerError, err.Error())
+		responses.WriteErrorMessage(w, http.StatusInternalServerError, errors.New("ха-ха, разработчик не смог создать zip-архив"))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s.zip", noteTitle))
+	_, _ = w.Write(YouNoteZip.Bytes())
+	w.WriteHeader(http.StatusOK)
+	log.LogHandlerInfo(logger, http.StatusOK, "success")
+}
+
+func (h *NoteHandler) ExportZipShared(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	noteIdString := mux.Vars(r)["id"]
+	_, err := uuid.FromString(noteIdString)
 	if err != nil {
 		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
-		w.WriteHeader(http.StatusBadRequest)
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
+		return
+	}
+
+	result, err := h.client.GetSharedAttachList(r.Context(), &gen.GetSharedAttachListRequest{
+		NoteId:   noteIdString,
+		Token:    r.URL.Query().Get("token"),
+		Password: r.Header.Get("X-Share-Password"),
+	})
+	if err != nil {
+		status := getShareLinkErrorStatus(err)
+		log.LogHandlerError(logger, status, err.Error())
+		responses.WriteErrorMessage(w, status, errors.New(strings.TrimPrefix(err.Error(), RpcErrorPrefix)))
+		return
+	}
+
+	payload, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrReadBody+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("can`t read request body"))
+		return
+	}
+
+	resultPDF, noteTitle, picturesOrder, filenames, err := exportpdf.GeneratePDF(string(payload))
+	if err != nil {
+		if err.Error() == ErrInputHTML {
+			log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+			responses.WriteErrorMessage(w, http.StatusBadRequest, err)
+			return
+		}
+
+		if err.Error() == ErrParsingHTML {
+			log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
+			responses.WriteErrorMessage(w, http.StatusInternalServerError, err)
+			return
+		}
+
+		log.LogHandlerInfo(logger, http.StatusUnavailableForLegalReasons, err.Error())
+		responses.WriteErrorMessage(w, http.StatusUnavailableForLegalReasons, errors.New("прости, но эта заметка слишком сложная для конвертации в PDF. Мы усиленно работаем, чтобы решить эту проблему"))
+		return
+	}
+
+	YouNoteZip, err := zipper.CreateYouNoteZip(noteTitle+".pdf", resultPDF, result.Paths, "anonymous user", picturesOrder, filenames)
+	if err != nil {
+		log.LogHandlerInfo(logger, http.StatusInternalServerError, err.Error())
+		responses.WriteErrorMessage(w, http.StatusInternalServerError, errors.New("ха-ха, разработчик не смог создать zip-архив"))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s.zip", noteTitle))
+	_, _ = w.Write(YouNoteZip.Bytes())
+	w.WriteHeader(http.StatusOK)
+	log.LogHandlerInfo(logger, http.StatusOK, "success")
+}
+
+func (h *NoteHandler) GetRevisions(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	noteIdString := mux.Vars(r)["id"]
+	_, err := uuid.FromString(noteIdString)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
+		return
+	}
+
+	count, offset, err := paging.GetParams(r)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("invalid parameters"))
+		return
+	}
+
+	protoData, err := h.client.GetRevisions(r.Context(), &gen.GetRevisionsRequest{
+		NoteId: noteIdString,
+		UserId: jwtPayload.Id.String(),
+		Count:  int64(count),
+		Offset: int64(offset),
+	})
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("note not found"))
+		return
+	}
+
+	data := make([]models.NoteRevision, len(protoData.Revisions))
+	for i, protoRevision := range protoData.Revisions {
+		data[i], err = getRevision(protoRevision)
+		if err != nil {
+			log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+
+	if err := responses.WriteResponseData(w, data, http.StatusOK); err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	log.LogHandlerInfo(logger, http.StatusOK, "success")
+}
+
+func (h *NoteHandler) GetRevision(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	noteIdString := mux.Vars(r)["id"]
+	_, err := uuid.FromString(noteIdString)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
+		return
+	}
+
+	revisionIdString := mux.Vars(r)["revision_id"]
+	_, err = uuid.FromString(revisionIdString)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("revision id must be a type of uuid"))
+		return
+	}
+
+	protoRevision, err := h.client.GetRevision(r.Context(), &gen.RevisionRequest{
+		NoteId:     noteIdString,
+		RevisionId: revisionIdString,
+		UserId:     jwtPayload.Id.String(),
+	})
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("revision not found"))
+		return
+	}
+
+	result, err := getRevision(protoRevision.Revision)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if err := responses.WriteResponseData(w, result, http.StatusOK); err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	log.LogHandlerInfo(logger, http.StatusOK, "success")
+}
+
+func (h *NoteHandler) GetRevisionDiff(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	noteIdString := mux.Vars(r)["id"]
+	_, err := uuid.FromString(noteIdString)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
+		return
+	}
+
+	fromIdString := r.URL.Query().Get("from")
+	_, err = uuid.FromString(fromIdString)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("revision id must be a type of uuid"))
+		return
+	}
+
+	toIdString := r.URL.Query().Get("to")
+	_, err = uuid.FromString(toIdString)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("revision id must be a type of uuid"))
+		return
+	}
+
+	protoDiff, err := h.client.GetRevisionDiff(r.Context(), &gen.GetRevisionDiffRequest{
+		NoteId: noteIdString,
+		FromId: fromIdString,
+		ToId:   toIdString,
+		UserId: jwtPayload.Id.String(),
+	})
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("revision not found"))
+		return
+	}
+
+	blocks := make([]models.BlockChange, len(protoDiff.Blocks))
+	for i, block := range protoDiff.Blocks {
+		blocks[i] = models.BlockChange{
+			Type:  block.Type,
+			Block: block.Block,
+		}
+	}
+
+	result := models.RevisionDiff{
+		From:     uuid.FromStringOrNil(protoDiff.From),
+		To:       uuid.FromStringOrNil(protoDiff.To),
+		OldTitle: protoDiff.OldTitle,
+		NewTitle: protoDiff.NewTitle,
+		Blocks:   blocks,
+	}
+
+	if err := responses.WriteResponseData(w, result, http.StatusOK); err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	log.LogHandlerInfo(logger, http.StatusOK, "success")
+}
+
+func (h *NoteHandler) RestoreRevision(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
+
+	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
+	if !ok {
+		log.LogHandlerError(logger, http.StatusUnauthorized, responses.JwtPayloadParseError)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	noteIdString := mux.Vars(r)["id"]
+	_, err := uuid.FromString(noteIdString)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
+		return
+	}
+
+	revisionIdString := mux.Vars(r)["revision_id"]
+	_, err = uuid.FromString(revisionIdString)
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("revision id must be a type of uuid"))
+		return
+	}
+
+	protoNote, err := h.client.RestoreRevision(r.Context(), &gen.RevisionRequest{
+		NoteId:     noteIdString,
+		RevisionId: revisionIdString,
+		UserId:     jwtPayload.Id.String(),
+	})
+	if err != nil {
+		if isForbidden(err) {
+			log.LogHandlerError(logger, http.StatusForbidden, err.Error())
+			responses.WriteErrorMessage(w, http.StatusForbidden, errors.New(note.ErrNotEnoughRights))
+			return
+		}
+
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("revision not found"))
 		return
 	}
 
-	resultNote, err := getNote(currentNote.Note)
+	resultNote, err := getNote(protoNote.Note)
 	if err != nil {
 		log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
 		w.WriteHeader(http.StatusInternalServerError)
@@ -1045,10 +3718,39 @@ func (h *NoteHandler) SetHeader(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	h.hub.WriteToCache(r.Context(), models.CacheMessage{
+		Type:        "updated",
+		NoteId:      resultNote.Id,
+		Username:    jwtPayload.Username,
+		Created:     time.Now().UTC(),
+		MessageInfo: resultNote.Data,
+	})
+
 	log.LogHandlerInfo(logger, http.StatusOK, "success")
 }
 
-func (h *NoteHandler) AddFavorite(w http.ResponseWriter, r *http.Request) {
+func getTrashedNote(note *gen.TrashedNoteModel) (models.TrashedNote, error) {
+	if note == nil {
+		return models.TrashedNote{}, errors.New("not found")
+	}
+
+	resultNote, err := getNote(note.Note)
+	if err != nil {
+		return models.TrashedNote{}, err
+	}
+
+	deletedAt, err := time.Parse(TimeLayout, note.DeletedAt)
+	if err != nil {
+		return models.TrashedNote{}, err
+	}
+
+	return models.TrashedNote{
+		Note:      resultNote,
+		DeletedAt: deletedAt,
+	}, nil
+}
+
+func (h *NoteHandler) GetTrash(w http.ResponseWriter, r *http.Request) {
 	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
 
 	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
@@ -1058,33 +3760,35 @@ func (h *NoteHandler) AddFavorite(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	noteIdString := mux.Vars(r)["id"]
-	_, err := uuid.FromString(noteIdString)
+	count, offset, err := paging.GetParams(r)
 	if err != nil {
 		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
-		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("invalid parameters"))
 		return
 	}
 
-	protoNote, err := h.client.AddFav(r.Context(), &gen.ChangeFlagRequest{
-
-		NoteId: noteIdString,
+	protoData, err := h.client.GetTrash(r.Context(), &gen.GetTrashRequest{
 		UserId: jwtPayload.Id.String(),
+		Count:  int64(count),
+		Offset: int64(offset),
 	})
-	if err != nil {
-		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
-		w.WriteHeader(http.StatusBadRequest)
-		return
-	}
-
-	resultNote, err := getNote(protoNote.Note)
 	if err != nil {
 		log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
 
-	if err := responses.WriteResponseData(w, resultNote, http.StatusOK); err != nil {
+	data := make([]models.TrashedNote, len(protoData.Notes))
+	for i, protoNote := range protoData.Notes {
+		data[i], err = getTrashedNote(protoNote)
+		if err != nil {
+			log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+
+	if err := responses.WriteResponseData(w, data, http.StatusOK); err != nil {
 		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
 		w.WriteHeader(http.StatusInternalServerError)
 		return
@@ -1093,7 +3797,7 @@ func (h *NoteHandler) AddFavorite(w http.ResponseWriter, r *http.Request) {
 	log.LogHandlerInfo(logger, http.StatusOK, "success")
 }
 
-func (h *NoteHandler) DeleteFavorite(w http.ResponseWriter, r *http.Request) {
+func (h *NoteHandler) RestoreNote(w http.ResponseWriter, r *http.Request) {
 	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
 
 	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
@@ -1106,18 +3810,18 @@ func (h *NoteHandler) DeleteFavorite(w http.ResponseWriter, r *http.Request) {
 	noteIdString := mux.Vars(r)["id"]
 	_, err := uuid.FromString(noteIdString)
 	if err != nil {
-		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
 		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
 		return
 	}
 
-	protoNote, err := h.client.DelFav(r.Context(), &gen.ChangeFlagRequest{
+	protoNote, err := h.client.RestoreNote(r.Context(), &gen.TrashNoteRequest{
 		NoteId: noteIdString,
 		UserId: jwtPayload.Id.String(),
 	})
 	if err != nil {
-		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
-		w.WriteHeader(http.StatusBadRequest)
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("note not found"))
 		return
 	}
 
@@ -1137,7 +3841,7 @@ func (h *NoteHandler) DeleteFavorite(w http.ResponseWriter, r *http.Request) {
 	log.LogHandlerInfo(logger, http.StatusOK, "success")
 }
 
-func (h *NoteHandler) GetTags(w http.ResponseWriter, r *http.Request) {
+func (h *NoteHandler) DeleteNotePermanently(w http.ResponseWriter, r *http.Request) {
 	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
 
 	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
@@ -1147,28 +3851,29 @@ func (h *NoteHandler) GetTags(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	result, err := h.client.GetTags(r.Context(), &gen.GetTagsRequest{
-		UserId: jwtPayload.Id.String(),
-	})
+	noteIdString := mux.Vars(r)["id"]
+	_, err := uuid.FromString(noteIdString)
 	if err != nil {
-		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
-		w.WriteHeader(http.StatusBadRequest)
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
 		return
 	}
 
-	tags := make([]string, len(result.Tags))
-	copy(tags, result.Tags)
-	response := models.GetTagsResponse{Tags: tags}
-	if err := responses.WriteResponseData(w, response, http.StatusOK); err != nil {
-		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
-		w.WriteHeader(http.StatusInternalServerError)
+	_, err = h.client.DeleteNotePermanently(r.Context(), &gen.TrashNoteRequest{
+		NoteId: noteIdString,
+		UserId: jwtPayload.Id.String(),
+	})
+	if err != nil {
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("note not found"))
 		return
 	}
 
-	log.LogHandlerInfo(logger, http.StatusOK, "success")
+	w.WriteHeader(http.StatusNoContent)
+	log.LogHandlerInfo(logger, http.StatusNoContent, "success")
 }
 
-func (h *NoteHandler) SetPublic(w http.ResponseWriter, r *http.Request) {
+func (h *NoteHandler) setTemplate(w http.ResponseWriter, r *http.Request, isTemplate bool) {
 	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
 
 	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
@@ -1181,18 +3886,19 @@ func (h *NoteHandler) SetPublic(w http.ResponseWriter, r *http.Request) {
 	noteIdString := mux.Vars(r)["id"]
 	_, err := uuid.FromString(noteIdString)
 	if err != nil {
-		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
 		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
 		return
 	}
 
-	currentNote, err := h.client.SetPublic(r.Context(), &gen.AccessModeRequest{
-		NoteId: noteIdString,
-		UserId: jwtPayload.Id.String(),
+	currentNote, err := h.client.SetTemplate(r.Context(), &gen.SetTemplateRequest{
+		NoteId:   noteIdString,
+		UserId:   jwtPayload.Id.String(),
+		Template: isTemplate,
 	})
 	if err != nil {
-		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
-		w.WriteHeader(http.StatusBadRequest)
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("note not found"))
 		return
 	}
 
@@ -1212,7 +3918,15 @@ func (h *NoteHandler) SetPublic(w http.ResponseWriter, r *http.Request) {
 	log.LogHandlerInfo(logger, http.StatusOK, "success")
 }
 
-func (h *NoteHandler) SetPrivate(w http.ResponseWriter, r *http.Request) {
+func (h *NoteHandler) SetTemplate(w http.ResponseWriter, r *http.Request) {
+	h.setTemplate(w, r, true)
+}
+
+func (h *NoteHandler) UnsetTemplate(w http.ResponseWriter, r *http.Request) {
+	h.setTemplate(w, r, false)
+}
+
+func (h *NoteHandler) GetTemplates(w http.ResponseWriter, r *http.Request) {
 	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
 
 	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
@@ -1222,77 +3936,34 @@ func (h *NoteHandler) SetPrivate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	noteIdString := mux.Vars(r)["id"]
-	_, err := uuid.FromString(noteIdString)
-	if err != nil {
-		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
-		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
-		return
-	}
-
-	currentNote, err := h.client.SetPrivate(r.Context(), &gen.AccessModeRequest{
-		NoteId: noteIdString,
+	protoData, err := h.client.GetTemplates(r.Context(), &gen.GetTemplatesRequest{
 		UserId: jwtPayload.Id.String(),
 	})
-	if err != nil {
-		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
-		w.WriteHeader(http.StatusBadRequest)
-		return
-	}
-
-	resultNote, err := getNote(currentNote.Note)
 	if err != nil {
 		log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
 
-	if err := responses.WriteResponseData(w, resultNote, http.StatusOK); err != nil {
-		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
-		w.WriteHeader(http.StatusInternalServerError)
-		return
-	}
-
-	log.LogHandlerInfo(logger, http.StatusOK, "success")
-}
-
-func (h *NoteHandler) ExportToPDF(w http.ResponseWriter, r *http.Request) {
-	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
-
-	payload, err := io.ReadAll(r.Body)
-	if err != nil {
-		log.LogHandlerError(logger, http.StatusBadRequest, ErrReadBody+err.Error())
-		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("can`t read request body"))
-		return
-	}
-
-	resultPDF, noteTitle, _, _, err := exportpdf.GeneratePDF(string(payload))
-	if err != nil {
-		if err.Error() == ErrInputHTML {
-			log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
-			responses.WriteErrorMessage(w, http.StatusBadRequest, err)
-			return
-		}
-
-		if err.Error() == ErrParsingHTML {
-			log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
-			responses.WriteErrorMessage(w, http.StatusInternalServerError, err)
-			return
+	data := make([]models.Template, len(protoData.Templates))
+	for i, protoTemplate := range protoData.Templates {
+		data[i] = models.Template{
+			Id:     uuid.FromStringOrNil(protoTemplate.Id),
+			Data:   protoTemplate.Data,
+			System: protoTemplate.System,
 		}
+	}
 
-		log.LogHandlerInfo(logger, http.StatusUnavailableForLegalReasons, err.Error())
-		responses.WriteErrorMessage(w, http.StatusUnavailableForLegalReasons, errors.New("прости, но эта заметка слишком сложная для конвертации в PDF. Мы усиленно работаем, чтобы решить эту проблему"))
+	if err := responses.WriteResponseData(w, data, http.StatusOK); err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/pdf")
-	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s.pdf", noteTitle))
-	_, _ = w.Write(resultPDF)
-	w.WriteHeader(http.StatusOK)
 	log.LogHandlerInfo(logger, http.StatusOK, "success")
 }
 
-func (h *NoteHandler) ExportZip(w http.ResponseWriter, r *http.Request) {
+func (h *NoteHandler) CreateFromTemplate(w http.ResponseWriter, r *http.Request) {
 	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
 
 	jwtPayload, ok := r.Context().Value(config.PayloadContextKey).(models.JwtPayload)
@@ -1302,110 +3973,63 @@ func (h *NoteHandler) ExportZip(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	noteIdString := mux.Vars(r)["id"]
-	_, err := uuid.FromString(noteIdString)
+	templateIdString := mux.Vars(r)["id"]
+	_, err := uuid.FromString(templateIdString)
 	if err != nil {
-		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
-		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
+		log.LogHandlerError(logger, http.StatusBadRequest, ErrIncorrectId+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("template id must be a type of uuid"))
 		return
 	}
 
-	payload, err := io.ReadAll(r.Body)
-	if err != nil {
-		log.LogHandlerError(logger, http.StatusBadRequest, ErrReadBody+err.Error())
-		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("can`t read request body"))
+	payload := models.CreateFromTemplateRequest{}
+	if err := responses.GetRequestData(r, &payload); err != nil {
+		log.LogHandlerError(logger, http.StatusBadRequest, responses.ParseBodyError+err.Error())
+		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("incorrect data format"))
 		return
 	}
 
-	resultPDF, noteTitle, picturesOrder, filenames, err := exportpdf.GeneratePDF(string(payload))
+	createdNote, err := h.client.CreateFromTemplate(r.Context(), &gen.CreateFromTemplateRequest{
+		TemplateId: templateIdString,
+		ParentId:   payload.Parent.String(),
+		UserId:     jwtPayload.Id.String(),
+		Username:   jwtPayload.Username,
+	})
 	if err != nil {
-		if err.Error() == ErrInputHTML {
-			log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
-			responses.WriteErrorMessage(w, http.StatusBadRequest, err)
+		if isForbidden(err) {
+			log.LogHandlerError(logger, http.StatusForbidden, err.Error())
+			responses.WriteErrorMessage(w, http.StatusForbidden, errors.New(note.ErrNotEnoughRights))
 			return
 		}
 
-		if err.Error() == ErrParsingHTML {
-			log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
-			responses.WriteErrorMessage(w, http.StatusInternalServerError, err)
+		if err.Error()[len(RpcErrorPrefix):] == note.ErrTooManySubnotes {
+			log.LogHandlerError(logger, http.StatusConflict, err.Error())
+			responses.WriteErrorMessage(w, http.StatusConflict, err)
 			return
 		}
 
-		log.LogHandlerInfo(logger, http.StatusUnavailableForLegalReasons, err.Error())
-		responses.WriteErrorMessage(w, http.StatusUnavailableForLegalReasons, errors.New("прости, но эта заметка слишком сложная для конвертации в PDF. Мы усиленно работаем, чтобы решить эту проблему"))
-		return
-	}
-
-	result, _ := h.client.GetAttachList(r.Context(), &gen.GetAttachListRequest{
-		NoteId: noteIdString,
-		UserId: jwtPayload.Id.String(),
-	})
-
-	YouNoteZip, err := zipper.CreateYouNoteZip(noteTitle+".pdf", resultPDF, result.Paths, jwtPayload.Username, picturesOrder, filenames)
-	if err != nil {
-		log.LogHandlerInfo(logger, http.StatusInternalServerError, err.Error())
-		responses.WriteErrorMessage(w, http.StatusInternalServerError, errors.New("ха-ха, разработчик не смог создать zip-архив"))
-		return
-	}
-
-	w.Header().Set("Content-Type", "application/zip")
-	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s.zip", noteTitle))
-	_, _ = w.Write(YouNoteZip.Bytes())
-	w.WriteHeader(http.StatusOK)
-	log.LogHandlerInfo(logger, http.StatusOK, "success")
-}
-
-func (h *NoteHandler) ExportZipShared(w http.ResponseWriter, r *http.Request) {
-	logger := log.GetLoggerFromContext(r.Context()).With(slog.String("func", log.GFN()))
-
-	noteIdString := mux.Vars(r)["id"]
-	_, err := uuid.FromString(noteIdString)
-	if err != nil {
-		log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
-		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("note id must be a type of uuid"))
-		return
-	}
+		if err.Error()[len(RpcErrorPrefix):] == note.ErrTooDeep {
+			log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+			responses.WriteErrorMessage(w, http.StatusNotFound, err)
+			return
+		}
 
-	payload, err := io.ReadAll(r.Body)
-	if err != nil {
-		log.LogHandlerError(logger, http.StatusBadRequest, ErrReadBody+err.Error())
-		responses.WriteErrorMessage(w, http.StatusBadRequest, errors.New("can`t read request body"))
+		log.LogHandlerError(logger, http.StatusNotFound, err.Error())
+		responses.WriteErrorMessage(w, http.StatusNotFound, errors.New("template not found"))
 		return
 	}
 
-	resultPDF, noteTitle, picturesOrder, filenames, err := exportpdf.GeneratePDF(string(payload))
+	resultNote, err := getNote(createdNote.Note)
 	if err != nil {
-		if err.Error() == ErrInputHTML {
-			log.LogHandlerError(logger, http.StatusBadRequest, err.Error())
-			responses.WriteErrorMessage(w, http.StatusBadRequest, err)
-			return
-		}
-
-		if err.Error() == ErrParsingHTML {
-			log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
-			responses.WriteErrorMessage(w, http.StatusInternalServerError, err)
-			return
-		}
-
-		log.LogHandlerInfo(logger, http.StatusUnavailableForLegalReasons, err.Error())
-		responses.WriteErrorMessage(w, http.StatusUnavailableForLegalReasons, errors.New("прости, но эта заметка слишком сложная для конвертации в PDF. Мы усиленно работаем, чтобы решить эту проблему"))
+		log.LogHandlerError(logger, http.StatusInternalServerError, err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
 
-	result, _ := h.client.GetSharedAttachList(r.Context(), &gen.GetSharedAttachListRequest{
-		NoteId: noteIdString,
-	})
-
-	YouNoteZip, err := zipper.CreateYouNoteZip(noteTitle+".pdf", resultPDF, result.Paths, "anonymous user", picturesOrder, filenames)
-	if err != nil {
-		log.LogHandlerInfo(logger, http.StatusInternalServerError, err.Error())
-		responses.WriteErrorMessage(w, http.StatusInternalServerError, errors.New("ха-ха, разработчик не смог создать zip-архив"))
+	if err := responses.WriteResponseData(w, resultNote, http.StatusOK); err != nil {
+		log.LogHandlerError(logger, http.StatusInternalServerError, responses.WriteBodyError+err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/zip")
-	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s.zip", noteTitle))
-	_, _ = w.Write(YouNoteZip.Bytes())
-	w.WriteHeader(http.StatusOK)
 	log.LogHandlerInfo(logger, http.StatusOK, "success")
 }
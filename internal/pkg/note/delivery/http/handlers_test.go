@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -18,13 +19,18 @@ import (
 	mock_hub "github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/hub/mocks"
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/middleware/protection"
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/note"
+	noteDelivery "github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/note/delivery/grpc"
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/note/delivery/grpc/gen"
 	mock_grpc "github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/note/delivery/grpc/gen/mocks"
+	mock_note "github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/note/mocks"
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/utils/exportpdf"
 	"github.com/golang/mock/gomock"
 	"github.com/gorilla/mux"
 	"github.com/satori/uuid"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
 )
 
 func TestNoteHandler_GetAllNotes(t *testing.T) {
@@ -101,10 +107,10 @@ func TestNoteHandler_GetAllNotes(t *testing.T) {
 
 			}
 			if tt.name == successTestName {
-				mockClient.EXPECT().GetAllNotes(ctx, &gen.GetAllRequest{Count: int64(10), Offset: int64(0), Title: "", UserId: tt.id.String(), Tags: []string{}}, gomock.Any()).Return(&gen.GetAllResponse{Notes: tt.expectedData}, nil)
+				mockClient.EXPECT().GetAllNotes(ctx, &gen.GetAllRequest{Count: int64(10), Offset: int64(0), Title: "", UserId: tt.id.String(), Tags: []string{}, Archived: models.ArchiveFilterActive, Sort: models.SortUpdated}, gomock.Any()).Return(&gen.GetAllResponse{Notes: tt.expectedData}, nil)
 			}
 			if tt.name == "Test Error" {
-				mockClient.EXPECT().GetAllNotes(ctx, &gen.GetAllRequest{Count: int64(10), Offset: int64(0), Title: "", UserId: tt.id.String(), Tags: []string{}}, gomock.Any()).Return(&gen.GetAllResponse{Notes: tt.expectedData}, errors.New("error"))
+				mockClient.EXPECT().GetAllNotes(ctx, &gen.GetAllRequest{Count: int64(10), Offset: int64(0), Title: "", UserId: tt.id.String(), Tags: []string{}, Archived: models.ArchiveFilterActive, Sort: models.SortUpdated}, gomock.Any()).Return(&gen.GetAllResponse{Notes: tt.expectedData}, errors.New("error"))
 
 			}
 			req = req.WithContext(ctx)
@@ -406,9 +412,10 @@ func TestNoteHandler_GetTags(t *testing.T) {
 
 	tests := []struct {
 		name             string
+		query            string
 		expectedStatus   int
 		mockUsecase      func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface)
-		expectedResponse models.GetTagsResponse
+		expectedResponse interface{}
 	}{
 		{
 			name:           "Test_GetTags_Success",
@@ -416,10 +423,44 @@ func TestNoteHandler_GetTags(t *testing.T) {
 			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
 				mockClient.EXPECT().GetTags(gomock.Any(), gomock.Any()).Return(&gen.GetTagsResponse{
 					Tags: []string{"first", "second"},
+					TagsInfo: []*gen.TagModel{
+						{Name: "first", Color: "#ffffff", NotesCount: 2},
+						{Name: "second", Emoji: "💼", Description: "job"},
+					},
 				}, nil)
 			},
 			expectedResponse: models.GetTagsResponse{
 				Tags: []string{"first", "second"},
+				Info: []models.Tag{
+					{Name: "first", Color: "#ffffff", NotesCount: 2},
+					{Name: "second", Emoji: "💼", Description: "job"},
+				},
+			},
+		},
+		{
+			name:           "Test_GetTags_Tree",
+			query:          "?tree=true",
+			expectedStatus: http.StatusOK,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().GetTags(gomock.Any(), gomock.Any()).Return(&gen.GetTagsResponse{
+					Tags: []string{"home", "work/clients/acme", "work/todo"},
+					TagsInfo: []*gen.TagModel{
+						{Name: "home"},
+						{Name: "work/clients/acme", NotesCount: 1},
+						{Name: "work/todo"},
+					},
+				}, nil)
+			},
+			expectedResponse: models.GetTagTreeResponse{
+				Tags: []models.TagNode{
+					{Name: "home", Path: "home", Children: []models.TagNode{}},
+					{Name: "work", Path: "work", Children: []models.TagNode{
+						{Name: "clients", Path: "work/clients", Children: []models.TagNode{
+							{Name: "acme", Path: "work/clients/acme", NotesCount: 1, Children: []models.TagNode{}},
+						}},
+						{Name: "todo", Path: "work/todo", Children: []models.TagNode{}},
+					}},
+				},
 			},
 		},
 		{
@@ -448,7 +489,7 @@ func TestNoteHandler_GetTags(t *testing.T) {
 
 			defer ctrl.Finish()
 
-			r := httptest.NewRequest("POST", "http://example.com/api/handler", bytes.NewReader([]byte{}))
+			r := httptest.NewRequest("POST", "http://example.com/api/handler"+tt.query, bytes.NewReader([]byte{}))
 			ctx := context.Background()
 			if tt.expectedStatus != http.StatusUnauthorized {
 				ctx = context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
@@ -472,6 +513,159 @@ func TestNoteHandler_GetTags(t *testing.T) {
 	}
 }
 
+func TestNoteHandler_MergeTags(t *testing.T) {
+	userId := uuid.NewV4()
+
+	tests := []struct {
+		requestBody    []byte
+		name           string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient)
+	}{
+		{
+			requestBody:    []byte(`{"tags":["first","second"],"new_name":"target"}`),
+			name:           "Test_MergeTags_Success",
+			expectedStatus: http.StatusNoContent,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().MergeTags(gomock.Any(), &gen.MergeTagsRequest{
+					Tags:   []string{"first", "second"},
+					NewTag: "target",
+					UserId: userId.String(),
+				}).Return(&gen.EmptyResponse{}, nil)
+			},
+		},
+		{
+			requestBody:    []byte(""),
+			name:           "Test_MergeTags_Unauthorized",
+			expectedStatus: http.StatusUnauthorized,
+			mockUsecase:    func(mockClient *mock_grpc.MockNoteClient) {},
+		},
+		{
+			requestBody:    []byte(""),
+			name:           "Test_MergeTags_BadBody",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase:    func(mockClient *mock_grpc.MockNoteClient) {},
+		},
+		{
+			requestBody:    []byte(`{"tags":["work"],"new_name":"work/clients"}`),
+			name:           "Test_MergeTags_IntoItself",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase:    func(mockClient *mock_grpc.MockNoteClient) {},
+		},
+		{
+			requestBody:    []byte(`{"tags":["first"],"new_name":"target"}`),
+			name:           "Test_MergeTags_ClientErr",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().MergeTags(gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("POST", "http://example.com/api/handler", bytes.NewReader(tt.requestBody))
+			ctx := context.Background()
+			if tt.expectedStatus != http.StatusUnauthorized {
+				ctx = context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+					Id:       userId,
+					Username: "username",
+				})
+			}
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient)
+			handler.MergeTags(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestNoteHandler_SetTagInfo(t *testing.T) {
+	userId := uuid.NewV4()
+
+	tests := []struct {
+		requestBody    []byte
+		name           string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient)
+	}{
+		{
+			requestBody:    []byte(`{"tag_name":"work","color":"#ffffff","emoji":"x","description":"job"}`),
+			name:           "Test_SetTagInfo_Success",
+			expectedStatus: http.StatusNoContent,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().SetTagInfo(gomock.Any(), &gen.TagInfoRequest{
+					UserId: userId.String(),
+					Tag:    &gen.TagModel{Name: "work", Color: "#ffffff", Emoji: "x", Description: "job"},
+				}).Return(&gen.EmptyResponse{}, nil)
+			},
+		},
+		{
+			requestBody:    []byte(""),
+			name:           "Test_SetTagInfo_Unauthorized",
+			expectedStatus: http.StatusUnauthorized,
+			mockUsecase:    func(mockClient *mock_grpc.MockNoteClient) {},
+		},
+		{
+			requestBody:    []byte(""),
+			name:           "Test_SetTagInfo_BadBody",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase:    func(mockClient *mock_grpc.MockNoteClient) {},
+		},
+		{
+			requestBody:    []byte(`{"tag_name":"work","color":"red"}`),
+			name:           "Test_SetTagInfo_BadColor",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase:    func(mockClient *mock_grpc.MockNoteClient) {},
+		},
+		{
+			requestBody:    []byte(`{"tag_name":"work"}`),
+			name:           "Test_SetTagInfo_ClientErr",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().SetTagInfo(gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("POST", "http://example.com/api/handler", bytes.NewReader(tt.requestBody))
+			ctx := context.Background()
+			if tt.expectedStatus != http.StatusUnauthorized {
+				ctx = context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+					Id:       userId,
+					Username: "username",
+				})
+			}
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient)
+			handler.SetTagInfo(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
 func TestNoteHandler_AddTag(t *testing.T) {
 	userId := uuid.NewV4()
 	noteId := uuid.NewV4()
@@ -917,49 +1111,49 @@ func TestNoteHandler_CreateSubNote(t *testing.T) {
 	}
 }
 
-func TestNoteHandler_UpdateNote(t *testing.T) {
+func TestNoteHandler_MoveNote(t *testing.T) {
 	userId := uuid.NewV4()
 	noteId := uuid.NewV4()
+	parentId := uuid.NewV4()
 
 	tests := []struct {
 		requestBody      []byte
+		id               string
 		name             string
 		expectedStatus   int
 		mockUsecase      func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface)
 		expectedResponse models.Note
 	}{
 		{
-			requestBody:    []byte("{\"data\":\"new\"}"),
-			name:           "Test_UpdateNote_Success",
+			requestBody:    []byte("{\"parent\":\"" + parentId.String() + "\"}"),
+			id:             noteId.String(),
+			name:           "Test_MoveNote_Success",
 			expectedStatus: http.StatusOK,
 			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-				mockClient.EXPECT().UpdateNote(gomock.Any(), &gen.UpdateNoteRequest{
-					UserId: userId.String(),
-					Data:   "\"new\"",
-					Id:     noteId.String(),
-				}).Return(&gen.UpdateNoteResponse{
+				mockClient.EXPECT().MoveNote(gomock.Any(), &gen.MoveNoteRequest{
+					NoteId:   noteId.String(),
+					ParentId: parentId.String(),
+					UserId:   userId.String(),
+				}).Return(&gen.GetNoteResponse{
 					Note: &gen.NoteModel{
 						Id:            noteId.String(),
 						OwnerId:       userId.String(),
 						Tags:          []string{},
 						CreateTime:    time.Time{}.String(),
 						UpdateTime:    time.Time{}.String(),
-						Parent:        uuid.UUID{}.String(),
+						Parent:        parentId.String(),
 						Collaborators: []string{},
 						Children:      []string{},
-						Data:          "\"new\"",
 					},
 				}, nil)
-				mockHub.EXPECT().WriteToCache(gomock.Any(), gomock.Any())
-
 			},
 			expectedResponse: models.Note{
 				Id:            noteId,
 				OwnerId:       userId,
 				UpdateTime:    time.Time{},
 				CreateTime:    time.Time{},
-				Parent:        uuid.UUID{},
-				Data:          "new",
+				Parent:        parentId,
+				Data:          "",
 				Children:      []uuid.UUID{},
 				Tags:          []string{},
 				Collaborators: []uuid.UUID{},
@@ -967,47 +1161,72 @@ func TestNoteHandler_UpdateNote(t *testing.T) {
 		},
 		{
 			requestBody:    []byte(""),
-			name:           "Test_UpdateNote_Unauthorized",
+			id:             noteId.String(),
+			name:           "Test_MoveNote_Unauthorized",
 			expectedStatus: http.StatusUnauthorized,
 			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
 			},
-			expectedResponse: models.Note{},
 		},
 		{
-			requestBody:    []byte(""),
-			name:           "Test_UpdateNote_BadRequest",
+			requestBody:    []byte("{}"),
+			id:             "",
+			name:           "Test_MoveNote_BadId",
 			expectedStatus: http.StatusBadRequest,
 			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
 			},
-			expectedResponse: models.Note{},
 		},
-
 		{
-			requestBody:    []byte("{\"data\":\"\"}"),
-			name:           "Test_UpdateNote_NotFound",
+			requestBody:    []byte("dsfdgf"),
+			id:             noteId.String(),
+			name:           "Test_MoveNote_BadBody",
 			expectedStatus: http.StatusBadRequest,
 			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-				mockClient.EXPECT().UpdateNote(gomock.Any(), &gen.UpdateNoteRequest{
-					UserId: userId.String(),
-					Data:   "\"\"",
-					Id:     noteId.String(),
-				}).Return(&gen.UpdateNoteResponse{}, errors.New("rpc error: code = Unknown desc = error"))
 			},
-			expectedResponse: models.Note{},
 		},
-
 		{
-			requestBody:    []byte("{\"data\":\"\"}"),
-			name:           "Test_UpdateNote_BadRequest_getNoteErr",
+			requestBody:    []byte("{\"parent\":\"" + parentId.String() + "\"}"),
+			id:             noteId.String(),
+			name:           "Test_MoveNote_NotFound",
+			expectedStatus: http.StatusNotFound,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().MoveNote(gomock.Any(), gomock.Any()).Return(nil, errors.New("rpc error: code = Unknown desc = error"))
+			},
+		},
+		{
+			requestBody:    []byte("{\"parent\":\"" + parentId.String() + "\"}"),
+			id:             noteId.String(),
+			name:           "Test_MoveNote_TooManySubnotes",
+			expectedStatus: http.StatusConflict,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().MoveNote(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+note.ErrTooManySubnotes))
+			},
+		},
+		{
+			requestBody:    []byte("{\"parent\":\"" + parentId.String() + "\"}"),
+			id:             noteId.String(),
+			name:           "Test_MoveNote_TooDeep",
+			expectedStatus: http.StatusNotFound,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().MoveNote(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+note.ErrTooDeep))
+			},
+		},
+		{
+			requestBody:    []byte("{\"parent\":\"" + parentId.String() + "\"}"),
+			id:             noteId.String(),
+			name:           "Test_MoveNote_Cyclic",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().MoveNote(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+note.ErrCyclicMove))
+			},
+		},
+		{
+			requestBody:    []byte("{\"parent\":\"" + parentId.String() + "\"}"),
+			id:             noteId.String(),
+			name:           "Test_MoveNote_getNoteErr",
 			expectedStatus: http.StatusInternalServerError,
 			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-				mockClient.EXPECT().UpdateNote(gomock.Any(), &gen.UpdateNoteRequest{
-					UserId: userId.String(),
-					Data:   "\"\"",
-					Id:     noteId.String(),
-				}).Return(&gen.UpdateNoteResponse{Note: nil}, nil)
+				mockClient.EXPECT().MoveNote(gomock.Any(), gomock.Any()).Return(&gen.GetNoteResponse{Note: nil}, nil)
 			},
-			expectedResponse: models.Note{},
 		},
 	}
 	for _, tt := range tests {
@@ -1029,152 +1248,107 @@ func TestNoteHandler_UpdateNote(t *testing.T) {
 			}
 			w := httptest.NewRecorder()
 			r = r.WithContext(ctx)
-			if tt.name == "Test_UpdateNote_BadRequest" {
-				r = mux.SetURLVars(r, map[string]string{"id": ""})
-
-			} else {
-				r = mux.SetURLVars(r, map[string]string{"id": noteId.String()})
-
-			}
+			r = mux.SetURLVars(r, map[string]string{"id": tt.id})
 
 			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
 			tt.mockUsecase(mockClient, mockAuthClient, mockHub)
-			handler.UpdateNote(w, r)
+			handler.MoveNote(w, r)
 
+			data, _ := json.Marshal(tt.expectedResponse)
 			assert.Equal(t, tt.expectedStatus, w.Code)
-
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, data, w.Body.Bytes())
+			}
 		})
 	}
 }
 
-func TestNoteHandler_AddCollaborator(t *testing.T) {
+func TestNoteHandler_DuplicateNote(t *testing.T) {
 	userId := uuid.NewV4()
 	noteId := uuid.NewV4()
-	guestId := uuid.NewV4()
+	copyId := uuid.NewV4()
 
 	tests := []struct {
-		requestBody    []byte
-		name           string
-		expectedStatus int
-		mockUsecase    func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface)
+		query            string
+		id               string
+		name             string
+		expectedStatus   int
+		mockUsecase      func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface)
+		expectedResponse models.Note
 	}{
 		{
-			requestBody:    []byte("{\"username\":\"guestuser\"}"),
-			name:           "Test_AddCollaborator_Success",
-			expectedStatus: http.StatusNoContent,
+			query:          "?with_subnotes=true",
+			id:             noteId.String(),
+			name:           "Test_DuplicateNote_Success",
+			expectedStatus: http.StatusOK,
 			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-				mockAuth.EXPECT().GetUserByUsername(gomock.Any(), &authGen.GetUserByUsernameRequest{
-					Username: "guestuser",
-				}).Return(&authGen.User{
-					Id:         guestId.String(),
-					Username:   "guestuser",
-					CreateTime: time.Time{}.String(),
+				mockClient.EXPECT().DuplicateNote(gomock.Any(), &gen.DuplicateNoteRequest{
+					NoteId:       noteId.String(),
+					UserId:       userId.String(),
+					WithSubnotes: true,
+				}).Return(&gen.GetNoteResponse{
+					Note: &gen.NoteModel{
+						Id:            copyId.String(),
+						OwnerId:       userId.String(),
+						Tags:          []string{},
+						CreateTime:    time.Time{}.String(),
+						UpdateTime:    time.Time{}.String(),
+						Parent:        uuid.UUID{}.String(),
+						Collaborators: []string{},
+						Children:      []string{},
+					},
 				}, nil)
-				mockClient.EXPECT().AddCollaborator(gomock.Any(), &gen.AddCollaboratorRequest{
-					NoteId:  noteId.String(),
-					UserId:  userId.String(),
-					GuestId: guestId.String(),
-				}).Return(&gen.AddCollaboratorResponse{}, nil)
-				mockHub.EXPECT().WriteToCacheMain(gomock.Any(), gomock.Any(), gomock.Any())
+			},
+			expectedResponse: models.Note{
+				Id:            copyId,
+				OwnerId:       userId,
+				UpdateTime:    time.Time{},
+				CreateTime:    time.Time{},
+				Data:          "",
+				Children:      []uuid.UUID{},
+				Tags:          []string{},
+				Collaborators: []uuid.UUID{},
 			},
 		},
 		{
-			requestBody:    []byte(""),
-			name:           "Test_AddCollaborator_Unauthorized",
+			id:             noteId.String(),
+			name:           "Test_DuplicateNote_Unauthorized",
 			expectedStatus: http.StatusUnauthorized,
 			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
 			},
 		},
 		{
-			requestBody:    []byte(""),
-			name:           "Test_AddCollaborator_BadRequest",
+			id:             "",
+			name:           "Test_DuplicateNote_BadId",
 			expectedStatus: http.StatusBadRequest,
 			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
 			},
 		},
-
 		{
-			requestBody:    []byte("{\"username\":\"guestuser\"}"),
-			name:           "Test_AddCollaborator_NotFound",
+			id:             noteId.String(),
+			name:           "Test_DuplicateNote_NotFound",
 			expectedStatus: http.StatusNotFound,
 			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-				mockAuth.EXPECT().GetUserByUsername(gomock.Any(), &authGen.GetUserByUsernameRequest{
-					Username: "guestuser",
-				}).Return(&authGen.User{
-					Id:         guestId.String(),
-					Username:   "guestuser",
-					CreateTime: time.Time{}.String(),
-				}, nil)
-				mockClient.EXPECT().AddCollaborator(gomock.Any(), &gen.AddCollaboratorRequest{
-					NoteId:  noteId.String(),
-					UserId:  userId.String(),
-					GuestId: guestId.String(),
-				}).Return(&gen.AddCollaboratorResponse{}, errors.New("rpc error: code = Unknown desc = error"))
-			},
-		},
-		{
-			requestBody:    []byte("{\"username\":\"guestuser\"}"),
-			name:           "Test_AddCollaborator_GetUserError",
-			expectedStatus: http.StatusNotFound,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-
-				mockAuth.EXPECT().GetUserByUsername(gomock.Any(), &authGen.GetUserByUsernameRequest{
-					Username: "guestuser",
-				}).Return(&authGen.User{}, errors.New("rpc error: code = Unknown desc = error"))
-
-			},
-		},
-		{
-			requestBody:    []byte("{\"username\":\"username\"}"),
-			name:           "Test_AddCollaborator_AddedHimselfErr",
-			expectedStatus: http.StatusBadRequest,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-				mockAuth.EXPECT().GetUserByUsername(gomock.Any(), &authGen.GetUserByUsernameRequest{
-					Username: "username",
-				}).Return(&authGen.User{
-					Id:         userId.String(),
-					Username:   "username",
-					CreateTime: time.Time{}.String(),
-				}, nil)
-
+				mockClient.EXPECT().DuplicateNote(gomock.Any(), &gen.DuplicateNoteRequest{
+					NoteId: noteId.String(),
+					UserId: userId.String(),
+				}).Return(nil, errors.New("rpc error: code = Unknown desc = error"))
 			},
 		},
 		{
-			requestBody:    []byte("{\"username\":\"guestuser\"}"),
-			name:           "Test_AddCollaborator_AlreadyCollaborator",
+			id:             noteId.String(),
+			name:           "Test_DuplicateNote_TooManySubnotes",
 			expectedStatus: http.StatusConflict,
 			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-				mockAuth.EXPECT().GetUserByUsername(gomock.Any(), &authGen.GetUserByUsernameRequest{
-					Username: "guestuser",
-				}).Return(&authGen.User{
-					Id:         guestId.String(),
-					Username:   "guestuser",
-					CreateTime: time.Time{}.String(),
-				}, nil)
-				mockClient.EXPECT().AddCollaborator(gomock.Any(), &gen.AddCollaboratorRequest{
-					NoteId:  noteId.String(),
-					UserId:  userId.String(),
-					GuestId: guestId.String(),
-				}).Return(&gen.AddCollaboratorResponse{}, errors.New(RpcErrorPrefix+note.ErrAlreadyCollaborator))
+				mockClient.EXPECT().DuplicateNote(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+note.ErrTooManySubnotes))
 			},
 		},
 		{
-			requestBody:    []byte("{\"username\":\"guestuser\"}"),
-			name:           "Test_AddCollaborator_TooManyCollaboratorsErr",
-			expectedStatus: http.StatusExpectationFailed,
+			id:             noteId.String(),
+			name:           "Test_DuplicateNote_getNoteErr",
+			expectedStatus: http.StatusInternalServerError,
 			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-				mockAuth.EXPECT().GetUserByUsername(gomock.Any(), &authGen.GetUserByUsernameRequest{
-					Username: "guestuser",
-				}).Return(&authGen.User{
-					Id:         guestId.String(),
-					Username:   "guestuser",
-					CreateTime: time.Time{}.String(),
-				}, nil)
-				mockClient.EXPECT().AddCollaborator(gomock.Any(), &gen.AddCollaboratorRequest{
-					NoteId:  noteId.String(),
-					UserId:  userId.String(),
-					GuestId: guestId.String(),
-				}).Return(&gen.AddCollaboratorResponse{}, errors.New(RpcErrorPrefix+note.ErrTooManyCollaborators))
+				mockClient.EXPECT().DuplicateNote(gomock.Any(), gomock.Any()).Return(&gen.GetNoteResponse{Note: nil}, nil)
 			},
 		},
 	}
@@ -1187,7 +1361,7 @@ func TestNoteHandler_AddCollaborator(t *testing.T) {
 
 			defer ctrl.Finish()
 
-			r := httptest.NewRequest("POST", "http://example.com/api/handler", bytes.NewReader(tt.requestBody))
+			r := httptest.NewRequest("POST", "http://example.com/api/handler"+tt.query, nil)
 			ctx := context.Background()
 			if tt.expectedStatus != http.StatusUnauthorized {
 				ctx = context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
@@ -1197,116 +1371,182 @@ func TestNoteHandler_AddCollaborator(t *testing.T) {
 			}
 			w := httptest.NewRecorder()
 			r = r.WithContext(ctx)
-			if tt.name == "Test_AddCollaborator_BadRequest" {
-				r = mux.SetURLVars(r, map[string]string{"id": ""})
-
-			} else {
-				r = mux.SetURLVars(r, map[string]string{"id": noteId.String()})
-
-			}
+			r = mux.SetURLVars(r, map[string]string{"id": tt.id})
 
 			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
 			tt.mockUsecase(mockClient, mockAuthClient, mockHub)
-			handler.AddCollaborator(w, r)
+			handler.DuplicateNote(w, r)
 
+			data, _ := json.Marshal(tt.expectedResponse)
 			assert.Equal(t, tt.expectedStatus, w.Code)
-
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, data, w.Body.Bytes())
+			}
 		})
 	}
 }
 
-func TestExportToPDF(t *testing.T) {
-	_ = os.Setenv("ATTACHES_BASE_PATH", "/mnt/c/projects/Go/YouNote_data/attaches")
-
-	t.Run("TestExportToPDF_Success", func(t *testing.T) {
-		ctrl := gomock.NewController(t)
-		mockClient := mock_grpc.NewMockNoteClient(ctrl)
-		mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
-		mockHub := mock_hub.NewMockHubInterface(ctrl)
-		defer ctrl.Finish()
-
-		handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
-
-		req, err := http.NewRequest("POST", "/export_to_pdf", bytes.NewBufferString(exportpdf.TestNoteHTMLInput))
-		if err != nil {
-			t.Fatal(err)
-		}
-
-		ctx := context.WithValue(context.Background(), config.PayloadContextKey, models.JwtPayload{
-			Id:       uuid.NewV4(),
-			Username: "username",
-		})
-		req = req.WithContext(ctx)
-
-		rr := httptest.NewRecorder()
-
-		r := mux.NewRouter()
-		r.Use(protection.ReadAndCloseBody)
-		r.Handle("/export_to_pdf", http.HandlerFunc(handler.ExportToPDF)).Methods(http.MethodPost, http.MethodOptions)
-		http.HandlerFunc(handler.ExportToPDF).ServeHTTP(rr, req)
-
-		if status := rr.Code; status != http.StatusOK {
-			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
-		}
-
-		contentType := rr.Header().Get("Content-Type")
-		if contentType != "application/pdf" {
-			t.Errorf("handler returned wrong content type: got %v want %v", contentType, "application/pdf")
-		}
-
-		err = os.WriteFile("exported.pdf", rr.Body.Bytes(), 0644)
-		if err != nil {
-			t.Fatal(err)
-		}
-	})
-}
-
-func TestNoteHandler_RememberTag(t *testing.T) {
+func TestNoteHandler_UpdateNote(t *testing.T) {
 	userId := uuid.NewV4()
 	noteId := uuid.NewV4()
 
 	tests := []struct {
-		requestBody    []byte
-		name           string
-		expectedStatus int
-		mockUsecase    func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface)
+		requestBody      []byte
+		name             string
+		ifMatch          string
+		expectedStatus   int
+		expectedETag     string
+		mockUsecase      func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface)
+		expectedResponse models.Note
 	}{
 		{
-			requestBody:    []byte("{\"tag_name\":\"tag\"}"),
-			name:           "Test_RememberTag_Success",
-			expectedStatus: http.StatusNoContent,
+			requestBody:    []byte("{\"data\":\"new\"}"),
+			name:           "Test_UpdateNote_Success",
+			expectedStatus: http.StatusOK,
 			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-				mockClient.EXPECT().RememberTag(gomock.Any(), &gen.AllTagRequest{
-					TagName: "tag",
+				mockClient.EXPECT().UpdateNote(gomock.Any(), &gen.UpdateNoteRequest{
+					UserId: userId.String(),
+					Data:   "\"new\"",
+					Id:     noteId.String(),
+				}).Return(&gen.UpdateNoteResponse{
+					Note: &gen.NoteModel{
+						Id:            noteId.String(),
+						OwnerId:       userId.String(),
+						Tags:          []string{},
+						CreateTime:    time.Time{}.String(),
+						UpdateTime:    time.Time{}.String(),
+						Parent:        uuid.UUID{}.String(),
+						Collaborators: []string{},
+						Children:      []string{},
+						Data:          "\"new\"",
+					},
+				}, nil)
+				mockHub.EXPECT().WriteToCache(gomock.Any(), gomock.Any())
+
+			},
+			expectedResponse: models.Note{
+				Id:            noteId,
+				OwnerId:       userId,
+				UpdateTime:    time.Time{},
+				CreateTime:    time.Time{},
+				Parent:        uuid.UUID{},
+				Data:          "new",
+				Children:      []uuid.UUID{},
+				Tags:          []string{},
+				Collaborators: []uuid.UUID{},
+			},
+		},
+		{
+			requestBody:    []byte("{\"data\":\"new\"}"),
+			name:           "Test_UpdateNote_IfMatch",
+			ifMatch:        `W/"3"`,
+			expectedStatus: http.StatusOK,
+			expectedETag:   `"4"`,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().UpdateNote(gomock.Any(), &gen.UpdateNoteRequest{
 					UserId:  userId.String(),
-				}).Return(&gen.EmptyResponse{}, nil)
+					Data:    "\"new\"",
+					Id:      noteId.String(),
+					Version: 3,
+				}).Return(&gen.UpdateNoteResponse{
+					Note: &gen.NoteModel{
+						Id:         noteId.String(),
+						OwnerId:    userId.String(),
+						CreateTime: time.Time{}.String(),
+						UpdateTime: time.Time{}.String(),
+						Parent:     uuid.UUID{}.String(),
+						Data:       "\"new\"",
+						Version:    4,
+					},
+				}, nil)
+				mockHub.EXPECT().WriteToCache(gomock.Any(), gomock.Any())
+			},
+			expectedResponse: models.Note{},
+		},
+		{
+			requestBody:    []byte("{\"data\":\"new\"}"),
+			name:           "Test_UpdateNote_VersionConflict",
+			ifMatch:        `"3"`,
+			expectedStatus: http.StatusConflict,
+			expectedETag:   `"5"`,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().UpdateNote(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+note.ErrVersionConflict))
+				mockClient.EXPECT().GetNote(gomock.Any(), &gen.GetNoteRequest{
+					Id:     noteId.String(),
+					UserId: userId.String(),
+				}).Return(&gen.GetNoteResponseResponse{Note: &gen.NoteResponseModel{
+					Id:         noteId.String(),
+					OwnerId:    userId.String(),
+					CreateTime: time.Time{}.String(),
+					UpdateTime: time.Time{}.String(),
+					Parent:     uuid.UUID{}.String(),
+					Data:       "\"current\"",
+					Version:    5,
+				}}, nil)
+			},
+			expectedResponse: models.Note{},
+		},
+		{
+			requestBody:    []byte("{\"data\":\"new\"}"),
+			name:           "Test_UpdateNote_BadIfMatch",
+			ifMatch:        `"abc"`,
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
 			},
+			expectedResponse: models.Note{},
 		},
 		{
 			requestBody:    []byte(""),
-			name:           "Test_RememberTag_Unauthorized",
+			name:           "Test_UpdateNote_Unauthorized",
 			expectedStatus: http.StatusUnauthorized,
 			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
 			},
+			expectedResponse: models.Note{},
 		},
 		{
 			requestBody:    []byte(""),
-			name:           "Test_RememberTag_BadRequest",
+			name:           "Test_UpdateNote_BadRequest",
 			expectedStatus: http.StatusBadRequest,
 			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
 			},
+			expectedResponse: models.Note{},
 		},
+
 		{
-			requestBody:    []byte("{\"tag_name\":\"tag\"}"),
-			name:           "Test_RememberTag_BadRequestClientErr",
+			requestBody:    []byte("{\"data\":\"\"}"),
+			name:           "Test_UpdateNote_NotFound",
 			expectedStatus: http.StatusBadRequest,
 			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-				mockClient.EXPECT().RememberTag(gomock.Any(), &gen.AllTagRequest{
-					TagName: "tag",
+				mockClient.EXPECT().UpdateNote(gomock.Any(), &gen.UpdateNoteRequest{
+					UserId: userId.String(),
+					Data:   "\"\"",
+					Id:     noteId.String(),
+				}).Return(&gen.UpdateNoteResponse{}, errors.New("rpc error: code = Unknown desc = error"))
+			},
+			expectedResponse: models.Note{},
+		},
+		{
+			requestBody:    []byte("{\"data\":\"\"}"),
+			name:           "Test_UpdateNote_Forbidden",
+			expectedStatus: http.StatusForbidden,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().UpdateNote(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+note.ErrNotEnoughRights))
+			},
+			expectedResponse: models.Note{},
+		},
 
+		{
+			requestBody:    []byte("{\"data\":\"\"}"),
+			name:           "Test_UpdateNote_BadRequest_getNoteErr",
+			expectedStatus: http.StatusInternalServerError,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().UpdateNote(gomock.Any(), &gen.UpdateNoteRequest{
 					UserId: userId.String(),
-				}).Return(&gen.EmptyResponse{}, errors.New("rpc error: code = Unknown desc = error"))
+					Data:   "\"\"",
+					Id:     noteId.String(),
+				}).Return(&gen.UpdateNoteResponse{Note: nil}, nil)
 			},
+			expectedResponse: models.Note{},
 		},
 	}
 	for _, tt := range tests {
@@ -1326,9 +1566,12 @@ func TestNoteHandler_RememberTag(t *testing.T) {
 					Username: "username",
 				})
 			}
+			if tt.ifMatch != "" {
+				r.Header.Set("If-Match", tt.ifMatch)
+			}
 			w := httptest.NewRecorder()
 			r = r.WithContext(ctx)
-			if tt.name == "Test_RememberTag_BadRequest" {
+			if tt.name == "Test_UpdateNote_BadRequest" {
 				r = mux.SetURLVars(r, map[string]string{"id": ""})
 
 			} else {
@@ -1338,17 +1581,19 @@ func TestNoteHandler_RememberTag(t *testing.T) {
 
 			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
 			tt.mockUsecase(mockClient, mockAuthClient, mockHub)
-			handler.RememberTag(w, r)
+			handler.UpdateNote(w, r)
 
 			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedETag, w.Header().Get("ETag"))
 
 		})
 	}
 }
 
-func TestNoteHandler_ForgetTag(t *testing.T) {
+func TestNoteHandler_AddCollaborator(t *testing.T) {
 	userId := uuid.NewV4()
 	noteId := uuid.NewV4()
+	guestId := uuid.NewV4()
 
 	tests := []struct {
 		requestBody    []byte
@@ -1357,40 +1602,133 @@ func TestNoteHandler_ForgetTag(t *testing.T) {
 		mockUsecase    func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface)
 	}{
 		{
-			requestBody:    []byte("{\"tag_name\":\"tag\"}"),
-			name:           "Test_ForgetTag_Success",
+			requestBody:    []byte("{\"username\":\"guestuser\",\"role\":\"viewer\"}"),
+			name:           "Test_AddCollaborator_Success",
 			expectedStatus: http.StatusNoContent,
 			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-				mockClient.EXPECT().ForgetTag(gomock.Any(), &gen.AllTagRequest{
-					TagName: "tag",
+				mockAuth.EXPECT().GetUserByUsername(gomock.Any(), &authGen.GetUserByUsernameRequest{
+					Username: "guestuser",
+				}).Return(&authGen.User{
+					Id:         guestId.String(),
+					Username:   "guestuser",
+					CreateTime: time.Time{}.String(),
+				}, nil)
+				mockClient.EXPECT().AddCollaborator(gomock.Any(), &gen.AddCollaboratorRequest{
+					NoteId:  noteId.String(),
 					UserId:  userId.String(),
-				}).Return(&gen.EmptyResponse{}, nil)
+					GuestId: guestId.String(),
+					Role:    models.RoleViewer,
+				}).Return(&gen.AddCollaboratorResponse{Title: "title", InviteId: uuid.NewV4().String()}, nil)
+				mockHub.EXPECT().WriteToCacheMain(gomock.Any(), gomock.Any(), gomock.Any())
+			},
+		},
+		{
+			requestBody:    []byte("{\"username\":\"guestuser\",\"role\":\"owner\"}"),
+			name:           "Test_AddCollaborator_InvalidRole",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
 			},
 		},
 		{
 			requestBody:    []byte(""),
-			name:           "Test_ForgetTag_Unauthorized",
+			name:           "Test_AddCollaborator_Unauthorized",
 			expectedStatus: http.StatusUnauthorized,
 			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
 			},
 		},
 		{
 			requestBody:    []byte(""),
-			name:           "Test_ForgetTag_BadRequest",
+			name:           "Test_AddCollaborator_BadRequest",
 			expectedStatus: http.StatusBadRequest,
 			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
 			},
 		},
+
 		{
-			requestBody:    []byte("{\"tag_name\":\"tag\"}"),
-			name:           "Test_ForgetTag_BadRequestClientErr",
+			requestBody:    []byte("{\"username\":\"guestuser\"}"),
+			name:           "Test_AddCollaborator_NotFound",
+			expectedStatus: http.StatusNotFound,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockAuth.EXPECT().GetUserByUsername(gomock.Any(), &authGen.GetUserByUsernameRequest{
+					Username: "guestuser",
+				}).Return(&authGen.User{
+					Id:         guestId.String(),
+					Username:   "guestuser",
+					CreateTime: time.Time{}.String(),
+				}, nil)
+				mockClient.EXPECT().AddCollaborator(gomock.Any(), &gen.AddCollaboratorRequest{
+					NoteId:  noteId.String(),
+					UserId:  userId.String(),
+					GuestId: guestId.String(),
+					Role:    models.RoleEditor,
+				}).Return(&gen.AddCollaboratorResponse{}, errors.New("rpc error: code = Unknown desc = error"))
+			},
+		},
+		{
+			requestBody:    []byte("{\"username\":\"guestuser\"}"),
+			name:           "Test_AddCollaborator_GetUserError",
+			expectedStatus: http.StatusNotFound,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+
+				mockAuth.EXPECT().GetUserByUsername(gomock.Any(), &authGen.GetUserByUsernameRequest{
+					Username: "guestuser",
+				}).Return(&authGen.User{}, errors.New("rpc error: code = Unknown desc = error"))
+
+			},
+		},
+		{
+			requestBody:    []byte("{\"username\":\"username\"}"),
+			name:           "Test_AddCollaborator_AddedHimselfErr",
 			expectedStatus: http.StatusBadRequest,
 			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-				mockClient.EXPECT().ForgetTag(gomock.Any(), &gen.AllTagRequest{
-					TagName: "tag",
+				mockAuth.EXPECT().GetUserByUsername(gomock.Any(), &authGen.GetUserByUsernameRequest{
+					Username: "username",
+				}).Return(&authGen.User{
+					Id:         userId.String(),
+					Username:   "username",
+					CreateTime: time.Time{}.String(),
+				}, nil)
 
-					UserId: userId.String(),
-				}).Return(&gen.EmptyResponse{}, errors.New("rpc error: code = Unknown desc = error"))
+			},
+		},
+		{
+			requestBody:    []byte("{\"username\":\"guestuser\"}"),
+			name:           "Test_AddCollaborator_AlreadyCollaborator",
+			expectedStatus: http.StatusConflict,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockAuth.EXPECT().GetUserByUsername(gomock.Any(), &authGen.GetUserByUsernameRequest{
+					Username: "guestuser",
+				}).Return(&authGen.User{
+					Id:         guestId.String(),
+					Username:   "guestuser",
+					CreateTime: time.Time{}.String(),
+				}, nil)
+				mockClient.EXPECT().AddCollaborator(gomock.Any(), &gen.AddCollaboratorRequest{
+					NoteId:  noteId.String(),
+					UserId:  userId.String(),
+					GuestId: guestId.String(),
+					Role:    models.RoleEditor,
+				}).Return(&gen.AddCollaboratorResponse{}, errors.New(RpcErrorPrefix+note.ErrAlreadyCollaborator))
+			},
+		},
+		{
+			requestBody:    []byte("{\"username\":\"guestuser\"}"),
+			name:           "Test_AddCollaborator_TooManyCollaboratorsErr",
+			expectedStatus: http.StatusExpectationFailed,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockAuth.EXPECT().GetUserByUsername(gomock.Any(), &authGen.GetUserByUsernameRequest{
+					Username: "guestuser",
+				}).Return(&authGen.User{
+					Id:         guestId.String(),
+					Username:   "guestuser",
+					CreateTime: time.Time{}.String(),
+				}, nil)
+				mockClient.EXPECT().AddCollaborator(gomock.Any(), &gen.AddCollaboratorRequest{
+					NoteId:  noteId.String(),
+					UserId:  userId.String(),
+					GuestId: guestId.String(),
+					Role:    models.RoleEditor,
+				}).Return(&gen.AddCollaboratorResponse{}, errors.New(RpcErrorPrefix+note.ErrTooManyCollaborators))
 			},
 		},
 	}
@@ -1413,7 +1751,7 @@ func TestNoteHandler_ForgetTag(t *testing.T) {
 			}
 			w := httptest.NewRecorder()
 			r = r.WithContext(ctx)
-			if tt.name == "Test_ForgetTag_BadRequest" {
+			if tt.name == "Test_AddCollaborator_BadRequest" {
 				r = mux.SetURLVars(r, map[string]string{"id": ""})
 
 			} else {
@@ -1423,68 +1761,3215 @@ func TestNoteHandler_ForgetTag(t *testing.T) {
 
 			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
 			tt.mockUsecase(mockClient, mockAuthClient, mockHub)
-			handler.ForgetTag(w, r)
+			handler.AddCollaborator(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+		})
+	}
+}
+
+func TestNoteHandler_SetCollaboratorRole(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	guestId := uuid.NewV4()
+
+	tests := []struct {
+		requestBody    []byte
+		name           string
+		id             string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface)
+	}{
+		{
+			requestBody:    []byte(`{"username":"guestuser","role":"commenter"}`),
+			name:           "Test_SetCollaboratorRole_Success",
+			id:             noteId.String(),
+			expectedStatus: http.StatusNoContent,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockAuth.EXPECT().GetUserByUsername(gomock.Any(), &authGen.GetUserByUsernameRequest{
+					Username: "guestuser",
+				}).Return(&authGen.User{
+					Id:       guestId.String(),
+					Username: "guestuser",
+				}, nil)
+				mockClient.EXPECT().SetCollaboratorRole(gomock.Any(), &gen.SetCollaboratorRoleRequest{
+					NoteId:  noteId.String(),
+					UserId:  userId.String(),
+					GuestId: guestId.String(),
+					Role:    models.RoleCommenter,
+				}).Return(&gen.EmptyResponse{}, nil)
+				mockHub.EXPECT().RefreshAccess(gomock.Any(), guestId)
+			},
+		},
+		{
+			requestBody:    []byte(`{"username":"guestuser","role":"commenter"}`),
+			name:           "Test_SetCollaboratorRole_BadId",
+			id:             "",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			requestBody:    []byte(`{"username":"guestuser"}`),
+			name:           "Test_SetCollaboratorRole_NoRole",
+			id:             noteId.String(),
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			requestBody:    []byte(`{"username":"guestuser","role":"viewer"}`),
+			name:           "Test_SetCollaboratorRole_UserNotFound",
+			id:             noteId.String(),
+			expectedStatus: http.StatusNotFound,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockAuth.EXPECT().GetUserByUsername(gomock.Any(), gomock.Any()).Return(nil, errors.New("rpc error: code = Unknown desc = error"))
+			},
+		},
+		{
+			requestBody:    []byte(`{"username":"guestuser","role":"viewer"}`),
+			name:           "Test_SetCollaboratorRole_NotFound",
+			id:             noteId.String(),
+			expectedStatus: http.StatusNotFound,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockAuth.EXPECT().GetUserByUsername(gomock.Any(), gomock.Any()).Return(&authGen.User{Id: guestId.String()}, nil)
+				mockClient.EXPECT().SetCollaboratorRole(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+note.ErrNotCollaborator))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("PUT", "http://example.com/api/handler", bytes.NewReader(tt.requestBody))
+			ctx := context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+				Id:       userId,
+				Username: "username",
+			})
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+			r = mux.SetURLVars(r, map[string]string{"id": tt.id})
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient, mockAuthClient, mockHub)
+			handler.SetCollaboratorRole(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestNoteHandler_RemoveCollaborator(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	guestId := uuid.NewV4()
+
+	tests := []struct {
+		requestBody    []byte
+		name           string
+		id             string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface)
+	}{
+		{
+			requestBody:    []byte(`{"username":"guestuser"}`),
+			name:           "Test_RemoveCollaborator_Success",
+			id:             noteId.String(),
+			expectedStatus: http.StatusNoContent,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockAuth.EXPECT().GetUserByUsername(gomock.Any(), &authGen.GetUserByUsernameRequest{
+					Username: "guestuser",
+				}).Return(&authGen.User{Id: guestId.String(), Username: "guestuser"}, nil)
+				mockClient.EXPECT().RemoveCollaborator(gomock.Any(), &gen.RemoveCollaboratorRequest{
+					NoteId:  noteId.String(),
+					UserId:  userId.String(),
+					GuestId: guestId.String(),
+				}).Return(&gen.RemoveCollaboratorResponse{Title: "title", NoteIds: []string{noteId.String()}}, nil)
+				mockHub.EXPECT().DisconnectUser(gomock.Any(), guestId, []uuid.UUID{noteId})
+				mockHub.EXPECT().WriteToCacheMain(gomock.Any(), guestId, gomock.Any())
+			},
+		},
+		{
+			requestBody:    []byte(`{"username":"guestuser"}`),
+			name:           "Test_RemoveCollaborator_BadId",
+			id:             "",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			requestBody:    []byte(`{"username":"guestuser"}`),
+			name:           "Test_RemoveCollaborator_UserNotFound",
+			id:             noteId.String(),
+			expectedStatus: http.StatusNotFound,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockAuth.EXPECT().GetUserByUsername(gomock.Any(), gomock.Any()).Return(nil, errors.New("rpc error: code = Unknown desc = error"))
+			},
+		},
+		{
+			requestBody:    []byte(`{"username":"guestuser"}`),
+			name:           "Test_RemoveCollaborator_NotFound",
+			id:             noteId.String(),
+			expectedStatus: http.StatusNotFound,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockAuth.EXPECT().GetUserByUsername(gomock.Any(), gomock.Any()).Return(&authGen.User{Id: guestId.String()}, nil)
+				mockClient.EXPECT().RemoveCollaborator(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+note.ErrNotCollaborator))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("DELETE", "http://example.com/api/handler", bytes.NewReader(tt.requestBody))
+			ctx := context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+				Id:       userId,
+				Username: "username",
+			})
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+			r = mux.SetURLVars(r, map[string]string{"id": tt.id})
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient, mockAuthClient, mockHub)
+			handler.RemoveCollaborator(w, r)
 
 			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestNoteHandler_LeaveNote(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
 
+	tests := []struct {
+		name           string
+		id             string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface)
+	}{
+		{
+			name:           "Test_LeaveNote_Success",
+			id:             noteId.String(),
+			expectedStatus: http.StatusNoContent,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().LeaveNote(gomock.Any(), &gen.LeaveNoteRequest{
+					NoteId: noteId.String(),
+					UserId: userId.String(),
+				}).Return(&gen.LeaveNoteResponse{NoteIds: []string{noteId.String()}}, nil)
+				mockHub.EXPECT().DisconnectUser(gomock.Any(), userId, []uuid.UUID{noteId})
+			},
+		},
+		{
+			name:           "Test_LeaveNote_BadId",
+			id:             "",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			name:           "Test_LeaveNote_NotFound",
+			id:             noteId.String(),
+			expectedStatus: http.StatusNotFound,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().LeaveNote(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+note.ErrNotCollaborator))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("POST", "http://example.com/api/handler", nil)
+			ctx := context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+				Id:       userId,
+				Username: "username",
+			})
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+			r = mux.SetURLVars(r, map[string]string{"id": tt.id})
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient, mockHub)
+			handler.LeaveNote(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestNoteHandler_ProposeTransfer(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	newOwnerId := uuid.NewV4()
+
+	tests := []struct {
+		requestBody    []byte
+		name           string
+		id             string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface)
+	}{
+		{
+			requestBody:    []byte(`{"username":"newowner"}`),
+			name:           "Test_ProposeTransfer_Success",
+			id:             noteId.String(),
+			expectedStatus: http.StatusNoContent,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockAuth.EXPECT().GetUserByUsername(gomock.Any(), &authGen.GetUserByUsernameRequest{
+					Username: "newowner",
+				}).Return(&authGen.User{Id: newOwnerId.String(), Username: "newowner"}, nil)
+				mockClient.EXPECT().ProposeTransfer(gomock.Any(), &gen.ProposeTransferRequest{
+					NoteId:     noteId.String(),
+					UserId:     userId.String(),
+					NewOwnerId: newOwnerId.String(),
+				}).Return(&gen.ProposeTransferResponse{Title: "title"}, nil)
+				mockHub.EXPECT().WriteToCacheMain(gomock.Any(), newOwnerId, gomock.Any())
+			},
+		},
+		{
+			requestBody:    []byte(`{"username":"newowner"}`),
+			name:           "Test_ProposeTransfer_BadId",
+			id:             "",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			requestBody:    []byte(`{"username":"newowner"}`),
+			name:           "Test_ProposeTransfer_UserNotFound",
+			id:             noteId.String(),
+			expectedStatus: http.StatusNotFound,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockAuth.EXPECT().GetUserByUsername(gomock.Any(), gomock.Any()).Return(nil, errors.New("rpc error: code = Unknown desc = error"))
+			},
+		},
+		{
+			requestBody:    []byte(`{"username":"username"}`),
+			name:           "Test_ProposeTransfer_AlreadyOwner",
+			id:             noteId.String(),
+			expectedStatus: http.StatusConflict,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockAuth.EXPECT().GetUserByUsername(gomock.Any(), gomock.Any()).Return(&authGen.User{Id: userId.String()}, nil)
+				mockClient.EXPECT().ProposeTransfer(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+note.ErrAlreadyOwner))
+			},
+		},
+		{
+			requestBody:    []byte(`{"username":"newowner"}`),
+			name:           "Test_ProposeTransfer_TooManyCollaborators",
+			id:             noteId.String(),
+			expectedStatus: http.StatusExpectationFailed,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockAuth.EXPECT().GetUserByUsername(gomock.Any(), gomock.Any()).Return(&authGen.User{Id: newOwnerId.String()}, nil)
+				mockClient.EXPECT().ProposeTransfer(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+note.ErrTooManyCollaborators))
+			},
+		},
+		{
+			requestBody:    []byte(`{"username":"newowner"}`),
+			name:           "Test_ProposeTransfer_NotFound",
+			id:             noteId.String(),
+			expectedStatus: http.StatusNotFound,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockAuth.EXPECT().GetUserByUsername(gomock.Any(), gomock.Any()).Return(&authGen.User{Id: newOwnerId.String()}, nil)
+				mockClient.EXPECT().ProposeTransfer(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+"not found"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("POST", "http://example.com/api/handler", bytes.NewReader(tt.requestBody))
+			ctx := context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+				Id:       userId,
+				Username: "username",
+			})
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+			r = mux.SetURLVars(r, map[string]string{"id": tt.id})
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient, mockAuthClient, mockHub)
+			handler.ProposeTransfer(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestNoteHandler_AcceptTransfer(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	oldOwnerId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		id             string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface)
+	}{
+		{
+			name:           "Test_AcceptTransfer_Success",
+			id:             noteId.String(),
+			expectedStatus: http.StatusNoContent,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().AcceptTransfer(gomock.Any(), &gen.AcceptTransferRequest{
+					NoteId: noteId.String(),
+					UserId: userId.String(),
+				}).Return(&gen.AcceptTransferResponse{
+					NoteId: noteId.String(),
+					FromId: oldOwnerId.String(),
+					ToId:   userId.String(),
+				}, nil)
+				mockHub.EXPECT().WriteToCacheMain(gomock.Any(), oldOwnerId, gomock.Any())
+			},
+		},
+		{
+			name:           "Test_AcceptTransfer_BadId",
+			id:             "",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			name:           "Test_AcceptTransfer_NotFound",
+			id:             noteId.String(),
+			expectedStatus: http.StatusNotFound,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().AcceptTransfer(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+"not found"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("POST", "http://example.com/api/handler", nil)
+			ctx := context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+				Id:       userId,
+				Username: "username",
+			})
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+			r = mux.SetURLVars(r, map[string]string{"id": tt.id})
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient, mockHub)
+			handler.AcceptTransfer(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestNoteHandler_AcceptInvite(t *testing.T) {
+	userId := uuid.NewV4()
+	inviteId := uuid.NewV4()
+	ownerId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		id             string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface)
+	}{
+		{
+			name:           "Test_AcceptInvite_Success",
+			id:             inviteId.String(),
+			expectedStatus: http.StatusNoContent,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().AcceptInvite(gomock.Any(), &gen.InviteRequest{
+					InviteId: inviteId.String(),
+					UserId:   userId.String(),
+				}).Return(&gen.InviteResponse{Invite: &gen.InviteModel{
+					Id:        inviteId.String(),
+					InviterId: ownerId.String(),
+				}}, nil)
+				mockHub.EXPECT().WriteToCacheMain(gomock.Any(), ownerId, gomock.Any())
+			},
+		},
+		{
+			name:           "Test_AcceptInvite_BadId",
+			id:             "",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			name:           "Test_AcceptInvite_Expired",
+			id:             inviteId.String(),
+			expectedStatus: http.StatusGone,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().AcceptInvite(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+note.ErrInviteExpired))
+			},
+		},
+		{
+			name:           "Test_AcceptInvite_AlreadyCollaborator",
+			id:             inviteId.String(),
+			expectedStatus: http.StatusConflict,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().AcceptInvite(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+note.ErrAlreadyCollaborator))
+			},
+		},
+		{
+			name:           "Test_AcceptInvite_TooManyCollaborators",
+			id:             inviteId.String(),
+			expectedStatus: http.StatusExpectationFailed,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().AcceptInvite(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+note.ErrTooManyCollaborators))
+			},
+		},
+		{
+			name:           "Test_AcceptInvite_NotFound",
+			id:             inviteId.String(),
+			expectedStatus: http.StatusNotFound,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().AcceptInvite(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+"not found"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("POST", "http://example.com/api/handler", nil)
+			ctx := context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+				Id:       userId,
+				Username: "username",
+			})
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+			r = mux.SetURLVars(r, map[string]string{"id": tt.id})
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient, mockHub)
+			handler.AcceptInvite(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestNoteHandler_RevokeInvite(t *testing.T) {
+	userId := uuid.NewV4()
+	inviteId := uuid.NewV4()
+	guestId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		id             string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface)
+	}{
+		{
+			name:           "Test_RevokeInvite_Success",
+			id:             inviteId.String(),
+			expectedStatus: http.StatusNoContent,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().RevokeInvite(gomock.Any(), &gen.InviteRequest{
+					InviteId: inviteId.String(),
+					UserId:   userId.String(),
+				}).Return(&gen.InviteResponse{Invite: &gen.InviteModel{
+					Id:        inviteId.String(),
+					InviteeId: guestId.String(),
+				}}, nil)
+				mockHub.EXPECT().WriteToCacheMain(gomock.Any(), guestId, gomock.Any())
+			},
+		},
+		{
+			name:           "Test_RevokeInvite_BadId",
+			id:             "",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			name:           "Test_RevokeInvite_NotFound",
+			id:             inviteId.String(),
+			expectedStatus: http.StatusNotFound,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().RevokeInvite(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+"not found"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("DELETE", "http://example.com/api/handler", nil)
+			ctx := context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+				Id:       userId,
+				Username: "username",
+			})
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+			r = mux.SetURLVars(r, map[string]string{"id": tt.id})
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient, mockHub)
+			handler.RevokeInvite(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestNoteHandler_GetReceivedInvites(t *testing.T) {
+	userId := uuid.NewV4()
+	created := time.Now().UTC()
+
+	tests := []struct {
+		name           string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient)
+	}{
+		{
+			name:           "Test_GetReceivedInvites_Success",
+			expectedStatus: http.StatusOK,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().GetReceivedInvites(gomock.Any(), &gen.GetInvitesRequest{
+					UserId: userId.String(),
+				}).Return(&gen.GetInvitesResponse{Invites: []*gen.InviteModel{{
+					Id:        uuid.NewV4().String(),
+					InviteeId: userId.String(),
+					Created:   created.String(),
+					ExpiresAt: created.String(),
+				}}}, nil)
+			},
+		},
+		{
+			name:           "Test_GetReceivedInvites_BadTime",
+			expectedStatus: http.StatusInternalServerError,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().GetReceivedInvites(gomock.Any(), gomock.Any()).Return(&gen.GetInvitesResponse{Invites: []*gen.InviteModel{{
+					Created: "time",
+				}}}, nil)
+			},
+		},
+		{
+			name:           "Test_GetReceivedInvites_Fail",
+			expectedStatus: http.StatusInternalServerError,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().GetReceivedInvites(gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("GET", "http://example.com/api/handler", nil)
+			ctx := context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+				Id:       userId,
+				Username: "username",
+			})
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient)
+			handler.GetReceivedInvites(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestExportToPDF(t *testing.T) {
+	_ = os.Setenv("ATTACHES_BASE_PATH", "/mnt/c/projects/Go/YouNote_data/attaches")
+
+	t.Run("TestExportToPDF_Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockClient := mock_grpc.NewMockNoteClient(ctrl)
+		mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+		mockHub := mock_hub.NewMockHubInterface(ctrl)
+		defer ctrl.Finish()
+
+		handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+
+		req, err := http.NewRequest("POST", "/export_to_pdf", bytes.NewBufferString(exportpdf.TestNoteHTMLInput))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ctx := context.WithValue(context.Background(), config.PayloadContextKey, models.JwtPayload{
+			Id:       uuid.NewV4(),
+			Username: "username",
+		})
+		req = req.WithContext(ctx)
+
+		rr := httptest.NewRecorder()
+
+		r := mux.NewRouter()
+		r.Use(protection.ReadAndCloseBody)
+		r.Handle("/export_to_pdf", http.HandlerFunc(handler.ExportToPDF)).Methods(http.MethodPost, http.MethodOptions)
+		http.HandlerFunc(handler.ExportToPDF).ServeHTTP(rr, req)
+
+		if status := rr.Code; status != http.StatusOK {
+			t.Errorf("handler returned wrong status code: got %v want %v", status, http.StatusOK)
+		}
+
+		contentType := rr.Header().Get("Content-Type")
+		if contentType != "application/pdf" {
+			t.Errorf("handler returned wrong content type: got %v want %v", contentType, "application/pdf")
+		}
+
+		err = os.WriteFile("exported.pdf", rr.Body.Bytes(), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestNoteHandler_RememberTag(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+
+	tests := []struct {
+		requestBody    []byte
+		name           string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface)
+	}{
+		{
+			requestBody:    []byte("{\"tag_name\":\"tag\"}"),
+			name:           "Test_RememberTag_Success",
+			expectedStatus: http.StatusNoContent,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().RememberTag(gomock.Any(), &gen.AllTagRequest{
+					TagName: "tag",
+					UserId:  userId.String(),
+				}).Return(&gen.EmptyResponse{}, nil)
+			},
+		},
+		{
+			requestBody:    []byte(""),
+			name:           "Test_RememberTag_Unauthorized",
+			expectedStatus: http.StatusUnauthorized,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			requestBody:    []byte(""),
+			name:           "Test_RememberTag_BadRequest",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			requestBody:    []byte("{\"tag_name\":\"tag\"}"),
+			name:           "Test_RememberTag_BadRequestClientErr",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().RememberTag(gomock.Any(), &gen.AllTagRequest{
+					TagName: "tag",
+
+					UserId: userId.String(),
+				}).Return(&gen.EmptyResponse{}, errors.New("rpc error: code = Unknown desc = error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("POST", "http://example.com/api/handler", bytes.NewReader(tt.requestBody))
+			ctx := context.Background()
+			if tt.expectedStatus != http.StatusUnauthorized {
+				ctx = context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+					Id:       userId,
+					Username: "username",
+				})
+			}
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+			if tt.name == "Test_RememberTag_BadRequest" {
+				r = mux.SetURLVars(r, map[string]string{"id": ""})
+
+			} else {
+				r = mux.SetURLVars(r, map[string]string{"id": noteId.String()})
+
+			}
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient, mockAuthClient, mockHub)
+			handler.RememberTag(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+		})
+	}
+}
+
+func TestNoteHandler_ForgetTag(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+
+	tests := []struct {
+		requestBody    []byte
+		name           string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface)
+	}{
+		{
+			requestBody:    []byte("{\"tag_name\":\"tag\"}"),
+			name:           "Test_ForgetTag_Success",
+			expectedStatus: http.StatusNoContent,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().ForgetTag(gomock.Any(), &gen.AllTagRequest{
+					TagName: "tag",
+					UserId:  userId.String(),
+				}).Return(&gen.EmptyResponse{}, nil)
+			},
+		},
+		{
+			requestBody:    []byte(""),
+			name:           "Test_ForgetTag_Unauthorized",
+			expectedStatus: http.StatusUnauthorized,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			requestBody:    []byte(""),
+			name:           "Test_ForgetTag_BadRequest",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			requestBody:    []byte("{\"tag_name\":\"tag\"}"),
+			name:           "Test_ForgetTag_BadRequestClientErr",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().ForgetTag(gomock.Any(), &gen.AllTagRequest{
+					TagName: "tag",
+
+					UserId: userId.String(),
+				}).Return(&gen.EmptyResponse{}, errors.New("rpc error: code = Unknown desc = error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("POST", "http://example.com/api/handler", bytes.NewReader(tt.requestBody))
+			ctx := context.Background()
+			if tt.expectedStatus != http.StatusUnauthorized {
+				ctx = context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+					Id:       userId,
+					Username: "username",
+				})
+			}
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+			if tt.name == "Test_ForgetTag_BadRequest" {
+				r = mux.SetURLVars(r, map[string]string{"id": ""})
+
+			} else {
+				r = mux.SetURLVars(r, map[string]string{"id": noteId.String()})
+
+			}
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient, mockAuthClient, mockHub)
+			handler.ForgetTag(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+		})
+	}
+}
+
+func TestNoteHandler_UpdateTag(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+
+	tests := []struct {
+		requestBody    []byte
+		name           string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface)
+	}{
+		{
+			requestBody:    []byte("{\"old_name\":\"old\",\"new_name\":\"new\"}"),
+			name:           "Test_UpdateTag_Success",
+			expectedStatus: http.StatusNoContent,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().UpdateTag(gomock.Any(), &gen.UpdateTagRequest{
+					OldTag: "old",
+					NewTag: "new",
+					UserId: userId.String(),
+				}).Return(&gen.EmptyResponse{}, nil)
+			},
+		},
+		{
+			requestBody:    []byte(""),
+			name:           "Test_UpdateTag_Unauthorized",
+			expectedStatus: http.StatusUnauthorized,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			requestBody:    []byte(""),
+			name:           "Test_UpdateTag_BadRequest",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			requestBody:    []byte("{\"old_name\":\"old\",\"new_name\":\"new1234567891234567890\"}"),
+			name:           "Test_UpdateTag_Invalid_Request",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			requestBody:    []byte("{\"old_name\":\"old\",\"new_name\":\"new\"}"),
+			name:           "Test_UpdateTag_BadRequestClientErr",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().UpdateTag(gomock.Any(), &gen.UpdateTagRequest{
+					OldTag: "old",
+					NewTag: "new",
+
+					UserId: userId.String(),
+				}).Return(&gen.EmptyResponse{}, errors.New("rpc error: code = Unknown desc = error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("POST", "http://example.com/api/handler", bytes.NewReader(tt.requestBody))
+			ctx := context.Background()
+			if tt.expectedStatus != http.StatusUnauthorized {
+				ctx = context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+					Id:       userId,
+					Username: "username",
+				})
+			}
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+			if tt.name == "Test_UpdateTag_BadRequest" {
+				r = mux.SetURLVars(r, map[string]string{"id": ""})
+
+			} else {
+				r = mux.SetURLVars(r, map[string]string{"id": noteId.String()})
+
+			}
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient, mockAuthClient, mockHub)
+			handler.UpdateTag(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+		})
+	}
+}
+
+func TestNoteHandler_SetIcon(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+
+	tests := []struct {
+		requestBody    []byte
+		name           string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface)
+	}{
+		{
+			requestBody:    []byte("{\"icon\":\"icon\"}"),
+			name:           "Test_SetIcon_Success",
+			expectedStatus: http.StatusOK,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().SetIcon(gomock.Any(), &gen.SetIconRequest{
+					NoteId: noteId.String(),
+					Icon:   "icon",
+					UserId: userId.String(),
+				}).Return(&gen.GetNoteResponse{
+					Note: &gen.NoteModel{
+						Id:            noteId.String(),
+						OwnerId:       userId.String(),
+						Tags:          []string{},
+						CreateTime:    time.Time{}.String(),
+						UpdateTime:    time.Time{}.String(),
+						Parent:        uuid.UUID{}.String(),
+						Collaborators: []string{},
+						Children:      []string{},
+						Icon:          "icon",
+					},
+				}, nil)
+			},
+		},
+		{
+			requestBody:    []byte(""),
+			name:           "Test_SetIcon_Unauthorized",
+			expectedStatus: http.StatusUnauthorized,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			requestBody:    []byte(""),
+			name:           "Test_SetIcon_BadRequest",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			requestBody:    []byte("{\"icon\":\"icon\"}"),
+			name:           "Test_SetIcon_BadRequestClientErr",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().SetIcon(gomock.Any(), &gen.SetIconRequest{
+					NoteId: noteId.String(),
+					Icon:   "icon",
+					UserId: userId.String(),
+				}).Return(&gen.GetNoteResponse{}, errors.New("rpc error: code = Unknown desc = error"))
+			},
+		},
+		{
+			requestBody:    []byte("{\"icon\":\"icon\"}"),
+			name:           "Test_SetIcon_GetNoteErr",
+			expectedStatus: http.StatusInternalServerError,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().SetIcon(gomock.Any(), &gen.SetIconRequest{
+					NoteId: noteId.String(),
+					Icon:   "icon",
+					UserId: userId.String(),
+				}).Return(&gen.GetNoteResponse{Note: nil}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("POST", "http://example.com/api/handler", bytes.NewReader(tt.requestBody))
+			ctx := context.Background()
+			if tt.expectedStatus != http.StatusUnauthorized {
+				ctx = context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+					Id:       userId,
+					Username: "username",
+				})
+			}
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+			if tt.name == "Test_SetIcon_BadRequest" {
+				r = mux.SetURLVars(r, map[string]string{"id": ""})
+
+			} else {
+				r = mux.SetURLVars(r, map[string]string{"id": noteId.String()})
+
+			}
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient, mockAuthClient, mockHub)
+			handler.SetIcon(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+		})
+	}
+}
+
+func TestNoteHandler_SetHeader(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+
+	tests := []struct {
+		requestBody    []byte
+		name           string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface)
+	}{
+		{
+			requestBody:    []byte("{\"header\":\"header\"}"),
+			name:           "Test_SetHeader_Success",
+			expectedStatus: http.StatusOK,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().SetHeader(gomock.Any(), &gen.SetHeaderRequest{
+					NoteId: noteId.String(),
+					Header: "header",
+					UserId: userId.String(),
+				}).Return(&gen.GetNoteResponse{
+					Note: &gen.NoteModel{
+						Id:            noteId.String(),
+						OwnerId:       userId.String(),
+						Tags:          []string{},
+						CreateTime:    time.Time{}.String(),
+						UpdateTime:    time.Time{}.String(),
+						Parent:        uuid.UUID{}.String(),
+						Collaborators: []string{},
+						Children:      []string{},
+						Header:        "header",
+					},
+				}, nil)
+			},
+		},
+		{
+			requestBody:    []byte(""),
+			name:           "Test_SetHeader_Unauthorized",
+			expectedStatus: http.StatusUnauthorized,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			requestBody:    []byte(""),
+			name:           "Test_SetHeader_BadRequest",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			requestBody:    []byte("{\"header\":\"header\"}"),
+			name:           "Test_SetHeader_BadRequestClientErr",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().SetHeader(gomock.Any(), &gen.SetHeaderRequest{
+					NoteId: noteId.String(),
+					Header: "header",
+					UserId: userId.String(),
+				}).Return(&gen.GetNoteResponse{}, errors.New("rpc error: code = Unknown desc = error"))
+			},
+		},
+		{
+			requestBody:    []byte("{\"header\":\"header\"}"),
+			name:           "Test_SetHeader_GetNoteErr",
+			expectedStatus: http.StatusInternalServerError,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().SetHeader(gomock.Any(), &gen.SetHeaderRequest{
+					NoteId: noteId.String(),
+					Header: "header",
+					UserId: userId.String(),
+				}).Return(&gen.GetNoteResponse{Note: nil}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("POST", "http://example.com/api/handler", bytes.NewReader(tt.requestBody))
+			ctx := context.Background()
+			if tt.expectedStatus != http.StatusUnauthorized {
+				ctx = context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+					Id:       userId,
+					Username: "username",
+				})
+			}
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+			if tt.name == "Test_SetHeader_BadRequest" {
+				r = mux.SetURLVars(r, map[string]string{"id": ""})
+
+			} else {
+				r = mux.SetURLVars(r, map[string]string{"id": noteId.String()})
+
+			}
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient, mockAuthClient, mockHub)
+			handler.SetHeader(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+		})
+	}
+}
+
+func TestNoteHandler_AddFavorite(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface)
+	}{
+		{
+			name:           "Test_AddFavorite_Success",
+			expectedStatus: http.StatusOK,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().AddFav(gomock.Any(), &gen.ChangeFlagRequest{
+					NoteId: noteId.String(),
+					UserId: userId.String(),
+				}).Return(&gen.GetNoteResponse{
+					Note: &gen.NoteModel{
+						Id:            noteId.String(),
+						OwnerId:       userId.String(),
+						Tags:          []string{},
+						CreateTime:    time.Time{}.String(),
+						UpdateTime:    time.Time{}.String(),
+						Parent:        uuid.UUID{}.String(),
+						Collaborators: []string{},
+						Children:      []string{},
+					},
+				}, nil)
+			},
+		},
+		{
+			name:           "Test_AddFavorite_Unauthorized",
+			expectedStatus: http.StatusUnauthorized,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			name:           "Test_AddFavorite_BadRequest",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			name:           "Test_AddFavorite_BadRequestClientErr",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().AddFav(gomock.Any(), &gen.ChangeFlagRequest{
+					NoteId: noteId.String(),
+					UserId: userId.String(),
+				}).Return(&gen.GetNoteResponse{}, errors.New("rpc error: code = Unknown desc = error"))
+			},
+		},
+		{
+			name:           "Test_AddFavorite_GetNoteErr",
+			expectedStatus: http.StatusInternalServerError,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().AddFav(gomock.Any(), &gen.ChangeFlagRequest{
+					NoteId: noteId.String(),
+					UserId: userId.String(),
+				}).Return(&gen.GetNoteResponse{Note: nil}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("POST", "http://example.com/api/handler", bytes.NewReader([]byte{}))
+			ctx := context.Background()
+			if tt.expectedStatus != http.StatusUnauthorized {
+				ctx = context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+					Id:       userId,
+					Username: "username",
+				})
+			}
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+			if tt.name == "Test_AddFavorite_BadRequest" {
+				r = mux.SetURLVars(r, map[string]string{"id": ""})
+
+			} else {
+				r = mux.SetURLVars(r, map[string]string{"id": noteId.String()})
+
+			}
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient, mockAuthClient, mockHub)
+			handler.AddFavorite(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+		})
+	}
+}
+
+func TestNoteHandler_DeleteFavorite(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface)
+	}{
+		{
+			name:           "Test_DeleteFavorite_Success",
+			expectedStatus: http.StatusOK,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().DelFav(gomock.Any(), &gen.ChangeFlagRequest{
+					NoteId: noteId.String(),
+					UserId: userId.String(),
+				}).Return(&gen.GetNoteResponse{
+					Note: &gen.NoteModel{
+						Id:            noteId.String(),
+						OwnerId:       userId.String(),
+						Tags:          []string{},
+						CreateTime:    time.Time{}.String(),
+						UpdateTime:    time.Time{}.String(),
+						Parent:        uuid.UUID{}.String(),
+						Collaborators: []string{},
+						Children:      []string{},
+					},
+				}, nil)
+			},
+		},
+		{
+			name:           "Test_DeleteFavorite_Unauthorized",
+			expectedStatus: http.StatusUnauthorized,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			name:           "Test_DeleteFavorite_BadRequest",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			name:           "Test_DeleteFavorite_BadRequestClientErr",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().DelFav(gomock.Any(), &gen.ChangeFlagRequest{
+					NoteId: noteId.String(),
+					UserId: userId.String(),
+				}).Return(&gen.GetNoteResponse{}, errors.New("rpc error: code = Unknown desc = error"))
+			},
+		},
+		{
+			name:           "Test_DeleteFavorite_GetNoteErr",
+			expectedStatus: http.StatusInternalServerError,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().DelFav(gomock.Any(), &gen.ChangeFlagRequest{
+					NoteId: noteId.String(),
+					UserId: userId.String(),
+				}).Return(&gen.GetNoteResponse{Note: nil}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("POST", "http://example.com/api/handler", bytes.NewReader([]byte{}))
+			ctx := context.Background()
+			if tt.expectedStatus != http.StatusUnauthorized {
+				ctx = context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+					Id:       userId,
+					Username: "username",
+				})
+			}
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+			if tt.name == "Test_DeleteFavorite_BadRequest" {
+				r = mux.SetURLVars(r, map[string]string{"id": ""})
+
+			} else {
+				r = mux.SetURLVars(r, map[string]string{"id": noteId.String()})
+
+			}
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient, mockAuthClient, mockHub)
+			handler.DeleteFavorite(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+		})
+	}
+}
+
+func TestNoteHandler_SetPublic(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface)
+	}{
+		{
+			name:           "Test_SetPublic_Success",
+			expectedStatus: http.StatusOK,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().SetPublic(gomock.Any(), &gen.AccessModeRequest{
+					NoteId: noteId.String(),
+					UserId: userId.String(),
+				}).Return(&gen.GetNoteResponse{
+					Note: &gen.NoteModel{
+						Id:            noteId.String(),
+						OwnerId:       userId.String(),
+						Tags:          []string{},
+						CreateTime:    time.Time{}.String(),
+						UpdateTime:    time.Time{}.String(),
+						Parent:        uuid.UUID{}.String(),
+						Collaborators: []string{},
+						Children:      []string{},
+					},
+				}, nil)
+			},
+		},
+		{
+			name:           "Test_SetPublic_Unauthorized",
+			expectedStatus: http.StatusUnauthorized,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			name:           "Test_SetPublic_BadRequest",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			name:           "Test_SetPublic_BadRequestClientErr",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().SetPublic(gomock.Any(), &gen.AccessModeRequest{
+					NoteId: noteId.String(),
+					UserId: userId.String(),
+				}).Return(&gen.GetNoteResponse{}, errors.New("rpc error: code = Unknown desc = error"))
+			},
+		},
+		{
+			name:           "Test_SetPublic_GetNoteErr",
+			expectedStatus: http.StatusInternalServerError,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().SetPublic(gomock.Any(), &gen.AccessModeRequest{
+					NoteId: noteId.String(),
+					UserId: userId.String(),
+				}).Return(&gen.GetNoteResponse{Note: nil}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("POST", "http://example.com/api/handler", bytes.NewReader([]byte{}))
+			ctx := context.Background()
+			if tt.expectedStatus != http.StatusUnauthorized {
+				ctx = context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+					Id:       userId,
+					Username: "username",
+				})
+			}
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+			if tt.name == "Test_SetPublic_BadRequest" {
+				r = mux.SetURLVars(r, map[string]string{"id": ""})
+
+			} else {
+				r = mux.SetURLVars(r, map[string]string{"id": noteId.String()})
+
+			}
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient, mockAuthClient, mockHub)
+			handler.SetPublic(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, "true", w.Header().Get("Deprecation"))
+
+		})
+	}
+}
+
+func TestNoteHandler_SetPrivate(t *testing.T) {
+
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface)
+	}{
+		{
+			name:           "Test_SetPrivate_Success",
+			expectedStatus: http.StatusOK,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().SetPrivate(gomock.Any(), &gen.AccessModeRequest{
+					NoteId: noteId.String(),
+					UserId: userId.String(),
+				}).Return(&gen.GetNoteResponse{
+					Note: &gen.NoteModel{
+						Id:            noteId.String(),
+						OwnerId:       userId.String(),
+						Tags:          []string{},
+						CreateTime:    time.Time{}.String(),
+						UpdateTime:    time.Time{}.String(),
+						Parent:        uuid.UUID{}.String(),
+						Collaborators: []string{},
+						Children:      []string{},
+					},
+				}, nil)
+			},
+		},
+		{
+			name:           "Test_SetPrivate_Unauthorized",
+			expectedStatus: http.StatusUnauthorized,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			name:           "Test_SetPrivate_BadRequest",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			name:           "Test_SetPrivate_BadRequestClientErr",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().SetPrivate(gomock.Any(), &gen.AccessModeRequest{
+					NoteId: noteId.String(),
+					UserId: userId.String(),
+				}).Return(&gen.GetNoteResponse{}, errors.New("rpc error: code = Unknown desc = error"))
+			},
+		},
+		{
+			name:           "Test_SetPrivate_GetNoteErr",
+			expectedStatus: http.StatusInternalServerError,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().SetPrivate(gomock.Any(), &gen.AccessModeRequest{
+					NoteId: noteId.String(),
+					UserId: userId.String(),
+				}).Return(&gen.GetNoteResponse{Note: nil}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("POST", "http://example.com/api/handler", bytes.NewReader([]byte{}))
+			ctx := context.Background()
+			if tt.expectedStatus != http.StatusUnauthorized {
+				ctx = context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+					Id:       userId,
+					Username: "username",
+				})
+			}
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+			if tt.name == "Test_SetPrivate_BadRequest" {
+				r = mux.SetURLVars(r, map[string]string{"id": ""})
+
+			} else {
+				r = mux.SetURLVars(r, map[string]string{"id": noteId.String()})
+
+			}
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient, mockAuthClient, mockHub)
+			handler.SetPrivate(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+		})
+	}
+}
+
+func TestNoteHandler_GetPublicNote(t *testing.T) {
+	noteId := uuid.NewV4()
+	created := time.Now().UTC()
+
+	tests := []struct {
+		name           string
+		id             string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient)
+	}{
+		{
+			name:           "Test_GetPublicNote_Success",
+			id:             noteId.String(),
+			expectedStatus: http.StatusOK,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().GetPublicNote(gomock.Any(), &gen.GetPublicNoteRequest{
+					NoteId:   noteId.String(),
+					Token:    "token",
+					Password: "password",
+				}).Return(&gen.GetNoteResponseResponse{Note: &gen.NoteResponseModel{
+					Id:         noteId.String(),
+					CreateTime: created.String(),
+					UpdateTime: created.String(),
+				}}, nil)
+			},
+		},
+		{
+			name:           "Test_GetPublicNote_BadId",
+			id:             "",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+			},
+		},
+		{
+			name:           "Test_GetPublicNote_Expired",
+			id:             noteId.String(),
+			expectedStatus: http.StatusGone,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().GetPublicNote(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+note.ErrShareLinkExpired))
+			},
+		},
+		{
+			name:           "Test_GetPublicNote_WrongPassword",
+			id:             noteId.String(),
+			expectedStatus: http.StatusForbidden,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().GetPublicNote(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+note.ErrWrongPassword))
+			},
+		},
+		{
+			name:           "Test_GetPublicNote_NotFound",
+			id:             noteId.String(),
+			expectedStatus: http.StatusNotFound,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().GetPublicNote(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+"not found"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("GET", "http://example.com/api/handler?token=token", nil)
+			r.Header.Set("X-Share-Password", "password")
+			w := httptest.NewRecorder()
+			r = mux.SetURLVars(r, map[string]string{"id": tt.id})
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient)
+			handler.GetPublicNote(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestNoteHandler_CreateShareLink(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	created := time.Now().UTC()
+
+	tests := []struct {
+		name           string
+		id             string
+		body           string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient)
+	}{
+		{
+			name:           "Test_CreateShareLink_Success",
+			id:             noteId.String(),
+			body:           `{"password":"password","include_subtree":true}`,
+			expectedStatus: http.StatusOK,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().CreateShareLink(gomock.Any(), &gen.CreateShareLinkRequest{
+					NoteId:         noteId.String(),
+					UserId:         userId.String(),
+					Password:       "password",
+					IncludeSubtree: true,
+				}).Return(&gen.ShareLinkResponse{Link: &gen.ShareLinkModel{
+					Id:             uuid.NewV4().String(),
+					Token:          "token",
+					NoteId:         noteId.String(),
+					HasPassword:    true,
+					IncludeSubtree: true,
+					Created:        created.String(),
+					ExpiresAt:      created.Add(time.Hour).String(),
+				}}, nil)
+			},
+		},
+		{
+			name:           "Test_CreateShareLink_BadId",
+			id:             "",
+			body:           `{}`,
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+			},
+		},
+		{
+			name:           "Test_CreateShareLink_Expired",
+			id:             noteId.String(),
+			body:           `{"expires_at":"2020-01-01T00:00:00Z"}`,
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+			},
+		},
+		{
+			name:           "Test_CreateShareLink_Forbidden",
+			id:             noteId.String(),
+			body:           `{}`,
+			expectedStatus: http.StatusForbidden,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().CreateShareLink(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+note.ErrNotEnoughRights))
+			},
+		},
+		{
+			name:           "Test_CreateShareLink_NotFound",
+			id:             noteId.String(),
+			body:           `{}`,
+			expectedStatus: http.StatusNotFound,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().CreateShareLink(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+"not found"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("POST", "http://example.com/api/handler", bytes.NewBufferString(tt.body))
+			ctx := context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+				Id:       userId,
+				Username: "username",
+			})
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+			r = mux.SetURLVars(r, map[string]string{"id": tt.id})
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient)
+			handler.CreateShareLink(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestNoteHandler_RevokeShareLink(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	linkId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		linkId         string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient)
+	}{
+		{
+			name:           "Test_RevokeShareLink_Success",
+			linkId:         linkId.String(),
+			expectedStatus: http.StatusNoContent,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().RevokeShareLink(gomock.Any(), &gen.RevokeShareLinkRequest{
+					NoteId: noteId.String(),
+					LinkId: linkId.String(),
+					UserId: userId.String(),
+				}).Return(&gen.EmptyResponse{}, nil)
+			},
+		},
+		{
+			name:           "Test_RevokeShareLink_BadLinkId",
+			linkId:         "",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+			},
+		},
+		{
+			name:           "Test_RevokeShareLink_NotFound",
+			linkId:         linkId.String(),
+			expectedStatus: http.StatusNotFound,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().RevokeShareLink(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+"not found"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("DELETE", "http://example.com/api/handler", nil)
+			ctx := context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+				Id:       userId,
+				Username: "username",
+			})
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+			r = mux.SetURLVars(r, map[string]string{"id": noteId.String(), "link_id": tt.linkId})
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient)
+			handler.RevokeShareLink(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestNoteHandler_GetRevisions(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		noteId         string
+		query          string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient)
+	}{
+		{
+			name:           "Test_GetRevisions_Success",
+			noteId:         noteId.String(),
+			expectedStatus: http.StatusOK,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().GetRevisions(gomock.Any(), &gen.GetRevisionsRequest{
+					NoteId: noteId.String(),
+					UserId: userId.String(),
+					Count:  10,
+					Offset: 0,
+				}).Return(&gen.GetRevisionsResponse{
+					Revisions: []*gen.RevisionModel{
+						{
+							Id:       uuid.NewV4().String(),
+							NoteId:   noteId.String(),
+							Created:  time.Time{}.String(),
+							AuthorId: userId.String(),
+							Username: "username",
+						},
+					},
+				}, nil)
+			},
+		},
+		{
+			name:           "Test_GetRevisions_Unauthorized",
+			noteId:         noteId.String(),
+			expectedStatus: http.StatusUnauthorized,
+			mockUsecase:    func(mockClient *mock_grpc.MockNoteClient) {},
+		},
+		{
+			name:           "Test_GetRevisions_BadId",
+			noteId:         "",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase:    func(mockClient *mock_grpc.MockNoteClient) {},
+		},
+		{
+			name:           "Test_GetRevisions_BadParams",
+			noteId:         noteId.String(),
+			query:          "?count=abc",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase:    func(mockClient *mock_grpc.MockNoteClient) {},
+		},
+		{
+			name:           "Test_GetRevisions_NotFound",
+			noteId:         noteId.String(),
+			expectedStatus: http.StatusNotFound,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().GetRevisions(gomock.Any(), gomock.Any()).Return(nil, errors.New("rpc error: code = Unknown desc = not found"))
+			},
+		},
+		{
+			name:           "Test_GetRevisions_BadTime",
+			noteId:         noteId.String(),
+			expectedStatus: http.StatusInternalServerError,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().GetRevisions(gomock.Any(), gomock.Any()).Return(&gen.GetRevisionsResponse{
+					Revisions: []*gen.RevisionModel{{Created: "time"}},
+				}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("GET", "http://example.com/api/handler"+tt.query, nil)
+			ctx := context.Background()
+			if tt.expectedStatus != http.StatusUnauthorized {
+				ctx = context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+					Id:       userId,
+					Username: "username",
+				})
+			}
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+			r = mux.SetURLVars(r, map[string]string{"id": tt.noteId})
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient)
+			handler.GetRevisions(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestNoteHandler_GetRevision(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	revisionId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		noteId         string
+		revisionId     string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient)
+	}{
+		{
+			name:           "Test_GetRevision_Success",
+			noteId:         noteId.String(),
+			revisionId:     revisionId.String(),
+			expectedStatus: http.StatusOK,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().GetRevision(gomock.Any(), &gen.RevisionRequest{
+					NoteId:     noteId.String(),
+					RevisionId: revisionId.String(),
+					UserId:     userId.String(),
+				}).Return(&gen.GetRevisionResponse{
+					Revision: &gen.RevisionModel{
+						Id:      revisionId.String(),
+						NoteId:  noteId.String(),
+						Created: time.Time{}.String(),
+						Data:    "{}",
+					},
+				}, nil)
+			},
+		},
+		{
+			name:           "Test_GetRevision_Unauthorized",
+			noteId:         noteId.String(),
+			revisionId:     revisionId.String(),
+			expectedStatus: http.StatusUnauthorized,
+			mockUsecase:    func(mockClient *mock_grpc.MockNoteClient) {},
+		},
+		{
+			name:           "Test_GetRevision_BadNoteId",
+			noteId:         "",
+			revisionId:     revisionId.String(),
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase:    func(mockClient *mock_grpc.MockNoteClient) {},
+		},
+		{
+			name:           "Test_GetRevision_BadRevisionId",
+			noteId:         noteId.String(),
+			revisionId:     "",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase:    func(mockClient *mock_grpc.MockNoteClient) {},
+		},
+		{
+			name:           "Test_GetRevision_NotFound",
+			noteId:         noteId.String(),
+			revisionId:     revisionId.String(),
+			expectedStatus: http.StatusNotFound,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().GetRevision(gomock.Any(), gomock.Any()).Return(nil, errors.New("rpc error: code = Unknown desc = not found"))
+			},
+		},
+		{
+			name:           "Test_GetRevision_Nil",
+			noteId:         noteId.String(),
+			revisionId:     revisionId.String(),
+			expectedStatus: http.StatusInternalServerError,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().GetRevision(gomock.Any(), gomock.Any()).Return(&gen.GetRevisionResponse{Revision: nil}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("GET", "http://example.com/api/handler", nil)
+			ctx := context.Background()
+			if tt.expectedStatus != http.StatusUnauthorized {
+				ctx = context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+					Id:       userId,
+					Username: "username",
+				})
+			}
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+			r = mux.SetURLVars(r, map[string]string{"id": tt.noteId, "revision_id": tt.revisionId})
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient)
+			handler.GetRevision(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestNoteHandler_GetRevisionDiff(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	fromId := uuid.NewV4()
+	toId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		noteId         string
+		query          string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient)
+	}{
+		{
+			name:           "Test_GetRevisionDiff_Success",
+			noteId:         noteId.String(),
+			query:          "?from=" + fromId.String() + "&to=" + toId.String(),
+			expectedStatus: http.StatusOK,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().GetRevisionDiff(gomock.Any(), &gen.GetRevisionDiffRequest{
+					NoteId: noteId.String(),
+					FromId: fromId.String(),
+					ToId:   toId.String(),
+					UserId: userId.String(),
+				}).Return(&gen.GetRevisionDiffResponse{
+					From:   fromId.String(),
+					To:     toId.String(),
+					Blocks: []*gen.BlockChangeModel{{Type: models.BlockAdded, Block: "{}"}},
+				}, nil)
+			},
+		},
+		{
+			name:           "Test_GetRevisionDiff_Unauthorized",
+			noteId:         noteId.String(),
+			query:          "?from=" + fromId.String() + "&to=" + toId.String(),
+			expectedStatus: http.StatusUnauthorized,
+			mockUsecase:    func(mockClient *mock_grpc.MockNoteClient) {},
+		},
+		{
+			name:           "Test_GetRevisionDiff_BadNoteId",
+			noteId:         "",
+			query:          "?from=" + fromId.String() + "&to=" + toId.String(),
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase:    func(mockClient *mock_grpc.MockNoteClient) {},
+		},
+		{
+			name:           "Test_GetRevisionDiff_BadFrom",
+			noteId:         noteId.String(),
+			query:          "?to=" + toId.String(),
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase:    func(mockClient *mock_grpc.MockNoteClient) {},
+		},
+		{
+			name:           "Test_GetRevisionDiff_BadTo",
+			noteId:         noteId.String(),
+			query:          "?from=" + fromId.String(),
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase:    func(mockClient *mock_grpc.MockNoteClient) {},
+		},
+		{
+			name:           "Test_GetRevisionDiff_NotFound",
+			noteId:         noteId.String(),
+			query:          "?from=" + fromId.String() + "&to=" + toId.String(),
+			expectedStatus: http.StatusNotFound,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().GetRevisionDiff(gomock.Any(), gomock.Any()).Return(nil, errors.New("rpc error: code = Unknown desc = not found"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("GET", "http://example.com/api/handler"+tt.query, nil)
+			ctx := context.Background()
+			if tt.expectedStatus != http.StatusUnauthorized {
+				ctx = context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+					Id:       userId,
+					Username: "username",
+				})
+			}
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+			r = mux.SetURLVars(r, map[string]string{"id": tt.noteId})
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient)
+			handler.GetRevisionDiff(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestNoteHandler_RestoreRevision(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	revisionId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		noteId         string
+		revisionId     string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface)
+	}{
+		{
+			name:           "Test_RestoreRevision_Success",
+			noteId:         noteId.String(),
+			revisionId:     revisionId.String(),
+			expectedStatus: http.StatusOK,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().RestoreRevision(gomock.Any(), &gen.RevisionRequest{
+					NoteId:     noteId.String(),
+					RevisionId: revisionId.String(),
+					UserId:     userId.String(),
+				}).Return(&gen.GetNoteResponse{
+					Note: &gen.NoteModel{
+						Id:            noteId.String(),
+						OwnerId:       userId.String(),
+						Tags:          []string{},
+						CreateTime:    time.Time{}.String(),
+						UpdateTime:    time.Time{}.String(),
+						Parent:        uuid.UUID{}.String(),
+						Collaborators: []string{},
+						Children:      []string{},
+					},
+				}, nil)
+				mockHub.EXPECT().WriteToCache(gomock.Any(), gomock.Any()).Return()
+			},
+		},
+		{
+			name:           "Test_RestoreRevision_Unauthorized",
+			noteId:         noteId.String(),
+			revisionId:     revisionId.String(),
+			expectedStatus: http.StatusUnauthorized,
+			mockUsecase:    func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface) {},
+		},
+		{
+			name:           "Test_RestoreRevision_BadNoteId",
+			noteId:         "",
+			revisionId:     revisionId.String(),
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase:    func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface) {},
+		},
+		{
+			name:           "Test_RestoreRevision_BadRevisionId",
+			noteId:         noteId.String(),
+			revisionId:     "",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase:    func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface) {},
+		},
+		{
+			name:           "Test_RestoreRevision_NotFound",
+			noteId:         noteId.String(),
+			revisionId:     revisionId.String(),
+			expectedStatus: http.StatusNotFound,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().RestoreRevision(gomock.Any(), gomock.Any()).Return(nil, errors.New("rpc error: code = Unknown desc = not found"))
+			},
+		},
+		{
+			name:           "Test_RestoreRevision_Nil",
+			noteId:         noteId.String(),
+			revisionId:     revisionId.String(),
+			expectedStatus: http.StatusInternalServerError,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().RestoreRevision(gomock.Any(), gomock.Any()).Return(&gen.GetNoteResponse{Note: nil}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("POST", "http://example.com/api/handler", nil)
+			ctx := context.Background()
+			if tt.expectedStatus != http.StatusUnauthorized {
+				ctx = context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+					Id:       userId,
+					Username: "username",
+				})
+			}
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+			r = mux.SetURLVars(r, map[string]string{"id": tt.noteId, "revision_id": tt.revisionId})
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient, mockHub)
+			handler.RestoreRevision(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestNoteHandler_GetTrash(t *testing.T) {
+	userId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		query          string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient)
+	}{
+		{
+			name:           "Test_GetTrash_Success",
+			expectedStatus: http.StatusOK,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().GetTrash(gomock.Any(), &gen.GetTrashRequest{
+					UserId: userId.String(),
+					Count:  10,
+					Offset: 0,
+				}).Return(&gen.GetTrashResponse{
+					Notes: []*gen.TrashedNoteModel{
+						{
+							Note: &gen.NoteModel{
+								Id:         uuid.NewV4().String(),
+								OwnerId:    userId.String(),
+								CreateTime: time.Time{}.String(),
+								UpdateTime: time.Time{}.String(),
+								Parent:     uuid.UUID{}.String(),
+							},
+							DeletedAt: time.Time{}.String(),
+						},
+					},
+				}, nil)
+			},
+		},
+		{
+			name:           "Test_GetTrash_Unauthorized",
+			expectedStatus: http.StatusUnauthorized,
+			mockUsecase:    func(mockClient *mock_grpc.MockNoteClient) {},
+		},
+		{
+			name:           "Test_GetTrash_BadParams",
+			query:          "?offset=abc",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase:    func(mockClient *mock_grpc.MockNoteClient) {},
+		},
+		{
+			name:           "Test_GetTrash_ClientErr",
+			expectedStatus: http.StatusInternalServerError,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().GetTrash(gomock.Any(), gomock.Any()).Return(nil, errors.New("rpc error: code = Unknown desc = error"))
+			},
+		},
+		{
+			name:           "Test_GetTrash_BadTime",
+			expectedStatus: http.StatusInternalServerError,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().GetTrash(gomock.Any(), gomock.Any()).Return(&gen.GetTrashResponse{
+					Notes: []*gen.TrashedNoteModel{
+						{
+							Note: &gen.NoteModel{
+								CreateTime: time.Time{}.String(),
+								UpdateTime: time.Time{}.String(),
+							},
+							DeletedAt: "time",
+						},
+					},
+				}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("GET", "http://example.com/api/handler"+tt.query, nil)
+			ctx := context.Background()
+			if tt.expectedStatus != http.StatusUnauthorized {
+				ctx = context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+					Id:       userId,
+					Username: "username",
+				})
+			}
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient)
+			handler.GetTrash(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestNoteHandler_RestoreNote(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		noteId         string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient)
+	}{
+		{
+			name:           "Test_RestoreNote_Success",
+			noteId:         noteId.String(),
+			expectedStatus: http.StatusOK,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().RestoreNote(gomock.Any(), &gen.TrashNoteRequest{
+					NoteId: noteId.String(),
+					UserId: userId.String(),
+				}).Return(&gen.GetNoteResponse{
+					Note: &gen.NoteModel{
+						Id:         noteId.String(),
+						OwnerId:    userId.String(),
+						CreateTime: time.Time{}.String(),
+						UpdateTime: time.Time{}.String(),
+						Parent:     uuid.UUID{}.String(),
+					},
+				}, nil)
+			},
+		},
+		{
+			name:           "Test_RestoreNote_Unauthorized",
+			noteId:         noteId.String(),
+			expectedStatus: http.StatusUnauthorized,
+			mockUsecase:    func(mockClient *mock_grpc.MockNoteClient) {},
+		},
+		{
+			name:           "Test_RestoreNote_BadId",
+			noteId:         "",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase:    func(mockClient *mock_grpc.MockNoteClient) {},
+		},
+		{
+			name:           "Test_RestoreNote_NotFound",
+			noteId:         noteId.String(),
+			expectedStatus: http.StatusNotFound,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().RestoreNote(gomock.Any(), gomock.Any()).Return(nil, errors.New("rpc error: code = Unknown desc = not found"))
+			},
+		},
+		{
+			name:           "Test_RestoreNote_Nil",
+			noteId:         noteId.String(),
+			expectedStatus: http.StatusInternalServerError,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().RestoreNote(gomock.Any(), gomock.Any()).Return(&gen.GetNoteResponse{Note: nil}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("POST", "http://example.com/api/handler", nil)
+			ctx := context.Background()
+			if tt.expectedStatus != http.StatusUnauthorized {
+				ctx = context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+					Id:       userId,
+					Username: "username",
+				})
+			}
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+			r = mux.SetURLVars(r, map[string]string{"id": tt.noteId})
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient)
+			handler.RestoreNote(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestNoteHandler_DeleteNotePermanently(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		noteId         string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient)
+	}{
+		{
+			name:           "Test_DeleteNotePermanently_Success",
+			noteId:         noteId.String(),
+			expectedStatus: http.StatusNoContent,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().DeleteNotePermanently(gomock.Any(), &gen.TrashNoteRequest{
+					NoteId: noteId.String(),
+					UserId: userId.String(),
+				}).Return(&gen.EmptyResponse{}, nil)
+			},
+		},
+		{
+			name:           "Test_DeleteNotePermanently_Unauthorized",
+			noteId:         noteId.String(),
+			expectedStatus: http.StatusUnauthorized,
+			mockUsecase:    func(mockClient *mock_grpc.MockNoteClient) {},
+		},
+		{
+			name:           "Test_DeleteNotePermanently_BadId",
+			noteId:         "",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase:    func(mockClient *mock_grpc.MockNoteClient) {},
+		},
+		{
+			name:           "Test_DeleteNotePermanently_NotFound",
+			noteId:         noteId.String(),
+			expectedStatus: http.StatusNotFound,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().DeleteNotePermanently(gomock.Any(), gomock.Any()).Return(nil, errors.New("rpc error: code = Unknown desc = not found"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("DELETE", "http://example.com/api/handler", nil)
+			ctx := context.Background()
+			if tt.expectedStatus != http.StatusUnauthorized {
+				ctx = context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+					Id:       userId,
+					Username: "username",
+				})
+			}
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+			r = mux.SetURLVars(r, map[string]string{"id": tt.noteId})
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient)
+			handler.DeleteNotePermanently(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestNoteHandler_SetTemplate(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+
+	tests := []struct {
+		id             string
+		isTemplate     bool
+		name           string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface)
+	}{
+		{
+			id:             noteId.String(),
+			isTemplate:     true,
+			name:           "Test_SetTemplate_Success",
+			expectedStatus: http.StatusOK,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().SetTemplate(gomock.Any(), &gen.SetTemplateRequest{
+					NoteId:   noteId.String(),
+					UserId:   userId.String(),
+					Template: true,
+				}).Return(&gen.GetNoteResponse{
+					Note: &gen.NoteModel{
+						Id:         noteId.String(),
+						OwnerId:    userId.String(),
+						CreateTime: time.Time{}.String(),
+						UpdateTime: time.Time{}.String(),
+					},
+				}, nil)
+			},
+		},
+		{
+			id:             noteId.String(),
+			isTemplate:     false,
+			name:           "Test_UnsetTemplate_Success",
+			expectedStatus: http.StatusOK,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().SetTemplate(gomock.Any(), &gen.SetTemplateRequest{
+					NoteId:   noteId.String(),
+					UserId:   userId.String(),
+					Template: false,
+				}).Return(&gen.GetNoteResponse{
+					Note: &gen.NoteModel{
+						Id:         noteId.String(),
+						OwnerId:    userId.String(),
+						CreateTime: time.Time{}.String(),
+						UpdateTime: time.Time{}.String(),
+					},
+				}, nil)
+			},
+		},
+		{
+			id:             noteId.String(),
+			name:           "Test_SetTemplate_Unauthorized",
+			expectedStatus: http.StatusUnauthorized,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			id:             "",
+			name:           "Test_SetTemplate_BadId",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			id:             noteId.String(),
+			isTemplate:     true,
+			name:           "Test_SetTemplate_NotFound",
+			expectedStatus: http.StatusNotFound,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().SetTemplate(gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
+			},
+		},
+		{
+			id:             noteId.String(),
+			isTemplate:     true,
+			name:           "Test_SetTemplate_getNoteErr",
+			expectedStatus: http.StatusInternalServerError,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().SetTemplate(gomock.Any(), gomock.Any()).Return(&gen.GetNoteResponse{Note: nil}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("PUT", "http://example.com/api/handler", nil)
+			ctx := context.Background()
+			if tt.expectedStatus != http.StatusUnauthorized {
+				ctx = context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+					Id:       userId,
+					Username: "username",
+				})
+			}
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+			r = mux.SetURLVars(r, map[string]string{"id": tt.id})
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient, mockAuthClient, mockHub)
+			if tt.isTemplate {
+				handler.SetTemplate(w, r)
+			} else {
+				handler.UnsetTemplate(w, r)
+			}
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestNoteHandler_GetTemplates(t *testing.T) {
+	userId := uuid.NewV4()
+	templateId := uuid.NewV4()
+
+	tests := []struct {
+		name             string
+		expectedStatus   int
+		mockUsecase      func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface)
+		expectedResponse []models.Template
+	}{
+		{
+			name:           "Test_GetTemplates_Success",
+			expectedStatus: http.StatusOK,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().GetTemplates(gomock.Any(), &gen.GetTemplatesRequest{
+					UserId: userId.String(),
+				}).Return(&gen.GetTemplatesResponse{
+					Templates: []*gen.TemplateModel{{Id: templateId.String(), Data: "{}", System: true}},
+				}, nil)
+			},
+			expectedResponse: []models.Template{{Id: templateId, Data: "{}", System: true}},
+		},
+		{
+			name:           "Test_GetTemplates_Unauthorized",
+			expectedStatus: http.StatusUnauthorized,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			name:           "Test_GetTemplates_Fail",
+			expectedStatus: http.StatusInternalServerError,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().GetTemplates(gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("GET", "http://example.com/api/handler", nil)
+			ctx := context.Background()
+			if tt.expectedStatus != http.StatusUnauthorized {
+				ctx = context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+					Id:       userId,
+					Username: "username",
+				})
+			}
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient, mockAuthClient, mockHub)
+			handler.GetTemplates(w, r)
+
+			data, _ := json.Marshal(tt.expectedResponse)
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, data, w.Body.Bytes())
+			}
+		})
+	}
+}
+
+func TestNoteHandler_CreateFromTemplate(t *testing.T) {
+	userId := uuid.NewV4()
+	templateId := uuid.NewV4()
+	noteId := uuid.NewV4()
+
+	tests := []struct {
+		requestBody    []byte
+		id             string
+		name           string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface)
+	}{
+		{
+			requestBody:    []byte("{}"),
+			id:             templateId.String(),
+			name:           "Test_CreateFromTemplate_Success",
+			expectedStatus: http.StatusOK,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().CreateFromTemplate(gomock.Any(), &gen.CreateFromTemplateRequest{
+					TemplateId: templateId.String(),
+					ParentId:   uuid.UUID{}.String(),
+					UserId:     userId.String(),
+					Username:   "username",
+				}).Return(&gen.GetNoteResponse{
+					Note: &gen.NoteModel{
+						Id:         noteId.String(),
+						OwnerId:    userId.String(),
+						CreateTime: time.Time{}.String(),
+						UpdateTime: time.Time{}.String(),
+					},
+				}, nil)
+			},
+		},
+		{
+			requestBody:    []byte("{}"),
+			id:             templateId.String(),
+			name:           "Test_CreateFromTemplate_Unauthorized",
+			expectedStatus: http.StatusUnauthorized,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			requestBody:    []byte("{}"),
+			id:             "",
+			name:           "Test_CreateFromTemplate_BadId",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			requestBody:    []byte("dsfdgf"),
+			id:             templateId.String(),
+			name:           "Test_CreateFromTemplate_BadBody",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			requestBody:    []byte("{}"),
+			id:             templateId.String(),
+			name:           "Test_CreateFromTemplate_NotFound",
+			expectedStatus: http.StatusNotFound,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().CreateFromTemplate(gomock.Any(), gomock.Any()).Return(nil, errors.New("rpc error: code = Unknown desc = not found"))
+			},
+		},
+		{
+			requestBody:    []byte("{}"),
+			id:             templateId.String(),
+			name:           "Test_CreateFromTemplate_TooManySubnotes",
+			expectedStatus: http.StatusConflict,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().CreateFromTemplate(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+note.ErrTooManySubnotes))
+			},
+		},
+		{
+			requestBody:    []byte("{}"),
+			id:             templateId.String(),
+			name:           "Test_CreateFromTemplate_TooDeep",
+			expectedStatus: http.StatusNotFound,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().CreateFromTemplate(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+note.ErrTooDeep))
+			},
+		},
+		{
+			requestBody:    []byte("{}"),
+			id:             templateId.String(),
+			name:           "Test_CreateFromTemplate_getNoteErr",
+			expectedStatus: http.StatusInternalServerError,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().CreateFromTemplate(gomock.Any(), gomock.Any()).Return(&gen.GetNoteResponse{Note: nil}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("POST", "http://example.com/api/handler", bytes.NewReader(tt.requestBody))
+			ctx := context.Background()
+			if tt.expectedStatus != http.StatusUnauthorized {
+				ctx = context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+					Id:       userId,
+					Username: "username",
+				})
+			}
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+			r = mux.SetURLVars(r, map[string]string{"id": tt.id})
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient, mockAuthClient, mockHub)
+			handler.CreateFromTemplate(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestNoteHandler_AddComment(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	currentTime := time.Now().UTC().String()
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface)
+	}{
+		{
+			name:           "Test_AddComment_Success",
+			requestBody:    `{"text":"TODO review","block_id":"block"}`,
+			expectedStatus: http.StatusOK,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().AddComment(gomock.Any(), &gen.AddCommentRequest{
+					NoteId:   noteId.String(),
+					UserId:   userId.String(),
+					ParentId: uuid.Nil.String(),
+					BlockId:  "block",
+					Text:     "TODO review",
+				}).Return(&gen.CommentResponse{
+					Comment: &gen.CommentModel{
+						Id:       uuid.NewV4().String(),
+						NoteId:   noteId.String(),
+						Parent:   uuid.Nil.String(),
+						AuthorId: userId.String(),
+						Author:   "username",
+						BlockId:  "block",
+						Text:     "TODO review",
+						Created:  currentTime,
+						Updated:  currentTime,
+					},
+				}, nil)
+				mockHub.EXPECT().BroadcastComment(gomock.Any(), gomock.Any()).Return()
+			},
+		},
+		{
+			name:           "Test_AddComment_EmptyText",
+			requestBody:    `{"text":""}`,
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			name:           "Test_AddComment_Forbidden",
+			requestBody:    `{"text":"TODO review"}`,
+			expectedStatus: http.StatusForbidden,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().AddComment(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+note.ErrNotEnoughRights))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("POST", "http://example.com/api/handler", bytes.NewBufferString(tt.requestBody))
+			ctx := context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+				Id:       userId,
+				Username: "username",
+			})
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+			r = mux.SetURLVars(r, map[string]string{"id": noteId.String()})
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient, mockHub)
+			handler.AddComment(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestNoteHandler_DeleteComment(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	commentId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		commentId      string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface)
+	}{
+		{
+			name:           "Test_DeleteComment_Success",
+			commentId:      commentId.String(),
+			expectedStatus: http.StatusNoContent,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().DeleteComment(gomock.Any(), &gen.CommentRequest{
+					NoteId:    noteId.String(),
+					CommentId: commentId.String(),
+					UserId:    userId.String(),
+				}).Return(&gen.EmptyResponse{}, nil)
+				mockHub.EXPECT().BroadcastComment(gomock.Any(), gomock.Any()).Return()
+			},
+		},
+		{
+			name:           "Test_DeleteComment_BadCommentId",
+			commentId:      "",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface) {
+			},
+		},
+		{
+			name:           "Test_DeleteComment_NotFound",
+			commentId:      commentId.String(),
+			expectedStatus: http.StatusNotFound,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().DeleteComment(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+"not found"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("DELETE", "http://example.com/api/handler", nil)
+			ctx := context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+				Id:       userId,
+				Username: "username",
+			})
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+			r = mux.SetURLVars(r, map[string]string{"id": noteId.String(), "comment_id": tt.commentId})
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient, mockHub)
+			handler.DeleteComment(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestNoteHandler_ResolveComment(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	commentId := uuid.NewV4()
+	currentTime := time.Now().UTC().String()
+
+	tests := []struct {
+		name           string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface)
+	}{
+		{
+			name:           "Test_ResolveComment_Success",
+			expectedStatus: http.StatusOK,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().SetCommentResolved(gomock.Any(), &gen.SetCommentResolvedRequest{
+					NoteId:    noteId.String(),
+					CommentId: commentId.String(),
+					UserId:    userId.String(),
+					Resolved:  true,
+				}).Return(&gen.CommentResponse{
+					Comment: &gen.CommentModel{
+						Id:       commentId.String(),
+						NoteId:   noteId.String(),
+						Parent:   uuid.Nil.String(),
+						AuthorId: userId.String(),
+						Text:     "text",
+						Created:  currentTime,
+						Updated:  currentTime,
+						Resolved: true,
+					},
+				}, nil)
+				mockHub.EXPECT().BroadcastComment(gomock.Any(), gomock.Any()).Return()
+			},
+		},
+		{
+			name:           "Test_ResolveComment_Forbidden",
+			expectedStatus: http.StatusForbidden,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockHub *mock_hub.MockHubInterface) {
+				mockClient.EXPECT().SetCommentResolved(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+note.ErrNotEnoughRights))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("PUT", "http://example.com/api/handler", nil)
+			ctx := context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+				Id:       userId,
+				Username: "username",
+			})
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+			r = mux.SetURLVars(r, map[string]string{"id": noteId.String(), "comment_id": commentId.String()})
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient, mockHub)
+			handler.ResolveComment(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestNoteHandler_AddReminder(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	remindAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient)
+	}{
+		{
+			name:           "Test_AddReminder_Success",
+			requestBody:    `{"remind_at":"` + remindAt.Format(time.RFC3339) + `","repeat":"daily"}`,
+			expectedStatus: http.StatusOK,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().AddReminder(gomock.Any(), &gen.AddReminderRequest{
+					NoteId:   noteId.String(),
+					UserId:   userId.String(),
+					RemindAt: remindAt.String(),
+					Repeat:   models.RepeatDaily,
+				}).Return(&gen.ReminderResponse{
+					Reminder: &gen.ReminderModel{
+						Id:       uuid.NewV4().String(),
+						NoteId:   noteId.String(),
+						UserId:   userId.String(),
+						RemindAt: remindAt.String(),
+						Repeat:   models.RepeatDaily,
+						Created:  time.Now().UTC().String(),
+					},
+				}, nil)
+			},
+		},
+		{
+			name:           "Test_AddReminder_Past",
+			requestBody:    `{"remind_at":"2020-01-01T00:00:00Z"}`,
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+			},
+		},
+		{
+			name:           "Test_AddReminder_NotFound",
+			requestBody:    `{"remind_at":"` + remindAt.Format(time.RFC3339) + `"}`,
+			expectedStatus: http.StatusNotFound,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().AddReminder(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+"not found"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("POST", "http://example.com/api/handler", bytes.NewBufferString(tt.requestBody))
+			ctx := context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+				Id:       userId,
+				Username: "username",
+			})
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+			r = mux.SetURLVars(r, map[string]string{"id": noteId.String()})
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient)
+			handler.AddReminder(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestNoteHandler_GetUpcomingReminders(t *testing.T) {
+	userId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		query          string
+		expectedStatus int
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient)
+	}{
+		{
+			name:           "Test_GetUpcomingReminders_Success",
+			expectedStatus: http.StatusOK,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().GetUpcomingReminders(gomock.Any(), &gen.GetUpcomingRemindersRequest{
+					UserId: userId.String(),
+					Count:  10,
+					Offset: 0,
+				}).Return(&gen.GetRemindersResponse{
+					Reminders: []*gen.ReminderModel{
+						{
+							Id:       uuid.NewV4().String(),
+							NoteId:   uuid.NewV4().String(),
+							UserId:   userId.String(),
+							RemindAt: time.Now().UTC().String(),
+							Created:  time.Now().UTC().String(),
+						},
+					},
+				}, nil)
+			},
+		},
+		{
+			name:           "Test_GetUpcomingReminders_BadParams",
+			query:          "?count=abc",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+			},
+		},
+		{
+			name:           "Test_GetUpcomingReminders_Fail",
+			expectedStatus: http.StatusInternalServerError,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().GetUpcomingReminders(gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("GET", "http://example.com/api/handler"+tt.query, nil)
+			ctx := context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+				Id:       userId,
+				Username: "username",
+			})
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient)
+			handler.GetUpcomingReminders(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
 		})
 	}
 }
 
-func TestNoteHandler_UpdateTag(t *testing.T) {
+func TestNoteHandler_AcknowledgeReminder(t *testing.T) {
 	userId := uuid.NewV4()
-	noteId := uuid.NewV4()
+	reminderId := uuid.NewV4()
 
 	tests := []struct {
-		requestBody    []byte
 		name           string
+		reminderId     string
 		expectedStatus int
-		mockUsecase    func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface)
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient)
 	}{
 		{
-			requestBody:    []byte("{\"old_name\":\"old\",\"new_name\":\"new\"}"),
-			name:           "Test_UpdateTag_Success",
+			name:           "Test_AcknowledgeReminder_Success",
+			reminderId:     reminderId.String(),
 			expectedStatus: http.StatusNoContent,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-				mockClient.EXPECT().UpdateTag(gomock.Any(), &gen.UpdateTagRequest{
-					OldTag: "old",
-					NewTag: "new",
-					UserId: userId.String(),
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().AcknowledgeReminder(gomock.Any(), &gen.ReminderRequest{
+					ReminderId: reminderId.String(),
+					UserId:     userId.String(),
 				}).Return(&gen.EmptyResponse{}, nil)
 			},
 		},
 		{
-			requestBody:    []byte(""),
-			name:           "Test_UpdateTag_Unauthorized",
-			expectedStatus: http.StatusUnauthorized,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-			},
-		},
-		{
-			requestBody:    []byte(""),
-			name:           "Test_UpdateTag_BadRequest",
-			expectedStatus: http.StatusBadRequest,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-			},
-		},
-		{
-			requestBody:    []byte("{\"old_name\":\"old\",\"new_name\":\"new1234567891234567890\"}"),
-			name:           "Test_UpdateTag_Invalid_Request",
+			name:           "Test_AcknowledgeReminder_BadId",
+			reminderId:     "",
 			expectedStatus: http.StatusBadRequest,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
 			},
 		},
 		{
-			requestBody:    []byte("{\"old_name\":\"old\",\"new_name\":\"new\"}"),
-			name:           "Test_UpdateTag_BadRequestClientErr",
-			expectedStatus: http.StatusBadRequest,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-				mockClient.EXPECT().UpdateTag(gomock.Any(), &gen.UpdateTagRequest{
-					OldTag: "old",
-					NewTag: "new",
-
-					UserId: userId.String(),
-				}).Return(&gen.EmptyResponse{}, errors.New("rpc error: code = Unknown desc = error"))
+			name:           "Test_AcknowledgeReminder_NotFound",
+			reminderId:     reminderId.String(),
+			expectedStatus: http.StatusNotFound,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().AcknowledgeReminder(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+"not found"))
 			},
 		},
 	}
@@ -1497,104 +4982,92 @@ func TestNoteHandler_UpdateTag(t *testing.T) {
 
 			defer ctrl.Finish()
 
-			r := httptest.NewRequest("POST", "http://example.com/api/handler", bytes.NewReader(tt.requestBody))
-			ctx := context.Background()
-			if tt.expectedStatus != http.StatusUnauthorized {
-				ctx = context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
-					Id:       userId,
-					Username: "username",
-				})
-			}
+			r := httptest.NewRequest("POST", "http://example.com/api/handler", nil)
+			ctx := context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+				Id:       userId,
+				Username: "username",
+			})
 			w := httptest.NewRecorder()
 			r = r.WithContext(ctx)
-			if tt.name == "Test_UpdateTag_BadRequest" {
-				r = mux.SetURLVars(r, map[string]string{"id": ""})
-
-			} else {
-				r = mux.SetURLVars(r, map[string]string{"id": noteId.String()})
-
-			}
+			r = mux.SetURLVars(r, map[string]string{"id": tt.reminderId})
 
 			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
-			tt.mockUsecase(mockClient, mockAuthClient, mockHub)
-			handler.UpdateTag(w, r)
+			tt.mockUsecase(mockClient)
+			handler.AcknowledgeReminder(w, r)
 
 			assert.Equal(t, tt.expectedStatus, w.Code)
-
 		})
 	}
 }
 
-func TestNoteHandler_SetIcon(t *testing.T) {
+func TestNoteHandler_GetAllNotesParams(t *testing.T) {
 	userId := uuid.NewV4()
-	noteId := uuid.NewV4()
 
 	tests := []struct {
-		requestBody    []byte
 		name           string
+		query          string
 		expectedStatus int
-		mockUsecase    func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface)
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient)
 	}{
 		{
-			requestBody:    []byte("{\"icon\":\"icon\"}"),
-			name:           "Test_SetIcon_Success",
+			name:           "Test_GetAllNotesParams_Archived",
+			query:          "?archived=archived",
 			expectedStatus: http.StatusOK,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-				mockClient.EXPECT().SetIcon(gomock.Any(), &gen.SetIconRequest{
-					NoteId: noteId.String(),
-					Icon:   "icon",
-					UserId: userId.String(),
-				}).Return(&gen.GetNoteResponse{
-					Note: &gen.NoteModel{
-						Id:            noteId.String(),
-						OwnerId:       userId.String(),
-						Tags:          []string{},
-						CreateTime:    time.Time{}.String(),
-						UpdateTime:    time.Time{}.String(),
-						Parent:        uuid.UUID{}.String(),
-						Collaborators: []string{},
-						Children:      []string{},
-						Icon:          "icon",
-					},
-				}, nil)
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().GetAllNotes(gomock.Any(), &gen.GetAllRequest{Count: int64(10), Offset: int64(0), UserId: userId.String(), Tags: []string{}, Archived: models.ArchiveFilterArchived, Sort: models.SortUpdated}).Return(&gen.GetAllResponse{}, nil)
 			},
 		},
 		{
-			requestBody:    []byte(""),
-			name:           "Test_SetIcon_Unauthorized",
-			expectedStatus: http.StatusUnauthorized,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			name:           "Test_GetAllNotesParams_All",
+			query:          "?archived=all",
+			expectedStatus: http.StatusOK,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().GetAllNotes(gomock.Any(), &gen.GetAllRequest{Count: int64(10), Offset: int64(0), UserId: userId.String(), Tags: []string{}, Archived: models.ArchiveFilterAll, Sort: models.SortUpdated}).Return(&gen.GetAllResponse{}, nil)
 			},
 		},
 		{
-			requestBody:    []byte(""),
-			name:           "Test_SetIcon_BadRequest",
+			name:           "Test_GetAllNotesParams_BadFilter",
+			query:          "?archived=hidden",
 			expectedStatus: http.StatusBadRequest,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
 			},
 		},
 		{
-			requestBody:    []byte("{\"icon\":\"icon\"}"),
-			name:           "Test_SetIcon_BadRequestClientErr",
+			name:           "Test_GetAllNotesParams_ManualSort",
+			query:          "?sort=manual",
+			expectedStatus: http.StatusOK,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().GetAllNotes(gomock.Any(), &gen.GetAllRequest{Count: int64(10), Offset: int64(0), UserId: userId.String(), Tags: []string{}, Archived: models.ArchiveFilterActive, Sort: models.SortManual}).Return(&gen.GetAllResponse{}, nil)
+			},
+		},
+		{
+			name:           "Test_GetAllNotesParams_Cursor",
+			query:          "?cursor=abc",
+			expectedStatus: http.StatusOK,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().GetAllNotes(gomock.Any(), &gen.GetAllRequest{Count: int64(10), Offset: int64(0), UserId: userId.String(), Tags: []string{}, Archived: models.ArchiveFilterActive, Sort: models.SortUpdated, Cursor: "abc"}).Return(&gen.GetAllResponse{NextCursor: "def"}, nil)
+			},
+		},
+		{
+			name:           "Test_GetAllNotesParams_BadSort",
+			query:          "?sort=random",
 			expectedStatus: http.StatusBadRequest,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-				mockClient.EXPECT().SetIcon(gomock.Any(), &gen.SetIconRequest{
-					NoteId: noteId.String(),
-					Icon:   "icon",
-					UserId: userId.String(),
-				}).Return(&gen.GetNoteResponse{}, errors.New("rpc error: code = Unknown desc = error"))
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
 			},
 		},
 		{
-			requestBody:    []byte("{\"icon\":\"icon\"}"),
-			name:           "Test_SetIcon_GetNoteErr",
-			expectedStatus: http.StatusInternalServerError,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-				mockClient.EXPECT().SetIcon(gomock.Any(), &gen.SetIconRequest{
-					NoteId: noteId.String(),
-					Icon:   "icon",
-					UserId: userId.String(),
-				}).Return(&gen.GetNoteResponse{Note: nil}, nil)
+			name:           "Test_GetAllNotesParams_TagMode",
+			query:          "?tags=first|-second&tag_mode=any",
+			expectedStatus: http.StatusOK,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().GetAllNotes(gomock.Any(), &gen.GetAllRequest{Count: int64(10), Offset: int64(0), UserId: userId.String(), Tags: []string{"first", "-second"}, Archived: models.ArchiveFilterActive, Sort: models.SortUpdated, TagMode: models.TagModeAny}).Return(&gen.GetAllResponse{}, nil)
+			},
+		},
+		{
+			name:           "Test_GetAllNotesParams_BadTagMode",
+			query:          "?tags=first&tag_mode=some",
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
 			},
 		},
 	}
@@ -1607,104 +5080,71 @@ func TestNoteHandler_SetIcon(t *testing.T) {
 
 			defer ctrl.Finish()
 
-			r := httptest.NewRequest("POST", "http://example.com/api/handler", bytes.NewReader(tt.requestBody))
-			ctx := context.Background()
-			if tt.expectedStatus != http.StatusUnauthorized {
-				ctx = context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
-					Id:       userId,
-					Username: "username",
-				})
-			}
+			r := httptest.NewRequest("GET", "http://example.com/api/handler"+tt.query, nil)
+			ctx := context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+				Id:       userId,
+				Username: "username",
+			})
 			w := httptest.NewRecorder()
 			r = r.WithContext(ctx)
-			if tt.name == "Test_SetIcon_BadRequest" {
-				r = mux.SetURLVars(r, map[string]string{"id": ""})
-
-			} else {
-				r = mux.SetURLVars(r, map[string]string{"id": noteId.String()})
-
-			}
 
 			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
-			tt.mockUsecase(mockClient, mockAuthClient, mockHub)
-			handler.SetIcon(w, r)
+			tt.mockUsecase(mockClient)
+			handler.GetAllNotes(w, r)
 
 			assert.Equal(t, tt.expectedStatus, w.Code)
-
+			if tt.name == "Test_GetAllNotesParams_Cursor" {
+				page := models.NotesPage{}
+				assert.Nil(t, json.NewDecoder(w.Body).Decode(&page))
+				assert.Equal(t, "def", page.NextCursor)
+			}
 		})
 	}
 }
 
-func TestNoteHandler_SetHeader(t *testing.T) {
+func TestNoteHandler_ArchiveNote(t *testing.T) {
 	userId := uuid.NewV4()
 	noteId := uuid.NewV4()
 
 	tests := []struct {
-		requestBody    []byte
 		name           string
+		noteId         string
 		expectedStatus int
-		mockUsecase    func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface)
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient)
 	}{
 		{
-			requestBody:    []byte("{\"header\":\"header\"}"),
-			name:           "Test_SetHeader_Success",
+			name:           "Test_ArchiveNote_Success",
+			noteId:         noteId.String(),
 			expectedStatus: http.StatusOK,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-				mockClient.EXPECT().SetHeader(gomock.Any(), &gen.SetHeaderRequest{
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().ArchiveNote(gomock.Any(), &gen.ChangeFlagRequest{
 					NoteId: noteId.String(),
-					Header: "header",
 					UserId: userId.String(),
 				}).Return(&gen.GetNoteResponse{
 					Note: &gen.NoteModel{
-						Id:            noteId.String(),
-						OwnerId:       userId.String(),
-						Tags:          []string{},
-						CreateTime:    time.Time{}.String(),
-						UpdateTime:    time.Time{}.String(),
-						Parent:        uuid.UUID{}.String(),
-						Collaborators: []string{},
-						Children:      []string{},
-						Header:        "header",
+						Id:         noteId.String(),
+						OwnerId:    userId.String(),
+						CreateTime: time.Time{}.String(),
+						UpdateTime: time.Time{}.String(),
+						Parent:     uuid.UUID{}.String(),
+						Archived:   true,
 					},
 				}, nil)
 			},
 		},
 		{
-			requestBody:    []byte(""),
-			name:           "Test_SetHeader_Unauthorized",
-			expectedStatus: http.StatusUnauthorized,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-			},
-		},
-		{
-			requestBody:    []byte(""),
-			name:           "Test_SetHeader_BadRequest",
-			expectedStatus: http.StatusBadRequest,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-			},
-		},
-		{
-			requestBody:    []byte("{\"header\":\"header\"}"),
-			name:           "Test_SetHeader_BadRequestClientErr",
+			name:           "Test_ArchiveNote_BadId",
+			noteId:         "",
 			expectedStatus: http.StatusBadRequest,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-				mockClient.EXPECT().SetHeader(gomock.Any(), &gen.SetHeaderRequest{
-					NoteId: noteId.String(),
-					Header: "header",
-					UserId: userId.String(),
-				}).Return(&gen.GetNoteResponse{}, errors.New("rpc error: code = Unknown desc = error"))
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
 			},
 		},
 		{
-			requestBody:    []byte("{\"header\":\"header\"}"),
-			name:           "Test_SetHeader_GetNoteErr",
-			expectedStatus: http.StatusInternalServerError,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-				mockClient.EXPECT().SetHeader(gomock.Any(), &gen.SetHeaderRequest{
-					NoteId: noteId.String(),
-					Header: "header",
-					UserId: userId.String(),
-				}).Return(&gen.GetNoteResponse{Note: nil}, nil)
+			name:           "Test_ArchiveNote_NotFound",
+			noteId:         noteId.String(),
+			expectedStatus: http.StatusNotFound,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().ArchiveNote(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+"not found"))
 			},
 		},
 	}
@@ -1717,94 +5157,68 @@ func TestNoteHandler_SetHeader(t *testing.T) {
 
 			defer ctrl.Finish()
 
-			r := httptest.NewRequest("POST", "http://example.com/api/handler", bytes.NewReader(tt.requestBody))
-			ctx := context.Background()
-			if tt.expectedStatus != http.StatusUnauthorized {
-				ctx = context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
-					Id:       userId,
-					Username: "username",
-				})
-			}
+			r := httptest.NewRequest("PUT", "http://example.com/api/handler", nil)
+			ctx := context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+				Id:       userId,
+				Username: "username",
+			})
 			w := httptest.NewRecorder()
 			r = r.WithContext(ctx)
-			if tt.name == "Test_SetHeader_BadRequest" {
-				r = mux.SetURLVars(r, map[string]string{"id": ""})
-
-			} else {
-				r = mux.SetURLVars(r, map[string]string{"id": noteId.String()})
-
-			}
+			r = mux.SetURLVars(r, map[string]string{"id": tt.noteId})
 
 			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
-			tt.mockUsecase(mockClient, mockAuthClient, mockHub)
-			handler.SetHeader(w, r)
+			tt.mockUsecase(mockClient)
+			handler.ArchiveNote(w, r)
 
 			assert.Equal(t, tt.expectedStatus, w.Code)
-
 		})
 	}
 }
 
-func TestNoteHandler_AddFavorite(t *testing.T) {
+func TestNoteHandler_ReorderNotes(t *testing.T) {
 	userId := uuid.NewV4()
-	noteId := uuid.NewV4()
+	first := uuid.NewV4()
+	second := uuid.NewV4()
 
 	tests := []struct {
 		name           string
+		body           string
 		expectedStatus int
-		mockUsecase    func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface)
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient)
 	}{
 		{
-			name:           "Test_AddFavorite_Success",
-			expectedStatus: http.StatusOK,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-				mockClient.EXPECT().AddFav(gomock.Any(), &gen.ChangeFlagRequest{
-					NoteId: noteId.String(),
-					UserId: userId.String(),
-				}).Return(&gen.GetNoteResponse{
-					Note: &gen.NoteModel{
-						Id:            noteId.String(),
-						OwnerId:       userId.String(),
-						Tags:          []string{},
-						CreateTime:    time.Time{}.String(),
-						UpdateTime:    time.Time{}.String(),
-						Parent:        uuid.UUID{}.String(),
-						Collaborators: []string{},
-						Children:      []string{},
-					},
-				}, nil)
-			},
-		},
-		{
-			name:           "Test_AddFavorite_Unauthorized",
-			expectedStatus: http.StatusUnauthorized,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-			},
-		},
-		{
-			name:           "Test_AddFavorite_BadRequest",
-			expectedStatus: http.StatusBadRequest,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			name:           "Test_ReorderNotes_Success",
+			body:           `{"notes":["` + second.String() + `","` + first.String() + `"]}`,
+			expectedStatus: http.StatusNoContent,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().ReorderNotes(gomock.Any(), &gen.ReorderNotesRequest{
+					UserId:   userId.String(),
+					ParentId: uuid.UUID{}.String(),
+					Notes:    []string{second.String(), first.String()},
+				}).Return(&gen.EmptyResponse{}, nil)
 			},
 		},
 		{
-			name:           "Test_AddFavorite_BadRequestClientErr",
+			name:           "Test_ReorderNotes_Duplicate",
+			body:           `{"notes":["` + first.String() + `","` + first.String() + `"]}`,
 			expectedStatus: http.StatusBadRequest,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-				mockClient.EXPECT().AddFav(gomock.Any(), &gen.ChangeFlagRequest{
-					NoteId: noteId.String(),
-					UserId: userId.String(),
-				}).Return(&gen.GetNoteResponse{}, errors.New("rpc error: code = Unknown desc = error"))
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
 			},
 		},
 		{
-			name:           "Test_AddFavorite_GetNoteErr",
-			expectedStatus: http.StatusInternalServerError,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-				mockClient.EXPECT().AddFav(gomock.Any(), &gen.ChangeFlagRequest{
-					NoteId: noteId.String(),
-					UserId: userId.String(),
-				}).Return(&gen.GetNoteResponse{Note: nil}, nil)
+			name:           "Test_ReorderNotes_WrongParent",
+			body:           `{"notes":["` + first.String() + `"]}`,
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().ReorderNotes(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+note.ErrWrongParent))
+			},
+		},
+		{
+			name:           "Test_ReorderNotes_NotFound",
+			body:           `{"notes":["` + first.String() + `"]}`,
+			expectedStatus: http.StatusNotFound,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().ReorderNotes(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+"not found"))
 			},
 		},
 	}
@@ -1817,94 +5231,72 @@ func TestNoteHandler_AddFavorite(t *testing.T) {
 
 			defer ctrl.Finish()
 
-			r := httptest.NewRequest("POST", "http://example.com/api/handler", bytes.NewReader([]byte{}))
-			ctx := context.Background()
-			if tt.expectedStatus != http.StatusUnauthorized {
-				ctx = context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
-					Id:       userId,
-					Username: "username",
-				})
-			}
+			r := httptest.NewRequest("PUT", "http://example.com/api/handler", bytes.NewReader([]byte(tt.body)))
+			ctx := context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+				Id:       userId,
+				Username: "username",
+			})
 			w := httptest.NewRecorder()
 			r = r.WithContext(ctx)
-			if tt.name == "Test_AddFavorite_BadRequest" {
-				r = mux.SetURLVars(r, map[string]string{"id": ""})
-
-			} else {
-				r = mux.SetURLVars(r, map[string]string{"id": noteId.String()})
-
-			}
 
 			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
-			tt.mockUsecase(mockClient, mockAuthClient, mockHub)
-			handler.AddFavorite(w, r)
+			tt.mockUsecase(mockClient)
+			handler.ReorderNotes(w, r)
 
 			assert.Equal(t, tt.expectedStatus, w.Code)
-
 		})
 	}
 }
 
-func TestNoteHandler_DeleteFavorite(t *testing.T) {
+func TestNoteHandler_BulkUpdate(t *testing.T) {
 	userId := uuid.NewV4()
-	noteId := uuid.NewV4()
+	first := uuid.NewV4()
+	second := uuid.NewV4()
 
 	tests := []struct {
 		name           string
+		body           string
 		expectedStatus int
-		mockUsecase    func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface)
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient)
 	}{
 		{
-			name:           "Test_DeleteFavorite_Success",
+			name:           "Test_BulkUpdate_Success",
+			body:           `{"notes":["` + first.String() + `","` + second.String() + `"],"operations":[{"type":"add_tag","value":"work"},{"type":"add_fav"}]}`,
 			expectedStatus: http.StatusOK,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-				mockClient.EXPECT().DelFav(gomock.Any(), &gen.ChangeFlagRequest{
-					NoteId: noteId.String(),
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().BulkUpdate(gomock.Any(), &gen.BulkRequest{
+					NoteIds: []string{first.String(), second.String()},
+					Operations: []*gen.BulkOperationModel{
+						{Type: models.BulkAddTag, Value: "work"},
+						{Type: models.BulkAddFav},
+					},
 					UserId: userId.String(),
-				}).Return(&gen.GetNoteResponse{
-					Note: &gen.NoteModel{
-						Id:            noteId.String(),
-						OwnerId:       userId.String(),
-						Tags:          []string{},
-						CreateTime:    time.Time{}.String(),
-						UpdateTime:    time.Time{}.String(),
-						Parent:        uuid.UUID{}.String(),
-						Collaborators: []string{},
-						Children:      []string{},
+				}).Return(&gen.BulkResponse{
+					Results: []*gen.BulkResultModel{
+						{NoteId: first.String(), Success: true},
+						{NoteId: second.String(), Error: "not found"},
 					},
 				}, nil)
 			},
 		},
 		{
-			name:           "Test_DeleteFavorite_Unauthorized",
-			expectedStatus: http.StatusUnauthorized,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-			},
-		},
-		{
-			name:           "Test_DeleteFavorite_BadRequest",
+			name:           "Test_BulkUpdate_BadBody",
+			body:           `{"notes":`,
 			expectedStatus: http.StatusBadRequest,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-			},
+			mockUsecase:    func(mockClient *mock_grpc.MockNoteClient) {},
 		},
 		{
-			name:           "Test_DeleteFavorite_BadRequestClientErr",
+			name:           "Test_BulkUpdate_UnknownOperation",
+			body:           `{"notes":["` + first.String() + `"],"operations":[{"type":"rename"}]}`,
 			expectedStatus: http.StatusBadRequest,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-				mockClient.EXPECT().DelFav(gomock.Any(), &gen.ChangeFlagRequest{
-					NoteId: noteId.String(),
-					UserId: userId.String(),
-				}).Return(&gen.GetNoteResponse{}, errors.New("rpc error: code = Unknown desc = error"))
-			},
+			mockUsecase:    func(mockClient *mock_grpc.MockNoteClient) {},
 		},
 		{
-			name:           "Test_DeleteFavorite_GetNoteErr",
-			expectedStatus: http.StatusInternalServerError,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-				mockClient.EXPECT().DelFav(gomock.Any(), &gen.ChangeFlagRequest{
-					NoteId: noteId.String(),
-					UserId: userId.String(),
-				}).Return(&gen.GetNoteResponse{Note: nil}, nil)
+			name:           "Test_BulkUpdate_ClientErr",
+			body:           `{"notes":["` + first.String() + `"],"operations":[{"type":"delete"}]}`,
+			expectedStatus: http.StatusBadRequest,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().BulkUpdate(gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
 			},
 		},
 	}
@@ -1917,94 +5309,58 @@ func TestNoteHandler_DeleteFavorite(t *testing.T) {
 
 			defer ctrl.Finish()
 
-			r := httptest.NewRequest("POST", "http://example.com/api/handler", bytes.NewReader([]byte{}))
-			ctx := context.Background()
-			if tt.expectedStatus != http.StatusUnauthorized {
-				ctx = context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
-					Id:       userId,
-					Username: "username",
-				})
-			}
+			r := httptest.NewRequest("POST", "http://example.com/api/handler", bytes.NewReader([]byte(tt.body)))
+			ctx := context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+				Id:       userId,
+				Username: "username",
+			})
 			w := httptest.NewRecorder()
 			r = r.WithContext(ctx)
-			if tt.name == "Test_DeleteFavorite_BadRequest" {
-				r = mux.SetURLVars(r, map[string]string{"id": ""})
-
-			} else {
-				r = mux.SetURLVars(r, map[string]string{"id": noteId.String()})
-
-			}
 
 			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
-			tt.mockUsecase(mockClient, mockAuthClient, mockHub)
-			handler.DeleteFavorite(w, r)
+			tt.mockUsecase(mockClient)
+			handler.BulkUpdate(w, r)
 
 			assert.Equal(t, tt.expectedStatus, w.Code)
-
 		})
 	}
 }
 
-func TestNoteHandler_SetPublic(t *testing.T) {
+func TestNoteHandler_GetBacklinks(t *testing.T) {
 	userId := uuid.NewV4()
 	noteId := uuid.NewV4()
 
 	tests := []struct {
 		name           string
+		id             string
 		expectedStatus int
-		mockUsecase    func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface)
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient)
 	}{
 		{
-			name:           "Test_SetPublic_Success",
+			name:           "Test_GetBacklinks_Success",
+			id:             noteId.String(),
 			expectedStatus: http.StatusOK,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-				mockClient.EXPECT().SetPublic(gomock.Any(), &gen.AccessModeRequest{
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().GetBacklinks(gomock.Any(), &gen.GetBacklinksRequest{
 					NoteId: noteId.String(),
 					UserId: userId.String(),
-				}).Return(&gen.GetNoteResponse{
-					Note: &gen.NoteModel{
-						Id:            noteId.String(),
-						OwnerId:       userId.String(),
-						Tags:          []string{},
-						CreateTime:    time.Time{}.String(),
-						UpdateTime:    time.Time{}.String(),
-						Parent:        uuid.UUID{}.String(),
-						Collaborators: []string{},
-						Children:      []string{},
-					},
+				}).Return(&gen.GetBacklinksResponse{
+					Notes: []*gen.NoteLinkModel{{Id: uuid.NewV4().String(), Title: "source"}},
 				}, nil)
 			},
 		},
 		{
-			name:           "Test_SetPublic_Unauthorized",
-			expectedStatus: http.StatusUnauthorized,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-			},
-		},
-		{
-			name:           "Test_SetPublic_BadRequest",
-			expectedStatus: http.StatusBadRequest,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-			},
-		},
-		{
-			name:           "Test_SetPublic_BadRequestClientErr",
+			name:           "Test_GetBacklinks_BadId",
+			id:             "id",
 			expectedStatus: http.StatusBadRequest,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-				mockClient.EXPECT().SetPublic(gomock.Any(), &gen.AccessModeRequest{
-					NoteId: noteId.String(),
-					UserId: userId.String(),
-				}).Return(&gen.GetNoteResponse{}, errors.New("rpc error: code = Unknown desc = error"))
-			},
+			mockUsecase:    func(mockClient *mock_grpc.MockNoteClient) {},
 		},
 		{
-			name:           "Test_SetPublic_GetNoteErr",
-			expectedStatus: http.StatusInternalServerError,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-				mockClient.EXPECT().SetPublic(gomock.Any(), &gen.AccessModeRequest{
-					NoteId: noteId.String(),
-					UserId: userId.String(),
-				}).Return(&gen.GetNoteResponse{Note: nil}, nil)
+			name:           "Test_GetBacklinks_NotFound",
+			id:             noteId.String(),
+			expectedStatus: http.StatusNotFound,
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().GetBacklinks(gomock.Any(), gomock.Any()).Return(nil, errors.New(RpcErrorPrefix+"not found"))
 			},
 		},
 	}
@@ -2017,131 +5373,153 @@ func TestNoteHandler_SetPublic(t *testing.T) {
 
 			defer ctrl.Finish()
 
-			r := httptest.NewRequest("POST", "http://example.com/api/handler", bytes.NewReader([]byte{}))
-			ctx := context.Background()
-			if tt.expectedStatus != http.StatusUnauthorized {
-				ctx = context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
-					Id:       userId,
-					Username: "username",
-				})
-			}
+			r := httptest.NewRequest("GET", "http://example.com/api/handler", nil)
+			r = mux.SetURLVars(r, map[string]string{"id": tt.id})
+			ctx := context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+				Id:       userId,
+				Username: "username",
+			})
 			w := httptest.NewRecorder()
 			r = r.WithContext(ctx)
-			if tt.name == "Test_SetPublic_BadRequest" {
-				r = mux.SetURLVars(r, map[string]string{"id": ""})
-
-			} else {
-				r = mux.SetURLVars(r, map[string]string{"id": noteId.String()})
-
-			}
 
 			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
-			tt.mockUsecase(mockClient, mockAuthClient, mockHub)
-			handler.SetPublic(w, r)
+			tt.mockUsecase(mockClient)
+			handler.GetBacklinks(w, r)
 
 			assert.Equal(t, tt.expectedStatus, w.Code)
-
 		})
 	}
 }
 
-func TestNoteHandler_SetPrivate(t *testing.T) {
-
+func TestNoteHandler_GetNoteGraph(t *testing.T) {
 	userId := uuid.NewV4()
-	noteId := uuid.NewV4()
 
 	tests := []struct {
 		name           string
 		expectedStatus int
-		mockUsecase    func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface)
+		mockUsecase    func(mockClient *mock_grpc.MockNoteClient)
 	}{
 		{
-			name:           "Test_SetPrivate_Success",
+			name:           "Test_GetNoteGraph_Success",
 			expectedStatus: http.StatusOK,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-				mockClient.EXPECT().SetPrivate(gomock.Any(), &gen.AccessModeRequest{
-					NoteId: noteId.String(),
-					UserId: userId.String(),
-				}).Return(&gen.GetNoteResponse{
-					Note: &gen.NoteModel{
-						Id:            noteId.String(),
-						OwnerId:       userId.String(),
-						Tags:          []string{},
-						CreateTime:    time.Time{}.String(),
-						UpdateTime:    time.Time{}.String(),
-						Parent:        uuid.UUID{}.String(),
-						Collaborators: []string{},
-						Children:      []string{},
-					},
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				first := uuid.NewV4().String()
+				second := uuid.NewV4().String()
+				mockClient.EXPECT().GetNoteGraph(gomock.Any(), &gen.GetNoteGraphRequest{UserId: userId.String()}).Return(&gen.GetNoteGraphResponse{
+					Nodes: []*gen.NoteLinkModel{{Id: first}, {Id: second}},
+					Edges: []*gen.NoteEdgeModel{{Source: first, Target: second}},
 				}, nil)
 			},
 		},
 		{
-			name:           "Test_SetPrivate_Unauthorized",
-			expectedStatus: http.StatusUnauthorized,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-			},
-		},
-		{
-			name:           "Test_SetPrivate_BadRequest",
+			name:           "Test_GetNoteGraph_Fail",
 			expectedStatus: http.StatusBadRequest,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
+			mockUsecase: func(mockClient *mock_grpc.MockNoteClient) {
+				mockClient.EXPECT().GetNoteGraph(gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
 			},
 		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
+			mockHub := mock_hub.NewMockHubInterface(ctrl)
+
+			defer ctrl.Finish()
+
+			r := httptest.NewRequest("GET", "http://example.com/api/handler", nil)
+			ctx := context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+				Id:       userId,
+				Username: "username",
+			})
+			w := httptest.NewRecorder()
+			r = r.WithContext(ctx)
+
+			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
+			tt.mockUsecase(mockClient)
+			handler.GetNoteGraph(w, r)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+// ошибки usecase должны доходить до HTTP-обработчика через настоящий gRPC-сервер без подмены
+func TestNoteHandler_UpdateNote_ThroughGrpc(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		ifMatch        string
+		expectedStatus int
+		expectedETag   string
+		mockUsecase    func(mockUsecase *mock_note.MockNoteUsecase)
+	}{
 		{
-			name:           "Test_SetPrivate_BadRequestClientErr",
-			expectedStatus: http.StatusBadRequest,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-				mockClient.EXPECT().SetPrivate(gomock.Any(), &gen.AccessModeRequest{
-					NoteId: noteId.String(),
-					UserId: userId.String(),
-				}).Return(&gen.GetNoteResponse{}, errors.New("rpc error: code = Unknown desc = error"))
+			name:           "Test_UpdateNote_VersionConflict",
+			ifMatch:        `"3"`,
+			expectedStatus: http.StatusConflict,
+			expectedETag:   `"5"`,
+			mockUsecase: func(mockUsecase *mock_note.MockNoteUsecase) {
+				mockUsecase.EXPECT().UpdateNote(gomock.Any(), noteId, userId, "\"new\"", int64(3)).Return(models.Note{}, errors.New(note.ErrVersionConflict))
+				mockUsecase.EXPECT().GetNote(gomock.Any(), noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: noteId, OwnerId: userId, Data: "\"current\"", Version: 5},
+				}, nil)
 			},
 		},
 		{
-			name:           "Test_SetPrivate_GetNoteErr",
-			expectedStatus: http.StatusInternalServerError,
-			mockUsecase: func(mockClient *mock_grpc.MockNoteClient, mockAuth *mock_auth.MockAuthClient, mockHub *mock_hub.MockHubInterface) {
-				mockClient.EXPECT().SetPrivate(gomock.Any(), &gen.AccessModeRequest{
-					NoteId: noteId.String(),
-					UserId: userId.String(),
-				}).Return(&gen.GetNoteResponse{Note: nil}, nil)
+			name:           "Test_UpdateNote_Forbidden",
+			expectedStatus: http.StatusForbidden,
+			mockUsecase: func(mockUsecase *mock_note.MockNoteUsecase) {
+				mockUsecase.EXPECT().UpdateNote(gomock.Any(), noteId, userId, "\"new\"", int64(0)).Return(models.Note{}, errors.New(note.ErrNotEnoughRights))
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
-			mockClient := mock_grpc.NewMockNoteClient(ctrl)
+			mockUsecase := mock_note.NewMockNoteUsecase(ctrl)
 			mockAuthClient := mock_auth.NewMockAuthClient(ctrl)
 			mockHub := mock_hub.NewMockHubInterface(ctrl)
 
 			defer ctrl.Finish()
 
-			r := httptest.NewRequest("POST", "http://example.com/api/handler", bytes.NewReader([]byte{}))
-			ctx := context.Background()
-			if tt.expectedStatus != http.StatusUnauthorized {
-				ctx = context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
-					Id:       userId,
-					Username: "username",
-				})
+			listener := bufconn.Listen(1024 * 1024)
+			server := grpc.NewServer()
+			gen.RegisterNoteServer(server, noteDelivery.NewGrpcNoteHandler(mockUsecase))
+			go func() {
+				_ = server.Serve(listener)
+			}()
+			defer server.Stop()
+
+			connection, err := grpc.NewClient("passthrough:///bufnet",
+				grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+					return listener.DialContext(ctx)
+				}),
+				grpc.WithTransportCredentials(insecure.NewCredentials()),
+			)
+			assert.NoError(t, err)
+			defer connection.Close()
+
+			r := httptest.NewRequest("PUT", "http://example.com/api/handler", bytes.NewReader([]byte("{\"data\":\"new\"}")))
+			r = r.WithContext(context.WithValue(r.Context(), config.PayloadContextKey, models.JwtPayload{
+				Id:       userId,
+				Username: "username",
+			}))
+			if tt.ifMatch != "" {
+				r.Header.Set("If-Match", tt.ifMatch)
 			}
+			r = mux.SetURLVars(r, map[string]string{"id": noteId.String()})
 			w := httptest.NewRecorder()
-			r = r.WithContext(ctx)
-			if tt.name == "Test_SetPrivate_BadRequest" {
-				r = mux.SetURLVars(r, map[string]string{"id": ""})
-
-			} else {
-				r = mux.SetURLVars(r, map[string]string{"id": noteId.String()})
-
-			}
 
-			handler := CreateNotesHandler(mockClient, mockAuthClient, mockHub)
-			tt.mockUsecase(mockClient, mockAuthClient, mockHub)
-			handler.SetPrivate(w, r)
+			handler := CreateNotesHandler(gen.NewNoteClient(connection), mockAuthClient, mockHub)
+			tt.mockUsecase(mockUsecase)
+			handler.UpdateNote(w, r)
 
 			assert.Equal(t, tt.expectedStatus, w.Code)
-
+			assert.Equal(t, tt.expectedETag, w.Header().Get("ETag"))
 		})
 	}
 }
@@ -17,102 +17,245 @@ const (
 	ErrTooManySubnotes      = "too many subnotes"
 	ErrTooManyCollaborators = "too many collaborators"
 	ErrAlreadyCollaborator  = "already a collaborator"
+	ErrCyclicMove           = "can`t move note into its own subtree"
+	ErrNotEnoughRights      = "not enough rights"
+	ErrNotCollaborator      = "not a collaborator"
+	ErrAlreadyOwner         = "already an owner"
+	ErrInviteExpired        = "invite expired"
+	ErrShareLinkExpired     = "share link expired"
+	ErrWrongPassword        = "wrong password"
+	ErrWrongParent          = "note doesn`t belong to this parent"
+	ErrInvalidCursor        = "invalid cursor"
+	ErrVersionConflict      = "version conflict"
 )
 
+const WelcomeTemplateID = "c5e3c4f0-4a4b-4c1f-9a55-6f2f4c0b7d01"
+
 type NoteUsecase interface {
-	GetAllNotes(context.Context, uuid.UUID, int64, int64, string, []string) ([]models.NoteResponse, error)
+	GetAllNotes(context.Context, uuid.UUID, int64, int64, string, models.TagFilter, string, string, string) ([]models.NoteResponse, string, error)
 	GetNote(context.Context, uuid.UUID, uuid.UUID) (models.NoteResponse, error)
-	GetPublicNote(ctx context.Context, noteId uuid.UUID) (models.NoteResponse, error)
+	GetPublicNote(ctx context.Context, noteId uuid.UUID, token string, password string) (models.NoteResponse, error)
 	CreateNote(context.Context, uuid.UUID, string) (models.Note, error)
-	UpdateNote(context.Context, uuid.UUID, uuid.UUID, string) (models.Note, error)
+	UpdateNote(context.Context, uuid.UUID, uuid.UUID, string, int64) (models.Note, error)
 	DeleteNote(context.Context, uuid.UUID, uuid.UUID) error
 
+	GetTrash(ctx context.Context, userID uuid.UUID, count int64, offset int64) ([]models.TrashedNote, error)
+	RestoreNote(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) (models.Note, error)
+	DeleteNotePermanently(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) error
+
 	CreateSubNote(context.Context, uuid.UUID, string, uuid.UUID) (models.Note, error)
+	MoveNote(ctx context.Context, noteID uuid.UUID, parentID uuid.UUID, userID uuid.UUID) (models.Note, error)
+	ReorderNotes(ctx context.Context, userID uuid.UUID, parentID uuid.UUID, notes []uuid.UUID) error
+	DuplicateNote(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, withSubnotes bool) (models.Note, error)
+
+	GetBacklinks(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) ([]models.NoteLink, error)
+	GetNoteGraph(ctx context.Context, userID uuid.UUID) (models.NoteGraph, error)
+
+	SetTemplate(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, isTemplate bool) (models.Note, error)
+	GetTemplates(ctx context.Context, userID uuid.UUID) ([]models.Template, error)
+	CreateFromTemplate(ctx context.Context, templateID uuid.UUID, parentID uuid.UUID, userID uuid.UUID, username string) (models.Note, error)
+
+	AddCollaborator(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, guestID uuid.UUID, role string) (models.Invite, error)
+	SetCollaboratorRole(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, guestID uuid.UUID, role string) error
+	RemoveCollaborator(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, guestID uuid.UUID) (string, []uuid.UUID, error)
+	LeaveNote(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) ([]uuid.UUID, error)
+
+	GetSentInvites(ctx context.Context, userID uuid.UUID) ([]models.Invite, error)
+	GetReceivedInvites(ctx context.Context, userID uuid.UUID) ([]models.Invite, error)
+	AcceptInvite(ctx context.Context, inviteID uuid.UUID, userID uuid.UUID) (models.Invite, error)
+	DeclineInvite(ctx context.Context, inviteID uuid.UUID, userID uuid.UUID) (models.Invite, error)
+	RevokeInvite(ctx context.Context, inviteID uuid.UUID, userID uuid.UUID) (models.Invite, error)
 
-	AddCollaborator(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (string, error)
+	ProposeTransfer(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, newOwnerID uuid.UUID) (string, error)
+	AcceptTransfer(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) (models.OwnershipTransfer, error)
 
-	AddTag(ctx context.Context, tagName string, noteId uuid.UUID, userId uuid.UUID) (models.Note, error)
-	DeleteTag(ctx context.Context, tagName string, noteId uuid.UUID, userId uuid.UUID) (models.Note, error)
-	GetTags(ctx context.Context, userID uuid.UUID) ([]string, error)
+	AddTag(ctx context.Context, tagName string, noteId uuid.UUID, userId uuid.UUID, version int64) (models.Note, error)
+	DeleteTag(ctx context.Context, tagName string, noteId uuid.UUID, userId uuid.UUID, version int64) (models.Note, error)
+	GetTags(ctx context.Context, userID uuid.UUID) ([]models.Tag, error)
 
 	UpdateTag(ctx context.Context, oldTag string, newTag string, userID uuid.UUID) error
+	MergeTags(ctx context.Context, tags []string, newTag string, userID uuid.UUID) error
+	SetTagInfo(ctx context.Context, tag models.Tag, userID uuid.UUID) error
 	RememberTag(ctx context.Context, tagName string, userID uuid.UUID) error
 	ForgetTag(ctx context.Context, tagName string, userID uuid.UUID) error
 
-	SetIcon(ctx context.Context, noteID uuid.UUID, icon string, userID uuid.UUID) (models.Note, error)
-	SetHeader(ctx context.Context, noteID uuid.UUID, header string, userID uuid.UUID) (models.Note, error)
+	SetIcon(ctx context.Context, noteID uuid.UUID, icon string, userID uuid.UUID, version int64) (models.Note, error)
+	SetHeader(ctx context.Context, noteID uuid.UUID, header string, userID uuid.UUID, version int64) (models.Note, error)
 
 	AddFav(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) (models.Note, error)
 	DelFav(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) (models.Note, error)
 
+	ArchiveNote(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) (models.Note, error)
+	UnarchiveNote(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) (models.Note, error)
+
 	SetPublic(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) (models.Note, error)
 	SetPrivate(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) (models.Note, error)
 
+	BulkUpdate(ctx context.Context, noteIDs []uuid.UUID, operations []models.BulkOperation, userID uuid.UUID) ([]models.BulkResult, error)
+
+	CreateShareLink(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, password string, expiresAt *time.Time, includeSubtree bool) (models.ShareLink, error)
+	GetShareLinks(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) ([]models.ShareLink, error)
+	RevokeShareLink(ctx context.Context, noteID uuid.UUID, linkID uuid.UUID, userID uuid.UUID) error
+
+	GetComments(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) ([]models.Comment, error)
+	AddComment(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, parentID uuid.UUID, blockID string, text string) (models.Comment, error)
+	UpdateComment(ctx context.Context, noteID uuid.UUID, commentID uuid.UUID, userID uuid.UUID, text string) (models.Comment, error)
+	DeleteComment(ctx context.Context, noteID uuid.UUID, commentID uuid.UUID, userID uuid.UUID) error
+	SetCommentResolved(ctx context.Context, noteID uuid.UUID, commentID uuid.UUID, userID uuid.UUID, resolved bool) (models.Comment, error)
+
+	AddReminder(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, remindAt time.Time, repeat string) (models.Reminder, error)
+	GetReminders(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) ([]models.Reminder, error)
+	GetUpcomingReminders(ctx context.Context, userID uuid.UUID, count int64, offset int64) ([]models.Reminder, error)
+	DeleteReminder(ctx context.Context, reminderID uuid.UUID, userID uuid.UUID) error
+	AcknowledgeReminder(ctx context.Context, reminderID uuid.UUID, userID uuid.UUID) error
+
 	GetAttachList(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) ([]string, error)
-	GetSharedAttachList(ctx context.Context, noteID uuid.UUID) ([]string, error)
+	GetSharedAttachList(ctx context.Context, noteID uuid.UUID, token string, password string) ([]string, error)
+
+	CheckPermissions(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) (string, error)
 
-	CheckPermissions(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) (bool, error)
+	GetRevisions(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, count int64, offset int64) ([]models.NoteRevision, error)
+	GetRevision(ctx context.Context, noteID uuid.UUID, revisionID uuid.UUID, userID uuid.UUID) (models.NoteRevision, error)
+	GetRevisionDiff(ctx context.Context, noteID uuid.UUID, fromID uuid.UUID, toID uuid.UUID, userID uuid.UUID) (models.RevisionDiff, error)
+	RestoreRevision(ctx context.Context, noteID uuid.UUID, revisionID uuid.UUID, userID uuid.UUID) (models.Note, error)
 }
 
 type NoteBaseRepo interface {
-	ReadAllNotes(context.Context, uuid.UUID, int64, int64, []string) ([]models.NoteResponse, error)
+	ReadAllNotes(context.Context, uuid.UUID, int64, int64, models.TagFilter, string, string, *models.NoteCursor) ([]models.NoteResponse, *models.NoteCursor, error)
 	ReadNote(context.Context, uuid.UUID, uuid.UUID) (models.NoteResponse, error)
 	ReadPublicNote(context.Context, uuid.UUID) (models.NoteResponse, error)
 	CreateNote(context.Context, models.Note) error
-	UpdateNote(context.Context, models.Note) error
+	CreateSubNote(ctx context.Context, note models.Note) (models.Note, error)
+	UpdateNote(context.Context, models.Note, uuid.UUID) error
 	DeleteNote(context.Context, uuid.UUID) error
 
+	TrashNote(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, deletedAt time.Time) ([]uuid.UUID, error)
+	ReadTrash(ctx context.Context, userID uuid.UUID, count int64, offset int64) ([]models.TrashedNote, error)
+	ReadTrashedNote(ctx context.Context, noteID uuid.UUID) (models.TrashedNote, error)
+	RestoreNote(ctx context.Context, noteID uuid.UUID, parentID uuid.UUID) ([]uuid.UUID, error)
+	GetExpiredTrash(ctx context.Context, before time.Time) ([]uuid.UUID, error)
+	GetSubtreeAttaches(ctx context.Context, noteID uuid.UUID) ([]string, error)
+	GetAttaches(ctx context.Context, noteID uuid.UUID) ([]models.Attach, error)
+	AddAttach(ctx context.Context, attach models.Attach) error
+
+	SetTemplate(ctx context.Context, noteID uuid.UUID, isTemplate bool) error
+	ReadTemplates(ctx context.Context, userID uuid.UUID) ([]models.Template, error)
+	ReadTemplate(ctx context.Context, templateID uuid.UUID) (models.Template, error)
+
 	AddSubNote(context.Context, uuid.UUID, uuid.UUID) error
 	RemoveSubNote(context.Context, uuid.UUID, uuid.UUID) error
+	MoveNote(ctx context.Context, noteID uuid.UUID, oldParentID uuid.UUID, newParentID uuid.UUID) ([]uuid.UUID, error)
+	SetNotePositions(ctx context.Context, userID uuid.UUID, notes []uuid.UUID) error
+	GetNotePositions(ctx context.Context, userID uuid.UUID) (map[uuid.UUID]int, error)
 
-	GetUpdates(context.Context, uuid.UUID, time.Time) ([]models.Message, error)
+	SetNoteLinks(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, targets []uuid.UUID, titles []string) error
+	GetBacklinks(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) ([]models.NoteLink, error)
+	GetNoteGraph(ctx context.Context, userID uuid.UUID) (models.NoteGraph, error)
 
-	AddCollaborator(context.Context, uuid.UUID, uuid.UUID) (string, error)
+	GetUpdates(context.Context, uuid.UUID, time.Time) ([]models.Message, error)
 
-	AddTag(ctx context.Context, tagName string, noteId uuid.UUID) error
-	DeleteTag(ctx context.Context, tagName string, noteId uuid.UUID) error
+	GetRevisions(ctx context.Context, noteID uuid.UUID, count int64, offset int64) ([]models.NoteRevision, error)
+	GetRevision(ctx context.Context, revisionID uuid.UUID) (models.NoteRevision, error)
 
-	GetTags(ctx context.Context, userID uuid.UUID) ([]string, error)
+	AddCollaborator(context.Context, uuid.UUID, uuid.UUID) (string, error)
+	RemoveCollaborator(ctx context.Context, noteID uuid.UUID, guestID uuid.UUID) (string, error)
+	GetCollaboratorRole(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) (string, error)
+	SetCollaboratorRole(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, role string) error
+
+	CreateInvite(ctx context.Context, invite models.Invite) (string, error)
+	GetInvite(ctx context.Context, inviteID uuid.UUID) (models.Invite, error)
+	GetSentInvites(ctx context.Context, userID uuid.UUID, now time.Time) ([]models.Invite, error)
+	GetReceivedInvites(ctx context.Context, userID uuid.UUID, now time.Time) ([]models.Invite, error)
+	DeleteInvite(ctx context.Context, inviteID uuid.UUID) error
+
+	ProposeTransfer(ctx context.Context, transfer models.OwnershipTransfer) (string, error)
+	GetTransfer(ctx context.Context, noteID uuid.UUID) (models.OwnershipTransfer, error)
+	TransferOwnership(ctx context.Context, noteID uuid.UUID, fromID uuid.UUID, toID uuid.UUID) ([]uuid.UUID, error)
+
+	AddTag(ctx context.Context, tagName string, noteId uuid.UUID, version int64) error
+	DeleteTag(ctx context.Context, tagName string, noteId uuid.UUID, version int64) error
+	AddTagToNotes(ctx context.Context, tagName string, noteIDs []uuid.UUID) error
+	DeleteTagFromNotes(ctx context.Context, tagName string, noteIDs []uuid.UUID) error
+
+	GetTags(ctx context.Context, userID uuid.UUID) ([]models.Tag, error)
+	ReconcileTags(ctx context.Context, noteIDs []uuid.UUID) error
+	SetTagInfo(ctx context.Context, tag models.Tag, userID uuid.UUID) error
 	RememberTag(ctx context.Context, tagName string, userID uuid.UUID) error
 	ForgetTag(ctx context.Context, tagName string, userID uuid.UUID) error
-	DeleteTagFromAllNotes(ctx context.Context, tagName string, userID uuid.UUID) error
+	DeleteTagFromAllNotes(ctx context.Context, tagName string, userID uuid.UUID) ([]uuid.UUID, error)
 	UpdateTag(ctx context.Context, oldTag string, newTag string, userID uuid.UUID) error
-	UpdateTagOnAllNotes(ctx context.Context, oldTag string, newTag string, userID uuid.UUID) error
+	UpdateTagOnAllNotes(ctx context.Context, oldTag string, newTag string, userID uuid.UUID) ([]uuid.UUID, error)
+	MergeTags(ctx context.Context, tags []string, newTag string, userID uuid.UUID) ([]uuid.UUID, error)
 
-	SetIcon(ctx context.Context, noteID uuid.UUID, icon string) error
-	SetHeader(ctx context.Context, noteID uuid.UUID, header string) error
+	SetIcon(ctx context.Context, noteID uuid.UUID, icon string, version int64) error
+	SetHeader(ctx context.Context, noteID uuid.UUID, header string, version int64) error
 
 	AddFav(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) error
 	DelFav(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) error
+	AddFavs(ctx context.Context, noteIDs []uuid.UUID, userID uuid.UUID) error
+	DelFavs(ctx context.Context, noteIDs []uuid.UUID, userID uuid.UUID) error
+
+	ArchiveNote(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, archivedAt time.Time) error
+	UnarchiveNote(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) error
 
 	SetPublic(ctx context.Context, noteID uuid.UUID) error
 	SetPrivate(ctx context.Context, noteID uuid.UUID) error
 
+	CreateShareLink(ctx context.Context, link models.ShareLink) error
+	GetShareLinks(ctx context.Context, noteID uuid.UUID) ([]models.ShareLink, error)
+	GetShareLink(ctx context.Context, token string, noteID uuid.UUID) (models.ShareLink, error)
+	DeleteShareLink(ctx context.Context, noteID uuid.UUID, linkID uuid.UUID) error
+
+	GetComments(ctx context.Context, noteID uuid.UUID) ([]models.Comment, error)
+	GetComment(ctx context.Context, commentID uuid.UUID) (models.Comment, error)
+	CreateComment(ctx context.Context, comment models.Comment) (string, error)
+	UpdateComment(ctx context.Context, commentID uuid.UUID, text string, updated time.Time) error
+	DeleteComment(ctx context.Context, commentID uuid.UUID) error
+	SetCommentResolved(ctx context.Context, commentID uuid.UUID, resolved bool) error
+
+	CreateReminder(ctx context.Context, reminder models.Reminder) (string, error)
+	GetReminder(ctx context.Context, reminderID uuid.UUID) (models.Reminder, error)
+	GetReminders(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) ([]models.Reminder, error)
+	GetUpcomingReminders(ctx context.Context, userID uuid.UUID, count int64, offset int64) ([]models.Reminder, error)
+	GetPendingReminders(ctx context.Context, userID uuid.UUID) ([]models.Reminder, error)
+	FireReminders(ctx context.Context, now time.Time) ([]models.Reminder, error)
+	RescheduleReminder(ctx context.Context, reminderID uuid.UUID, remindAt time.Time) error
+	DeleteReminder(ctx context.Context, reminderID uuid.UUID) error
+
 	GetAttachList(ctx context.Context, noteID uuid.UUID) ([]string, error)
 
 	GetOwnerInfo(ctx context.Context, ownerID uuid.UUID) (models.OwnerInfo, error)
 }
 
 type NoteSearchRepo interface {
-	SearchNotes(context.Context, uuid.UUID, int64, int64, string, []string) ([]models.NoteResponse, error)
+	SearchNotes(context.Context, uuid.UUID, int64, int64, string, models.TagFilter, string, string, map[uuid.UUID]int, *models.NoteCursor) ([]models.NoteResponse, *models.NoteCursor, error)
 	CreateNote(context.Context, models.Note) error
 	UpdateNote(context.Context, models.Note) error
 	DeleteNote(context.Context, uuid.UUID) error
 
 	AddSubNote(context.Context, uuid.UUID, uuid.UUID) error
 	RemoveSubNote(context.Context, uuid.UUID, uuid.UUID) error
+	SetParent(ctx context.Context, noteID uuid.UUID, parentID uuid.UUID) error
 
 	AddCollaborator(context.Context, uuid.UUID, uuid.UUID) error
+	RemoveCollaborator(ctx context.Context, noteID uuid.UUID, guestID uuid.UUID) error
+	SetCollaborators(ctx context.Context, noteIDs []uuid.UUID, collaborators []uuid.UUID) error
+	SetOwner(ctx context.Context, noteID uuid.UUID, fromID uuid.UUID, toID uuid.UUID) error
 
 	AddTag(ctx context.Context, tagName string, noteID uuid.UUID) error
 	DeleteTag(ctx context.Context, tagName string, noteID uuid.UUID) error
-	DeleteTagFromAllNotes(ctx context.Context, tagName string, userID uuid.UUID) error
-	UpdateTagOnAllNotes(ctx context.Context, oldTag string, newTag string, userID uuid.UUID) error
+	AddTagToNotes(ctx context.Context, tagName string, noteIDs []uuid.UUID) error
+	DeleteTagFromAllNotes(ctx context.Context, tagName string, noteIDs []uuid.UUID) error
+	UpdateTagOnAllNotes(ctx context.Context, oldTag string, newTag string, noteIDs []uuid.UUID) error
 
 	SetIcon(ctx context.Context, noteID uuid.UUID, icon string) error
 	SetHeader(ctx context.Context, noteID uuid.UUID, header string) error
 
 	ChangeFlag(ctx context.Context, noteID uuid.UUID, flag bool) error
+	ChangeFlagOnNotes(ctx context.Context, noteIDs []uuid.UUID, flag bool) error
+
+	ArchiveNote(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) error
+	UnarchiveNote(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) error
 
 	SetPublic(ctx context.Context, noteID uuid.UUID) error
 	SetPrivate(ctx context.Context, noteID uuid.UUID) error
@@ -37,19 +37,78 @@ func (m *MockNoteUsecase) EXPECT() *MockNoteUsecaseMockRecorder {
 	return m.recorder
 }
 
+// AcceptInvite mocks base method.
+func (m *MockNoteUsecase) AcceptInvite(ctx context.Context, inviteID, userID uuid.UUID) (models.Invite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvite", ctx, inviteID, userID)
+	ret0, _ := ret[0].(models.Invite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptInvite indicates an expected call of AcceptInvite.
+func (mr *MockNoteUsecaseMockRecorder) AcceptInvite(ctx, inviteID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvite", reflect.TypeOf((*MockNoteUsecase)(nil).AcceptInvite), ctx, inviteID, userID)
+}
+
+// AcceptTransfer mocks base method.
+func (m *MockNoteUsecase) AcceptTransfer(ctx context.Context, noteID, userID uuid.UUID) (models.OwnershipTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptTransfer", ctx, noteID, userID)
+	ret0, _ := ret[0].(models.OwnershipTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptTransfer indicates an expected call of AcceptTransfer.
+func (mr *MockNoteUsecaseMockRecorder) AcceptTransfer(ctx, noteID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptTransfer", reflect.TypeOf((*MockNoteUsecase)(nil).AcceptTransfer), ctx, noteID, userID)
+}
+
+// AcknowledgeReminder mocks base method.
+func (m *MockNoteUsecase) AcknowledgeReminder(ctx context.Context, reminderID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcknowledgeReminder", ctx, reminderID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcknowledgeReminder indicates an expected call of AcknowledgeReminder.
+func (mr *MockNoteUsecaseMockRecorder) AcknowledgeReminder(ctx, reminderID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcknowledgeReminder", reflect.TypeOf((*MockNoteUsecase)(nil).AcknowledgeReminder), ctx, reminderID, userID)
+}
+
 // AddCollaborator mocks base method.
-func (m *MockNoteUsecase) AddCollaborator(arg0 context.Context, arg1, arg2, arg3 uuid.UUID) (string, error) {
+func (m *MockNoteUsecase) AddCollaborator(ctx context.Context, noteID, userID, guestID uuid.UUID, role string) (models.Invite, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddCollaborator", arg0, arg1, arg2, arg3)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "AddCollaborator", ctx, noteID, userID, guestID, role)
+	ret0, _ := ret[0].(models.Invite)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddCollaborator indicates an expected call of AddCollaborator.
-func (mr *MockNoteUsecaseMockRecorder) AddCollaborator(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockNoteUsecaseMockRecorder) AddCollaborator(ctx, noteID, userID, guestID, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCollaborator", reflect.TypeOf((*MockNoteUsecase)(nil).AddCollaborator), ctx, noteID, userID, guestID, role)
+}
+
+// AddComment mocks base method.
+func (m *MockNoteUsecase) AddComment(ctx context.Context, noteID, userID, parentID uuid.UUID, blockID, text string) (models.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddComment", ctx, noteID, userID, parentID, blockID, text)
+	ret0, _ := ret[0].(models.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddComment indicates an expected call of AddComment.
+func (mr *MockNoteUsecaseMockRecorder) AddComment(ctx, noteID, userID, parentID, blockID, text interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCollaborator", reflect.TypeOf((*MockNoteUsecase)(nil).AddCollaborator), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddComment", reflect.TypeOf((*MockNoteUsecase)(nil).AddComment), ctx, noteID, userID, parentID, blockID, text)
 }
 
 // AddFav mocks base method.
@@ -67,26 +126,71 @@ func (mr *MockNoteUsecaseMockRecorder) AddFav(ctx, noteID, userID interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFav", reflect.TypeOf((*MockNoteUsecase)(nil).AddFav), ctx, noteID, userID)
 }
 
+// AddReminder mocks base method.
+func (m *MockNoteUsecase) AddReminder(ctx context.Context, noteID, userID uuid.UUID, remindAt time.Time, repeat string) (models.Reminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReminder", ctx, noteID, userID, remindAt, repeat)
+	ret0, _ := ret[0].(models.Reminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddReminder indicates an expected call of AddReminder.
+func (mr *MockNoteUsecaseMockRecorder) AddReminder(ctx, noteID, userID, remindAt, repeat interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReminder", reflect.TypeOf((*MockNoteUsecase)(nil).AddReminder), ctx, noteID, userID, remindAt, repeat)
+}
+
 // AddTag mocks base method.
-func (m *MockNoteUsecase) AddTag(ctx context.Context, tagName string, noteId, userId uuid.UUID) (models.Note, error) {
+func (m *MockNoteUsecase) AddTag(ctx context.Context, tagName string, noteId, userId uuid.UUID, version int64) (models.Note, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddTag", ctx, tagName, noteId, userId)
+	ret := m.ctrl.Call(m, "AddTag", ctx, tagName, noteId, userId, version)
 	ret0, _ := ret[0].(models.Note)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddTag indicates an expected call of AddTag.
-func (mr *MockNoteUsecaseMockRecorder) AddTag(ctx, tagName, noteId, userId interface{}) *gomock.Call {
+func (mr *MockNoteUsecaseMockRecorder) AddTag(ctx, tagName, noteId, userId, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTag", reflect.TypeOf((*MockNoteUsecase)(nil).AddTag), ctx, tagName, noteId, userId, version)
+}
+
+// ArchiveNote mocks base method.
+func (m *MockNoteUsecase) ArchiveNote(ctx context.Context, noteID, userID uuid.UUID) (models.Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveNote", ctx, noteID, userID)
+	ret0, _ := ret[0].(models.Note)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveNote indicates an expected call of ArchiveNote.
+func (mr *MockNoteUsecaseMockRecorder) ArchiveNote(ctx, noteID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveNote", reflect.TypeOf((*MockNoteUsecase)(nil).ArchiveNote), ctx, noteID, userID)
+}
+
+// BulkUpdate mocks base method.
+func (m *MockNoteUsecase) BulkUpdate(ctx context.Context, noteIDs []uuid.UUID, operations []models.BulkOperation, userID uuid.UUID) ([]models.BulkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkUpdate", ctx, noteIDs, operations, userID)
+	ret0, _ := ret[0].([]models.BulkResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkUpdate indicates an expected call of BulkUpdate.
+func (mr *MockNoteUsecaseMockRecorder) BulkUpdate(ctx, noteIDs, operations, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTag", reflect.TypeOf((*MockNoteUsecase)(nil).AddTag), ctx, tagName, noteId, userId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpdate", reflect.TypeOf((*MockNoteUsecase)(nil).BulkUpdate), ctx, noteIDs, operations, userID)
 }
 
 // CheckPermissions mocks base method.
-func (m *MockNoteUsecase) CheckPermissions(ctx context.Context, noteID, userID uuid.UUID) (bool, error) {
+func (m *MockNoteUsecase) CheckPermissions(ctx context.Context, noteID, userID uuid.UUID) (string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CheckPermissions", ctx, noteID, userID)
-	ret0, _ := ret[0].(bool)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -97,6 +201,21 @@ func (mr *MockNoteUsecaseMockRecorder) CheckPermissions(ctx, noteID, userID inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPermissions", reflect.TypeOf((*MockNoteUsecase)(nil).CheckPermissions), ctx, noteID, userID)
 }
 
+// CreateFromTemplate mocks base method.
+func (m *MockNoteUsecase) CreateFromTemplate(ctx context.Context, templateID, parentID, userID uuid.UUID, username string) (models.Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFromTemplate", ctx, templateID, parentID, userID, username)
+	ret0, _ := ret[0].(models.Note)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFromTemplate indicates an expected call of CreateFromTemplate.
+func (mr *MockNoteUsecaseMockRecorder) CreateFromTemplate(ctx, templateID, parentID, userID, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFromTemplate", reflect.TypeOf((*MockNoteUsecase)(nil).CreateFromTemplate), ctx, templateID, parentID, userID, username)
+}
+
 // CreateNote mocks base method.
 func (m *MockNoteUsecase) CreateNote(arg0 context.Context, arg1 uuid.UUID, arg2 string) (models.Note, error) {
 	m.ctrl.T.Helper()
@@ -112,6 +231,21 @@ func (mr *MockNoteUsecaseMockRecorder) CreateNote(arg0, arg1, arg2 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNote", reflect.TypeOf((*MockNoteUsecase)(nil).CreateNote), arg0, arg1, arg2)
 }
 
+// CreateShareLink mocks base method.
+func (m *MockNoteUsecase) CreateShareLink(ctx context.Context, noteID, userID uuid.UUID, password string, expiresAt *time.Time, includeSubtree bool) (models.ShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShareLink", ctx, noteID, userID, password, expiresAt, includeSubtree)
+	ret0, _ := ret[0].(models.ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateShareLink indicates an expected call of CreateShareLink.
+func (mr *MockNoteUsecaseMockRecorder) CreateShareLink(ctx, noteID, userID, password, expiresAt, includeSubtree interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShareLink", reflect.TypeOf((*MockNoteUsecase)(nil).CreateShareLink), ctx, noteID, userID, password, expiresAt, includeSubtree)
+}
+
 // CreateSubNote mocks base method.
 func (m *MockNoteUsecase) CreateSubNote(arg0 context.Context, arg1 uuid.UUID, arg2 string, arg3 uuid.UUID) (models.Note, error) {
 	m.ctrl.T.Helper()
@@ -127,6 +261,21 @@ func (mr *MockNoteUsecaseMockRecorder) CreateSubNote(arg0, arg1, arg2, arg3 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubNote", reflect.TypeOf((*MockNoteUsecase)(nil).CreateSubNote), arg0, arg1, arg2, arg3)
 }
 
+// DeclineInvite mocks base method.
+func (m *MockNoteUsecase) DeclineInvite(ctx context.Context, inviteID, userID uuid.UUID) (models.Invite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclineInvite", ctx, inviteID, userID)
+	ret0, _ := ret[0].(models.Invite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeclineInvite indicates an expected call of DeclineInvite.
+func (mr *MockNoteUsecaseMockRecorder) DeclineInvite(ctx, inviteID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclineInvite", reflect.TypeOf((*MockNoteUsecase)(nil).DeclineInvite), ctx, inviteID, userID)
+}
+
 // DelFav mocks base method.
 func (m *MockNoteUsecase) DelFav(ctx context.Context, noteID, userID uuid.UUID) (models.Note, error) {
 	m.ctrl.T.Helper()
@@ -142,6 +291,20 @@ func (mr *MockNoteUsecaseMockRecorder) DelFav(ctx, noteID, userID interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelFav", reflect.TypeOf((*MockNoteUsecase)(nil).DelFav), ctx, noteID, userID)
 }
 
+// DeleteComment mocks base method.
+func (m *MockNoteUsecase) DeleteComment(ctx context.Context, noteID, commentID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteComment", ctx, noteID, commentID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteComment indicates an expected call of DeleteComment.
+func (mr *MockNoteUsecaseMockRecorder) DeleteComment(ctx, noteID, commentID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockNoteUsecase)(nil).DeleteComment), ctx, noteID, commentID, userID)
+}
+
 // DeleteNote mocks base method.
 func (m *MockNoteUsecase) DeleteNote(arg0 context.Context, arg1, arg2 uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -156,19 +319,62 @@ func (mr *MockNoteUsecaseMockRecorder) DeleteNote(arg0, arg1, arg2 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNote", reflect.TypeOf((*MockNoteUsecase)(nil).DeleteNote), arg0, arg1, arg2)
 }
 
+// DeleteNotePermanently mocks base method.
+func (m *MockNoteUsecase) DeleteNotePermanently(ctx context.Context, noteID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNotePermanently", ctx, noteID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNotePermanently indicates an expected call of DeleteNotePermanently.
+func (mr *MockNoteUsecaseMockRecorder) DeleteNotePermanently(ctx, noteID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotePermanently", reflect.TypeOf((*MockNoteUsecase)(nil).DeleteNotePermanently), ctx, noteID, userID)
+}
+
+// DeleteReminder mocks base method.
+func (m *MockNoteUsecase) DeleteReminder(ctx context.Context, reminderID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReminder", ctx, reminderID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReminder indicates an expected call of DeleteReminder.
+func (mr *MockNoteUsecaseMockRecorder) DeleteReminder(ctx, reminderID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReminder", reflect.TypeOf((*MockNoteUsecase)(nil).DeleteReminder), ctx, reminderID, userID)
+}
+
 // DeleteTag mocks base method.
-func (m *MockNoteUsecase) DeleteTag(ctx context.Context, tagName string, noteId, userId uuid.UUID) (models.Note, error) {
+func (m *MockNoteUsecase) DeleteTag(ctx context.Context, tagName string, noteId, userId uuid.UUID, version int64) (models.Note, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTag", ctx, tagName, noteId, userId)
+	ret := m.ctrl.Call(m, "DeleteTag", ctx, tagName, noteId, userId, version)
 	ret0, _ := ret[0].(models.Note)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteTag indicates an expected call of DeleteTag.
-func (mr *MockNoteUsecaseMockRecorder) DeleteTag(ctx, tagName, noteId, userId interface{}) *gomock.Call {
+func (mr *MockNoteUsecaseMockRecorder) DeleteTag(ctx, tagName, noteId, userId, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTag", reflect.TypeOf((*MockNoteUsecase)(nil).DeleteTag), ctx, tagName, noteId, userId, version)
+}
+
+// DuplicateNote mocks base method.
+func (m *MockNoteUsecase) DuplicateNote(ctx context.Context, noteID, userID uuid.UUID, withSubnotes bool) (models.Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DuplicateNote", ctx, noteID, userID, withSubnotes)
+	ret0, _ := ret[0].(models.Note)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DuplicateNote indicates an expected call of DuplicateNote.
+func (mr *MockNoteUsecaseMockRecorder) DuplicateNote(ctx, noteID, userID, withSubnotes interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTag", reflect.TypeOf((*MockNoteUsecase)(nil).DeleteTag), ctx, tagName, noteId, userId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DuplicateNote", reflect.TypeOf((*MockNoteUsecase)(nil).DuplicateNote), ctx, noteID, userID, withSubnotes)
 }
 
 // ForgetTag mocks base method.
@@ -186,18 +392,19 @@ func (mr *MockNoteUsecaseMockRecorder) ForgetTag(ctx, tagName, userID interface{
 }
 
 // GetAllNotes mocks base method.
-func (m *MockNoteUsecase) GetAllNotes(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 int64, arg4 string, arg5 []string) ([]models.NoteResponse, error) {
+func (m *MockNoteUsecase) GetAllNotes(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 int64, arg4 string, arg5 models.TagFilter, arg6, arg7, arg8 string) ([]models.NoteResponse, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllNotes", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret := m.ctrl.Call(m, "GetAllNotes", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
 	ret0, _ := ret[0].([]models.NoteResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // GetAllNotes indicates an expected call of GetAllNotes.
-func (mr *MockNoteUsecaseMockRecorder) GetAllNotes(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+func (mr *MockNoteUsecaseMockRecorder) GetAllNotes(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllNotes", reflect.TypeOf((*MockNoteUsecase)(nil).GetAllNotes), arg0, arg1, arg2, arg3, arg4, arg5)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllNotes", reflect.TypeOf((*MockNoteUsecase)(nil).GetAllNotes), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
 }
 
 // GetAttachList mocks base method.
@@ -215,6 +422,36 @@ func (mr *MockNoteUsecaseMockRecorder) GetAttachList(ctx, noteID, userID interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachList", reflect.TypeOf((*MockNoteUsecase)(nil).GetAttachList), ctx, noteID, userID)
 }
 
+// GetBacklinks mocks base method.
+func (m *MockNoteUsecase) GetBacklinks(ctx context.Context, noteID, userID uuid.UUID) ([]models.NoteLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBacklinks", ctx, noteID, userID)
+	ret0, _ := ret[0].([]models.NoteLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBacklinks indicates an expected call of GetBacklinks.
+func (mr *MockNoteUsecaseMockRecorder) GetBacklinks(ctx, noteID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBacklinks", reflect.TypeOf((*MockNoteUsecase)(nil).GetBacklinks), ctx, noteID, userID)
+}
+
+// GetComments mocks base method.
+func (m *MockNoteUsecase) GetComments(ctx context.Context, noteID, userID uuid.UUID) ([]models.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComments", ctx, noteID, userID)
+	ret0, _ := ret[0].([]models.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComments indicates an expected call of GetComments.
+func (mr *MockNoteUsecaseMockRecorder) GetComments(ctx, noteID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComments", reflect.TypeOf((*MockNoteUsecase)(nil).GetComments), ctx, noteID, userID)
+}
+
 // GetNote mocks base method.
 func (m *MockNoteUsecase) GetNote(arg0 context.Context, arg1, arg2 uuid.UUID) (models.NoteResponse, error) {
 	m.ctrl.T.Helper()
@@ -230,260 +467,833 @@ func (mr *MockNoteUsecaseMockRecorder) GetNote(arg0, arg1, arg2 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNote", reflect.TypeOf((*MockNoteUsecase)(nil).GetNote), arg0, arg1, arg2)
 }
 
+// GetNoteGraph mocks base method.
+func (m *MockNoteUsecase) GetNoteGraph(ctx context.Context, userID uuid.UUID) (models.NoteGraph, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNoteGraph", ctx, userID)
+	ret0, _ := ret[0].(models.NoteGraph)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNoteGraph indicates an expected call of GetNoteGraph.
+func (mr *MockNoteUsecaseMockRecorder) GetNoteGraph(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNoteGraph", reflect.TypeOf((*MockNoteUsecase)(nil).GetNoteGraph), ctx, userID)
+}
+
 // GetPublicNote mocks base method.
-func (m *MockNoteUsecase) GetPublicNote(ctx context.Context, noteId uuid.UUID) (models.NoteResponse, error) {
+func (m *MockNoteUsecase) GetPublicNote(ctx context.Context, noteId uuid.UUID, token, password string) (models.NoteResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPublicNote", ctx, noteId)
+	ret := m.ctrl.Call(m, "GetPublicNote", ctx, noteId, token, password)
 	ret0, _ := ret[0].(models.NoteResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPublicNote indicates an expected call of GetPublicNote.
-func (mr *MockNoteUsecaseMockRecorder) GetPublicNote(ctx, noteId interface{}) *gomock.Call {
+func (mr *MockNoteUsecaseMockRecorder) GetPublicNote(ctx, noteId, token, password interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicNote", reflect.TypeOf((*MockNoteUsecase)(nil).GetPublicNote), ctx, noteId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicNote", reflect.TypeOf((*MockNoteUsecase)(nil).GetPublicNote), ctx, noteId, token, password)
 }
 
-// GetSharedAttachList mocks base method.
-func (m *MockNoteUsecase) GetSharedAttachList(ctx context.Context, noteID uuid.UUID) ([]string, error) {
+// GetReceivedInvites mocks base method.
+func (m *MockNoteUsecase) GetReceivedInvites(ctx context.Context, userID uuid.UUID) ([]models.Invite, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSharedAttachList", ctx, noteID)
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "GetReceivedInvites", ctx, userID)
+	ret0, _ := ret[0].([]models.Invite)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSharedAttachList indicates an expected call of GetSharedAttachList.
-func (mr *MockNoteUsecaseMockRecorder) GetSharedAttachList(ctx, noteID interface{}) *gomock.Call {
+// GetReceivedInvites indicates an expected call of GetReceivedInvites.
+func (mr *MockNoteUsecaseMockRecorder) GetReceivedInvites(ctx, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharedAttachList", reflect.TypeOf((*MockNoteUsecase)(nil).GetSharedAttachList), ctx, noteID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceivedInvites", reflect.TypeOf((*MockNoteUsecase)(nil).GetReceivedInvites), ctx, userID)
 }
 
-// GetTags mocks base method.
-func (m *MockNoteUsecase) GetTags(ctx context.Context, userID uuid.UUID) ([]string, error) {
+// GetReminders mocks base method.
+func (m *MockNoteUsecase) GetReminders(ctx context.Context, noteID, userID uuid.UUID) ([]models.Reminder, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTags", ctx, userID)
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "GetReminders", ctx, noteID, userID)
+	ret0, _ := ret[0].([]models.Reminder)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTags indicates an expected call of GetTags.
-func (mr *MockNoteUsecaseMockRecorder) GetTags(ctx, userID interface{}) *gomock.Call {
+// GetReminders indicates an expected call of GetReminders.
+func (mr *MockNoteUsecaseMockRecorder) GetReminders(ctx, noteID, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTags", reflect.TypeOf((*MockNoteUsecase)(nil).GetTags), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReminders", reflect.TypeOf((*MockNoteUsecase)(nil).GetReminders), ctx, noteID, userID)
 }
 
-// RememberTag mocks base method.
-func (m *MockNoteUsecase) RememberTag(ctx context.Context, tagName string, userID uuid.UUID) error {
+// GetRevision mocks base method.
+func (m *MockNoteUsecase) GetRevision(ctx context.Context, noteID, revisionID, userID uuid.UUID) (models.NoteRevision, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RememberTag", ctx, tagName, userID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetRevision", ctx, noteID, revisionID, userID)
+	ret0, _ := ret[0].(models.NoteRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// RememberTag indicates an expected call of RememberTag.
-func (mr *MockNoteUsecaseMockRecorder) RememberTag(ctx, tagName, userID interface{}) *gomock.Call {
+// GetRevision indicates an expected call of GetRevision.
+func (mr *MockNoteUsecaseMockRecorder) GetRevision(ctx, noteID, revisionID, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RememberTag", reflect.TypeOf((*MockNoteUsecase)(nil).RememberTag), ctx, tagName, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevision", reflect.TypeOf((*MockNoteUsecase)(nil).GetRevision), ctx, noteID, revisionID, userID)
 }
 
-// SetHeader mocks base method.
-func (m *MockNoteUsecase) SetHeader(ctx context.Context, noteID uuid.UUID, header string, userID uuid.UUID) (models.Note, error) {
+// GetRevisionDiff mocks base method.
+func (m *MockNoteUsecase) GetRevisionDiff(ctx context.Context, noteID, fromID, toID, userID uuid.UUID) (models.RevisionDiff, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetHeader", ctx, noteID, header, userID)
-	ret0, _ := ret[0].(models.Note)
+	ret := m.ctrl.Call(m, "GetRevisionDiff", ctx, noteID, fromID, toID, userID)
+	ret0, _ := ret[0].(models.RevisionDiff)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SetHeader indicates an expected call of SetHeader.
-func (mr *MockNoteUsecaseMockRecorder) SetHeader(ctx, noteID, header, userID interface{}) *gomock.Call {
+// GetRevisionDiff indicates an expected call of GetRevisionDiff.
+func (mr *MockNoteUsecaseMockRecorder) GetRevisionDiff(ctx, noteID, fromID, toID, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockNoteUsecase)(nil).SetHeader), ctx, noteID, header, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisionDiff", reflect.TypeOf((*MockNoteUsecase)(nil).GetRevisionDiff), ctx, noteID, fromID, toID, userID)
 }
 
-// SetIcon mocks base method.
-func (m *MockNoteUsecase) SetIcon(ctx context.Context, noteID uuid.UUID, icon string, userID uuid.UUID) (models.Note, error) {
+// GetRevisions mocks base method.
+func (m *MockNoteUsecase) GetRevisions(ctx context.Context, noteID, userID uuid.UUID, count, offset int64) ([]models.NoteRevision, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetIcon", ctx, noteID, icon, userID)
-	ret0, _ := ret[0].(models.Note)
+	ret := m.ctrl.Call(m, "GetRevisions", ctx, noteID, userID, count, offset)
+	ret0, _ := ret[0].([]models.NoteRevision)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SetIcon indicates an expected call of SetIcon.
-func (mr *MockNoteUsecaseMockRecorder) SetIcon(ctx, noteID, icon, userID interface{}) *gomock.Call {
+// GetRevisions indicates an expected call of GetRevisions.
+func (mr *MockNoteUsecaseMockRecorder) GetRevisions(ctx, noteID, userID, count, offset interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIcon", reflect.TypeOf((*MockNoteUsecase)(nil).SetIcon), ctx, noteID, icon, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisions", reflect.TypeOf((*MockNoteUsecase)(nil).GetRevisions), ctx, noteID, userID, count, offset)
 }
 
-// SetPrivate mocks base method.
-func (m *MockNoteUsecase) SetPrivate(ctx context.Context, noteID, userID uuid.UUID) (models.Note, error) {
+// GetSentInvites mocks base method.
+func (m *MockNoteUsecase) GetSentInvites(ctx context.Context, userID uuid.UUID) ([]models.Invite, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetPrivate", ctx, noteID, userID)
-	ret0, _ := ret[0].(models.Note)
+	ret := m.ctrl.Call(m, "GetSentInvites", ctx, userID)
+	ret0, _ := ret[0].([]models.Invite)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SetPrivate indicates an expected call of SetPrivate.
-func (mr *MockNoteUsecaseMockRecorder) SetPrivate(ctx, noteID, userID interface{}) *gomock.Call {
+// GetSentInvites indicates an expected call of GetSentInvites.
+func (mr *MockNoteUsecaseMockRecorder) GetSentInvites(ctx, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPrivate", reflect.TypeOf((*MockNoteUsecase)(nil).SetPrivate), ctx, noteID, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSentInvites", reflect.TypeOf((*MockNoteUsecase)(nil).GetSentInvites), ctx, userID)
 }
 
-// SetPublic mocks base method.
-func (m *MockNoteUsecase) SetPublic(ctx context.Context, noteID, userID uuid.UUID) (models.Note, error) {
+// GetShareLinks mocks base method.
+func (m *MockNoteUsecase) GetShareLinks(ctx context.Context, noteID, userID uuid.UUID) ([]models.ShareLink, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetPublic", ctx, noteID, userID)
-	ret0, _ := ret[0].(models.Note)
+	ret := m.ctrl.Call(m, "GetShareLinks", ctx, noteID, userID)
+	ret0, _ := ret[0].([]models.ShareLink)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SetPublic indicates an expected call of SetPublic.
-func (mr *MockNoteUsecaseMockRecorder) SetPublic(ctx, noteID, userID interface{}) *gomock.Call {
+// GetShareLinks indicates an expected call of GetShareLinks.
+func (mr *MockNoteUsecaseMockRecorder) GetShareLinks(ctx, noteID, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPublic", reflect.TypeOf((*MockNoteUsecase)(nil).SetPublic), ctx, noteID, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShareLinks", reflect.TypeOf((*MockNoteUsecase)(nil).GetShareLinks), ctx, noteID, userID)
 }
 
-// UpdateNote mocks base method.
-func (m *MockNoteUsecase) UpdateNote(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 string) (models.Note, error) {
+// GetSharedAttachList mocks base method.
+func (m *MockNoteUsecase) GetSharedAttachList(ctx context.Context, noteID uuid.UUID, token, password string) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateNote", arg0, arg1, arg2, arg3)
-	ret0, _ := ret[0].(models.Note)
+	ret := m.ctrl.Call(m, "GetSharedAttachList", ctx, noteID, token, password)
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateNote indicates an expected call of UpdateNote.
-func (mr *MockNoteUsecaseMockRecorder) UpdateNote(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+// GetSharedAttachList indicates an expected call of GetSharedAttachList.
+func (mr *MockNoteUsecaseMockRecorder) GetSharedAttachList(ctx, noteID, token, password interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNote", reflect.TypeOf((*MockNoteUsecase)(nil).UpdateNote), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharedAttachList", reflect.TypeOf((*MockNoteUsecase)(nil).GetSharedAttachList), ctx, noteID, token, password)
 }
 
-// UpdateTag mocks base method.
-func (m *MockNoteUsecase) UpdateTag(ctx context.Context, oldTag, newTag string, userID uuid.UUID) error {
+// GetTags mocks base method.
+func (m *MockNoteUsecase) GetTags(ctx context.Context, userID uuid.UUID) ([]models.Tag, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateTag", ctx, oldTag, newTag, userID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetTags", ctx, userID)
+	ret0, _ := ret[0].([]models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdateTag indicates an expected call of UpdateTag.
-func (mr *MockNoteUsecaseMockRecorder) UpdateTag(ctx, oldTag, newTag, userID interface{}) *gomock.Call {
+// GetTags indicates an expected call of GetTags.
+func (mr *MockNoteUsecaseMockRecorder) GetTags(ctx, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTag", reflect.TypeOf((*MockNoteUsecase)(nil).UpdateTag), ctx, oldTag, newTag, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTags", reflect.TypeOf((*MockNoteUsecase)(nil).GetTags), ctx, userID)
 }
 
-// MockNoteBaseRepo is a mock of NoteBaseRepo interface.
-type MockNoteBaseRepo struct {
-	ctrl     *gomock.Controller
-	recorder *MockNoteBaseRepoMockRecorder
+// GetTemplates mocks base method.
+func (m *MockNoteUsecase) GetTemplates(ctx context.Context, userID uuid.UUID) ([]models.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplates", ctx, userID)
+	ret0, _ := ret[0].([]models.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockNoteBaseRepoMockRecorder is the mock recorder for MockNoteBaseRepo.
-type MockNoteBaseRepoMockRecorder struct {
-	mock *MockNoteBaseRepo
+// GetTemplates indicates an expected call of GetTemplates.
+func (mr *MockNoteUsecaseMockRecorder) GetTemplates(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplates", reflect.TypeOf((*MockNoteUsecase)(nil).GetTemplates), ctx, userID)
 }
 
-// NewMockNoteBaseRepo creates a new mock instance.
-func NewMockNoteBaseRepo(ctrl *gomock.Controller) *MockNoteBaseRepo {
-	mock := &MockNoteBaseRepo{ctrl: ctrl}
-	mock.recorder = &MockNoteBaseRepoMockRecorder{mock}
-	return mock
+// GetTrash mocks base method.
+func (m *MockNoteUsecase) GetTrash(ctx context.Context, userID uuid.UUID, count, offset int64) ([]models.TrashedNote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrash", ctx, userID, count, offset)
+	ret0, _ := ret[0].([]models.TrashedNote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockNoteBaseRepo) EXPECT() *MockNoteBaseRepoMockRecorder {
-	return m.recorder
+// GetTrash indicates an expected call of GetTrash.
+func (mr *MockNoteUsecaseMockRecorder) GetTrash(ctx, userID, count, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrash", reflect.TypeOf((*MockNoteUsecase)(nil).GetTrash), ctx, userID, count, offset)
 }
 
-// AddCollaborator mocks base method.
-func (m *MockNoteBaseRepo) AddCollaborator(arg0 context.Context, arg1, arg2 uuid.UUID) (string, error) {
+// GetUpcomingReminders mocks base method.
+func (m *MockNoteUsecase) GetUpcomingReminders(ctx context.Context, userID uuid.UUID, count, offset int64) ([]models.Reminder, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddCollaborator", arg0, arg1, arg2)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "GetUpcomingReminders", ctx, userID, count, offset)
+	ret0, _ := ret[0].([]models.Reminder)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AddCollaborator indicates an expected call of AddCollaborator.
-func (mr *MockNoteBaseRepoMockRecorder) AddCollaborator(arg0, arg1, arg2 interface{}) *gomock.Call {
+// GetUpcomingReminders indicates an expected call of GetUpcomingReminders.
+func (mr *MockNoteUsecaseMockRecorder) GetUpcomingReminders(ctx, userID, count, offset interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCollaborator", reflect.TypeOf((*MockNoteBaseRepo)(nil).AddCollaborator), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpcomingReminders", reflect.TypeOf((*MockNoteUsecase)(nil).GetUpcomingReminders), ctx, userID, count, offset)
 }
 
-// AddFav mocks base method.
-func (m *MockNoteBaseRepo) AddFav(ctx context.Context, noteID, userID uuid.UUID) error {
+// LeaveNote mocks base method.
+func (m *MockNoteUsecase) LeaveNote(ctx context.Context, noteID, userID uuid.UUID) ([]uuid.UUID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddFav", ctx, noteID, userID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "LeaveNote", ctx, noteID, userID)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// AddFav indicates an expected call of AddFav.
-func (mr *MockNoteBaseRepoMockRecorder) AddFav(ctx, noteID, userID interface{}) *gomock.Call {
+// LeaveNote indicates an expected call of LeaveNote.
+func (mr *MockNoteUsecaseMockRecorder) LeaveNote(ctx, noteID, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFav", reflect.TypeOf((*MockNoteBaseRepo)(nil).AddFav), ctx, noteID, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveNote", reflect.TypeOf((*MockNoteUsecase)(nil).LeaveNote), ctx, noteID, userID)
 }
 
-// AddSubNote mocks base method.
-func (m *MockNoteBaseRepo) AddSubNote(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+// MergeTags mocks base method.
+func (m *MockNoteUsecase) MergeTags(ctx context.Context, tags []string, newTag string, userID uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddSubNote", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "MergeTags", ctx, tags, newTag, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// AddSubNote indicates an expected call of AddSubNote.
-func (mr *MockNoteBaseRepoMockRecorder) AddSubNote(arg0, arg1, arg2 interface{}) *gomock.Call {
+// MergeTags indicates an expected call of MergeTags.
+func (mr *MockNoteUsecaseMockRecorder) MergeTags(ctx, tags, newTag, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSubNote", reflect.TypeOf((*MockNoteBaseRepo)(nil).AddSubNote), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeTags", reflect.TypeOf((*MockNoteUsecase)(nil).MergeTags), ctx, tags, newTag, userID)
 }
 
-// AddTag mocks base method.
-func (m *MockNoteBaseRepo) AddTag(ctx context.Context, tagName string, noteId uuid.UUID) error {
+// MoveNote mocks base method.
+func (m *MockNoteUsecase) MoveNote(ctx context.Context, noteID, parentID, userID uuid.UUID) (models.Note, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddTag", ctx, tagName, noteId)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "MoveNote", ctx, noteID, parentID, userID)
+	ret0, _ := ret[0].(models.Note)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// AddTag indicates an expected call of AddTag.
-func (mr *MockNoteBaseRepoMockRecorder) AddTag(ctx, tagName, noteId interface{}) *gomock.Call {
+// MoveNote indicates an expected call of MoveNote.
+func (mr *MockNoteUsecaseMockRecorder) MoveNote(ctx, noteID, parentID, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTag", reflect.TypeOf((*MockNoteBaseRepo)(nil).AddTag), ctx, tagName, noteId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveNote", reflect.TypeOf((*MockNoteUsecase)(nil).MoveNote), ctx, noteID, parentID, userID)
+}
+
+// ProposeTransfer mocks base method.
+func (m *MockNoteUsecase) ProposeTransfer(ctx context.Context, noteID, userID, newOwnerID uuid.UUID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProposeTransfer", ctx, noteID, userID, newOwnerID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProposeTransfer indicates an expected call of ProposeTransfer.
+func (mr *MockNoteUsecaseMockRecorder) ProposeTransfer(ctx, noteID, userID, newOwnerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposeTransfer", reflect.TypeOf((*MockNoteUsecase)(nil).ProposeTransfer), ctx, noteID, userID, newOwnerID)
+}
+
+// RememberTag mocks base method.
+func (m *MockNoteUsecase) RememberTag(ctx context.Context, tagName string, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RememberTag", ctx, tagName, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RememberTag indicates an expected call of RememberTag.
+func (mr *MockNoteUsecaseMockRecorder) RememberTag(ctx, tagName, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RememberTag", reflect.TypeOf((*MockNoteUsecase)(nil).RememberTag), ctx, tagName, userID)
+}
+
+// RemoveCollaborator mocks base method.
+func (m *MockNoteUsecase) RemoveCollaborator(ctx context.Context, noteID, userID, guestID uuid.UUID) (string, []uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCollaborator", ctx, noteID, userID, guestID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].([]uuid.UUID)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RemoveCollaborator indicates an expected call of RemoveCollaborator.
+func (mr *MockNoteUsecaseMockRecorder) RemoveCollaborator(ctx, noteID, userID, guestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCollaborator", reflect.TypeOf((*MockNoteUsecase)(nil).RemoveCollaborator), ctx, noteID, userID, guestID)
+}
+
+// ReorderNotes mocks base method.
+func (m *MockNoteUsecase) ReorderNotes(ctx context.Context, userID, parentID uuid.UUID, notes []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderNotes", ctx, userID, parentID, notes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderNotes indicates an expected call of ReorderNotes.
+func (mr *MockNoteUsecaseMockRecorder) ReorderNotes(ctx, userID, parentID, notes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderNotes", reflect.TypeOf((*MockNoteUsecase)(nil).ReorderNotes), ctx, userID, parentID, notes)
+}
+
+// RestoreNote mocks base method.
+func (m *MockNoteUsecase) RestoreNote(ctx context.Context, noteID, userID uuid.UUID) (models.Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreNote", ctx, noteID, userID)
+	ret0, _ := ret[0].(models.Note)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreNote indicates an expected call of RestoreNote.
+func (mr *MockNoteUsecaseMockRecorder) RestoreNote(ctx, noteID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreNote", reflect.TypeOf((*MockNoteUsecase)(nil).RestoreNote), ctx, noteID, userID)
+}
+
+// RestoreRevision mocks base method.
+func (m *MockNoteUsecase) RestoreRevision(ctx context.Context, noteID, revisionID, userID uuid.UUID) (models.Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreRevision", ctx, noteID, revisionID, userID)
+	ret0, _ := ret[0].(models.Note)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreRevision indicates an expected call of RestoreRevision.
+func (mr *MockNoteUsecaseMockRecorder) RestoreRevision(ctx, noteID, revisionID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreRevision", reflect.TypeOf((*MockNoteUsecase)(nil).RestoreRevision), ctx, noteID, revisionID, userID)
+}
+
+// RevokeInvite mocks base method.
+func (m *MockNoteUsecase) RevokeInvite(ctx context.Context, inviteID, userID uuid.UUID) (models.Invite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeInvite", ctx, inviteID, userID)
+	ret0, _ := ret[0].(models.Invite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeInvite indicates an expected call of RevokeInvite.
+func (mr *MockNoteUsecaseMockRecorder) RevokeInvite(ctx, inviteID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeInvite", reflect.TypeOf((*MockNoteUsecase)(nil).RevokeInvite), ctx, inviteID, userID)
+}
+
+// RevokeShareLink mocks base method.
+func (m *MockNoteUsecase) RevokeShareLink(ctx context.Context, noteID, linkID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeShareLink", ctx, noteID, linkID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeShareLink indicates an expected call of RevokeShareLink.
+func (mr *MockNoteUsecaseMockRecorder) RevokeShareLink(ctx, noteID, linkID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeShareLink", reflect.TypeOf((*MockNoteUsecase)(nil).RevokeShareLink), ctx, noteID, linkID, userID)
+}
+
+// SetCollaboratorRole mocks base method.
+func (m *MockNoteUsecase) SetCollaboratorRole(ctx context.Context, noteID, userID, guestID uuid.UUID, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCollaboratorRole", ctx, noteID, userID, guestID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCollaboratorRole indicates an expected call of SetCollaboratorRole.
+func (mr *MockNoteUsecaseMockRecorder) SetCollaboratorRole(ctx, noteID, userID, guestID, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCollaboratorRole", reflect.TypeOf((*MockNoteUsecase)(nil).SetCollaboratorRole), ctx, noteID, userID, guestID, role)
+}
+
+// SetCommentResolved mocks base method.
+func (m *MockNoteUsecase) SetCommentResolved(ctx context.Context, noteID, commentID, userID uuid.UUID, resolved bool) (models.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCommentResolved", ctx, noteID, commentID, userID, resolved)
+	ret0, _ := ret[0].(models.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetCommentResolved indicates an expected call of SetCommentResolved.
+func (mr *MockNoteUsecaseMockRecorder) SetCommentResolved(ctx, noteID, commentID, userID, resolved interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCommentResolved", reflect.TypeOf((*MockNoteUsecase)(nil).SetCommentResolved), ctx, noteID, commentID, userID, resolved)
+}
+
+// SetHeader mocks base method.
+func (m *MockNoteUsecase) SetHeader(ctx context.Context, noteID uuid.UUID, header string, userID uuid.UUID, version int64) (models.Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", ctx, noteID, header, userID, version)
+	ret0, _ := ret[0].(models.Note)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockNoteUsecaseMockRecorder) SetHeader(ctx, noteID, header, userID, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockNoteUsecase)(nil).SetHeader), ctx, noteID, header, userID, version)
+}
+
+// SetIcon mocks base method.
+func (m *MockNoteUsecase) SetIcon(ctx context.Context, noteID uuid.UUID, icon string, userID uuid.UUID, version int64) (models.Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetIcon", ctx, noteID, icon, userID, version)
+	ret0, _ := ret[0].(models.Note)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetIcon indicates an expected call of SetIcon.
+func (mr *MockNoteUsecaseMockRecorder) SetIcon(ctx, noteID, icon, userID, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIcon", reflect.TypeOf((*MockNoteUsecase)(nil).SetIcon), ctx, noteID, icon, userID, version)
+}
+
+// SetPrivate mocks base method.
+func (m *MockNoteUsecase) SetPrivate(ctx context.Context, noteID, userID uuid.UUID) (models.Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPrivate", ctx, noteID, userID)
+	ret0, _ := ret[0].(models.Note)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPrivate indicates an expected call of SetPrivate.
+func (mr *MockNoteUsecaseMockRecorder) SetPrivate(ctx, noteID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPrivate", reflect.TypeOf((*MockNoteUsecase)(nil).SetPrivate), ctx, noteID, userID)
+}
+
+// SetPublic mocks base method.
+func (m *MockNoteUsecase) SetPublic(ctx context.Context, noteID, userID uuid.UUID) (models.Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPublic", ctx, noteID, userID)
+	ret0, _ := ret[0].(models.Note)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPublic indicates an expected call of SetPublic.
+func (mr *MockNoteUsecaseMockRecorder) SetPublic(ctx, noteID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPublic", reflect.TypeOf((*MockNoteUsecase)(nil).SetPublic), ctx, noteID, userID)
+}
+
+// SetTagInfo mocks base method.
+func (m *MockNoteUsecase) SetTagInfo(ctx context.Context, tag models.Tag, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTagInfo", ctx, tag, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTagInfo indicates an expected call of SetTagInfo.
+func (mr *MockNoteUsecaseMockRecorder) SetTagInfo(ctx, tag, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTagInfo", reflect.TypeOf((*MockNoteUsecase)(nil).SetTagInfo), ctx, tag, userID)
+}
+
+// SetTemplate mocks base method.
+func (m *MockNoteUsecase) SetTemplate(ctx context.Context, noteID, userID uuid.UUID, isTemplate bool) (models.Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTemplate", ctx, noteID, userID, isTemplate)
+	ret0, _ := ret[0].(models.Note)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTemplate indicates an expected call of SetTemplate.
+func (mr *MockNoteUsecaseMockRecorder) SetTemplate(ctx, noteID, userID, isTemplate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTemplate", reflect.TypeOf((*MockNoteUsecase)(nil).SetTemplate), ctx, noteID, userID, isTemplate)
+}
+
+// UnarchiveNote mocks base method.
+func (m *MockNoteUsecase) UnarchiveNote(ctx context.Context, noteID, userID uuid.UUID) (models.Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnarchiveNote", ctx, noteID, userID)
+	ret0, _ := ret[0].(models.Note)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnarchiveNote indicates an expected call of UnarchiveNote.
+func (mr *MockNoteUsecaseMockRecorder) UnarchiveNote(ctx, noteID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnarchiveNote", reflect.TypeOf((*MockNoteUsecase)(nil).UnarchiveNote), ctx, noteID, userID)
+}
+
+// UpdateComment mocks base method.
+func (m *MockNoteUsecase) UpdateComment(ctx context.Context, noteID, commentID, userID uuid.UUID, text string) (models.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateComment", ctx, noteID, commentID, userID, text)
+	ret0, _ := ret[0].(models.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateComment indicates an expected call of UpdateComment.
+func (mr *MockNoteUsecaseMockRecorder) UpdateComment(ctx, noteID, commentID, userID, text interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateComment", reflect.TypeOf((*MockNoteUsecase)(nil).UpdateComment), ctx, noteID, commentID, userID, text)
+}
+
+// UpdateNote mocks base method.
+func (m *MockNoteUsecase) UpdateNote(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 string, arg4 int64) (models.Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNote", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(models.Note)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNote indicates an expected call of UpdateNote.
+func (mr *MockNoteUsecaseMockRecorder) UpdateNote(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNote", reflect.TypeOf((*MockNoteUsecase)(nil).UpdateNote), arg0, arg1, arg2, arg3, arg4)
+}
+
+// UpdateTag mocks base method.
+func (m *MockNoteUsecase) UpdateTag(ctx context.Context, oldTag, newTag string, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTag", ctx, oldTag, newTag, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTag indicates an expected call of UpdateTag.
+func (mr *MockNoteUsecaseMockRecorder) UpdateTag(ctx, oldTag, newTag, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTag", reflect.TypeOf((*MockNoteUsecase)(nil).UpdateTag), ctx, oldTag, newTag, userID)
+}
+
+// MockNoteBaseRepo is a mock of NoteBaseRepo interface.
+type MockNoteBaseRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockNoteBaseRepoMockRecorder
+}
+
+// MockNoteBaseRepoMockRecorder is the mock recorder for MockNoteBaseRepo.
+type MockNoteBaseRepoMockRecorder struct {
+	mock *MockNoteBaseRepo
+}
+
+// NewMockNoteBaseRepo creates a new mock instance.
+func NewMockNoteBaseRepo(ctrl *gomock.Controller) *MockNoteBaseRepo {
+	mock := &MockNoteBaseRepo{ctrl: ctrl}
+	mock.recorder = &MockNoteBaseRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNoteBaseRepo) EXPECT() *MockNoteBaseRepoMockRecorder {
+	return m.recorder
+}
+
+// AddAttach mocks base method.
+func (m *MockNoteBaseRepo) AddAttach(ctx context.Context, attach models.Attach) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAttach", ctx, attach)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAttach indicates an expected call of AddAttach.
+func (mr *MockNoteBaseRepoMockRecorder) AddAttach(ctx, attach interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAttach", reflect.TypeOf((*MockNoteBaseRepo)(nil).AddAttach), ctx, attach)
+}
+
+// AddCollaborator mocks base method.
+func (m *MockNoteBaseRepo) AddCollaborator(arg0 context.Context, arg1, arg2 uuid.UUID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCollaborator", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCollaborator indicates an expected call of AddCollaborator.
+func (mr *MockNoteBaseRepoMockRecorder) AddCollaborator(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCollaborator", reflect.TypeOf((*MockNoteBaseRepo)(nil).AddCollaborator), arg0, arg1, arg2)
+}
+
+// AddFav mocks base method.
+func (m *MockNoteBaseRepo) AddFav(ctx context.Context, noteID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFav", ctx, noteID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddFav indicates an expected call of AddFav.
+func (mr *MockNoteBaseRepoMockRecorder) AddFav(ctx, noteID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFav", reflect.TypeOf((*MockNoteBaseRepo)(nil).AddFav), ctx, noteID, userID)
+}
+
+// AddFavs mocks base method.
+func (m *MockNoteBaseRepo) AddFavs(ctx context.Context, noteIDs []uuid.UUID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFavs", ctx, noteIDs, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddFavs indicates an expected call of AddFavs.
+func (mr *MockNoteBaseRepoMockRecorder) AddFavs(ctx, noteIDs, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFavs", reflect.TypeOf((*MockNoteBaseRepo)(nil).AddFavs), ctx, noteIDs, userID)
+}
+
+// AddSubNote mocks base method.
+func (m *MockNoteBaseRepo) AddSubNote(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSubNote", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSubNote indicates an expected call of AddSubNote.
+func (mr *MockNoteBaseRepoMockRecorder) AddSubNote(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSubNote", reflect.TypeOf((*MockNoteBaseRepo)(nil).AddSubNote), arg0, arg1, arg2)
+}
+
+// AddTag mocks base method.
+func (m *MockNoteBaseRepo) AddTag(ctx context.Context, tagName string, noteId uuid.UUID, version int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTag", ctx, tagName, noteId, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTag indicates an expected call of AddTag.
+func (mr *MockNoteBaseRepoMockRecorder) AddTag(ctx, tagName, noteId, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTag", reflect.TypeOf((*MockNoteBaseRepo)(nil).AddTag), ctx, tagName, noteId, version)
+}
+
+// AddTagToNotes mocks base method.
+func (m *MockNoteBaseRepo) AddTagToNotes(ctx context.Context, tagName string, noteIDs []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTagToNotes", ctx, tagName, noteIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTagToNotes indicates an expected call of AddTagToNotes.
+func (mr *MockNoteBaseRepoMockRecorder) AddTagToNotes(ctx, tagName, noteIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTagToNotes", reflect.TypeOf((*MockNoteBaseRepo)(nil).AddTagToNotes), ctx, tagName, noteIDs)
+}
+
+// ArchiveNote mocks base method.
+func (m *MockNoteBaseRepo) ArchiveNote(ctx context.Context, noteID, userID uuid.UUID, archivedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveNote", ctx, noteID, userID, archivedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArchiveNote indicates an expected call of ArchiveNote.
+func (mr *MockNoteBaseRepoMockRecorder) ArchiveNote(ctx, noteID, userID, archivedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveNote", reflect.TypeOf((*MockNoteBaseRepo)(nil).ArchiveNote), ctx, noteID, userID, archivedAt)
+}
+
+// CreateComment mocks base method.
+func (m *MockNoteBaseRepo) CreateComment(ctx context.Context, comment models.Comment) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateComment", ctx, comment)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateComment indicates an expected call of CreateComment.
+func (mr *MockNoteBaseRepoMockRecorder) CreateComment(ctx, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateComment", reflect.TypeOf((*MockNoteBaseRepo)(nil).CreateComment), ctx, comment)
+}
+
+// CreateInvite mocks base method.
+func (m *MockNoteBaseRepo) CreateInvite(ctx context.Context, invite models.Invite) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvite", ctx, invite)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInvite indicates an expected call of CreateInvite.
+func (mr *MockNoteBaseRepoMockRecorder) CreateInvite(ctx, invite interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvite", reflect.TypeOf((*MockNoteBaseRepo)(nil).CreateInvite), ctx, invite)
 }
 
 // CreateNote mocks base method.
 func (m *MockNoteBaseRepo) CreateNote(arg0 context.Context, arg1 models.Note) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateNote", arg0, arg1)
+	ret := m.ctrl.Call(m, "CreateNote", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNote indicates an expected call of CreateNote.
+func (mr *MockNoteBaseRepoMockRecorder) CreateNote(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNote", reflect.TypeOf((*MockNoteBaseRepo)(nil).CreateNote), arg0, arg1)
+}
+
+// CreateReminder mocks base method.
+func (m *MockNoteBaseRepo) CreateReminder(ctx context.Context, reminder models.Reminder) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReminder", ctx, reminder)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReminder indicates an expected call of CreateReminder.
+func (mr *MockNoteBaseRepoMockRecorder) CreateReminder(ctx, reminder interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReminder", reflect.TypeOf((*MockNoteBaseRepo)(nil).CreateReminder), ctx, reminder)
+}
+
+// CreateShareLink mocks base method.
+func (m *MockNoteBaseRepo) CreateShareLink(ctx context.Context, link models.ShareLink) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShareLink", ctx, link)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateShareLink indicates an expected call of CreateShareLink.
+func (mr *MockNoteBaseRepoMockRecorder) CreateShareLink(ctx, link interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShareLink", reflect.TypeOf((*MockNoteBaseRepo)(nil).CreateShareLink), ctx, link)
+}
+
+// CreateSubNote mocks base method.
+func (m *MockNoteBaseRepo) CreateSubNote(ctx context.Context, note models.Note) (models.Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubNote", ctx, note)
+	ret0, _ := ret[0].(models.Note)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSubNote indicates an expected call of CreateSubNote.
+func (mr *MockNoteBaseRepoMockRecorder) CreateSubNote(ctx, note interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubNote", reflect.TypeOf((*MockNoteBaseRepo)(nil).CreateSubNote), ctx, note)
+}
+
+// DelFav mocks base method.
+func (m *MockNoteBaseRepo) DelFav(ctx context.Context, noteID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DelFav", ctx, noteID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DelFav indicates an expected call of DelFav.
+func (mr *MockNoteBaseRepoMockRecorder) DelFav(ctx, noteID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelFav", reflect.TypeOf((*MockNoteBaseRepo)(nil).DelFav), ctx, noteID, userID)
+}
+
+// DelFavs mocks base method.
+func (m *MockNoteBaseRepo) DelFavs(ctx context.Context, noteIDs []uuid.UUID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DelFavs", ctx, noteIDs, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DelFavs indicates an expected call of DelFavs.
+func (mr *MockNoteBaseRepoMockRecorder) DelFavs(ctx, noteIDs, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelFavs", reflect.TypeOf((*MockNoteBaseRepo)(nil).DelFavs), ctx, noteIDs, userID)
+}
+
+// DeleteComment mocks base method.
+func (m *MockNoteBaseRepo) DeleteComment(ctx context.Context, commentID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteComment", ctx, commentID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// CreateNote indicates an expected call of CreateNote.
-func (mr *MockNoteBaseRepoMockRecorder) CreateNote(arg0, arg1 interface{}) *gomock.Call {
+// DeleteComment indicates an expected call of DeleteComment.
+func (mr *MockNoteBaseRepoMockRecorder) DeleteComment(ctx, commentID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNote", reflect.TypeOf((*MockNoteBaseRepo)(nil).CreateNote), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockNoteBaseRepo)(nil).DeleteComment), ctx, commentID)
 }
 
-// DelFav mocks base method.
-func (m *MockNoteBaseRepo) DelFav(ctx context.Context, noteID, userID uuid.UUID) error {
+// DeleteInvite mocks base method.
+func (m *MockNoteBaseRepo) DeleteInvite(ctx context.Context, inviteID uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DelFav", ctx, noteID, userID)
+	ret := m.ctrl.Call(m, "DeleteInvite", ctx, inviteID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DelFav indicates an expected call of DelFav.
-func (mr *MockNoteBaseRepoMockRecorder) DelFav(ctx, noteID, userID interface{}) *gomock.Call {
+// DeleteInvite indicates an expected call of DeleteInvite.
+func (mr *MockNoteBaseRepoMockRecorder) DeleteInvite(ctx, inviteID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelFav", reflect.TypeOf((*MockNoteBaseRepo)(nil).DelFav), ctx, noteID, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInvite", reflect.TypeOf((*MockNoteBaseRepo)(nil).DeleteInvite), ctx, inviteID)
 }
 
 // DeleteNote mocks base method.
@@ -500,26 +1310,55 @@ func (mr *MockNoteBaseRepoMockRecorder) DeleteNote(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNote", reflect.TypeOf((*MockNoteBaseRepo)(nil).DeleteNote), arg0, arg1)
 }
 
+// DeleteReminder mocks base method.
+func (m *MockNoteBaseRepo) DeleteReminder(ctx context.Context, reminderID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReminder", ctx, reminderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReminder indicates an expected call of DeleteReminder.
+func (mr *MockNoteBaseRepoMockRecorder) DeleteReminder(ctx, reminderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReminder", reflect.TypeOf((*MockNoteBaseRepo)(nil).DeleteReminder), ctx, reminderID)
+}
+
+// DeleteShareLink mocks base method.
+func (m *MockNoteBaseRepo) DeleteShareLink(ctx context.Context, noteID, linkID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteShareLink", ctx, noteID, linkID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteShareLink indicates an expected call of DeleteShareLink.
+func (mr *MockNoteBaseRepoMockRecorder) DeleteShareLink(ctx, noteID, linkID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteShareLink", reflect.TypeOf((*MockNoteBaseRepo)(nil).DeleteShareLink), ctx, noteID, linkID)
+}
+
 // DeleteTag mocks base method.
-func (m *MockNoteBaseRepo) DeleteTag(ctx context.Context, tagName string, noteId uuid.UUID) error {
+func (m *MockNoteBaseRepo) DeleteTag(ctx context.Context, tagName string, noteId uuid.UUID, version int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTag", ctx, tagName, noteId)
+	ret := m.ctrl.Call(m, "DeleteTag", ctx, tagName, noteId, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteTag indicates an expected call of DeleteTag.
-func (mr *MockNoteBaseRepoMockRecorder) DeleteTag(ctx, tagName, noteId interface{}) *gomock.Call {
+func (mr *MockNoteBaseRepoMockRecorder) DeleteTag(ctx, tagName, noteId, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTag", reflect.TypeOf((*MockNoteBaseRepo)(nil).DeleteTag), ctx, tagName, noteId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTag", reflect.TypeOf((*MockNoteBaseRepo)(nil).DeleteTag), ctx, tagName, noteId, version)
 }
 
 // DeleteTagFromAllNotes mocks base method.
-func (m *MockNoteBaseRepo) DeleteTagFromAllNotes(ctx context.Context, tagName string, userID uuid.UUID) error {
+func (m *MockNoteBaseRepo) DeleteTagFromAllNotes(ctx context.Context, tagName string, userID uuid.UUID) ([]uuid.UUID, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteTagFromAllNotes", ctx, tagName, userID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // DeleteTagFromAllNotes indicates an expected call of DeleteTagFromAllNotes.
@@ -528,6 +1367,35 @@ func (mr *MockNoteBaseRepoMockRecorder) DeleteTagFromAllNotes(ctx, tagName, user
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTagFromAllNotes", reflect.TypeOf((*MockNoteBaseRepo)(nil).DeleteTagFromAllNotes), ctx, tagName, userID)
 }
 
+// DeleteTagFromNotes mocks base method.
+func (m *MockNoteBaseRepo) DeleteTagFromNotes(ctx context.Context, tagName string, noteIDs []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTagFromNotes", ctx, tagName, noteIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTagFromNotes indicates an expected call of DeleteTagFromNotes.
+func (mr *MockNoteBaseRepoMockRecorder) DeleteTagFromNotes(ctx, tagName, noteIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTagFromNotes", reflect.TypeOf((*MockNoteBaseRepo)(nil).DeleteTagFromNotes), ctx, tagName, noteIDs)
+}
+
+// FireReminders mocks base method.
+func (m *MockNoteBaseRepo) FireReminders(ctx context.Context, now time.Time) ([]models.Reminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FireReminders", ctx, now)
+	ret0, _ := ret[0].([]models.Reminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FireReminders indicates an expected call of FireReminders.
+func (mr *MockNoteBaseRepoMockRecorder) FireReminders(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FireReminders", reflect.TypeOf((*MockNoteBaseRepo)(nil).FireReminders), ctx, now)
+}
+
 // ForgetTag mocks base method.
 func (m *MockNoteBaseRepo) ForgetTag(ctx context.Context, tagName string, userID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -545,76 +1413,437 @@ func (mr *MockNoteBaseRepoMockRecorder) ForgetTag(ctx, tagName, userID interface
 // GetAttachList mocks base method.
 func (m *MockNoteBaseRepo) GetAttachList(ctx context.Context, noteID uuid.UUID) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAttachList", ctx, noteID)
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "GetAttachList", ctx, noteID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachList indicates an expected call of GetAttachList.
+func (mr *MockNoteBaseRepoMockRecorder) GetAttachList(ctx, noteID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachList", reflect.TypeOf((*MockNoteBaseRepo)(nil).GetAttachList), ctx, noteID)
+}
+
+// GetAttaches mocks base method.
+func (m *MockNoteBaseRepo) GetAttaches(ctx context.Context, noteID uuid.UUID) ([]models.Attach, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttaches", ctx, noteID)
+	ret0, _ := ret[0].([]models.Attach)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttaches indicates an expected call of GetAttaches.
+func (mr *MockNoteBaseRepoMockRecorder) GetAttaches(ctx, noteID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttaches", reflect.TypeOf((*MockNoteBaseRepo)(nil).GetAttaches), ctx, noteID)
+}
+
+// GetBacklinks mocks base method.
+func (m *MockNoteBaseRepo) GetBacklinks(ctx context.Context, noteID, userID uuid.UUID) ([]models.NoteLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBacklinks", ctx, noteID, userID)
+	ret0, _ := ret[0].([]models.NoteLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBacklinks indicates an expected call of GetBacklinks.
+func (mr *MockNoteBaseRepoMockRecorder) GetBacklinks(ctx, noteID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBacklinks", reflect.TypeOf((*MockNoteBaseRepo)(nil).GetBacklinks), ctx, noteID, userID)
+}
+
+// GetCollaboratorRole mocks base method.
+func (m *MockNoteBaseRepo) GetCollaboratorRole(ctx context.Context, noteID, userID uuid.UUID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCollaboratorRole", ctx, noteID, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCollaboratorRole indicates an expected call of GetCollaboratorRole.
+func (mr *MockNoteBaseRepoMockRecorder) GetCollaboratorRole(ctx, noteID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollaboratorRole", reflect.TypeOf((*MockNoteBaseRepo)(nil).GetCollaboratorRole), ctx, noteID, userID)
+}
+
+// GetComment mocks base method.
+func (m *MockNoteBaseRepo) GetComment(ctx context.Context, commentID uuid.UUID) (models.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComment", ctx, commentID)
+	ret0, _ := ret[0].(models.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComment indicates an expected call of GetComment.
+func (mr *MockNoteBaseRepoMockRecorder) GetComment(ctx, commentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComment", reflect.TypeOf((*MockNoteBaseRepo)(nil).GetComment), ctx, commentID)
+}
+
+// GetComments mocks base method.
+func (m *MockNoteBaseRepo) GetComments(ctx context.Context, noteID uuid.UUID) ([]models.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComments", ctx, noteID)
+	ret0, _ := ret[0].([]models.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComments indicates an expected call of GetComments.
+func (mr *MockNoteBaseRepoMockRecorder) GetComments(ctx, noteID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComments", reflect.TypeOf((*MockNoteBaseRepo)(nil).GetComments), ctx, noteID)
+}
+
+// GetExpiredTrash mocks base method.
+func (m *MockNoteBaseRepo) GetExpiredTrash(ctx context.Context, before time.Time) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredTrash", ctx, before)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiredTrash indicates an expected call of GetExpiredTrash.
+func (mr *MockNoteBaseRepoMockRecorder) GetExpiredTrash(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredTrash", reflect.TypeOf((*MockNoteBaseRepo)(nil).GetExpiredTrash), ctx, before)
+}
+
+// GetInvite mocks base method.
+func (m *MockNoteBaseRepo) GetInvite(ctx context.Context, inviteID uuid.UUID) (models.Invite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvite", ctx, inviteID)
+	ret0, _ := ret[0].(models.Invite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInvite indicates an expected call of GetInvite.
+func (mr *MockNoteBaseRepoMockRecorder) GetInvite(ctx, inviteID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvite", reflect.TypeOf((*MockNoteBaseRepo)(nil).GetInvite), ctx, inviteID)
+}
+
+// GetNoteGraph mocks base method.
+func (m *MockNoteBaseRepo) GetNoteGraph(ctx context.Context, userID uuid.UUID) (models.NoteGraph, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNoteGraph", ctx, userID)
+	ret0, _ := ret[0].(models.NoteGraph)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNoteGraph indicates an expected call of GetNoteGraph.
+func (mr *MockNoteBaseRepoMockRecorder) GetNoteGraph(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNoteGraph", reflect.TypeOf((*MockNoteBaseRepo)(nil).GetNoteGraph), ctx, userID)
+}
+
+// GetNotePositions mocks base method.
+func (m *MockNoteBaseRepo) GetNotePositions(ctx context.Context, userID uuid.UUID) (map[uuid.UUID]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotePositions", ctx, userID)
+	ret0, _ := ret[0].(map[uuid.UUID]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotePositions indicates an expected call of GetNotePositions.
+func (mr *MockNoteBaseRepoMockRecorder) GetNotePositions(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotePositions", reflect.TypeOf((*MockNoteBaseRepo)(nil).GetNotePositions), ctx, userID)
+}
+
+// GetOwnerInfo mocks base method.
+func (m *MockNoteBaseRepo) GetOwnerInfo(ctx context.Context, ownerID uuid.UUID) (models.OwnerInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOwnerInfo", ctx, ownerID)
+	ret0, _ := ret[0].(models.OwnerInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOwnerInfo indicates an expected call of GetOwnerInfo.
+func (mr *MockNoteBaseRepoMockRecorder) GetOwnerInfo(ctx, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwnerInfo", reflect.TypeOf((*MockNoteBaseRepo)(nil).GetOwnerInfo), ctx, ownerID)
+}
+
+// GetPendingReminders mocks base method.
+func (m *MockNoteBaseRepo) GetPendingReminders(ctx context.Context, userID uuid.UUID) ([]models.Reminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingReminders", ctx, userID)
+	ret0, _ := ret[0].([]models.Reminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingReminders indicates an expected call of GetPendingReminders.
+func (mr *MockNoteBaseRepoMockRecorder) GetPendingReminders(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingReminders", reflect.TypeOf((*MockNoteBaseRepo)(nil).GetPendingReminders), ctx, userID)
+}
+
+// GetReceivedInvites mocks base method.
+func (m *MockNoteBaseRepo) GetReceivedInvites(ctx context.Context, userID uuid.UUID, now time.Time) ([]models.Invite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReceivedInvites", ctx, userID, now)
+	ret0, _ := ret[0].([]models.Invite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReceivedInvites indicates an expected call of GetReceivedInvites.
+func (mr *MockNoteBaseRepoMockRecorder) GetReceivedInvites(ctx, userID, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceivedInvites", reflect.TypeOf((*MockNoteBaseRepo)(nil).GetReceivedInvites), ctx, userID, now)
+}
+
+// GetReminder mocks base method.
+func (m *MockNoteBaseRepo) GetReminder(ctx context.Context, reminderID uuid.UUID) (models.Reminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReminder", ctx, reminderID)
+	ret0, _ := ret[0].(models.Reminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReminder indicates an expected call of GetReminder.
+func (mr *MockNoteBaseRepoMockRecorder) GetReminder(ctx, reminderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReminder", reflect.TypeOf((*MockNoteBaseRepo)(nil).GetReminder), ctx, reminderID)
+}
+
+// GetReminders mocks base method.
+func (m *MockNoteBaseRepo) GetReminders(ctx context.Context, noteID, userID uuid.UUID) ([]models.Reminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReminders", ctx, noteID, userID)
+	ret0, _ := ret[0].([]models.Reminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReminders indicates an expected call of GetReminders.
+func (mr *MockNoteBaseRepoMockRecorder) GetReminders(ctx, noteID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReminders", reflect.TypeOf((*MockNoteBaseRepo)(nil).GetReminders), ctx, noteID, userID)
+}
+
+// GetRevision mocks base method.
+func (m *MockNoteBaseRepo) GetRevision(ctx context.Context, revisionID uuid.UUID) (models.NoteRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevision", ctx, revisionID)
+	ret0, _ := ret[0].(models.NoteRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevision indicates an expected call of GetRevision.
+func (mr *MockNoteBaseRepoMockRecorder) GetRevision(ctx, revisionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevision", reflect.TypeOf((*MockNoteBaseRepo)(nil).GetRevision), ctx, revisionID)
+}
+
+// GetRevisions mocks base method.
+func (m *MockNoteBaseRepo) GetRevisions(ctx context.Context, noteID uuid.UUID, count, offset int64) ([]models.NoteRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisions", ctx, noteID, count, offset)
+	ret0, _ := ret[0].([]models.NoteRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisions indicates an expected call of GetRevisions.
+func (mr *MockNoteBaseRepoMockRecorder) GetRevisions(ctx, noteID, count, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisions", reflect.TypeOf((*MockNoteBaseRepo)(nil).GetRevisions), ctx, noteID, count, offset)
+}
+
+// GetSentInvites mocks base method.
+func (m *MockNoteBaseRepo) GetSentInvites(ctx context.Context, userID uuid.UUID, now time.Time) ([]models.Invite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSentInvites", ctx, userID, now)
+	ret0, _ := ret[0].([]models.Invite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSentInvites indicates an expected call of GetSentInvites.
+func (mr *MockNoteBaseRepoMockRecorder) GetSentInvites(ctx, userID, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSentInvites", reflect.TypeOf((*MockNoteBaseRepo)(nil).GetSentInvites), ctx, userID, now)
+}
+
+// GetShareLink mocks base method.
+func (m *MockNoteBaseRepo) GetShareLink(ctx context.Context, token string, noteID uuid.UUID) (models.ShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShareLink", ctx, token, noteID)
+	ret0, _ := ret[0].(models.ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShareLink indicates an expected call of GetShareLink.
+func (mr *MockNoteBaseRepoMockRecorder) GetShareLink(ctx, token, noteID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShareLink", reflect.TypeOf((*MockNoteBaseRepo)(nil).GetShareLink), ctx, token, noteID)
+}
+
+// GetShareLinks mocks base method.
+func (m *MockNoteBaseRepo) GetShareLinks(ctx context.Context, noteID uuid.UUID) ([]models.ShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShareLinks", ctx, noteID)
+	ret0, _ := ret[0].([]models.ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShareLinks indicates an expected call of GetShareLinks.
+func (mr *MockNoteBaseRepoMockRecorder) GetShareLinks(ctx, noteID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShareLinks", reflect.TypeOf((*MockNoteBaseRepo)(nil).GetShareLinks), ctx, noteID)
+}
+
+// GetSubtreeAttaches mocks base method.
+func (m *MockNoteBaseRepo) GetSubtreeAttaches(ctx context.Context, noteID uuid.UUID) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubtreeAttaches", ctx, noteID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubtreeAttaches indicates an expected call of GetSubtreeAttaches.
+func (mr *MockNoteBaseRepoMockRecorder) GetSubtreeAttaches(ctx, noteID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubtreeAttaches", reflect.TypeOf((*MockNoteBaseRepo)(nil).GetSubtreeAttaches), ctx, noteID)
+}
+
+// GetTags mocks base method.
+func (m *MockNoteBaseRepo) GetTags(ctx context.Context, userID uuid.UUID) ([]models.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTags", ctx, userID)
+	ret0, _ := ret[0].([]models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTags indicates an expected call of GetTags.
+func (mr *MockNoteBaseRepoMockRecorder) GetTags(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTags", reflect.TypeOf((*MockNoteBaseRepo)(nil).GetTags), ctx, userID)
+}
+
+// GetTransfer mocks base method.
+func (m *MockNoteBaseRepo) GetTransfer(ctx context.Context, noteID uuid.UUID) (models.OwnershipTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransfer", ctx, noteID)
+	ret0, _ := ret[0].(models.OwnershipTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransfer indicates an expected call of GetTransfer.
+func (mr *MockNoteBaseRepoMockRecorder) GetTransfer(ctx, noteID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfer", reflect.TypeOf((*MockNoteBaseRepo)(nil).GetTransfer), ctx, noteID)
+}
+
+// GetUpcomingReminders mocks base method.
+func (m *MockNoteBaseRepo) GetUpcomingReminders(ctx context.Context, userID uuid.UUID, count, offset int64) ([]models.Reminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpcomingReminders", ctx, userID, count, offset)
+	ret0, _ := ret[0].([]models.Reminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUpcomingReminders indicates an expected call of GetUpcomingReminders.
+func (mr *MockNoteBaseRepoMockRecorder) GetUpcomingReminders(ctx, userID, count, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpcomingReminders", reflect.TypeOf((*MockNoteBaseRepo)(nil).GetUpcomingReminders), ctx, userID, count, offset)
+}
+
+// GetUpdates mocks base method.
+func (m *MockNoteBaseRepo) GetUpdates(arg0 context.Context, arg1 uuid.UUID, arg2 time.Time) ([]models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpdates", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.Message)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAttachList indicates an expected call of GetAttachList.
-func (mr *MockNoteBaseRepoMockRecorder) GetAttachList(ctx, noteID interface{}) *gomock.Call {
+// GetUpdates indicates an expected call of GetUpdates.
+func (mr *MockNoteBaseRepoMockRecorder) GetUpdates(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachList", reflect.TypeOf((*MockNoteBaseRepo)(nil).GetAttachList), ctx, noteID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpdates", reflect.TypeOf((*MockNoteBaseRepo)(nil).GetUpdates), arg0, arg1, arg2)
 }
 
-// GetOwnerInfo mocks base method.
-func (m *MockNoteBaseRepo) GetOwnerInfo(ctx context.Context, ownerID uuid.UUID) (models.OwnerInfo, error) {
+// MergeTags mocks base method.
+func (m *MockNoteBaseRepo) MergeTags(ctx context.Context, tags []string, newTag string, userID uuid.UUID) ([]uuid.UUID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOwnerInfo", ctx, ownerID)
-	ret0, _ := ret[0].(models.OwnerInfo)
+	ret := m.ctrl.Call(m, "MergeTags", ctx, tags, newTag, userID)
+	ret0, _ := ret[0].([]uuid.UUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetOwnerInfo indicates an expected call of GetOwnerInfo.
-func (mr *MockNoteBaseRepoMockRecorder) GetOwnerInfo(ctx, ownerID interface{}) *gomock.Call {
+// MergeTags indicates an expected call of MergeTags.
+func (mr *MockNoteBaseRepoMockRecorder) MergeTags(ctx, tags, newTag, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwnerInfo", reflect.TypeOf((*MockNoteBaseRepo)(nil).GetOwnerInfo), ctx, ownerID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeTags", reflect.TypeOf((*MockNoteBaseRepo)(nil).MergeTags), ctx, tags, newTag, userID)
 }
 
-// GetTags mocks base method.
-func (m *MockNoteBaseRepo) GetTags(ctx context.Context, userID uuid.UUID) ([]string, error) {
+// MoveNote mocks base method.
+func (m *MockNoteBaseRepo) MoveNote(ctx context.Context, noteID, oldParentID, newParentID uuid.UUID) ([]uuid.UUID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTags", ctx, userID)
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "MoveNote", ctx, noteID, oldParentID, newParentID)
+	ret0, _ := ret[0].([]uuid.UUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTags indicates an expected call of GetTags.
-func (mr *MockNoteBaseRepoMockRecorder) GetTags(ctx, userID interface{}) *gomock.Call {
+// MoveNote indicates an expected call of MoveNote.
+func (mr *MockNoteBaseRepoMockRecorder) MoveNote(ctx, noteID, oldParentID, newParentID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTags", reflect.TypeOf((*MockNoteBaseRepo)(nil).GetTags), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveNote", reflect.TypeOf((*MockNoteBaseRepo)(nil).MoveNote), ctx, noteID, oldParentID, newParentID)
 }
 
-// GetUpdates mocks base method.
-func (m *MockNoteBaseRepo) GetUpdates(arg0 context.Context, arg1 uuid.UUID, arg2 time.Time) ([]models.Message, error) {
+// ProposeTransfer mocks base method.
+func (m *MockNoteBaseRepo) ProposeTransfer(ctx context.Context, transfer models.OwnershipTransfer) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUpdates", arg0, arg1, arg2)
-	ret0, _ := ret[0].([]models.Message)
+	ret := m.ctrl.Call(m, "ProposeTransfer", ctx, transfer)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUpdates indicates an expected call of GetUpdates.
-func (mr *MockNoteBaseRepoMockRecorder) GetUpdates(arg0, arg1, arg2 interface{}) *gomock.Call {
+// ProposeTransfer indicates an expected call of ProposeTransfer.
+func (mr *MockNoteBaseRepoMockRecorder) ProposeTransfer(ctx, transfer interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpdates", reflect.TypeOf((*MockNoteBaseRepo)(nil).GetUpdates), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposeTransfer", reflect.TypeOf((*MockNoteBaseRepo)(nil).ProposeTransfer), ctx, transfer)
 }
 
 // ReadAllNotes mocks base method.
-func (m *MockNoteBaseRepo) ReadAllNotes(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 int64, arg4 []string) ([]models.NoteResponse, error) {
+func (m *MockNoteBaseRepo) ReadAllNotes(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 int64, arg4 models.TagFilter, arg5, arg6 string, arg7 *models.NoteCursor) ([]models.NoteResponse, *models.NoteCursor, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadAllNotes", arg0, arg1, arg2, arg3, arg4)
+	ret := m.ctrl.Call(m, "ReadAllNotes", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
 	ret0, _ := ret[0].([]models.NoteResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(*models.NoteCursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // ReadAllNotes indicates an expected call of ReadAllNotes.
-func (mr *MockNoteBaseRepoMockRecorder) ReadAllNotes(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+func (mr *MockNoteBaseRepoMockRecorder) ReadAllNotes(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAllNotes", reflect.TypeOf((*MockNoteBaseRepo)(nil).ReadAllNotes), arg0, arg1, arg2, arg3, arg4)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAllNotes", reflect.TypeOf((*MockNoteBaseRepo)(nil).ReadAllNotes), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
 }
 
 // ReadNote mocks base method.
@@ -647,6 +1876,80 @@ func (mr *MockNoteBaseRepoMockRecorder) ReadPublicNote(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPublicNote", reflect.TypeOf((*MockNoteBaseRepo)(nil).ReadPublicNote), arg0, arg1)
 }
 
+// ReadTemplate mocks base method.
+func (m *MockNoteBaseRepo) ReadTemplate(ctx context.Context, templateID uuid.UUID) (models.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadTemplate", ctx, templateID)
+	ret0, _ := ret[0].(models.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadTemplate indicates an expected call of ReadTemplate.
+func (mr *MockNoteBaseRepoMockRecorder) ReadTemplate(ctx, templateID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTemplate", reflect.TypeOf((*MockNoteBaseRepo)(nil).ReadTemplate), ctx, templateID)
+}
+
+// ReadTemplates mocks base method.
+func (m *MockNoteBaseRepo) ReadTemplates(ctx context.Context, userID uuid.UUID) ([]models.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadTemplates", ctx, userID)
+	ret0, _ := ret[0].([]models.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadTemplates indicates an expected call of ReadTemplates.
+func (mr *MockNoteBaseRepoMockRecorder) ReadTemplates(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTemplates", reflect.TypeOf((*MockNoteBaseRepo)(nil).ReadTemplates), ctx, userID)
+}
+
+// ReadTrash mocks base method.
+func (m *MockNoteBaseRepo) ReadTrash(ctx context.Context, userID uuid.UUID, count, offset int64) ([]models.TrashedNote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadTrash", ctx, userID, count, offset)
+	ret0, _ := ret[0].([]models.TrashedNote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadTrash indicates an expected call of ReadTrash.
+func (mr *MockNoteBaseRepoMockRecorder) ReadTrash(ctx, userID, count, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTrash", reflect.TypeOf((*MockNoteBaseRepo)(nil).ReadTrash), ctx, userID, count, offset)
+}
+
+// ReadTrashedNote mocks base method.
+func (m *MockNoteBaseRepo) ReadTrashedNote(ctx context.Context, noteID uuid.UUID) (models.TrashedNote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadTrashedNote", ctx, noteID)
+	ret0, _ := ret[0].(models.TrashedNote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadTrashedNote indicates an expected call of ReadTrashedNote.
+func (mr *MockNoteBaseRepoMockRecorder) ReadTrashedNote(ctx, noteID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTrashedNote", reflect.TypeOf((*MockNoteBaseRepo)(nil).ReadTrashedNote), ctx, noteID)
+}
+
+// ReconcileTags mocks base method.
+func (m *MockNoteBaseRepo) ReconcileTags(ctx context.Context, noteIDs []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileTags", ctx, noteIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileTags indicates an expected call of ReconcileTags.
+func (mr *MockNoteBaseRepoMockRecorder) ReconcileTags(ctx, noteIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileTags", reflect.TypeOf((*MockNoteBaseRepo)(nil).ReconcileTags), ctx, noteIDs)
+}
+
 // RememberTag mocks base method.
 func (m *MockNoteBaseRepo) RememberTag(ctx context.Context, tagName string, userID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -661,6 +1964,21 @@ func (mr *MockNoteBaseRepoMockRecorder) RememberTag(ctx, tagName, userID interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RememberTag", reflect.TypeOf((*MockNoteBaseRepo)(nil).RememberTag), ctx, tagName, userID)
 }
 
+// RemoveCollaborator mocks base method.
+func (m *MockNoteBaseRepo) RemoveCollaborator(ctx context.Context, noteID, guestID uuid.UUID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCollaborator", ctx, noteID, guestID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveCollaborator indicates an expected call of RemoveCollaborator.
+func (mr *MockNoteBaseRepoMockRecorder) RemoveCollaborator(ctx, noteID, guestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCollaborator", reflect.TypeOf((*MockNoteBaseRepo)(nil).RemoveCollaborator), ctx, noteID, guestID)
+}
+
 // RemoveSubNote mocks base method.
 func (m *MockNoteBaseRepo) RemoveSubNote(arg0 context.Context, arg1, arg2 uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -675,32 +1993,117 @@ func (mr *MockNoteBaseRepoMockRecorder) RemoveSubNote(arg0, arg1, arg2 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSubNote", reflect.TypeOf((*MockNoteBaseRepo)(nil).RemoveSubNote), arg0, arg1, arg2)
 }
 
+// RescheduleReminder mocks base method.
+func (m *MockNoteBaseRepo) RescheduleReminder(ctx context.Context, reminderID uuid.UUID, remindAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RescheduleReminder", ctx, reminderID, remindAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RescheduleReminder indicates an expected call of RescheduleReminder.
+func (mr *MockNoteBaseRepoMockRecorder) RescheduleReminder(ctx, reminderID, remindAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RescheduleReminder", reflect.TypeOf((*MockNoteBaseRepo)(nil).RescheduleReminder), ctx, reminderID, remindAt)
+}
+
+// RestoreNote mocks base method.
+func (m *MockNoteBaseRepo) RestoreNote(ctx context.Context, noteID, parentID uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreNote", ctx, noteID, parentID)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreNote indicates an expected call of RestoreNote.
+func (mr *MockNoteBaseRepoMockRecorder) RestoreNote(ctx, noteID, parentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreNote", reflect.TypeOf((*MockNoteBaseRepo)(nil).RestoreNote), ctx, noteID, parentID)
+}
+
+// SetCollaboratorRole mocks base method.
+func (m *MockNoteBaseRepo) SetCollaboratorRole(ctx context.Context, noteID, userID uuid.UUID, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCollaboratorRole", ctx, noteID, userID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCollaboratorRole indicates an expected call of SetCollaboratorRole.
+func (mr *MockNoteBaseRepoMockRecorder) SetCollaboratorRole(ctx, noteID, userID, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCollaboratorRole", reflect.TypeOf((*MockNoteBaseRepo)(nil).SetCollaboratorRole), ctx, noteID, userID, role)
+}
+
+// SetCommentResolved mocks base method.
+func (m *MockNoteBaseRepo) SetCommentResolved(ctx context.Context, commentID uuid.UUID, resolved bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCommentResolved", ctx, commentID, resolved)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCommentResolved indicates an expected call of SetCommentResolved.
+func (mr *MockNoteBaseRepoMockRecorder) SetCommentResolved(ctx, commentID, resolved interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCommentResolved", reflect.TypeOf((*MockNoteBaseRepo)(nil).SetCommentResolved), ctx, commentID, resolved)
+}
+
 // SetHeader mocks base method.
-func (m *MockNoteBaseRepo) SetHeader(ctx context.Context, noteID uuid.UUID, header string) error {
+func (m *MockNoteBaseRepo) SetHeader(ctx context.Context, noteID uuid.UUID, header string, version int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetHeader", ctx, noteID, header)
+	ret := m.ctrl.Call(m, "SetHeader", ctx, noteID, header, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SetHeader indicates an expected call of SetHeader.
-func (mr *MockNoteBaseRepoMockRecorder) SetHeader(ctx, noteID, header interface{}) *gomock.Call {
+func (mr *MockNoteBaseRepoMockRecorder) SetHeader(ctx, noteID, header, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockNoteBaseRepo)(nil).SetHeader), ctx, noteID, header)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockNoteBaseRepo)(nil).SetHeader), ctx, noteID, header, version)
 }
 
 // SetIcon mocks base method.
-func (m *MockNoteBaseRepo) SetIcon(ctx context.Context, noteID uuid.UUID, icon string) error {
+func (m *MockNoteBaseRepo) SetIcon(ctx context.Context, noteID uuid.UUID, icon string, version int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetIcon", ctx, noteID, icon)
+	ret := m.ctrl.Call(m, "SetIcon", ctx, noteID, icon, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SetIcon indicates an expected call of SetIcon.
-func (mr *MockNoteBaseRepoMockRecorder) SetIcon(ctx, noteID, icon interface{}) *gomock.Call {
+func (mr *MockNoteBaseRepoMockRecorder) SetIcon(ctx, noteID, icon, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIcon", reflect.TypeOf((*MockNoteBaseRepo)(nil).SetIcon), ctx, noteID, icon, version)
+}
+
+// SetNoteLinks mocks base method.
+func (m *MockNoteBaseRepo) SetNoteLinks(ctx context.Context, noteID, userID uuid.UUID, targets []uuid.UUID, titles []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNoteLinks", ctx, noteID, userID, targets, titles)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetNoteLinks indicates an expected call of SetNoteLinks.
+func (mr *MockNoteBaseRepoMockRecorder) SetNoteLinks(ctx, noteID, userID, targets, titles interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNoteLinks", reflect.TypeOf((*MockNoteBaseRepo)(nil).SetNoteLinks), ctx, noteID, userID, targets, titles)
+}
+
+// SetNotePositions mocks base method.
+func (m *MockNoteBaseRepo) SetNotePositions(ctx context.Context, userID uuid.UUID, notes []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNotePositions", ctx, userID, notes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetNotePositions indicates an expected call of SetNotePositions.
+func (mr *MockNoteBaseRepoMockRecorder) SetNotePositions(ctx, userID, notes interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIcon", reflect.TypeOf((*MockNoteBaseRepo)(nil).SetIcon), ctx, noteID, icon)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNotePositions", reflect.TypeOf((*MockNoteBaseRepo)(nil).SetNotePositions), ctx, userID, notes)
 }
 
 // SetPrivate mocks base method.
@@ -731,18 +2134,104 @@ func (mr *MockNoteBaseRepoMockRecorder) SetPublic(ctx, noteID interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPublic", reflect.TypeOf((*MockNoteBaseRepo)(nil).SetPublic), ctx, noteID)
 }
 
+// SetTagInfo mocks base method.
+func (m *MockNoteBaseRepo) SetTagInfo(ctx context.Context, tag models.Tag, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTagInfo", ctx, tag, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTagInfo indicates an expected call of SetTagInfo.
+func (mr *MockNoteBaseRepoMockRecorder) SetTagInfo(ctx, tag, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTagInfo", reflect.TypeOf((*MockNoteBaseRepo)(nil).SetTagInfo), ctx, tag, userID)
+}
+
+// SetTemplate mocks base method.
+func (m *MockNoteBaseRepo) SetTemplate(ctx context.Context, noteID uuid.UUID, isTemplate bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTemplate", ctx, noteID, isTemplate)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTemplate indicates an expected call of SetTemplate.
+func (mr *MockNoteBaseRepoMockRecorder) SetTemplate(ctx, noteID, isTemplate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTemplate", reflect.TypeOf((*MockNoteBaseRepo)(nil).SetTemplate), ctx, noteID, isTemplate)
+}
+
+// TransferOwnership mocks base method.
+func (m *MockNoteBaseRepo) TransferOwnership(ctx context.Context, noteID, fromID, toID uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferOwnership", ctx, noteID, fromID, toID)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferOwnership indicates an expected call of TransferOwnership.
+func (mr *MockNoteBaseRepoMockRecorder) TransferOwnership(ctx, noteID, fromID, toID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferOwnership", reflect.TypeOf((*MockNoteBaseRepo)(nil).TransferOwnership), ctx, noteID, fromID, toID)
+}
+
+// TrashNote mocks base method.
+func (m *MockNoteBaseRepo) TrashNote(ctx context.Context, noteID, userID uuid.UUID, deletedAt time.Time) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrashNote", ctx, noteID, userID, deletedAt)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TrashNote indicates an expected call of TrashNote.
+func (mr *MockNoteBaseRepoMockRecorder) TrashNote(ctx, noteID, userID, deletedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrashNote", reflect.TypeOf((*MockNoteBaseRepo)(nil).TrashNote), ctx, noteID, userID, deletedAt)
+}
+
+// UnarchiveNote mocks base method.
+func (m *MockNoteBaseRepo) UnarchiveNote(ctx context.Context, noteID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnarchiveNote", ctx, noteID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnarchiveNote indicates an expected call of UnarchiveNote.
+func (mr *MockNoteBaseRepoMockRecorder) UnarchiveNote(ctx, noteID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnarchiveNote", reflect.TypeOf((*MockNoteBaseRepo)(nil).UnarchiveNote), ctx, noteID, userID)
+}
+
+// UpdateComment mocks base method.
+func (m *MockNoteBaseRepo) UpdateComment(ctx context.Context, commentID uuid.UUID, text string, updated time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateComment", ctx, commentID, text, updated)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateComment indicates an expected call of UpdateComment.
+func (mr *MockNoteBaseRepoMockRecorder) UpdateComment(ctx, commentID, text, updated interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateComment", reflect.TypeOf((*MockNoteBaseRepo)(nil).UpdateComment), ctx, commentID, text, updated)
+}
+
 // UpdateNote mocks base method.
-func (m *MockNoteBaseRepo) UpdateNote(arg0 context.Context, arg1 models.Note) error {
+func (m *MockNoteBaseRepo) UpdateNote(arg0 context.Context, arg1 models.Note, arg2 uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateNote", arg0, arg1)
+	ret := m.ctrl.Call(m, "UpdateNote", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateNote indicates an expected call of UpdateNote.
-func (mr *MockNoteBaseRepoMockRecorder) UpdateNote(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockNoteBaseRepoMockRecorder) UpdateNote(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNote", reflect.TypeOf((*MockNoteBaseRepo)(nil).UpdateNote), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNote", reflect.TypeOf((*MockNoteBaseRepo)(nil).UpdateNote), arg0, arg1, arg2)
 }
 
 // UpdateTag mocks base method.
@@ -760,11 +2249,12 @@ func (mr *MockNoteBaseRepoMockRecorder) UpdateTag(ctx, oldTag, newTag, userID in
 }
 
 // UpdateTagOnAllNotes mocks base method.
-func (m *MockNoteBaseRepo) UpdateTagOnAllNotes(ctx context.Context, oldTag, newTag string, userID uuid.UUID) error {
+func (m *MockNoteBaseRepo) UpdateTagOnAllNotes(ctx context.Context, oldTag, newTag string, userID uuid.UUID) ([]uuid.UUID, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateTagOnAllNotes", ctx, oldTag, newTag, userID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // UpdateTagOnAllNotes indicates an expected call of UpdateTagOnAllNotes.
@@ -838,6 +2328,34 @@ func (mr *MockNoteSearchRepoMockRecorder) AddTag(ctx, tagName, noteID interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTag", reflect.TypeOf((*MockNoteSearchRepo)(nil).AddTag), ctx, tagName, noteID)
 }
 
+// AddTagToNotes mocks base method.
+func (m *MockNoteSearchRepo) AddTagToNotes(ctx context.Context, tagName string, noteIDs []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTagToNotes", ctx, tagName, noteIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTagToNotes indicates an expected call of AddTagToNotes.
+func (mr *MockNoteSearchRepoMockRecorder) AddTagToNotes(ctx, tagName, noteIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTagToNotes", reflect.TypeOf((*MockNoteSearchRepo)(nil).AddTagToNotes), ctx, tagName, noteIDs)
+}
+
+// ArchiveNote mocks base method.
+func (m *MockNoteSearchRepo) ArchiveNote(ctx context.Context, noteID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveNote", ctx, noteID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArchiveNote indicates an expected call of ArchiveNote.
+func (mr *MockNoteSearchRepoMockRecorder) ArchiveNote(ctx, noteID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveNote", reflect.TypeOf((*MockNoteSearchRepo)(nil).ArchiveNote), ctx, noteID, userID)
+}
+
 // ChangeFlag mocks base method.
 func (m *MockNoteSearchRepo) ChangeFlag(ctx context.Context, noteID uuid.UUID, flag bool) error {
 	m.ctrl.T.Helper()
@@ -852,6 +2370,20 @@ func (mr *MockNoteSearchRepoMockRecorder) ChangeFlag(ctx, noteID, flag interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeFlag", reflect.TypeOf((*MockNoteSearchRepo)(nil).ChangeFlag), ctx, noteID, flag)
 }
 
+// ChangeFlagOnNotes mocks base method.
+func (m *MockNoteSearchRepo) ChangeFlagOnNotes(ctx context.Context, noteIDs []uuid.UUID, flag bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeFlagOnNotes", ctx, noteIDs, flag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeFlagOnNotes indicates an expected call of ChangeFlagOnNotes.
+func (mr *MockNoteSearchRepoMockRecorder) ChangeFlagOnNotes(ctx, noteIDs, flag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeFlagOnNotes", reflect.TypeOf((*MockNoteSearchRepo)(nil).ChangeFlagOnNotes), ctx, noteIDs, flag)
+}
+
 // CreateNote mocks base method.
 func (m *MockNoteSearchRepo) CreateNote(arg0 context.Context, arg1 models.Note) error {
 	m.ctrl.T.Helper()
@@ -895,17 +2427,31 @@ func (mr *MockNoteSearchRepoMockRecorder) DeleteTag(ctx, tagName, noteID interfa
 }
 
 // DeleteTagFromAllNotes mocks base method.
-func (m *MockNoteSearchRepo) DeleteTagFromAllNotes(ctx context.Context, tagName string, userID uuid.UUID) error {
+func (m *MockNoteSearchRepo) DeleteTagFromAllNotes(ctx context.Context, tagName string, noteIDs []uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTagFromAllNotes", ctx, tagName, userID)
+	ret := m.ctrl.Call(m, "DeleteTagFromAllNotes", ctx, tagName, noteIDs)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteTagFromAllNotes indicates an expected call of DeleteTagFromAllNotes.
-func (mr *MockNoteSearchRepoMockRecorder) DeleteTagFromAllNotes(ctx, tagName, userID interface{}) *gomock.Call {
+func (mr *MockNoteSearchRepoMockRecorder) DeleteTagFromAllNotes(ctx, tagName, noteIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTagFromAllNotes", reflect.TypeOf((*MockNoteSearchRepo)(nil).DeleteTagFromAllNotes), ctx, tagName, noteIDs)
+}
+
+// RemoveCollaborator mocks base method.
+func (m *MockNoteSearchRepo) RemoveCollaborator(ctx context.Context, noteID, guestID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCollaborator", ctx, noteID, guestID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveCollaborator indicates an expected call of RemoveCollaborator.
+func (mr *MockNoteSearchRepoMockRecorder) RemoveCollaborator(ctx, noteID, guestID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTagFromAllNotes", reflect.TypeOf((*MockNoteSearchRepo)(nil).DeleteTagFromAllNotes), ctx, tagName, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCollaborator", reflect.TypeOf((*MockNoteSearchRepo)(nil).RemoveCollaborator), ctx, noteID, guestID)
 }
 
 // RemoveSubNote mocks base method.
@@ -923,18 +2469,33 @@ func (mr *MockNoteSearchRepoMockRecorder) RemoveSubNote(arg0, arg1, arg2 interfa
 }
 
 // SearchNotes mocks base method.
-func (m *MockNoteSearchRepo) SearchNotes(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 int64, arg4 string, arg5 []string) ([]models.NoteResponse, error) {
+func (m *MockNoteSearchRepo) SearchNotes(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 int64, arg4 string, arg5 models.TagFilter, arg6, arg7 string, arg8 map[uuid.UUID]int, arg9 *models.NoteCursor) ([]models.NoteResponse, *models.NoteCursor, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchNotes", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret := m.ctrl.Call(m, "SearchNotes", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9)
 	ret0, _ := ret[0].([]models.NoteResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(*models.NoteCursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // SearchNotes indicates an expected call of SearchNotes.
-func (mr *MockNoteSearchRepoMockRecorder) SearchNotes(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+func (mr *MockNoteSearchRepoMockRecorder) SearchNotes(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchNotes", reflect.TypeOf((*MockNoteSearchRepo)(nil).SearchNotes), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9)
+}
+
+// SetCollaborators mocks base method.
+func (m *MockNoteSearchRepo) SetCollaborators(ctx context.Context, noteIDs, collaborators []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCollaborators", ctx, noteIDs, collaborators)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCollaborators indicates an expected call of SetCollaborators.
+func (mr *MockNoteSearchRepoMockRecorder) SetCollaborators(ctx, noteIDs, collaborators interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchNotes", reflect.TypeOf((*MockNoteSearchRepo)(nil).SearchNotes), arg0, arg1, arg2, arg3, arg4, arg5)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCollaborators", reflect.TypeOf((*MockNoteSearchRepo)(nil).SetCollaborators), ctx, noteIDs, collaborators)
 }
 
 // SetHeader mocks base method.
@@ -965,6 +2526,34 @@ func (mr *MockNoteSearchRepoMockRecorder) SetIcon(ctx, noteID, icon interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIcon", reflect.TypeOf((*MockNoteSearchRepo)(nil).SetIcon), ctx, noteID, icon)
 }
 
+// SetOwner mocks base method.
+func (m *MockNoteSearchRepo) SetOwner(ctx context.Context, noteID, fromID, toID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOwner", ctx, noteID, fromID, toID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOwner indicates an expected call of SetOwner.
+func (mr *MockNoteSearchRepoMockRecorder) SetOwner(ctx, noteID, fromID, toID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOwner", reflect.TypeOf((*MockNoteSearchRepo)(nil).SetOwner), ctx, noteID, fromID, toID)
+}
+
+// SetParent mocks base method.
+func (m *MockNoteSearchRepo) SetParent(ctx context.Context, noteID, parentID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetParent", ctx, noteID, parentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetParent indicates an expected call of SetParent.
+func (mr *MockNoteSearchRepoMockRecorder) SetParent(ctx, noteID, parentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetParent", reflect.TypeOf((*MockNoteSearchRepo)(nil).SetParent), ctx, noteID, parentID)
+}
+
 // SetPrivate mocks base method.
 func (m *MockNoteSearchRepo) SetPrivate(ctx context.Context, noteID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -993,6 +2582,20 @@ func (mr *MockNoteSearchRepoMockRecorder) SetPublic(ctx, noteID interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPublic", reflect.TypeOf((*MockNoteSearchRepo)(nil).SetPublic), ctx, noteID)
 }
 
+// UnarchiveNote mocks base method.
+func (m *MockNoteSearchRepo) UnarchiveNote(ctx context.Context, noteID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnarchiveNote", ctx, noteID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnarchiveNote indicates an expected call of UnarchiveNote.
+func (mr *MockNoteSearchRepoMockRecorder) UnarchiveNote(ctx, noteID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnarchiveNote", reflect.TypeOf((*MockNoteSearchRepo)(nil).UnarchiveNote), ctx, noteID, userID)
+}
+
 // UpdateNote mocks base method.
 func (m *MockNoteSearchRepo) UpdateNote(arg0 context.Context, arg1 models.Note) error {
 	m.ctrl.T.Helper()
@@ -1008,15 +2611,15 @@ func (mr *MockNoteSearchRepoMockRecorder) UpdateNote(arg0, arg1 interface{}) *go
 }
 
 // UpdateTagOnAllNotes mocks base method.
-func (m *MockNoteSearchRepo) UpdateTagOnAllNotes(ctx context.Context, oldTag, newTag string, userID uuid.UUID) error {
+func (m *MockNoteSearchRepo) UpdateTagOnAllNotes(ctx context.Context, oldTag, newTag string, noteIDs []uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateTagOnAllNotes", ctx, oldTag, newTag, userID)
+	ret := m.ctrl.Call(m, "UpdateTagOnAllNotes", ctx, oldTag, newTag, noteIDs)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateTagOnAllNotes indicates an expected call of UpdateTagOnAllNotes.
-func (mr *MockNoteSearchRepoMockRecorder) UpdateTagOnAllNotes(ctx, oldTag, newTag, userID interface{}) *gomock.Call {
+func (mr *MockNoteSearchRepoMockRecorder) UpdateTagOnAllNotes(ctx, oldTag, newTag, noteIDs interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTagOnAllNotes", reflect.TypeOf((*MockNoteSearchRepo)(nil).UpdateTagOnAllNotes), ctx, oldTag, newTag, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTagOnAllNotes", reflect.TypeOf((*MockNoteSearchRepo)(nil).UpdateTagOnAllNotes), ctx, oldTag, newTag, noteIDs)
 }
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/metrics"
 	"log/slog"
+	"slices"
 	"strings"
 	"time"
 
@@ -54,7 +55,7 @@ func (repo *NoteElastic) updateNullFieldToEmptyArray(ctx context.Context, fieldN
 	return nil
 }
 
-func (repo *NoteElastic) SearchNotes(ctx context.Context, userID uuid.UUID, count int64, offset int64, searchValue string, tags []string) ([]models.NoteResponse, error) {
+func (repo *NoteElastic) SearchNotes(ctx context.Context, userID uuid.UUID, count int64, offset int64, searchValue string, tags models.TagFilter, archived string, sort string, positions map[uuid.UUID]int, cursor *models.NoteCursor) ([]models.NoteResponse, *models.NoteCursor, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
 	ownerQuery := elastic.NewTermsQuery("owner_id", strings.ToLower(userID.String()))
@@ -64,43 +65,147 @@ func (repo *NoteElastic) SearchNotes(ctx context.Context, userID uuid.UUID, coun
 	userIdQuery := elastic.NewBoolQuery().Should(ownerQuery, collaboratorQuery)
 	fullQuery := elastic.NewBoolQuery().Must(searchQuery, userIdQuery)
 
-	if len(tags) > 0 {
-		tagQueries := make([]elastic.Query, len(tags))
-		for i, tag := range tags {
-			tagQueries[i] = elastic.NewTermsQuery("tags", tag)
-		}
+	fullQuery = withTagFilter(fullQuery, tags)
 
-		tagsQuery := elastic.NewBoolQuery().Should(tagQueries...)
-		fullQuery = fullQuery.Filter(tagsQuery)
+	archivedQuery := elastic.NewTermsQuery("archived_by", strings.ToLower(userID.String()))
+	switch archived {
+	case models.ArchiveFilterAll:
+	case models.ArchiveFilterArchived:
+		fullQuery = fullQuery.Filter(archivedQuery)
+	default:
+		fullQuery = fullQuery.MustNot(archivedQuery)
 	}
 
-	start := time.Now()
-	search, err := repo.elastic.Search().
+	searchService := repo.elastic.Search().
 		Query(fullQuery).
 		Index(repo.cfg.ElasticIndexName).
-		From(int(offset)).
 		Size(int(count)).
-		Sort("update_time", false).
-		Do(ctx)
+		SortBy(getSorters(userID, sort, positions)...)
+	if cursor != nil {
+		searchService = searchService.SearchAfter(cursor.SearchAfter...)
+	} else {
+		searchService = searchService.From(int(offset))
+	}
+
+	start := time.Now()
+	search, err := searchService.Do(ctx)
 	repo.metr.ObserveResponseTime(log.GFN(), time.Since(start).Seconds())
 	if err != nil {
 		logger.Error(err.Error())
 		repo.metr.IncreaseErrors(log.GFN())
-		return []models.NoteResponse{}, ErrCantGetResponse
+		return []models.NoteResponse{}, nil, ErrCantGetResponse
 	}
 
 	notes := make([]models.NoteResponse, 0)
+	var last *models.NoteCursor
 	for _, hit := range search.Hits.Hits {
 		note := models.NoteResponse{}
 		if err := json.Unmarshal(hit.Source, &note); err != nil {
 			logger.Error(err.Error())
-			return []models.NoteResponse{}, err
+			return []models.NoteResponse{}, nil, err
 		}
+
+		// архив у каждого пользователя свой
+		archivedBy := struct {
+			ArchivedBy []string `json:"archived_by"`
+		}{}
+		if err := json.Unmarshal(hit.Source, &archivedBy); err != nil {
+			logger.Error(err.Error())
+			return []models.NoteResponse{}, nil, err
+		}
+		note.Archived = slices.Contains(archivedBy.ArchivedBy, strings.ToLower(userID.String()))
+
 		notes = append(notes, note)
+		last = &models.NoteCursor{
+			Sort:        sort,
+			Favorite:    note.Favorite,
+			Created:     note.CreateTime,
+			OwnerId:     note.OwnerId,
+			Updated:     note.UpdateTime,
+			Id:          note.Id,
+			SearchAfter: hit.Sort,
+		}
 	}
 
 	logger.Info("success")
-	return notes, nil
+	return notes, last, nil
+}
+
+// withTagFilter - те же условия по тегам, что и в getAllNotes
+func withTagFilter(query *elastic.BoolQuery, filter models.TagFilter) *elastic.BoolQuery {
+	if len(filter.Included) > 0 {
+		switch filter.Mode {
+		case models.TagModeAny:
+			query = query.Filter(tagsQuery(filter.Included))
+		case models.TagModeNone:
+			query = query.MustNot(tagsQuery(filter.Included))
+		default:
+			for _, tag := range filter.Included {
+				query = query.Filter(tagQuery(tag))
+			}
+		}
+	}
+
+	if len(filter.Excluded) > 0 {
+		query = query.MustNot(tagsQuery(filter.Excluded))
+	}
+
+	return query
+}
+
+// tagQuery - сам тег или любой вложенный в него
+func tagQuery(tag string) elastic.Query {
+	return elastic.NewBoolQuery().Should(
+		elastic.NewTermQuery("tags", tag),
+		elastic.NewPrefixQuery("tags", tag+models.TagPathSeparator),
+	)
+}
+
+func tagsQuery(tags []string) elastic.Query {
+	queries := make([]elastic.Query, len(tags))
+	for i, tag := range tags {
+		queries[i] = tagQuery(tag)
+	}
+	return elastic.NewBoolQuery().Should(queries...)
+}
+
+// getSorters - порядок сортировки совпадает с getAllNotes в postgres, кроме избранного: поле favorite в индексе общее
+// для всех пользователей, а избранное у каждого свое, поэтому в поиске (и в ключе search_after) оно не участвует
+func getSorters(userID uuid.UUID, sort string, positions map[uuid.UUID]int) []elastic.Sorter {
+	sorters := make([]elastic.Sorter, 0, 3)
+
+	switch sort {
+	case models.SortManual:
+		params := make(map[string]interface{}, len(positions))
+		for noteID, position := range positions {
+			params[noteID.String()] = position
+		}
+		script := elastic.NewScript("params.positions.containsKey(doc['id'].value) ? params.positions[doc['id'].value] : Integer.MAX_VALUE").
+			Lang("painless").
+			Param("positions", params)
+		sorters = append(sorters, elastic.NewScriptSort(script, "number").Asc())
+	case models.SortCreated:
+		sorters = append(sorters, elastic.NewFieldSort("create_time").Desc())
+	case models.SortTitle:
+		sorters = append(sorters, elastic.NewFieldSort("sort_title").Asc().Missing("_last"))
+	case models.SortOwner:
+		script := elastic.NewScript("doc['owner_id'].value == params.userID ? 0 : 1").
+			Lang("painless").
+			Param("userID", strings.ToLower(userID.String()))
+		sorters = append(sorters, elastic.NewScriptSort(script, "number").Asc())
+	}
+
+	return append(sorters, elastic.NewFieldSort("update_time").Desc(), elastic.NewFieldSort("id").Asc())
+}
+
+func getSortTitle(data string) string {
+	noteData := struct {
+		Title string `json:"title"`
+	}{}
+	if err := json.Unmarshal([]byte(data), &noteData); err != nil {
+		return ""
+	}
+	return strings.ToLower(noteData.Title)
 }
 
 func (repo *NoteElastic) CreateNote(ctx context.Context, note models.Note) error {
@@ -117,6 +222,7 @@ func (repo *NoteElastic) CreateNote(ctx context.Context, note models.Note) error
 		logger.Error(err.Error())
 		return err
 	}
+	noteMap["sort_title"] = getSortTitle(note.Data)
 
 	start := time.Now()
 	_, err = repo.elastic.Index().
@@ -149,6 +255,7 @@ func (repo *NoteElastic) UpdateNote(ctx context.Context, note models.Note) error
 		logger.Error(err.Error())
 		return err
 	}
+	noteMap["sort_title"] = getSortTitle(note.Data)
 
 	start := time.Now()
 	_, err = repo.elastic.Update().
@@ -234,6 +341,26 @@ func (repo *NoteElastic) RemoveSubNote(ctx context.Context, id uuid.UUID, childI
 	return nil
 }
 
+func (repo *NoteElastic) SetParent(ctx context.Context, noteID uuid.UUID, parentID uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	start := time.Now()
+	_, err := repo.elastic.Update().
+		Index(repo.cfg.ElasticIndexName).
+		Id(noteID.String()).
+		Doc(map[string]interface{}{"parent": parentID.String()}).
+		Do(ctx)
+	repo.metr.ObserveResponseTime(log.GFN(), time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors(log.GFN())
+		return err
+	}
+
+	logger.Info("success")
+	return nil
+}
+
 func (repo *NoteElastic) AddCollaborator(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) error {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
@@ -258,6 +385,76 @@ func (repo *NoteElastic) AddCollaborator(ctx context.Context, noteID uuid.UUID,
 	return nil
 }
 
+func (repo *NoteElastic) RemoveCollaborator(ctx context.Context, noteID uuid.UUID, guestID uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	script := elastic.NewScript("ctx._source.collaborators.removeIf(id -> id == params.collaboratorID)").
+		Lang("painless").
+		Param("collaboratorID", guestID.String())
+
+	start := time.Now()
+	_, err := repo.elastic.Update().
+		Index(repo.cfg.ElasticIndexName).
+		Id(noteID.String()).
+		Script(script).
+		Do(ctx)
+	repo.metr.ObserveResponseTime(log.GFN(), time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors(log.GFN())
+		return err
+	}
+
+	logger.Info("success")
+	return nil
+}
+
+func (repo *NoteElastic) SetCollaborators(ctx context.Context, noteIDs []uuid.UUID, collaborators []uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	ids := make([]string, len(collaborators))
+	for i, id := range collaborators {
+		ids[i] = id.String()
+	}
+
+	script := elastic.NewScript("ctx._source.collaborators = params.collaborators").
+		Lang("painless").
+		Param("collaborators", ids)
+
+	if err := repo.updateNotes(ctx, noteIDs, script); err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	logger.Info("success")
+	return nil
+}
+
+func (repo *NoteElastic) SetOwner(ctx context.Context, noteID uuid.UUID, fromID uuid.UUID, toID uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	script := elastic.NewScript("ctx._source.owner_id = params.toID; ctx._source.collaborators.removeIf(id -> id == params.toID); ctx._source.collaborators.add(params.fromID)").
+		Lang("painless").
+		Param("fromID", fromID.String()).
+		Param("toID", toID.String())
+
+	start := time.Now()
+	_, err := repo.elastic.Update().
+		Index(repo.cfg.ElasticIndexName).
+		Id(noteID.String()).
+		Script(script).
+		Do(ctx)
+	repo.metr.ObserveResponseTime(log.GFN(), time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors(log.GFN())
+		return err
+	}
+
+	logger.Info("success")
+	return nil
+}
+
 func (repo *NoteElastic) AddTag(ctx context.Context, tagName string, noteID uuid.UUID) error {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
@@ -311,41 +508,38 @@ func (repo *NoteElastic) DeleteTag(ctx context.Context, tagName string, noteID u
 	return nil
 }
 
-func (repo *NoteElastic) DeleteTagFromAllNotes(ctx context.Context, tagName string, userID uuid.UUID) error {
+func (repo *NoteElastic) AddTagToNotes(ctx context.Context, tagName string, noteIDs []uuid.UUID) error {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	script := elastic.NewScript("ctx._source.tags.remove(ctx._source.tags.indexOf(params.tagName))").
+	script := elastic.NewScript(`
+		if (ctx._source.tags == null) {
+			ctx._source.tags = new ArrayList();
+		}
+		if (!ctx._source.tags.contains(params.tagName)) {
+			ctx._source.tags.add(params.tagName);
+		}
+	`).
 		Lang("painless").
 		Param("tagName", tagName)
 
-	query := elastic.NewBoolQuery().
-		Must(elastic.NewTermQuery("owner_id", userID)).
-		Must(elastic.NewTermQuery("tags", tagName))
-
-	searchResult, err := repo.elastic.Search().
-		Index(repo.cfg.ElasticIndexName).
-		Query(query).
-		Do(context.Background())
-	if err != nil {
+	if err := repo.updateNotes(ctx, noteIDs, script); err != nil {
 		logger.Error(err.Error())
 		return err
 	}
 
-	bulkRequest := repo.elastic.Bulk()
-	for _, hit := range searchResult.Hits.Hits {
-		bulkRequest = bulkRequest.Add(elastic.NewBulkUpdateRequest().
-			Index(repo.cfg.ElasticIndexName).
-			Id(hit.Id).
-			Script(script),
-		)
-	}
+	logger.Info("success")
+	return nil
+}
 
-	start := time.Now()
-	_, err = bulkRequest.Do(context.Background())
-	repo.metr.ObserveResponseTime(log.GFN(), time.Since(start).Seconds())
-	if err != nil {
+func (repo *NoteElastic) DeleteTagFromAllNotes(ctx context.Context, tagName string, noteIDs []uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	script := elastic.NewScript("ctx._source.tags.removeIf(tag -> tag == params.tagName)").
+		Lang("painless").
+		Param("tagName", tagName)
+
+	if err := repo.updateNotes(ctx, noteIDs, script); err != nil {
 		logger.Error(err.Error())
-		repo.metr.IncreaseErrors(log.GFN())
 		return err
 	}
 
@@ -353,48 +547,60 @@ func (repo *NoteElastic) DeleteTagFromAllNotes(ctx context.Context, tagName stri
 	return nil
 }
 
-func (repo *NoteElastic) UpdateTagOnAllNotes(ctx context.Context, oldTag string, newTag string, userID uuid.UUID) error {
+func (repo *NoteElastic) UpdateTagOnAllNotes(ctx context.Context, oldTag string, newTag string, noteIDs []uuid.UUID) error {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	script := elastic.NewScript("ctx._source.tags[ctx._source.tags.indexOf(params.oldTag)] = params.NewTag").
+	// переименовываем сам тег и всех его потомков, совпавшие после переименования теги сливаются
+	script := elastic.NewScript(`
+		List tags = new ArrayList();
+		for (String tag : ctx._source.tags) {
+			if (tag == params.oldTag || tag.startsWith(params.oldTag + '/')) {
+				tag = params.newTag + tag.substring(params.oldTag.length());
+			}
+			if (!tags.contains(tag)) {
+				tags.add(tag);
+			}
+		}
+		ctx._source.tags = tags;
+	`).
 		Lang("painless").
 		Params(map[string]interface{}{
 			"oldTag": oldTag,
 			"newTag": newTag,
 		})
 
-	query := elastic.NewBoolQuery().
-		Must(elastic.NewTermQuery("owner_id", userID)).
-		Must(elastic.NewTermQuery("tags", oldTag))
-
-	searchResult, err := repo.elastic.Search().
-		Index(repo.cfg.ElasticIndexName).
-		Query(query).
-		Do(context.Background())
-	if err != nil {
+	if err := repo.updateNotes(ctx, noteIDs, script); err != nil {
 		logger.Error(err.Error())
 		return err
 	}
 
+	logger.Info("success")
+	return nil
+}
+
+// updateNotes - применяет скрипт к заметкам, которые уже изменены в postgres
+func (repo *NoteElastic) updateNotes(ctx context.Context, noteIDs []uuid.UUID, script *elastic.Script) error {
+	if len(noteIDs) == 0 {
+		return nil
+	}
+
 	bulkRequest := repo.elastic.Bulk()
-	for _, hit := range searchResult.Hits.Hits {
+	for _, id := range noteIDs {
 		bulkRequest = bulkRequest.Add(elastic.NewBulkUpdateRequest().
 			Index(repo.cfg.ElasticIndexName).
-			Id(hit.Id).
+			Id(id.String()).
 			Script(script),
 		)
 	}
 
 	start := time.Now()
-	_, err = bulkRequest.Do(context.Background())
+	_, err := bulkRequest.Do(ctx)
 	repo.metr.ObserveResponseTime(log.GFN(), time.Since(start).Seconds())
 	if err != nil {
-		logger.Error(err.Error())
 		repo.metr.IncreaseErrors(log.GFN())
 		return err
 	}
 
-	logger.Info("success")
 	return nil
 }
 
@@ -458,6 +664,80 @@ func (repo *NoteElastic) ChangeFlag(ctx context.Context, noteID uuid.UUID, flag
 	return nil
 }
 
+func (repo *NoteElastic) ChangeFlagOnNotes(ctx context.Context, noteIDs []uuid.UUID, flag bool) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	script := elastic.NewScript("ctx._source.favorite = params.flag").
+		Lang("painless").
+		Param("flag", flag)
+
+	if err := repo.updateNotes(ctx, noteIDs, script); err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	logger.Info("success")
+	return nil
+}
+
+func (repo *NoteElastic) ArchiveNote(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	if err := repo.updateNullFieldToEmptyArray(ctx, "archived_by", noteID); err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	script := elastic.NewScript("if (!ctx._source.archived_by.contains(params.userID)) { ctx._source.archived_by.add(params.userID) }").
+		Lang("painless").
+		Param("userID", userID.String())
+
+	start := time.Now()
+	_, err := repo.elastic.Update().
+		Index(repo.cfg.ElasticIndexName).
+		Id(noteID.String()).
+		Script(script).
+		Do(ctx)
+	repo.metr.ObserveResponseTime(log.GFN(), time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors(log.GFN())
+		return err
+	}
+
+	logger.Info("success")
+	return nil
+}
+
+func (repo *NoteElastic) UnarchiveNote(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	if err := repo.updateNullFieldToEmptyArray(ctx, "archived_by", noteID); err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	script := elastic.NewScript("ctx._source.archived_by.removeIf(id -> id == params.userID)").
+		Lang("painless").
+		Param("userID", userID.String())
+
+	start := time.Now()
+	_, err := repo.elastic.Update().
+		Index(repo.cfg.ElasticIndexName).
+		Id(noteID.String()).
+		Script(script).
+		Do(ctx)
+	repo.metr.ObserveResponseTime(log.GFN(), time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors(log.GFN())
+		return err
+	}
+
+	logger.Info("success")
+	return nil
+}
+
 func (repo *NoteElastic) SetPublic(ctx context.Context, noteID uuid.UUID) error {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
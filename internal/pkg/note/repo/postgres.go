@@ -14,74 +14,593 @@ import (
 	"github.com/satori/uuid"
 
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/models"
+	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/note"
 )
 
 const (
+	// фильтр по тегу совпадает и с самим тегом, и со всеми вложенными: work -> work/clients/acme
+	tagMatches = "(nt = ft OR starts_with(nt, ft || '/'))"
+
+	// ключ сортировки (favorite, sort_position, create_time, sort_title, owner, update_time, id) задаёт полный порядок,
+	// курсор $7-$13 — ключ последней заметки предыдущей страницы
 	getAllNotes = `
-	SELECT  id, data, create_time, update_time, owner_id, parent, children, tags, collaborators, icon, header,
-	CASE WHEN f.note_id IS NULL 
-				THEN 'false'::bool
-			  ELSE 'true'::bool
-		  END as favorite,
-	    is_public
-	FROM notes n
-	LEFT JOIN favorites f 
-	ON n.id = f.note_id
-		WHERE parent = '00000000-0000-0000-0000-000000000000'::UUID
-		AND (
-			owner_id = $1
-			OR $1 = ANY(collaborators)
-		)
-		AND (
-			cardinality($4::TEXT[]) = 0 OR $4::TEXT[] IS NULL OR array(select unnest($4::TEXT[]) except select unnest(tags)) = '{}'
-		)
-		AND (f.user_id IS NULL OR f.user_id = $1)
-		
-		ORDER BY favorite DESC, update_time DESC
-		LIMIT $2 OFFSET $3;
+	SELECT id, data, create_time, update_time, owner_id, parent, children, tags, collaborators, icon, header, favorite, is_public, archived, sort_position, sort_title
+	FROM (
+		SELECT n.id, n.data, n.create_time, n.update_time, n.owner_id, n.parent, n.children, n.tags, n.collaborators, n.icon, n.header,
+		CASE WHEN f.note_id IS NULL 
+					THEN 'false'::bool
+				  ELSE 'true'::bool
+			  END as favorite,
+		    n.is_public,
+		    a.note_id IS NOT NULL AS archived,
+		    CASE WHEN $6::TEXT = 'manual' THEN COALESCE(p.position, 2147483647) ELSE 0 END AS sort_position,
+		    CASE WHEN $6::TEXT = 'title' THEN COALESCE(lower(n.data->>'title'), '') ELSE '' END AS sort_title
+		FROM notes n
+		LEFT JOIN favorites f 
+		ON n.id = f.note_id
+		LEFT JOIN archived_notes a
+		ON n.id = a.note_id AND a.user_id = $1
+		LEFT JOIN note_positions p
+		ON n.id = p.note_id AND p.user_id = $1
+			WHERE n.parent = '00000000-0000-0000-0000-000000000000'::UUID
+			AND n.deleted_at IS NULL
+			AND (
+				n.owner_id = $1
+				OR $1 = ANY(n.collaborators)
+			)
+			AND (
+				cardinality($4::TEXT[]) = 0 OR $4::TEXT[] IS NULL OR CASE $14::TEXT
+					WHEN 'any' THEN EXISTS (SELECT 1 FROM unnest(n.tags) nt, unnest($4::TEXT[]) ft WHERE ` + tagMatches + `)
+					WHEN 'none' THEN NOT EXISTS (SELECT 1 FROM unnest(n.tags) nt, unnest($4::TEXT[]) ft WHERE ` + tagMatches + `)
+					ELSE NOT EXISTS (SELECT 1 FROM unnest($4::TEXT[]) ft WHERE NOT EXISTS (SELECT 1 FROM unnest(n.tags) nt WHERE ` + tagMatches + `))
+				END
+			)
+			AND (
+				cardinality($15::TEXT[]) = 0 OR $15::TEXT[] IS NULL OR NOT EXISTS (SELECT 1 FROM unnest(n.tags) nt, unnest($15::TEXT[]) ft WHERE ` + tagMatches + `)
+			)
+			AND (f.user_id IS NULL OR f.user_id = $1)
+			AND ($5::TEXT = 'all' OR ($5::TEXT = 'archived') = (a.note_id IS NOT NULL))
+	) listed
+	WHERE $7::BOOLEAN IS NULL OR (
+		NOT favorite,
+		sort_position,
+		CASE WHEN $6::TEXT = 'created' THEN -EXTRACT(EPOCH FROM create_time) ELSE 0 END,
+		sort_title,
+		CASE WHEN $6::TEXT = 'owner' THEN owner_id <> $1 ELSE false END,
+		-EXTRACT(EPOCH FROM update_time),
+		id
+	) > (
+		NOT $7::BOOLEAN,
+		$8::INTEGER,
+		CASE WHEN $6::TEXT = 'created' THEN -EXTRACT(EPOCH FROM $9::TIMESTAMP) ELSE 0 END,
+		$10::TEXT,
+		CASE WHEN $6::TEXT = 'owner' THEN $11::UUID <> $1 ELSE false END,
+		-EXTRACT(EPOCH FROM $12::TIMESTAMP),
+		$13::UUID
+	)
+	ORDER BY favorite DESC,
+		sort_position ASC,
+		CASE WHEN $6::TEXT = 'created' THEN create_time END DESC,
+		sort_title ASC,
+		CASE WHEN $6::TEXT = 'owner' THEN owner_id <> $1 END ASC,
+		update_time DESC,
+		id ASC
+	LIMIT $2 OFFSET $3;
 	`
+	// дети упорядочены так, как их расставил пользователь, остальные — в порядке добавления
 	getNote = `
-		SELECT id, data, create_time, update_time, owner_id, parent, children, tags, collaborators, icon, header,
+		SELECT id, data, create_time, update_time, owner_id, parent,
+		ARRAY(
+			SELECT c.id FROM unnest(n.children) WITH ORDINALITY AS c(id, ord)
+			LEFT JOIN note_positions p
+			ON c.id = p.note_id AND p.user_id = $2
+			ORDER BY p.position NULLS LAST, c.ord
+		) AS children,
+		tags, collaborators, icon, header,
 		CASE WHEN f.note_id IS NULL 
 					THEN 'false'::bool
 				ELSE 'true'::bool
 			END as favorite,
-		    is_public
+		    is_public,
+		    a.note_id IS NOT NULL AS archived,
+		    version
 		FROM notes n
 		LEFT JOIN favorites f 
 		ON n.id = f.note_id
-		WHERE id = $1 AND (f.user_id = $2 OR f.user_id IS NULL);
+		LEFT JOIN archived_notes a
+		ON n.id = a.note_id AND a.user_id = $2
+		WHERE id = $1 AND deleted_at IS NULL AND (f.user_id = $2 OR f.user_id IS NULL);
 	`
-	getPublicNote = "SELECT id, data, create_time, update_time, owner_id, parent, children, tags, collaborators, icon, header, is_public FROM notes WHERE id = $1;"
+	getPublicNote = "SELECT id, data, create_time, update_time, owner_id, parent, children, tags, collaborators, icon, header, is_public FROM notes WHERE id = $1 AND deleted_at IS NULL;"
 	createNote    = "INSERT INTO notes(id, data, create_time, update_time, owner_id, parent, children, tags, collaborators, icon, header, is_public) VALUES ($1, $2::json, $3, $4, $5, $6, $7::UUID[], $8::TEXT[], $9::UUID[], $10, $11, $12);"
-	updateNote    = "UPDATE notes SET data = $1, update_time = $2 WHERE id = $3; "
-	deleteNote    = "DELETE FROM notes CASCADE WHERE id = $1;"
+	updateNote    = "UPDATE notes SET data = $1, update_time = $2, last_editor = $3, version = version + 1 WHERE id = $4 AND version = $5;"
+
+	subtree = `
+		WITH RECURSIVE subtree AS (
+			SELECT id, children FROM notes WHERE id = $1
+			UNION ALL
+			SELECT n.id, n.children FROM notes n JOIN subtree s ON n.id = ANY(s.children)
+		)
+	`
+	deleteNote = subtree + `
+		, deleted_messages AS (
+			DELETE FROM messages WHERE note_id IN (SELECT id FROM subtree)
+		)
+		DELETE FROM notes WHERE id IN (SELECT id FROM subtree);
+	`
+	trashNote = subtree + `
+		, trashed AS (
+			INSERT INTO trash(note_id, user_id, deleted_at) VALUES ($1, $2, $3)
+		)
+		UPDATE notes SET deleted_at = $3 WHERE id IN (SELECT id FROM subtree) RETURNING id;
+	`
+	// пока заметка в корзине, изменения соавторов родителя до нее не доходят, поэтому соавторы пересчитываются как в moveNote:
+	// берутся у родителя, к которому заметка возвращается, бывший корень сохраняет своих, отцепленная подзаметка остается без соавторов
+	restoreNote = subtree + `
+		, restored AS (
+			DELETE FROM trash WHERE note_id = $1
+		), added AS (
+			UPDATE notes SET children = array_append(children, $1) WHERE id = $2
+		), original AS (
+			SELECT parent = '00000000-0000-0000-0000-000000000000'::UUID AS was_root, collaborators, owner_id FROM notes WHERE id = $1
+		), inherited AS (
+			SELECT CASE WHEN o.was_root THEN o.collaborators
+			ELSE COALESCE((SELECT collaborators FROM notes WHERE id = $2), '{}'::UUID[]) END AS collaborators
+			FROM original o
+		), deleted_role AS (
+			DELETE FROM collaborators
+			WHERE note_id IN (SELECT id FROM subtree) AND NOT (note_id = $1 AND (SELECT was_root FROM original))
+		), deleted_invite AS (
+			DELETE FROM invites
+			WHERE note_id IN (SELECT id FROM subtree) AND NOT (note_id = $1 AND (SELECT was_root FROM original))
+		), deleted_fav AS (
+			DELETE FROM favorites
+			WHERE note_id IN (SELECT id FROM subtree)
+			AND user_id <> ALL((SELECT collaborators FROM inherited))
+			AND user_id <> (SELECT owner_id FROM original)
+		), deleted_archive AS (
+			DELETE FROM archived_notes
+			WHERE note_id IN (SELECT id FROM subtree)
+			AND user_id <> ALL((SELECT collaborators FROM inherited))
+			AND user_id <> (SELECT owner_id FROM original)
+		), deleted_reminder AS (
+			DELETE FROM reminders
+			WHERE note_id IN (SELECT id FROM subtree)
+			AND user_id <> ALL((SELECT collaborators FROM inherited))
+			AND user_id <> (SELECT owner_id FROM original)
+		)
+		UPDATE notes SET deleted_at = NULL, parent = CASE WHEN id = $1 THEN $2::UUID ELSE parent END,
+		collaborators = (SELECT collaborators FROM inherited)
+		WHERE id IN (SELECT id FROM subtree) RETURNING id;
+	`
+	getSubtreeAttaches = subtree + "SELECT path FROM attaches WHERE note_id IN (SELECT id FROM subtree);"
+	getAttaches        = "SELECT id, path, note_id FROM attaches WHERE note_id = $1;"
+	addAttach          = "INSERT INTO attaches(id, path, note_id) VALUES ($1, $2, $3);"
+	getTrash           = `
+		SELECT n.id, n.data, n.create_time, n.update_time, n.owner_id, n.parent, n.children, n.tags, n.collaborators, n.icon, n.header, n.is_public, t.deleted_at
+		FROM trash t
+		JOIN notes n
+		ON t.note_id = n.id
+		WHERE t.user_id = $1
+		ORDER BY t.deleted_at DESC
+		LIMIT $2 OFFSET $3;
+	`
+	getTrashedNote = `
+		SELECT n.id, n.data, n.create_time, n.update_time, n.owner_id, n.parent, n.children, n.tags, n.collaborators, n.icon, n.header, n.is_public, t.deleted_at
+		FROM trash t
+		JOIN notes n
+		ON t.note_id = n.id
+		WHERE t.note_id = $1;
+	`
+	getExpiredTrash = "SELECT note_id FROM trash WHERE deleted_at < $1;"
 
 	addSubNote    = "UPDATE notes SET children = array_append(children, $1) WHERE id = $2;"
 	removeSubNote = "UPDATE notes SET children = array_remove(children, $1) WHERE id = $2;"
+	// подзаметка получает владельца и соавторов родителя тем же запросом, которым добавляется в его children
+	createSubNote = `
+		WITH added AS (
+			UPDATE notes SET children = array_append(children, $1) WHERE id = $5 AND deleted_at IS NULL
+			RETURNING owner_id, collaborators
+		)
+		INSERT INTO notes(id, data, create_time, update_time, owner_id, parent, children, tags, collaborators, icon, header, is_public)
+		SELECT $1, $2::json, $3, $4, owner_id, $5, '{}'::UUID[], '{}'::TEXT[], collaborators, $6, $7, false FROM added
+		RETURNING owner_id, collaborators;
+	`
+	// перенесенное поддерево получает соавторов нового родителя (в корне - никого), собственные роли и приглашения
+	// поддерева удаляются, т.к. роль хранится только у корня. Избранное, архив и напоминания теряют те, у кого пропал доступ
+	moveNote = subtree + `
+		, removed AS (
+			UPDATE notes SET children = array_remove(children, $1) WHERE id = $2
+		), added AS (
+			UPDATE notes SET children = array_append(children, $1) WHERE id = $3
+		), inherited AS (
+			SELECT COALESCE((SELECT collaborators FROM notes WHERE id = $3), '{}'::UUID[]) AS collaborators
+		), deleted_role AS (
+			DELETE FROM collaborators WHERE note_id IN (SELECT id FROM subtree)
+		), deleted_invite AS (
+			DELETE FROM invites WHERE note_id IN (SELECT id FROM subtree)
+		), deleted_fav AS (
+			DELETE FROM favorites
+			WHERE note_id IN (SELECT id FROM subtree)
+			AND user_id <> ALL((SELECT collaborators FROM inherited))
+			AND user_id <> (SELECT owner_id FROM notes WHERE id = $1)
+		), deleted_archive AS (
+			DELETE FROM archived_notes
+			WHERE note_id IN (SELECT id FROM subtree)
+			AND user_id <> ALL((SELECT collaborators FROM inherited))
+			AND user_id <> (SELECT owner_id FROM notes WHERE id = $1)
+		), deleted_reminder AS (
+			DELETE FROM reminders
+			WHERE note_id IN (SELECT id FROM subtree)
+			AND user_id <> ALL((SELECT collaborators FROM inherited))
+			AND user_id <> (SELECT owner_id FROM notes WHERE id = $1)
+		)
+		UPDATE notes SET parent = CASE WHEN id = $1 THEN $3 ELSE parent END, collaborators = (SELECT collaborators FROM inherited)
+		WHERE id IN (SELECT id FROM subtree) RETURNING id;
+	`
+
+	setTemplate  = "UPDATE notes SET is_template = $1 WHERE id = $2;"
+	getTemplates = `
+		SELECT id, data, system FROM (
+			SELECT id, data, true AS system, NULL::TIMESTAMP AS update_time FROM system_templates
+			UNION ALL
+			SELECT id, data, false, update_time FROM notes WHERE owner_id = $1 AND is_template AND deleted_at IS NULL
+		) templates
+		ORDER BY system DESC, update_time DESC;
+	`
+	getTemplate = `
+		SELECT id, data, true FROM system_templates WHERE id = $1
+		UNION ALL
+		SELECT id, data, false FROM notes WHERE id = $1 AND is_template AND deleted_at IS NULL;
+	`
 
 	getUpdates = "SELECT note_id, created, message_info FROM messages WHERE note_id = $1 AND created > $2;"
 
-	addCollaborator = "UPDATE notes SET collaborators = array_append(collaborators, $1) WHERE id = $2 returning notes.data->'title';"
+	getRevisions = `
+		SELECT m.id, m.note_id, m.created, COALESCE(m.author_id, '00000000-0000-0000-0000-000000000000'::UUID), COALESCE(u.username, '')
+		FROM messages m
+		LEFT JOIN users u
+		ON m.author_id = u.id
+		WHERE m.note_id = $1
+		ORDER BY m.created DESC
+		LIMIT $2 OFFSET $3;
+	`
+	getRevision = `
+		SELECT m.id, m.note_id, m.created, COALESCE(m.author_id, '00000000-0000-0000-0000-000000000000'::UUID), COALESCE(u.username, ''), m.message_info
+		FROM messages m
+		LEFT JOIN users u
+		ON m.author_id = u.id
+		WHERE m.id = $1;
+	`
+
+	addCollaborator    = "UPDATE notes SET collaborators = array_append(collaborators, $1) WHERE id = $2 returning notes.data->'title';"
+	removeCollaborator = `
+		WITH deleted_role AS (
+			DELETE FROM collaborators WHERE note_id = $2 AND user_id = $1
+		), deleted_fav AS (
+			DELETE FROM favorites WHERE note_id = $2 AND user_id = $1
+		), deleted_archive AS (
+			DELETE FROM archived_notes WHERE note_id = $2 AND user_id = $1
+		), deleted_reminder AS (
+			DELETE FROM reminders WHERE note_id = $2 AND user_id = $1
+		)
+		UPDATE notes SET collaborators = array_remove(collaborators, $1) WHERE id = $2 returning notes.data->'title';
+	`
+	setCollaboratorRole = "INSERT INTO collaborators(note_id, user_id, role) VALUES ($1, $2, $3) ON CONFLICT (note_id, user_id) DO UPDATE SET role = EXCLUDED.role;"
+	getCollaboratorRole = `
+		WITH RECURSIVE ancestors AS (
+			SELECT id, parent, 0 AS level FROM notes WHERE id = $1
+			UNION ALL
+			SELECT n.id, n.parent, a.level + 1 FROM notes n JOIN ancestors a ON n.id = a.parent
+		)
+		SELECT c.role
+		FROM collaborators c
+		JOIN ancestors a
+		ON c.note_id = a.id
+		WHERE c.user_id = $2
+		ORDER BY a.level
+		LIMIT 1;
+	`
+
+	createInvite = `
+		WITH invited AS (
+			INSERT INTO invites(id, note_id, inviter_id, invitee_id, role, created, expires_at) VALUES ($1, $2, $3, $4, $5, $6, $7)
+			ON CONFLICT (note_id, invitee_id) DO UPDATE
+			SET id = EXCLUDED.id, inviter_id = EXCLUDED.inviter_id, role = EXCLUDED.role, created = EXCLUDED.created, expires_at = EXCLUDED.expires_at
+		)
+		SELECT data->'title' FROM notes WHERE id = $2;
+	`
+	selectInvites = `
+		SELECT i.id, i.note_id, COALESCE(n.data->>'title', ''), i.inviter_id, inviter.username, i.invitee_id, invitee.username, i.role, i.created, i.expires_at
+		FROM invites i
+		JOIN notes n
+		ON i.note_id = n.id
+		JOIN users inviter
+		ON i.inviter_id = inviter.id
+		JOIN users invitee
+		ON i.invitee_id = invitee.id
+	`
+	getInvite          = selectInvites + "WHERE i.id = $1;"
+	getSentInvites     = selectInvites + "WHERE i.inviter_id = $1 AND i.expires_at > $2 AND n.deleted_at IS NULL ORDER BY i.created DESC;"
+	getReceivedInvites = selectInvites + "WHERE i.invitee_id = $1 AND i.expires_at > $2 AND n.deleted_at IS NULL ORDER BY i.created DESC;"
+	deleteInvite       = "DELETE FROM invites WHERE id = $1;"
+
+	proposeTransfer = `
+		WITH proposed AS (
+			INSERT INTO ownership_transfers(note_id, from_id, to_id, created) VALUES ($1, $2, $3, $4)
+			ON CONFLICT (note_id) DO UPDATE SET from_id = EXCLUDED.from_id, to_id = EXCLUDED.to_id, created = EXCLUDED.created
+		)
+		SELECT data->'title' FROM notes WHERE id = $1;
+	`
+	getTransfer       = "SELECT note_id, from_id, to_id, created FROM ownership_transfers WHERE note_id = $1;"
+	transferOwnership = subtree + `
+		, remembered_tags AS (
+			INSERT INTO all_tags(tag_name, user_id)
+			SELECT DISTINCT unnest(tags), $3::UUID FROM notes WHERE id IN (SELECT id FROM subtree)
+			ON CONFLICT (tag_name, user_id) DO NOTHING
+		), deleted_role AS (
+			DELETE FROM collaborators WHERE note_id IN (SELECT id FROM subtree) AND user_id = $3
+		), added_role AS (
+			INSERT INTO collaborators(note_id, user_id, role) VALUES ($1, $2, 'editor')
+			ON CONFLICT (note_id, user_id) DO UPDATE SET role = EXCLUDED.role
+		), deleted_transfer AS (
+			DELETE FROM ownership_transfers WHERE note_id = $1
+		)
+		UPDATE notes SET owner_id = $3, collaborators = array_append(array_remove(collaborators, $3), $2)
+		WHERE id IN (SELECT id FROM subtree) RETURNING id;
+	`
+
+	addTag    = "UPDATE notes SET tags = array_append(tags, $1), version = version + 1 WHERE id = $2 AND version = $3;"
+	deleteTag = "UPDATE notes SET tags = array_remove(tags, $1), version = version + 1 WHERE id = $2 AND version = $3;"
+
+	// пакетные операции применяются к списку заметок одним запросом, т.е. атомарно
+	addTagToNotes      = "UPDATE notes SET tags = array_append(tags, $1), version = version + 1 WHERE id = ANY($2::UUID[]) AND NOT COALESCE($1 = ANY(tags), false);"
+	deleteTagFromNotes = "UPDATE notes SET tags = array_remove(tags, $1), version = version + 1 WHERE id = ANY($2::UUID[]) AND $1 = ANY(tags);"
+
+	rememberTag = "INSERT INTO all_tags(tag_name, user_id) VALUES ($1, $2) ON CONFLICT (tag_name, user_id) DO NOTHING;"
+	forgetTag   = "DELETE FROM all_tags WHERE tag_name = $1 AND user_id = $2;"
 
-	addTag    = "UPDATE notes SET tags = array_append(tags, $1) WHERE id = $2;"
-	deleteTag = "UPDATE notes SET tags = array_remove(tags, $1) WHERE id = $2;"
+	// переименование в существующий тег сливает их: метаданные остаются у существующего
+	updateTag = `
+		WITH moved AS (
+			DELETE FROM all_tags WHERE user_id = $2 AND (tag_name = $3 OR starts_with(tag_name, $3 || '/'))
+			RETURNING tag_name, color, emoji, description
+		)
+		INSERT INTO all_tags(tag_name, user_id, color, emoji, description)
+		SELECT $1 || substr(tag_name, char_length($3) + 1), $2, color, emoji, description FROM moved
+		ON CONFLICT (tag_name, user_id) DO NOTHING;
+	`
+	// теги меняются на всех заметках, которые пользователь может редактировать, включая чужие, повторы убираются.
+	// Роль подзаметки, как и в getCollaboratorRole, берется у ближайшего предка с записью в collaborators
+	updateTagInAllNotes = `
+		WITH RECURSIVE editable AS (
+			SELECT note_id AS id, role FROM collaborators WHERE user_id = $3
+			UNION ALL
+			SELECT n.id, e.role FROM notes n JOIN editable e ON n.parent = e.id
+			WHERE NOT EXISTS (SELECT 1 FROM collaborators c WHERE c.note_id = n.id AND c.user_id = $3)
+		)
+		UPDATE notes n SET tags = ARRAY(
+			SELECT tag FROM (
+				SELECT DISTINCT ON (tag) tag, ord FROM (
+					SELECT CASE WHEN t = $1 OR starts_with(t, $1 || '/') THEN $2 || substr(t, char_length($1) + 1) ELSE t END AS tag, ord
+					FROM unnest(n.tags) WITH ORDINALITY AS u(t, ord)
+				) renamed
+				ORDER BY tag, ord
+			) deduplicated
+			ORDER BY ord
+		), version = n.version + 1
+		WHERE (n.owner_id = $3 OR n.id IN (SELECT id FROM editable WHERE role = 'editor'))
+		AND EXISTS (SELECT 1 FROM unnest(n.tags) t WHERE t = $1 OR starts_with(t, $1 || '/'))
+		RETURNING n.id;
+	`
+	// слияние тегов одним запросом, чтобы при ошибке не осталось частично слитых тегов.
+	// Тег переименовывается по первому подходящему тегу из списка, метаданные остаются у существующего тега
+	mergeTags = `
+		WITH RECURSIVE editable AS (
+			SELECT note_id AS id, role FROM collaborators WHERE user_id = $3
+			UNION ALL
+			SELECT n.id, e.role FROM notes n JOIN editable e ON n.parent = e.id
+			WHERE NOT EXISTS (SELECT 1 FROM collaborators c WHERE c.note_id = n.id AND c.user_id = $3)
+		), moved AS (
+			DELETE FROM all_tags a WHERE a.user_id = $3
+			AND EXISTS (SELECT 1 FROM unnest($1::TEXT[]) s WHERE a.tag_name = s OR starts_with(a.tag_name, s || '/'))
+			RETURNING a.tag_name, a.color, a.emoji, a.description
+		), remembered AS (
+			INSERT INTO all_tags(tag_name, user_id, color, emoji, description)
+			SELECT (
+				SELECT $2 || substr(m.tag_name, char_length(s) + 1) FROM unnest($1::TEXT[]) WITH ORDINALITY AS src(s, pos)
+				WHERE m.tag_name = s OR starts_with(m.tag_name, s || '/') ORDER BY pos LIMIT 1
+			), $3, m.color, m.emoji, m.description FROM moved m
+			ON CONFLICT (tag_name, user_id) DO NOTHING
+		)
+		UPDATE notes n SET tags = ARRAY(
+			SELECT tag FROM (
+				SELECT DISTINCT ON (tag) tag, ord FROM (
+					SELECT COALESCE((
+						SELECT $2 || substr(t, char_length(s) + 1) FROM unnest($1::TEXT[]) WITH ORDINALITY AS src(s, pos)
+						WHERE t = s OR starts_with(t, s || '/') ORDER BY pos LIMIT 1
+					), t) AS tag, ord
+					FROM unnest(n.tags) WITH ORDINALITY AS u(t, ord)
+				) renamed
+				ORDER BY tag, ord
+			) deduplicated
+			ORDER BY ord
+		), version = n.version + 1
+		WHERE (n.owner_id = $3 OR n.id IN (SELECT id FROM editable WHERE role = 'editor'))
+		AND EXISTS (SELECT 1 FROM unnest(n.tags) t, unnest($1::TEXT[]) s WHERE t = s OR starts_with(t, s || '/'))
+		RETURNING n.id;
+	`
+	deleteTagFromAllNotes = `
+		WITH RECURSIVE editable AS (
+			SELECT note_id AS id, role FROM collaborators WHERE user_id = $2
+			UNION ALL
+			SELECT n.id, e.role FROM notes n JOIN editable e ON n.parent = e.id
+			WHERE NOT EXISTS (SELECT 1 FROM collaborators c WHERE c.note_id = n.id AND c.user_id = $2)
+		)
+		UPDATE notes n SET tags = array_remove(n.tags, $1), version = n.version + 1
+		WHERE $1 = ANY(n.tags) AND (n.owner_id = $2 OR n.id IN (SELECT id FROM editable WHERE role = 'editor'))
+		RETURNING n.id;
+	`
 
-	getTags               = `SELECT tag_name FROM all_tags WHERE user_id = $1 ORDER BY tag_name;`
-	rememberTag           = "INSERT INTO all_tags(tag_name, user_id) VALUES ($1, $2) ON CONFLICT (tag_name, user_id) DO NOTHING;"
-	forgetTag             = "DELETE FROM all_tags WHERE tag_name = $1 AND user_id = $2;"
-	updateTag             = "UPDATE all_tags SET tag_name = $1 WHERE user_id = $2 AND tag_name = $3;"
-	updateTagInAllNotes   = "UPDATE notes SET tags = array_replace(tags, $1, $2) WHERE owner_id = $3;"
-	deleteTagFromAllNotes = "UPDATE notes SET tags = array_remove(tags, $1) WHERE owner_id = $2;"
+	getTags = `
+		WITH used AS (
+			SELECT t AS tag_name, count(DISTINCT n.id) AS notes_count
+			FROM notes n, unnest(n.tags) t
+			WHERE n.deleted_at IS NULL AND (n.owner_id = $1 OR $1 = ANY(n.collaborators))
+			GROUP BY t
+		)
+		SELECT a.tag_name, a.color, a.emoji, a.description, COALESCE(u.notes_count, 0)
+		FROM all_tags a
+		LEFT JOIN used u ON u.tag_name = a.tag_name
+		WHERE a.user_id = $1
+		ORDER BY a.tag_name;
+	`
+	// теги, которые есть на доступных пользователю заметках, но отсутствуют в all_tags
+	// теги заметок попадают в списки всех, кто их видит: владельца и соавторов
+	reconcileTags = `
+		INSERT INTO all_tags(tag_name, user_id)
+		SELECT DISTINCT t.tag_name, u.user_id
+		FROM notes n, unnest(n.tags) AS t(tag_name), unnest(array_append(n.collaborators, n.owner_id)) AS u(user_id)
+		WHERE n.id = ANY($1::UUID[]) AND n.deleted_at IS NULL
+		ON CONFLICT (tag_name, user_id) DO NOTHING;
+	`
+	setTagInfo = `
+		INSERT INTO all_tags(tag_name, user_id, color, emoji, description) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (tag_name, user_id) DO UPDATE SET color = EXCLUDED.color, emoji = EXCLUDED.emoji, description = EXCLUDED.description;
+	`
 
-	setIcon   = "UPDATE notes SET icon = $1 WHERE id = $2;"
-	setHeader = "UPDATE notes SET header = $1 WHERE id = $2;"
+	setIcon   = "UPDATE notes SET icon = $1, version = version + 1 WHERE id = $2 AND version = $3;"
+	setHeader = "UPDATE notes SET header = $1, version = version + 1 WHERE id = $2 AND version = $3;"
 
 	addFav = "INSERT INTO favorites (note_id, user_id) VALUES ($1, $2);"
 	delFav = "DELETE FROM favorites WHERE note_id = $1 AND user_id=$2;"
 
+	addFavs = "INSERT INTO favorites (note_id, user_id) SELECT unnest($1::UUID[]), $2 ON CONFLICT (note_id, user_id) DO NOTHING;"
+	delFavs = "DELETE FROM favorites WHERE note_id = ANY($1::UUID[]) AND user_id = $2;"
+
+	setNotePositions = `
+		INSERT INTO note_positions(note_id, user_id, position)
+		SELECT c.id, $1, c.ord FROM unnest($2::UUID[]) WITH ORDINALITY AS c(id, ord)
+		ON CONFLICT (note_id, user_id) DO UPDATE SET position = EXCLUDED.position;
+	`
+	getNotePositions = "SELECT note_id, position FROM note_positions WHERE user_id = $1;"
+
+	// ссылки [[Заголовок]] разрешаются только среди заметок, доступных автору правки, чтобы не раскрыть чужие заголовки
+	setNoteLinks = `
+		WITH targets AS (
+			SELECT n.id FROM notes n WHERE n.id = ANY($2::UUID[]) AND n.id <> $1
+			UNION
+			SELECT n.id FROM notes n
+			WHERE lower(n.data->>'title') = ANY($3::TEXT[]) AND n.id <> $1 AND n.deleted_at IS NULL
+			AND (n.owner_id = $4 OR $4 = ANY(n.collaborators))
+		), removed AS (
+			DELETE FROM note_links WHERE source_id = $1 AND target_id NOT IN (SELECT id FROM targets)
+		)
+		INSERT INTO note_links(source_id, target_id) SELECT $1, id FROM targets
+		ON CONFLICT (source_id, target_id) DO NOTHING;
+	`
+
+	// в обратных ссылках и графе видны только заметки, доступные смотрящему
+	getBacklinks = `
+		SELECT n.id, COALESCE(n.data->>'title', ''), COALESCE(n.icon, '') FROM note_links l
+		JOIN notes n ON n.id = l.source_id
+		WHERE l.target_id = $1 AND n.deleted_at IS NULL AND (n.owner_id = $2 OR $2 = ANY(n.collaborators))
+		ORDER BY n.update_time DESC;
+	`
+
+	getGraphNodes = `
+		SELECT n.id, COALESCE(n.data->>'title', ''), COALESCE(n.icon, '') FROM notes n
+		WHERE n.deleted_at IS NULL AND (n.owner_id = $1 OR $1 = ANY(n.collaborators))
+		ORDER BY n.create_time;
+	`
+
+	getGraphEdges = `
+		WITH visible AS (
+			SELECT n.id FROM notes n WHERE n.deleted_at IS NULL AND (n.owner_id = $1 OR $1 = ANY(n.collaborators))
+		)
+		SELECT l.source_id, l.target_id FROM note_links l
+		JOIN visible s ON s.id = l.source_id
+		JOIN visible t ON t.id = l.target_id;
+	`
+
+	archiveNote   = "INSERT INTO archived_notes(note_id, user_id, archived_at) VALUES ($1, $2, $3) ON CONFLICT (note_id, user_id) DO NOTHING;"
+	unarchiveNote = "DELETE FROM archived_notes WHERE note_id = $1 AND user_id = $2;"
+
 	setPublic  = "UPDATE notes SET is_public = true WHERE id = $1;"
-	setPrivate = "UPDATE notes SET is_public = false WHERE id = $1;"
+	setPrivate = `
+		WITH revoked AS (
+			DELETE FROM share_links WHERE note_id = $1
+		)
+		UPDATE notes SET is_public = false WHERE id = $1;
+	`
+
+	createShareLink = "INSERT INTO share_links(id, token, note_id, creator_id, password_hash, include_subtree, created, expires_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8);"
+	getShareLinks   = "SELECT id, token, note_id, creator_id, password_hash, include_subtree, created, expires_at FROM share_links WHERE note_id = $1 ORDER BY created DESC;"
+	// ссылка подходит, если ведёт на саму заметку или на её предка с include_subtree
+	getShareLink = `
+		WITH RECURSIVE link AS (
+			SELECT id, token, note_id, creator_id, password_hash, include_subtree, created, expires_at FROM share_links WHERE token = $1
+		), subtree AS (
+			SELECT n.id, n.children FROM notes n JOIN link l ON n.id = l.note_id WHERE l.include_subtree
+			UNION ALL
+			SELECT n.id, n.children FROM notes n JOIN subtree s ON n.id = ANY(s.children)
+		)
+		SELECT id, token, note_id, creator_id, password_hash, include_subtree, created, expires_at FROM link
+		WHERE note_id = $2 OR $2 IN (SELECT id FROM subtree);
+	`
+	deleteShareLink = "DELETE FROM share_links WHERE id = $1 AND note_id = $2 RETURNING id;"
+
+	selectComments = "SELECT c.id, c.note_id, c.parent, c.author_id, u.username, c.block_id, c.text, c.created, c.updated, c.resolved, c.deleted FROM comments c JOIN users u ON u.id = c.author_id"
+	getComments    = selectComments + " WHERE c.note_id = $1 ORDER BY c.created;"
+	getComment     = selectComments + " WHERE c.id = $1;"
+	createComment  = `
+		WITH inserted AS (
+			INSERT INTO comments(id, note_id, parent, author_id, block_id, text, created, updated, resolved) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		)
+		SELECT username FROM users WHERE id = $4;
+	`
+	updateComment = "UPDATE comments SET text = $1, updated = $2 WHERE id = $3;"
+	// комментарий с ответами остается пустой заглушкой, чтобы не удалять чужие ответы.
+	// Заглушка удаляется вместе с последним ответом
+	deleteComment = `
+		WITH tombstoned AS (
+			UPDATE comments SET text = '', deleted = true
+			WHERE id = $1 AND EXISTS (SELECT 1 FROM comments WHERE parent = $1)
+		), orphaned AS (
+			DELETE FROM comments p
+			WHERE p.deleted AND p.id = (SELECT parent FROM comments WHERE id = $1)
+			AND NOT EXISTS (SELECT 1 FROM comments r WHERE r.parent = p.id AND r.id <> $1)
+		)
+		DELETE FROM comments WHERE id = $1 AND NOT EXISTS (SELECT 1 FROM comments WHERE parent = $1);
+	`
+	setCommentResolved = "UPDATE comments SET resolved = $1 WHERE id = $2;"
+
+	createReminder = `
+		WITH inserted AS (
+			INSERT INTO reminders(id, note_id, user_id, remind_at, repeat, fired, created) VALUES ($1, $2, $3, $4, $5, $6, $7)
+		)
+		SELECT COALESCE(data->>'title', '') FROM notes WHERE id = $2;
+	`
+	selectReminders = `
+		SELECT r.id, r.note_id, COALESCE(n.data->>'title', ''), r.user_id, r.remind_at, r.repeat, r.fired, r.created
+		FROM reminders r
+		JOIN notes n
+		ON r.note_id = n.id
+	`
+	getReminder  = selectReminders + "WHERE r.id = $1;"
+	getReminders = selectReminders + "WHERE r.note_id = $1 AND r.user_id = $2 ORDER BY r.remind_at;"
+	// напоминания приходят, только пока у пользователя есть доступ к заметке
+	reminderAccess       = "(n.owner_id = r.user_id OR r.user_id = ANY(n.collaborators))"
+	getUpcomingReminders = selectReminders + "WHERE r.user_id = $1 AND n.deleted_at IS NULL AND " + reminderAccess + " ORDER BY r.remind_at LIMIT $2 OFFSET $3;"
+	getPendingReminders  = selectReminders + "WHERE r.user_id = $1 AND r.fired AND n.deleted_at IS NULL AND " + reminderAccess + " ORDER BY r.remind_at;"
+	fireReminders        = `
+		UPDATE reminders r SET fired = true
+		FROM notes n
+		WHERE r.note_id = n.id AND n.deleted_at IS NULL AND NOT r.fired AND r.remind_at <= $1 AND ` + reminderAccess + `
+		RETURNING r.id, r.note_id, COALESCE(n.data->>'title', ''), r.user_id, r.remind_at, r.repeat, r.fired, r.created;
+	`
+	rescheduleReminder = "UPDATE reminders SET remind_at = $1, fired = false WHERE id = $2;"
+	deleteReminder     = "DELETE FROM reminders WHERE id = $1;"
 
 	getAttachList = "SELECT path FROM attaches WHERE note_id = $1;"
 
@@ -100,10 +619,10 @@ func CreateNotePostgres(db pgxtype.Querier, metr metrics.DBMetrics) *NotePostgre
 	}
 }
 
-func (repo *NotePostgres) GetTags(ctx context.Context, userID uuid.UUID) ([]string, error) {
+func (repo *NotePostgres) GetTags(ctx context.Context, userID uuid.UUID) ([]models.Tag, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	result := make([]string, 0)
+	result := make([]models.Tag, 0)
 
 	start := time.Now()
 	query, err := repo.db.Query(ctx, getTags, userID)
@@ -115,8 +634,8 @@ func (repo *NotePostgres) GetTags(ctx context.Context, userID uuid.UUID) ([]stri
 	}
 
 	for query.Next() {
-		var tag string
-		if err := query.Scan(&tag); err != nil {
+		var tag models.Tag
+		if err := query.Scan(&tag.Name, &tag.Color, &tag.Emoji, &tag.Description, &tag.NotesCount); err != nil {
 			logger.Error(err.Error())
 			return result, fmt.Errorf("error occured while scanning tags: %w", err)
 		}
@@ -126,6 +645,38 @@ func (repo *NotePostgres) GetTags(ctx context.Context, userID uuid.UUID) ([]stri
 	return result, nil
 }
 
+func (repo *NotePostgres) ReconcileTags(ctx context.Context, noteIDs []uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	start := time.Now()
+	_, err := repo.db.Exec(ctx, reconcileTags, noteIDs)
+	repo.metr.ObserveResponseTime("reconcileTags", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("reconcileTags")
+		return err
+	}
+
+	logger.Info("success")
+	return nil
+}
+
+func (repo *NotePostgres) SetTagInfo(ctx context.Context, tag models.Tag, userID uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	start := time.Now()
+	_, err := repo.db.Exec(ctx, setTagInfo, tag.Name, userID, tag.Color, tag.Emoji, tag.Description)
+	repo.metr.ObserveResponseTime("setTagInfo", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("setTagInfo")
+		return err
+	}
+
+	logger.Info("success")
+	return nil
+}
+
 func (repo *NotePostgres) AddCollaborator(ctx context.Context, noteID uuid.UUID, guestID uuid.UUID) (string, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
@@ -143,160 +694,1336 @@ func (repo *NotePostgres) AddCollaborator(ctx context.Context, noteID uuid.UUID,
 	return title, nil
 }
 
-func (repo *NotePostgres) GetUpdates(ctx context.Context, noteID uuid.UUID, offset time.Time) ([]models.Message, error) {
+func (repo *NotePostgres) RemoveCollaborator(ctx context.Context, noteID uuid.UUID, guestID uuid.UUID) (string, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	result := make([]models.Message, 0)
+	var title string
 
 	start := time.Now()
-	query, err := repo.db.Query(ctx, getUpdates, noteID, offset)
-	repo.metr.ObserveResponseTime("getUpdates", time.Since(start).Seconds())
+	err := repo.db.QueryRow(ctx, removeCollaborator, guestID, noteID).Scan(&title)
+	repo.metr.ObserveResponseTime("removeCollaborator", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("removeCollaborator")
+		return "", err
+	}
+
+	return title, nil
+}
+
+func (repo *NotePostgres) GetCollaboratorRole(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) (string, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	var role string
+
+	start := time.Now()
+	err := repo.db.QueryRow(ctx, getCollaboratorRole, noteID, userID).Scan(&role)
+	repo.metr.ObserveResponseTime("getCollaboratorRole", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("getCollaboratorRole")
+		return "", err
+	}
+
+	return role, nil
+}
+
+func (repo *NotePostgres) SetCollaboratorRole(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, role string) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	start := time.Now()
+	_, err := repo.db.Exec(ctx, setCollaboratorRole, noteID, userID, role)
+	repo.metr.ObserveResponseTime("setCollaboratorRole", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("setCollaboratorRole")
+		return err
+	}
+
+	logger.Info("success")
+	return nil
+}
+
+func (repo *NotePostgres) CreateInvite(ctx context.Context, invite models.Invite) (string, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	var title string
+
+	start := time.Now()
+	err := repo.db.QueryRow(ctx, createInvite,
+		invite.Id,
+		invite.NoteId,
+		invite.InviterId,
+		invite.InviteeId,
+		invite.Role,
+		invite.Created,
+		invite.ExpiresAt,
+	).Scan(&title)
+	repo.metr.ObserveResponseTime("createInvite", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("createInvite")
+		return "", err
+	}
+
+	return title, nil
+}
+
+func (repo *NotePostgres) GetInvite(ctx context.Context, inviteID uuid.UUID) (models.Invite, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	invite := models.Invite{}
+
+	start := time.Now()
+	err := repo.db.QueryRow(ctx, getInvite, inviteID).Scan(
+		&invite.Id,
+		&invite.NoteId,
+		&invite.NoteTitle,
+		&invite.InviterId,
+		&invite.Inviter,
+		&invite.InviteeId,
+		&invite.Invitee,
+		&invite.Role,
+		&invite.Created,
+		&invite.ExpiresAt,
+	)
+	repo.metr.ObserveResponseTime("getInvite", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("getInvite")
+		return models.Invite{}, err
+	}
+
+	return invite, nil
+}
+
+func (repo *NotePostgres) queryInvites(ctx context.Context, name string, sql string, args ...interface{}) ([]models.Invite, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	result := make([]models.Invite, 0)
+
+	start := time.Now()
+	query, err := repo.db.Query(ctx, sql, args...)
+	repo.metr.ObserveResponseTime(name, time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors(name)
+		return result, err
+	}
+
+	for query.Next() {
+		var invite models.Invite
+		if err := query.Scan(
+			&invite.Id,
+			&invite.NoteId,
+			&invite.NoteTitle,
+			&invite.InviterId,
+			&invite.Inviter,
+			&invite.InviteeId,
+			&invite.Invitee,
+			&invite.Role,
+			&invite.Created,
+			&invite.ExpiresAt,
+		); err != nil {
+			logger.Error(err.Error())
+			return result, fmt.Errorf("error occured while scanning invites: %w", err)
+		}
+		result = append(result, invite)
+	}
+
+	return result, nil
+}
+
+func (repo *NotePostgres) GetSentInvites(ctx context.Context, userID uuid.UUID, now time.Time) ([]models.Invite, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	result, err := repo.queryInvites(ctx, "getSentInvites", getSentInvites, userID, now)
+	if err != nil {
+		logger.Error(err.Error())
+		return result, err
+	}
+
+	logger.Info("success")
+	return result, nil
+}
+
+func (repo *NotePostgres) GetReceivedInvites(ctx context.Context, userID uuid.UUID, now time.Time) ([]models.Invite, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	result, err := repo.queryInvites(ctx, "getReceivedInvites", getReceivedInvites, userID, now)
+	if err != nil {
+		logger.Error(err.Error())
+		return result, err
+	}
+
+	logger.Info("success")
+	return result, nil
+}
+
+func (repo *NotePostgres) DeleteInvite(ctx context.Context, inviteID uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	start := time.Now()
+	_, err := repo.db.Exec(ctx, deleteInvite, inviteID)
+	repo.metr.ObserveResponseTime("deleteInvite", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("deleteInvite")
+		return err
+	}
+
+	logger.Info("success")
+	return nil
+}
+
+func (repo *NotePostgres) ProposeTransfer(ctx context.Context, transfer models.OwnershipTransfer) (string, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	var title string
+
+	start := time.Now()
+	err := repo.db.QueryRow(ctx, proposeTransfer, transfer.NoteId, transfer.FromId, transfer.ToId, transfer.Created).Scan(&title)
+	repo.metr.ObserveResponseTime("proposeTransfer", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("proposeTransfer")
+		return "", err
+	}
+
+	return title, nil
+}
+
+func (repo *NotePostgres) GetTransfer(ctx context.Context, noteID uuid.UUID) (models.OwnershipTransfer, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	transfer := models.OwnershipTransfer{}
+
+	start := time.Now()
+	err := repo.db.QueryRow(ctx, getTransfer, noteID).Scan(
+		&transfer.NoteId,
+		&transfer.FromId,
+		&transfer.ToId,
+		&transfer.Created,
+	)
+	repo.metr.ObserveResponseTime("getTransfer", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("getTransfer")
+		return models.OwnershipTransfer{}, err
+	}
+
+	return transfer, nil
+}
+
+func (repo *NotePostgres) TransferOwnership(ctx context.Context, noteID uuid.UUID, fromID uuid.UUID, toID uuid.UUID) ([]uuid.UUID, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	result, err := repo.queryIDs(ctx, "transferOwnership", transferOwnership, noteID, fromID, toID)
 	if err != nil {
 		logger.Error(err.Error())
-		repo.metr.IncreaseErrors("getUpdates")
 		return result, err
 	}
 
+	logger.Info("success")
+	return result, nil
+}
+
+func (repo *NotePostgres) GetUpdates(ctx context.Context, noteID uuid.UUID, offset time.Time) ([]models.Message, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	result := make([]models.Message, 0)
+
+	start := time.Now()
+	query, err := repo.db.Query(ctx, getUpdates, noteID, offset)
+	repo.metr.ObserveResponseTime("getUpdates", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("getUpdates")
+		return result, err
+	}
+
+	for query.Next() {
+		var message models.Message
+		if err := query.Scan(&message.NoteId, &message.Created, &message.MessageInfo); err != nil {
+			logger.Error(err.Error())
+			return result, fmt.Errorf("error occured while scanning messages: %w", err)
+		}
+		result = append(result, message)
+	}
+
+	return result, nil
+}
+
+func (repo *NotePostgres) GetRevisions(ctx context.Context, noteID uuid.UUID, count int64, offset int64) ([]models.NoteRevision, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	result := make([]models.NoteRevision, 0, count)
+
+	start := time.Now()
+	query, err := repo.db.Query(ctx, getRevisions, noteID, count, offset)
+	repo.metr.ObserveResponseTime("getRevisions", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("getRevisions")
+		return result, err
+	}
+
+	for query.Next() {
+		var revision models.NoteRevision
+		if err := query.Scan(&revision.Id, &revision.NoteId, &revision.Created, &revision.AuthorId, &revision.Username); err != nil {
+			logger.Error(err.Error())
+			return result, fmt.Errorf("error occured while scanning revisions: %w", err)
+		}
+		result = append(result, revision)
+	}
+
+	logger.Info("success")
+	return result, nil
+}
+
+func (repo *NotePostgres) GetRevision(ctx context.Context, revisionID uuid.UUID) (models.NoteRevision, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	revision := models.NoteRevision{}
+
+	start := time.Now()
+	err := repo.db.QueryRow(ctx, getRevision, revisionID).Scan(
+		&revision.Id,
+		&revision.NoteId,
+		&revision.Created,
+		&revision.AuthorId,
+		&revision.Username,
+		&revision.Data,
+	)
+	repo.metr.ObserveResponseTime("getRevision", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("getRevision")
+		return models.NoteRevision{}, err
+	}
+
+	logger.Info("success")
+	return revision, nil
+}
+
+func (repo *NotePostgres) ReadAllNotes(ctx context.Context, userId uuid.UUID, count int64, offset int64, tags models.TagFilter, archived string, sort string, cursor *models.NoteCursor) ([]models.NoteResponse, *models.NoteCursor, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	result := make([]models.NoteResponse, 0, count)
+	var last *models.NoteCursor
+
+	args := []interface{}{userId, count, offset, tags.Included, archived, sort, nil, nil, nil, nil, nil, nil, nil, tags.Mode, tags.Excluded}
+	if cursor != nil {
+		args = []interface{}{userId, count, offset, tags.Included, archived, sort, cursor.Favorite, cursor.Position, cursor.Created, cursor.Title, cursor.OwnerId, cursor.Updated, cursor.Id, tags.Mode, tags.Excluded}
+	}
+
+	start := time.Now()
+	query, err := repo.db.Query(ctx, getAllNotes, args...)
+	repo.metr.ObserveResponseTime("getAllNotes", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("getAllNotes")
+		return result, nil, err
+	}
+
+	for query.Next() {
+		var note models.NoteResponse
+		var position int
+		var title string
+		if err := query.Scan(
+			&note.Id,
+			&note.Data,
+			&note.CreateTime,
+			&note.UpdateTime,
+			&note.OwnerId,
+			&note.Parent,
+			&note.Children,
+			&note.Tags,
+			&note.Collaborators,
+			&note.Icon,
+			&note.Header,
+			&note.Favorite,
+			&note.Public,
+			&note.Archived,
+			&position,
+			&title,
+		); err != nil {
+			logger.Error("scanning" + err.Error())
+			return result, nil, fmt.Errorf("error occured while scanning notes: %w", err)
+		}
+		result = append(result, note)
+
+		last = &models.NoteCursor{
+			Sort:     sort,
+			Favorite: note.Favorite,
+			Position: position,
+			Created:  note.CreateTime,
+			Title:    title,
+			OwnerId:  note.OwnerId,
+			Updated:  note.UpdateTime,
+			Id:       note.Id,
+		}
+	}
+
+	logger.Info("success")
+	return result, last, nil
+}
+
+func (repo *NotePostgres) ReadNote(ctx context.Context, noteId uuid.UUID, userId uuid.UUID) (models.NoteResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	resultNote := models.NoteResponse{}
+
+	start := time.Now()
+	err := repo.db.QueryRow(ctx, getNote, noteId, userId).Scan(
+		&resultNote.Id,
+		&resultNote.Data,
+		&resultNote.CreateTime,
+		&resultNote.UpdateTime,
+		&resultNote.OwnerId,
+		&resultNote.Parent,
+		&resultNote.Children,
+		&resultNote.Tags,
+		&resultNote.Collaborators,
+		&resultNote.Icon,
+		&resultNote.Header,
+		&resultNote.Favorite,
+		&resultNote.Public,
+		&resultNote.Archived,
+		&resultNote.Version,
+	)
+	repo.metr.ObserveResponseTime("getNote", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("getNote")
+		return models.NoteResponse{}, err
+	}
+
+	logger.Info("success")
+	return resultNote, nil
+}
+
+func (repo *NotePostgres) ReadPublicNote(ctx context.Context, noteId uuid.UUID) (models.NoteResponse, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	resultNote := models.NoteResponse{}
+
+	start := time.Now()
+	err := repo.db.QueryRow(ctx, getPublicNote, noteId).Scan(
+		&resultNote.Id,
+		&resultNote.Data,
+		&resultNote.CreateTime,
+		&resultNote.UpdateTime,
+		&resultNote.OwnerId,
+		&resultNote.Parent,
+		&resultNote.Children,
+		&resultNote.Tags,
+		&resultNote.Collaborators,
+		&resultNote.Icon,
+		&resultNote.Header,
+		&resultNote.Public,
+	)
+	repo.metr.ObserveResponseTime("getNote", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("getNote")
+		return models.NoteResponse{}, err
+	}
+
+	resultNote.Favorite = false
+
+	logger.Info("success")
+	return resultNote, nil
+}
+
+func (repo *NotePostgres) CreateNote(ctx context.Context, note models.Note) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	start := time.Now()
+	_, err := repo.db.Exec(ctx, createNote,
+		note.Id,
+		note.Data,
+		note.CreateTime,
+		note.UpdateTime,
+		note.OwnerId,
+		note.Parent,
+		note.Children,
+		note.Tags,
+		note.Collaborators,
+		note.Icon,
+		note.Header,
+		note.Public,
+	)
+	repo.metr.ObserveResponseTime("createNote", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("createNote")
+		return err
+	}
+
+	logger.Info("success")
+	return nil
+}
+
+func (repo *NotePostgres) CreateSubNote(ctx context.Context, note models.Note) (models.Note, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	start := time.Now()
+	err := repo.db.QueryRow(ctx, createSubNote,
+		note.Id,
+		note.Data,
+		note.CreateTime,
+		note.UpdateTime,
+		note.Parent,
+		note.Icon,
+		note.Header,
+	).Scan(&note.OwnerId, &note.Collaborators)
+	repo.metr.ObserveResponseTime("createSubNote", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("createSubNote")
+		return models.Note{}, err
+	}
+
+	logger.Info("success")
+	return note, nil
+}
+
+func (repo *NotePostgres) UpdateNote(ctx context.Context, updatedNote models.Note, editorID uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	start := time.Now()
+	result, err := repo.db.Exec(ctx, updateNote, updatedNote.Data, updatedNote.UpdateTime, editorID, updatedNote.Id, updatedNote.Version)
+	repo.metr.ObserveResponseTime("updateNote", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("updateNote")
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		logger.Error(note.ErrVersionConflict)
+		return errors.New(note.ErrVersionConflict)
+	}
+
+	logger.Info("success")
+	return nil
+
+}
+
+func (repo *NotePostgres) DeleteNote(ctx context.Context, id uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	start := time.Now()
+	_, err := repo.db.Exec(ctx, deleteNote, id)
+	repo.metr.ObserveResponseTime("deleteNote", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("deleteNote")
+		return err
+	}
+
+	logger.Info("success")
+	return nil
+}
+
+func (repo *NotePostgres) queryIDs(ctx context.Context, name string, sql string, args ...interface{}) ([]uuid.UUID, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	result := make([]uuid.UUID, 0)
+
+	start := time.Now()
+	query, err := repo.db.Query(ctx, sql, args...)
+	repo.metr.ObserveResponseTime(name, time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors(name)
+		return result, err
+	}
+
+	for query.Next() {
+		var id uuid.UUID
+		if err := query.Scan(&id); err != nil {
+			logger.Error(err.Error())
+			return result, fmt.Errorf("error occured while scanning ids: %w", err)
+		}
+		result = append(result, id)
+	}
+
+	return result, nil
+}
+
+func (repo *NotePostgres) TrashNote(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, deletedAt time.Time) ([]uuid.UUID, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	result, err := repo.queryIDs(ctx, "trashNote", trashNote, noteID, userID, deletedAt)
+	if err != nil {
+		logger.Error(err.Error())
+		return result, err
+	}
+
+	logger.Info("success")
+	return result, nil
+}
+
+func (repo *NotePostgres) RestoreNote(ctx context.Context, noteID uuid.UUID, parentID uuid.UUID) ([]uuid.UUID, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	result, err := repo.queryIDs(ctx, "restoreNote", restoreNote, noteID, parentID)
+	if err != nil {
+		logger.Error(err.Error())
+		return result, err
+	}
+
+	logger.Info("success")
+	return result, nil
+}
+
+func (repo *NotePostgres) GetExpiredTrash(ctx context.Context, before time.Time) ([]uuid.UUID, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	result, err := repo.queryIDs(ctx, "getExpiredTrash", getExpiredTrash, before)
+	if err != nil {
+		logger.Error(err.Error())
+		return result, err
+	}
+
+	logger.Info("success")
+	return result, nil
+}
+
+func (repo *NotePostgres) ReadTrash(ctx context.Context, userID uuid.UUID, count int64, offset int64) ([]models.TrashedNote, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	result := make([]models.TrashedNote, 0, count)
+
+	start := time.Now()
+	query, err := repo.db.Query(ctx, getTrash, userID, count, offset)
+	repo.metr.ObserveResponseTime("getTrash", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("getTrash")
+		return result, err
+	}
+
+	for query.Next() {
+		var note models.TrashedNote
+		if err := query.Scan(
+			&note.Id,
+			&note.Data,
+			&note.CreateTime,
+			&note.UpdateTime,
+			&note.OwnerId,
+			&note.Parent,
+			&note.Children,
+			&note.Tags,
+			&note.Collaborators,
+			&note.Icon,
+			&note.Header,
+			&note.Public,
+			&note.DeletedAt,
+		); err != nil {
+			logger.Error(err.Error())
+			return result, fmt.Errorf("error occured while scanning trash: %w", err)
+		}
+		result = append(result, note)
+	}
+
+	logger.Info("success")
+	return result, nil
+}
+
+func (repo *NotePostgres) ReadTrashedNote(ctx context.Context, noteID uuid.UUID) (models.TrashedNote, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	result := models.TrashedNote{}
+
+	start := time.Now()
+	err := repo.db.QueryRow(ctx, getTrashedNote, noteID).Scan(
+		&result.Id,
+		&result.Data,
+		&result.CreateTime,
+		&result.UpdateTime,
+		&result.OwnerId,
+		&result.Parent,
+		&result.Children,
+		&result.Tags,
+		&result.Collaborators,
+		&result.Icon,
+		&result.Header,
+		&result.Public,
+		&result.DeletedAt,
+	)
+	repo.metr.ObserveResponseTime("getTrashedNote", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("getTrashedNote")
+		return models.TrashedNote{}, err
+	}
+
+	logger.Info("success")
+	return result, nil
+}
+
+func (repo *NotePostgres) GetSubtreeAttaches(ctx context.Context, noteID uuid.UUID) ([]string, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	result := make([]string, 0)
+
+	start := time.Now()
+	query, err := repo.db.Query(ctx, getSubtreeAttaches, noteID)
+	repo.metr.ObserveResponseTime("getSubtreeAttaches", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("getSubtreeAttaches")
+		return result, err
+	}
+
+	for query.Next() {
+		var path string
+		if err := query.Scan(&path); err != nil {
+			logger.Error(err.Error())
+			return result, fmt.Errorf("error occured while scanning attaches: %w", err)
+		}
+		result = append(result, path)
+	}
+
+	logger.Info("success")
+	return result, nil
+}
+
+func (repo *NotePostgres) GetAttaches(ctx context.Context, noteID uuid.UUID) ([]models.Attach, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	result := make([]models.Attach, 0)
+
+	start := time.Now()
+	query, err := repo.db.Query(ctx, getAttaches, noteID)
+	repo.metr.ObserveResponseTime("getAttaches", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("getAttaches")
+		return result, err
+	}
+
+	for query.Next() {
+		var attach models.Attach
+		if err := query.Scan(&attach.Id, &attach.Path, &attach.NoteId); err != nil {
+			logger.Error(err.Error())
+			return result, fmt.Errorf("error occured while scanning attaches: %w", err)
+		}
+		result = append(result, attach)
+	}
+
+	logger.Info("success")
+	return result, nil
+}
+
+func (repo *NotePostgres) AddAttach(ctx context.Context, attach models.Attach) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	start := time.Now()
+	_, err := repo.db.Exec(ctx, addAttach, attach.Id, attach.Path, attach.NoteId)
+	repo.metr.ObserveResponseTime("addAttach", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("addAttach")
+		return err
+	}
+
+	logger.Info("success")
+	return nil
+}
+
+func (repo *NotePostgres) AddSubNote(ctx context.Context, id uuid.UUID, childID uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	start := time.Now()
+	_, err := repo.db.Exec(ctx, addSubNote, childID, id)
+	repo.metr.ObserveResponseTime("addSubNote", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("addSubNote")
+		return err
+	}
+
+	logger.Info("success")
+	return nil
+}
+
+func (repo *NotePostgres) RemoveSubNote(ctx context.Context, id uuid.UUID, childID uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	start := time.Now()
+	_, err := repo.db.Exec(ctx, removeSubNote, childID, id)
+	repo.metr.ObserveResponseTime("removeSubNote", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("removeSubNote")
+		return err
+	}
+
+	logger.Info("success")
+	return nil
+}
+
+func (repo *NotePostgres) MoveNote(ctx context.Context, noteID uuid.UUID, oldParentID uuid.UUID, newParentID uuid.UUID) ([]uuid.UUID, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	result, err := repo.queryIDs(ctx, "moveNote", moveNote, noteID, oldParentID, newParentID)
+	if err != nil {
+		return result, err
+	}
+
+	logger.Info("success")
+	return result, nil
+}
+
+func (repo *NotePostgres) SetTemplate(ctx context.Context, noteID uuid.UUID, isTemplate bool) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	start := time.Now()
+	_, err := repo.db.Exec(ctx, setTemplate, isTemplate, noteID)
+	repo.metr.ObserveResponseTime("setTemplate", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("setTemplate")
+		return err
+	}
+
+	logger.Info("success")
+	return nil
+}
+
+func (repo *NotePostgres) ReadTemplates(ctx context.Context, userID uuid.UUID) ([]models.Template, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	result := make([]models.Template, 0)
+
+	start := time.Now()
+	query, err := repo.db.Query(ctx, getTemplates, userID)
+	repo.metr.ObserveResponseTime("getTemplates", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("getTemplates")
+		return result, err
+	}
+
+	for query.Next() {
+		var template models.Template
+		if err := query.Scan(&template.Id, &template.Data, &template.System); err != nil {
+			logger.Error(err.Error())
+			return result, fmt.Errorf("error occured while scanning templates: %w", err)
+		}
+		result = append(result, template)
+	}
+
+	logger.Info("success")
+	return result, nil
+}
+
+func (repo *NotePostgres) ReadTemplate(ctx context.Context, templateID uuid.UUID) (models.Template, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	result := models.Template{}
+
+	start := time.Now()
+	err := repo.db.QueryRow(ctx, getTemplate, templateID).Scan(&result.Id, &result.Data, &result.System)
+	repo.metr.ObserveResponseTime("getTemplate", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("getTemplate")
+		return models.Template{}, err
+	}
+
+	logger.Info("success")
+	return result, nil
+}
+
+func (repo *NotePostgres) AddTag(ctx context.Context, tagName string, noteId uuid.UUID, version int64) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	start := time.Now()
+	result, err := repo.db.Exec(ctx, addTag, tagName, noteId, version)
+	repo.metr.ObserveResponseTime("addTag", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("addTag")
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		logger.Error(note.ErrVersionConflict)
+		return errors.New(note.ErrVersionConflict)
+	}
+
+	logger.Info("success")
+	return nil
+}
+
+func (repo *NotePostgres) DeleteTag(ctx context.Context, tagName string, noteId uuid.UUID, version int64) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	start := time.Now()
+	result, err := repo.db.Exec(ctx, deleteTag, tagName, noteId, version)
+	repo.metr.ObserveResponseTime("deleteTag", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("deleteTag")
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		logger.Error(note.ErrVersionConflict)
+		return errors.New(note.ErrVersionConflict)
+	}
+
+	logger.Info("success")
+	return nil
+}
+
+func (repo *NotePostgres) AddTagToNotes(ctx context.Context, tagName string, noteIDs []uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	start := time.Now()
+	_, err := repo.db.Exec(ctx, addTagToNotes, tagName, noteIDs)
+	repo.metr.ObserveResponseTime("addTagToNotes", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("addTagToNotes")
+		return err
+	}
+
+	logger.Info("success")
+	return nil
+}
+
+func (repo *NotePostgres) DeleteTagFromNotes(ctx context.Context, tagName string, noteIDs []uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	start := time.Now()
+	_, err := repo.db.Exec(ctx, deleteTagFromNotes, tagName, noteIDs)
+	repo.metr.ObserveResponseTime("deleteTagFromNotes", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("deleteTagFromNotes")
+		return err
+	}
+
+	logger.Info("success")
+	return nil
+}
+
+func (repo *NotePostgres) RememberTag(ctx context.Context, tagName string, userID uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	start := time.Now()
+	result, err := repo.db.Exec(ctx, rememberTag, tagName, userID)
+	repo.metr.ObserveResponseTime("rememberTag", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("rememberTag")
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		logger.Error("tag already exists")
+		return errors.New("tag already exists")
+	}
+
+	logger.Info("success")
+	return nil
+}
+
+func (repo *NotePostgres) ForgetTag(ctx context.Context, tagName string, userID uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	start := time.Now()
+	_, err := repo.db.Exec(ctx, forgetTag, tagName, userID)
+	repo.metr.ObserveResponseTime("forgetTag", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("forgetTag")
+		return err
+	}
+
+	logger.Info("success")
+	return nil
+}
+
+func (repo *NotePostgres) DeleteTagFromAllNotes(ctx context.Context, tagName string, userID uuid.UUID) ([]uuid.UUID, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	result, err := repo.queryIDs(ctx, "deleteTagFromAllNotes", deleteTagFromAllNotes, tagName, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return result, err
+	}
+
+	logger.Info("success")
+	return result, nil
+}
+
+func (repo *NotePostgres) UpdateTagOnAllNotes(ctx context.Context, oldTag string, newTag string, userID uuid.UUID) ([]uuid.UUID, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	result, err := repo.queryIDs(ctx, "updateTagInAllNotes", updateTagInAllNotes, oldTag, newTag, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return result, err
+	}
+
+	logger.Info("success")
+	return result, nil
+}
+
+func (repo *NotePostgres) MergeTags(ctx context.Context, tags []string, newTag string, userID uuid.UUID) ([]uuid.UUID, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	result, err := repo.queryIDs(ctx, "mergeTags", mergeTags, tags, newTag, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return result, err
+	}
+
+	logger.Info("success")
+	return result, nil
+}
+
+func (repo *NotePostgres) UpdateTag(ctx context.Context, oldTag string, newTag string, userID uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	start := time.Now()
+	_, err := repo.db.Exec(ctx, updateTag, newTag, userID, oldTag)
+	repo.metr.ObserveResponseTime("updateTag", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("updateTag")
+		return err
+	}
+
+	logger.Info("success")
+	return nil
+}
+
+func (repo *NotePostgres) SetIcon(ctx context.Context, noteID uuid.UUID, icon string, version int64) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	start := time.Now()
+	result, err := repo.db.Exec(ctx, setIcon, icon, noteID, version)
+	repo.metr.ObserveResponseTime("setIcon", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("setIcon")
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		logger.Error(note.ErrVersionConflict)
+		return errors.New(note.ErrVersionConflict)
+	}
+
+	logger.Info("success")
+	return nil
+}
+
+func (repo *NotePostgres) SetHeader(ctx context.Context, noteID uuid.UUID, header string, version int64) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	start := time.Now()
+	result, err := repo.db.Exec(ctx, setHeader, header, noteID, version)
+	repo.metr.ObserveResponseTime("setHeader", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("setHeader")
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		logger.Error(note.ErrVersionConflict)
+		return errors.New(note.ErrVersionConflict)
+	}
+
+	logger.Info("success")
+	return nil
+
+}
+
+func (repo *NotePostgres) AddFav(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	start := time.Now()
+	_, err := repo.db.Exec(ctx, addFav, noteID, userID)
+	repo.metr.ObserveResponseTime("addFav", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("addFav")
+		return err
+	}
+
+	logger.Info("success")
+	return nil
+}
+func (repo *NotePostgres) DelFav(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	start := time.Now()
+	_, err := repo.db.Exec(ctx, delFav, noteID, userID)
+	repo.metr.ObserveResponseTime("delFav", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("delFav")
+		return err
+	}
+
+	logger.Info("success")
+	return nil
+}
+
+func (repo *NotePostgres) AddFavs(ctx context.Context, noteIDs []uuid.UUID, userID uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	start := time.Now()
+	_, err := repo.db.Exec(ctx, addFavs, noteIDs, userID)
+	repo.metr.ObserveResponseTime("addFavs", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("addFavs")
+		return err
+	}
+
+	logger.Info("success")
+	return nil
+}
+
+func (repo *NotePostgres) DelFavs(ctx context.Context, noteIDs []uuid.UUID, userID uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	start := time.Now()
+	_, err := repo.db.Exec(ctx, delFavs, noteIDs, userID)
+	repo.metr.ObserveResponseTime("delFavs", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("delFavs")
+		return err
+	}
+
+	logger.Info("success")
+	return nil
+}
+
+func (repo *NotePostgres) ArchiveNote(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, archivedAt time.Time) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	start := time.Now()
+	_, err := repo.db.Exec(ctx, archiveNote, noteID, userID, archivedAt)
+	repo.metr.ObserveResponseTime("archiveNote", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("archiveNote")
+		return err
+	}
+
+	logger.Info("success")
+	return nil
+}
+
+func (repo *NotePostgres) UnarchiveNote(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	start := time.Now()
+	_, err := repo.db.Exec(ctx, unarchiveNote, noteID, userID)
+	repo.metr.ObserveResponseTime("unarchiveNote", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("unarchiveNote")
+		return err
+	}
+
+	logger.Info("success")
+	return nil
+}
+
+func (repo *NotePostgres) SetNotePositions(ctx context.Context, userID uuid.UUID, notes []uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	start := time.Now()
+	_, err := repo.db.Exec(ctx, setNotePositions, userID, notes)
+	repo.metr.ObserveResponseTime("setNotePositions", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("setNotePositions")
+		return err
+	}
+
+	logger.Info("success")
+	return nil
+}
+
+func (repo *NotePostgres) GetNotePositions(ctx context.Context, userID uuid.UUID) (map[uuid.UUID]int, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	result := make(map[uuid.UUID]int)
+
+	start := time.Now()
+	query, err := repo.db.Query(ctx, getNotePositions, userID)
+	repo.metr.ObserveResponseTime("getNotePositions", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("getNotePositions")
+		return result, err
+	}
+
+	for query.Next() {
+		var noteID uuid.UUID
+		var position int
+		if err := query.Scan(&noteID, &position); err != nil {
+			logger.Error("scanning" + err.Error())
+			return result, fmt.Errorf("error occured while scanning positions: %w", err)
+		}
+		result[noteID] = position
+	}
+
+	logger.Info("success")
+	return result, nil
+}
+
+func (repo *NotePostgres) SetNoteLinks(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, targets []uuid.UUID, titles []string) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	start := time.Now()
+	_, err := repo.db.Exec(ctx, setNoteLinks, noteID, targets, titles, userID)
+	repo.metr.ObserveResponseTime("setNoteLinks", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("setNoteLinks")
+		return err
+	}
+
+	logger.Info("success")
+	return nil
+}
+
+func (repo *NotePostgres) GetBacklinks(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) ([]models.NoteLink, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	result, err := repo.queryLinks(ctx, "getBacklinks", getBacklinks, noteID, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return result, err
+	}
+
+	logger.Info("success")
+	return result, nil
+}
+
+func (repo *NotePostgres) GetNoteGraph(ctx context.Context, userID uuid.UUID) (models.NoteGraph, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	nodes, err := repo.queryLinks(ctx, "getGraphNodes", getGraphNodes, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.NoteGraph{}, err
+	}
+
+	result := models.NoteGraph{
+		Nodes: nodes,
+		Edges: make([]models.NoteEdge, 0),
+	}
+
+	start := time.Now()
+	query, err := repo.db.Query(ctx, getGraphEdges, userID)
+	repo.metr.ObserveResponseTime("getGraphEdges", time.Since(start).Seconds())
+	if err != nil {
+		logger.Error(err.Error())
+		repo.metr.IncreaseErrors("getGraphEdges")
+		return models.NoteGraph{}, err
+	}
+
 	for query.Next() {
-		var message models.Message
-		if err := query.Scan(&message.NoteId, &message.Created, &message.MessageInfo); err != nil {
+		var edge models.NoteEdge
+		if err := query.Scan(&edge.Source, &edge.Target); err != nil {
 			logger.Error(err.Error())
-			return result, fmt.Errorf("error occured while scanning messages: %w", err)
+			return models.NoteGraph{}, fmt.Errorf("error occured while scanning note edges: %w", err)
 		}
-		result = append(result, message)
+		result.Edges = append(result.Edges, edge)
 	}
 
+	logger.Info("success")
 	return result, nil
 }
 
-func (repo *NotePostgres) ReadAllNotes(ctx context.Context, userId uuid.UUID, count int64, offset int64, tags []string) ([]models.NoteResponse, error) {
-	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
-
-	result := make([]models.NoteResponse, 0, count)
+func (repo *NotePostgres) queryLinks(ctx context.Context, name string, sql string, args ...interface{}) ([]models.NoteLink, error) {
+	result := make([]models.NoteLink, 0)
 
 	start := time.Now()
-	query, err := repo.db.Query(ctx, getAllNotes, userId, count, offset, tags)
-	repo.metr.ObserveResponseTime("getAllNotes", time.Since(start).Seconds())
+	query, err := repo.db.Query(ctx, sql, args...)
+	repo.metr.ObserveResponseTime(name, time.Since(start).Seconds())
 	if err != nil {
-		logger.Error(err.Error())
-		repo.metr.IncreaseErrors("getAllNotes")
+		repo.metr.IncreaseErrors(name)
 		return result, err
 	}
 
 	for query.Next() {
-		var note models.NoteResponse
-		if err := query.Scan(
-			&note.Id,
-			&note.Data,
-			&note.CreateTime,
-			&note.UpdateTime,
-			&note.OwnerId,
-			&note.Parent,
-			&note.Children,
-			&note.Tags,
-			&note.Collaborators,
-			&note.Icon,
-			&note.Header,
-			&note.Favorite,
-			&note.Public,
-		); err != nil {
-			logger.Error("scanning" + err.Error())
-			return result, fmt.Errorf("error occured while scanning notes: %w", err)
+		var link models.NoteLink
+		if err := query.Scan(&link.Id, &link.Title, &link.Icon); err != nil {
+			return result, fmt.Errorf("error occured while scanning note links: %w", err)
 		}
-		result = append(result, note)
+		result = append(result, link)
 	}
 
-	logger.Info("success")
 	return result, nil
 }
 
-func (repo *NotePostgres) ReadNote(ctx context.Context, noteId uuid.UUID, userId uuid.UUID) (models.NoteResponse, error) {
+func (repo *NotePostgres) SetPublic(ctx context.Context, noteID uuid.UUID) error {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	resultNote := models.NoteResponse{}
-
 	start := time.Now()
-	err := repo.db.QueryRow(ctx, getNote, noteId, userId).Scan(
-		&resultNote.Id,
-		&resultNote.Data,
-		&resultNote.CreateTime,
-		&resultNote.UpdateTime,
-		&resultNote.OwnerId,
-		&resultNote.Parent,
-		&resultNote.Children,
-		&resultNote.Tags,
-		&resultNote.Collaborators,
-		&resultNote.Icon,
-		&resultNote.Header,
-		&resultNote.Favorite,
-		&resultNote.Public,
-	)
-	repo.metr.ObserveResponseTime("getNote", time.Since(start).Seconds())
+	_, err := repo.db.Exec(ctx, setPublic, noteID)
+	repo.metr.ObserveResponseTime("setPublic", time.Since(start).Seconds())
 	if err != nil {
 		logger.Error(err.Error())
-		repo.metr.IncreaseErrors("getNote")
-		return models.NoteResponse{}, err
+		repo.metr.IncreaseErrors("setPublic")
+		return err
 	}
 
 	logger.Info("success")
-	return resultNote, nil
+	return nil
 }
-
-func (repo *NotePostgres) ReadPublicNote(ctx context.Context, noteId uuid.UUID) (models.NoteResponse, error) {
+func (repo *NotePostgres) SetPrivate(ctx context.Context, noteID uuid.UUID) error {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	resultNote := models.NoteResponse{}
-
 	start := time.Now()
-	err := repo.db.QueryRow(ctx, getPublicNote, noteId).Scan(
-		&resultNote.Id,
-		&resultNote.Data,
-		&resultNote.CreateTime,
-		&resultNote.UpdateTime,
-		&resultNote.OwnerId,
-		&resultNote.Parent,
-		&resultNote.Children,
-		&resultNote.Tags,
-		&resultNote.Collaborators,
-		&resultNote.Icon,
-		&resultNote.Header,
-		&resultNote.Public,
-	)
-	repo.metr.ObserveResponseTime("getNote", time.Since(start).Seconds())
+	_, err := repo.db.Exec(ctx, setPrivate, noteID)
+	repo.metr.ObserveResponseTime("setPrivate", time.Since(start).Seconds())
 	if err != nil {
 		logger.Error(err.Error())
-		repo.metr.IncreaseErrors("getNote")
-		return models.NoteResponse{}, err
+		repo.metr.IncreaseErrors("setPrivate")
+		return err
 	}
 
-	resultNote.Favorite = false
-
 	logger.Info("success")
-	return resultNote, nil
+	return nil
 }
 
-func (repo *NotePostgres) CreateNote(ctx context.Context, note models.Note) error {
+func (repo *NotePostgres) CreateShareLink(ctx context.Context, link models.ShareLink) error {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
 	start := time.Now()
-	_, err := repo.db.Exec(ctx, createNote,
-		note.Id,
-		note.Data,
-		note.CreateTime,
-		note.UpdateTime,
-		note.OwnerId,
-		note.Parent,
-		note.Children,
-		note.Tags,
-		note.Collaborators,
-		note.Icon,
-		note.Header,
-		note.Public,
+	_, err := repo.db.Exec(ctx, createShareLink,
+		link.Id,
+		link.Token,
+		link.NoteId,
+		link.CreatorId,
+		link.PasswordHash,
+		link.IncludeSubtree,
+		link.Created,
+		link.ExpiresAt,
 	)
-	repo.metr.ObserveResponseTime("createNote", time.Since(start).Seconds())
+	repo.metr.ObserveResponseTime("createShareLink", time.Since(start).Seconds())
 	if err != nil {
 		logger.Error(err.Error())
-		repo.metr.IncreaseErrors("createNote")
+		repo.metr.IncreaseErrors("createShareLink")
 		return err
 	}
 
@@ -304,133 +2031,205 @@ func (repo *NotePostgres) CreateNote(ctx context.Context, note models.Note) erro
 	return nil
 }
 
-func (repo *NotePostgres) UpdateNote(ctx context.Context, note models.Note) error {
+func (repo *NotePostgres) GetShareLinks(ctx context.Context, noteID uuid.UUID) ([]models.ShareLink, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
+	result := make([]models.ShareLink, 0)
+
 	start := time.Now()
-	_, err := repo.db.Exec(ctx, updateNote, note.Data, note.UpdateTime, note.Id)
-	repo.metr.ObserveResponseTime("updateNote", time.Since(start).Seconds())
+	query, err := repo.db.Query(ctx, getShareLinks, noteID)
+	repo.metr.ObserveResponseTime("getShareLinks", time.Since(start).Seconds())
 	if err != nil {
 		logger.Error(err.Error())
-		repo.metr.IncreaseErrors("updateNote")
-		return err
+		repo.metr.IncreaseErrors("getShareLinks")
+		return result, err
 	}
 
-	logger.Info("success")
-	return nil
+	for query.Next() {
+		var link models.ShareLink
+		if err := query.Scan(
+			&link.Id,
+			&link.Token,
+			&link.NoteId,
+			&link.CreatorId,
+			&link.PasswordHash,
+			&link.IncludeSubtree,
+			&link.Created,
+			&link.ExpiresAt,
+		); err != nil {
+			logger.Error(err.Error())
+			return result, fmt.Errorf("error occured while scanning share links: %w", err)
+		}
+		result = append(result, link)
+	}
 
+	logger.Info("success")
+	return result, nil
 }
 
-func (repo *NotePostgres) DeleteNote(ctx context.Context, id uuid.UUID) error {
+func (repo *NotePostgres) GetShareLink(ctx context.Context, token string, noteID uuid.UUID) (models.ShareLink, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
+	link := models.ShareLink{}
+
 	start := time.Now()
-	_, err := repo.db.Exec(ctx, deleteNote, id)
-	repo.metr.ObserveResponseTime("deleteNote", time.Since(start).Seconds())
+	err := repo.db.QueryRow(ctx, getShareLink, token, noteID).Scan(
+		&link.Id,
+		&link.Token,
+		&link.NoteId,
+		&link.CreatorId,
+		&link.PasswordHash,
+		&link.IncludeSubtree,
+		&link.Created,
+		&link.ExpiresAt,
+	)
+	repo.metr.ObserveResponseTime("getShareLink", time.Since(start).Seconds())
 	if err != nil {
 		logger.Error(err.Error())
-		repo.metr.IncreaseErrors("deleteNote")
-		return err
+		repo.metr.IncreaseErrors("getShareLink")
+		return models.ShareLink{}, err
 	}
 
-	logger.Info("success")
-	return nil
+	return link, nil
 }
 
-func (repo *NotePostgres) AddSubNote(ctx context.Context, id uuid.UUID, childID uuid.UUID) error {
+func (repo *NotePostgres) DeleteShareLink(ctx context.Context, noteID uuid.UUID, linkID uuid.UUID) error {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
+	var deletedID uuid.UUID
+
 	start := time.Now()
-	_, err := repo.db.Exec(ctx, addSubNote, childID, id)
-	repo.metr.ObserveResponseTime("addSubNote", time.Since(start).Seconds())
+	err := repo.db.QueryRow(ctx, deleteShareLink, linkID, noteID).Scan(&deletedID)
+	repo.metr.ObserveResponseTime("deleteShareLink", time.Since(start).Seconds())
 	if err != nil {
 		logger.Error(err.Error())
-		repo.metr.IncreaseErrors("addSubNote")
+		repo.metr.IncreaseErrors("deleteShareLink")
 		return err
 	}
 
-	logger.Info("success")
 	return nil
 }
 
-func (repo *NotePostgres) RemoveSubNote(ctx context.Context, id uuid.UUID, childID uuid.UUID) error {
+func (repo *NotePostgres) GetComments(ctx context.Context, noteID uuid.UUID) ([]models.Comment, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
+	result := make([]models.Comment, 0)
+
 	start := time.Now()
-	_, err := repo.db.Exec(ctx, removeSubNote, childID, id)
-	repo.metr.ObserveResponseTime("removeSubNote", time.Since(start).Seconds())
+	query, err := repo.db.Query(ctx, getComments, noteID)
+	repo.metr.ObserveResponseTime("getComments", time.Since(start).Seconds())
 	if err != nil {
 		logger.Error(err.Error())
-		repo.metr.IncreaseErrors("removeSubNote")
-		return err
+		repo.metr.IncreaseErrors("getComments")
+		return result, err
+	}
+
+	for query.Next() {
+		var comment models.Comment
+		if err := query.Scan(
+			&comment.Id,
+			&comment.NoteId,
+			&comment.Parent,
+			&comment.AuthorId,
+			&comment.Author,
+			&comment.BlockId,
+			&comment.Text,
+			&comment.Created,
+			&comment.Updated,
+			&comment.Resolved,
+			&comment.Deleted,
+		); err != nil {
+			logger.Error(err.Error())
+			return result, fmt.Errorf("error occured while scanning comments: %w", err)
+		}
+		result = append(result, comment)
 	}
 
 	logger.Info("success")
-	return nil
+	return result, nil
 }
 
-func (repo *NotePostgres) AddTag(ctx context.Context, tagName string, noteId uuid.UUID) error {
+func (repo *NotePostgres) GetComment(ctx context.Context, commentID uuid.UUID) (models.Comment, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
+	comment := models.Comment{}
+
 	start := time.Now()
-	_, err := repo.db.Exec(ctx, addTag, tagName, noteId)
-	repo.metr.ObserveResponseTime("addTag", time.Since(start).Seconds())
+	err := repo.db.QueryRow(ctx, getComment, commentID).Scan(
+		&comment.Id,
+		&comment.NoteId,
+		&comment.Parent,
+		&comment.AuthorId,
+		&comment.Author,
+		&comment.BlockId,
+		&comment.Text,
+		&comment.Created,
+		&comment.Updated,
+		&comment.Resolved,
+		&comment.Deleted,
+	)
+	repo.metr.ObserveResponseTime("getComment", time.Since(start).Seconds())
 	if err != nil {
 		logger.Error(err.Error())
-		repo.metr.IncreaseErrors("addTag")
-		return err
+		repo.metr.IncreaseErrors("getComment")
+		return models.Comment{}, err
 	}
 
-	logger.Info("success")
-	return nil
+	return comment, nil
 }
 
-func (repo *NotePostgres) DeleteTag(ctx context.Context, tagName string, noteId uuid.UUID) error {
+func (repo *NotePostgres) CreateComment(ctx context.Context, comment models.Comment) (string, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
+	var author string
+
 	start := time.Now()
-	_, err := repo.db.Exec(ctx, deleteTag, tagName, noteId)
-	repo.metr.ObserveResponseTime("deleteTag", time.Since(start).Seconds())
+	err := repo.db.QueryRow(ctx, createComment,
+		comment.Id,
+		comment.NoteId,
+		comment.Parent,
+		comment.AuthorId,
+		comment.BlockId,
+		comment.Text,
+		comment.Created,
+		comment.Updated,
+		comment.Resolved,
+	).Scan(&author)
+	repo.metr.ObserveResponseTime("createComment", time.Since(start).Seconds())
 	if err != nil {
 		logger.Error(err.Error())
-		repo.metr.IncreaseErrors("deleteTag")
-		return err
+		repo.metr.IncreaseErrors("createComment")
+		return "", err
 	}
 
-	logger.Info("success")
-	return nil
+	return author, nil
 }
 
-func (repo *NotePostgres) RememberTag(ctx context.Context, tagName string, userID uuid.UUID) error {
+func (repo *NotePostgres) UpdateComment(ctx context.Context, commentID uuid.UUID, text string, updated time.Time) error {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
 	start := time.Now()
-	result, err := repo.db.Exec(ctx, rememberTag, tagName, userID)
-	repo.metr.ObserveResponseTime("rememberTag", time.Since(start).Seconds())
+	_, err := repo.db.Exec(ctx, updateComment, text, updated, commentID)
+	repo.metr.ObserveResponseTime("updateComment", time.Since(start).Seconds())
 	if err != nil {
 		logger.Error(err.Error())
-		repo.metr.IncreaseErrors("rememberTag")
+		repo.metr.IncreaseErrors("updateComment")
 		return err
 	}
 
-	if result.RowsAffected() == 0 {
-		logger.Error("tag already exists")
-		return errors.New("tag already exists")
-	}
-
 	logger.Info("success")
 	return nil
 }
 
-func (repo *NotePostgres) ForgetTag(ctx context.Context, tagName string, userID uuid.UUID) error {
+func (repo *NotePostgres) DeleteComment(ctx context.Context, commentID uuid.UUID) error {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
 	start := time.Now()
-	_, err := repo.db.Exec(ctx, forgetTag, tagName, userID)
-	repo.metr.ObserveResponseTime("forgetTag", time.Since(start).Seconds())
+	_, err := repo.db.Exec(ctx, deleteComment, commentID)
+	repo.metr.ObserveResponseTime("deleteComment", time.Since(start).Seconds())
 	if err != nil {
 		logger.Error(err.Error())
-		repo.metr.IncreaseErrors("forgetTag")
+		repo.metr.IncreaseErrors("deleteComment")
 		return err
 	}
 
@@ -438,140 +2237,184 @@ func (repo *NotePostgres) ForgetTag(ctx context.Context, tagName string, userID
 	return nil
 }
 
-func (repo *NotePostgres) DeleteTagFromAllNotes(ctx context.Context, tagName string, userID uuid.UUID) error {
+func (repo *NotePostgres) SetCommentResolved(ctx context.Context, commentID uuid.UUID, resolved bool) error {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
 	start := time.Now()
-	_, err := repo.db.Exec(ctx, deleteTagFromAllNotes, tagName, userID)
-	repo.metr.ObserveResponseTime("deleteTagFromAllNotes", time.Since(start).Seconds())
+	_, err := repo.db.Exec(ctx, setCommentResolved, resolved, commentID)
+	repo.metr.ObserveResponseTime("setCommentResolved", time.Since(start).Seconds())
 	if err != nil {
 		logger.Error(err.Error())
-		repo.metr.IncreaseErrors("deleteTagFromAllNotes")
+		repo.metr.IncreaseErrors("setCommentResolved")
 		return err
 	}
 
 	logger.Info("success")
 	return nil
 }
-func (repo *NotePostgres) UpdateTagOnAllNotes(ctx context.Context, oldTag string, newTag string, userID uuid.UUID) error {
+
+func (repo *NotePostgres) CreateReminder(ctx context.Context, reminder models.Reminder) (string, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
+	var title string
+
 	start := time.Now()
-	_, err := repo.db.Exec(ctx, updateTagInAllNotes, oldTag, newTag, userID)
-	repo.metr.ObserveResponseTime("updateTagInAllNotes", time.Since(start).Seconds())
+	err := repo.db.QueryRow(ctx, createReminder,
+		reminder.Id,
+		reminder.NoteId,
+		reminder.UserId,
+		reminder.RemindAt,
+		reminder.Repeat,
+		reminder.Fired,
+		reminder.Created,
+	).Scan(&title)
+	repo.metr.ObserveResponseTime("createReminder", time.Since(start).Seconds())
 	if err != nil {
 		logger.Error(err.Error())
-		repo.metr.IncreaseErrors("updateTagInAllNotes")
-		return err
+		repo.metr.IncreaseErrors("createReminder")
+		return "", err
 	}
 
-	logger.Info("success")
-	return nil
+	return title, nil
 }
-func (repo *NotePostgres) UpdateTag(ctx context.Context, oldTag string, newTag string, userID uuid.UUID) error {
+
+func (repo *NotePostgres) GetReminder(ctx context.Context, reminderID uuid.UUID) (models.Reminder, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
+	reminder := models.Reminder{}
+
 	start := time.Now()
-	_, err := repo.db.Exec(ctx, updateTag, newTag, userID, oldTag)
-	repo.metr.ObserveResponseTime("updateTag", time.Since(start).Seconds())
+	err := repo.db.QueryRow(ctx, getReminder, reminderID).Scan(
+		&reminder.Id,
+		&reminder.NoteId,
+		&reminder.NoteTitle,
+		&reminder.UserId,
+		&reminder.RemindAt,
+		&reminder.Repeat,
+		&reminder.Fired,
+		&reminder.Created,
+	)
+	repo.metr.ObserveResponseTime("getReminder", time.Since(start).Seconds())
 	if err != nil {
 		logger.Error(err.Error())
-		repo.metr.IncreaseErrors("updateTag")
-		return err
+		repo.metr.IncreaseErrors("getReminder")
+		return models.Reminder{}, err
 	}
 
-	logger.Info("success")
-	return nil
+	return reminder, nil
 }
 
-func (repo *NotePostgres) SetIcon(ctx context.Context, noteID uuid.UUID, icon string) error {
+func (repo *NotePostgres) queryReminders(ctx context.Context, name string, sql string, args ...interface{}) ([]models.Reminder, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
+	result := make([]models.Reminder, 0)
+
 	start := time.Now()
-	_, err := repo.db.Exec(ctx, setIcon, icon, noteID)
-	repo.metr.ObserveResponseTime("setIcon", time.Since(start).Seconds())
+	query, err := repo.db.Query(ctx, sql, args...)
+	repo.metr.ObserveResponseTime(name, time.Since(start).Seconds())
 	if err != nil {
 		logger.Error(err.Error())
-		repo.metr.IncreaseErrors("setIcon")
-		return err
+		repo.metr.IncreaseErrors(name)
+		return result, err
 	}
 
-	logger.Info("success")
-	return nil
+	for query.Next() {
+		var reminder models.Reminder
+		if err := query.Scan(
+			&reminder.Id,
+			&reminder.NoteId,
+			&reminder.NoteTitle,
+			&reminder.UserId,
+			&reminder.RemindAt,
+			&reminder.Repeat,
+			&reminder.Fired,
+			&reminder.Created,
+		); err != nil {
+			logger.Error(err.Error())
+			return result, fmt.Errorf("error occured while scanning reminders: %w", err)
+		}
+		result = append(result, reminder)
+	}
+
+	return result, nil
 }
 
-func (repo *NotePostgres) SetHeader(ctx context.Context, noteID uuid.UUID, header string) error {
+func (repo *NotePostgres) GetReminders(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) ([]models.Reminder, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	start := time.Now()
-	_, err := repo.db.Exec(ctx, setHeader, header, noteID)
-	repo.metr.ObserveResponseTime("setHeader", time.Since(start).Seconds())
+	result, err := repo.queryReminders(ctx, "getReminders", getReminders, noteID, userID)
 	if err != nil {
 		logger.Error(err.Error())
-		repo.metr.IncreaseErrors("setHeader")
-		return err
+		return result, err
 	}
 
 	logger.Info("success")
-	return nil
-
+	return result, nil
 }
 
-func (repo *NotePostgres) AddFav(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) error {
+func (repo *NotePostgres) GetUpcomingReminders(ctx context.Context, userID uuid.UUID, count int64, offset int64) ([]models.Reminder, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	start := time.Now()
-	_, err := repo.db.Exec(ctx, addFav, noteID, userID)
-	repo.metr.ObserveResponseTime("addFav", time.Since(start).Seconds())
+	result, err := repo.queryReminders(ctx, "getUpcomingReminders", getUpcomingReminders, userID, count, offset)
 	if err != nil {
 		logger.Error(err.Error())
-		repo.metr.IncreaseErrors("addFav")
-		return err
+		return result, err
 	}
 
 	logger.Info("success")
-	return nil
+	return result, nil
 }
-func (repo *NotePostgres) DelFav(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) error {
+
+func (repo *NotePostgres) GetPendingReminders(ctx context.Context, userID uuid.UUID) ([]models.Reminder, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	start := time.Now()
-	_, err := repo.db.Exec(ctx, delFav, noteID, userID)
-	repo.metr.ObserveResponseTime("delFav", time.Since(start).Seconds())
+	result, err := repo.queryReminders(ctx, "getPendingReminders", getPendingReminders, userID)
 	if err != nil {
 		logger.Error(err.Error())
-		repo.metr.IncreaseErrors("delFav")
-		return err
+		return result, err
 	}
 
 	logger.Info("success")
-	return nil
+	return result, nil
 }
 
-func (repo *NotePostgres) SetPublic(ctx context.Context, noteID uuid.UUID) error {
+func (repo *NotePostgres) FireReminders(ctx context.Context, now time.Time) ([]models.Reminder, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	result, err := repo.queryReminders(ctx, "fireReminders", fireReminders, now)
+	if err != nil {
+		logger.Error(err.Error())
+		return result, err
+	}
+
+	return result, nil
+}
+
+func (repo *NotePostgres) RescheduleReminder(ctx context.Context, reminderID uuid.UUID, remindAt time.Time) error {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
 	start := time.Now()
-	_, err := repo.db.Exec(ctx, setPublic, noteID)
-	repo.metr.ObserveResponseTime("setPublic", time.Since(start).Seconds())
+	_, err := repo.db.Exec(ctx, rescheduleReminder, remindAt, reminderID)
+	repo.metr.ObserveResponseTime("rescheduleReminder", time.Since(start).Seconds())
 	if err != nil {
 		logger.Error(err.Error())
-		repo.metr.IncreaseErrors("setPublic")
+		repo.metr.IncreaseErrors("rescheduleReminder")
 		return err
 	}
 
 	logger.Info("success")
 	return nil
 }
-func (repo *NotePostgres) SetPrivate(ctx context.Context, noteID uuid.UUID) error {
+
+func (repo *NotePostgres) DeleteReminder(ctx context.Context, reminderID uuid.UUID) error {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
 	start := time.Now()
-	_, err := repo.db.Exec(ctx, setPrivate, noteID)
-	repo.metr.ObserveResponseTime("setPrivate", time.Since(start).Seconds())
+	_, err := repo.db.Exec(ctx, deleteReminder, reminderID)
+	repo.metr.ObserveResponseTime("deleteReminder", time.Since(start).Seconds())
 	if err != nil {
 		logger.Error(err.Error())
-		repo.metr.IncreaseErrors("setPrivate")
+		repo.metr.IncreaseErrors("deleteReminder")
 		return err
 	}
 
@@ -9,6 +9,7 @@ import (
 	"github.com/driftprogramming/pgxpoolmock"
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/models"
 	mock_metrics "github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/metrics/mocks"
+	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/note"
 	"github.com/golang/mock/gomock"
 	"github.com/jackc/pgconn"
 	"github.com/jackc/pgx/v4"
@@ -27,22 +28,22 @@ func TestNoteRepo_ReadAllNotes(t *testing.T) {
 		{
 			name: "ReadAllNotes_Success",
 			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics, pgxRows pgx.Rows, id uuid.UUID) {
-				mockPool.EXPECT().Query(gomock.Any(), getAllNotes, id, int64(1), int64(0), []string{}).Return(pgxRows, nil)
+				mockPool.EXPECT().Query(gomock.Any(), getAllNotes, id, int64(1), int64(0), []string{}, models.ArchiveFilterActive, models.SortUpdated, nil, nil, nil, nil, nil, nil, nil, models.TagModeAll, []string{}).Return(pgxRows, nil)
 				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
 			},
 			userId:      uuid.NewV4(),
-			columns:     []string{"id", "data", "create_time", "update_time", "owner_id", "parent", "children", "tags", "collaborators", "icon", "header", "favorite", "is_public"},
+			columns:     []string{"id", "data", "create_time", "update_time", "owner_id", "parent", "children", "tags", "collaborators", "icon", "header", "favorite", "is_public", "archived", "sort_position", "sort_title"},
 			expectedErr: nil,
 		},
 		{
 			name: "ReadAllNotes_Fail",
 			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics, pgxRows pgx.Rows, id uuid.UUID) {
-				mockPool.EXPECT().Query(gomock.Any(), getAllNotes, id, int64(1), int64(0), []string{}).Return(pgxRows, pgx.ErrNoRows)
+				mockPool.EXPECT().Query(gomock.Any(), getAllNotes, id, int64(1), int64(0), []string{}, models.ArchiveFilterActive, models.SortUpdated, nil, nil, nil, nil, nil, nil, nil, models.TagModeAll, []string{}).Return(pgxRows, pgx.ErrNoRows)
 				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
 				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
 			},
 			userId:      uuid.NewV4(),
-			columns:     []string{"id", "data", "create_time", "update_time", "owner_id", "parent", "children", "tags", "collaborators", "icon", "header", "favorite", "is_public"},
+			columns:     []string{"id", "data", "create_time", "update_time", "owner_id", "parent", "children", "tags", "collaborators", "icon", "header", "favorite", "is_public", "archived", "sort_position", "sort_title"},
 			expectedErr: pgx.ErrNoRows,
 		},
 	}
@@ -54,12 +55,12 @@ func TestNoteRepo_ReadAllNotes(t *testing.T) {
 			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
 			defer ctrl.Finish()
 
-			pgxRows := pgxpoolmock.NewRows(tt.columns).AddRow(uuid.NewV4(), "", time.Now(), time.Time{}, tt.userId, uuid.UUID{}, []uuid.UUID{}, []string{}, []uuid.UUID{}, "", "", false, false).ToPgxRows()
+			pgxRows := pgxpoolmock.NewRows(tt.columns).AddRow(uuid.NewV4(), "", time.Now(), time.Time{}, tt.userId, uuid.UUID{}, []uuid.UUID{}, []string{}, []uuid.UUID{}, "", "", false, false, false, 0, "").ToPgxRows()
 
 			tt.mockRepoAction(mockPool, mockMetrics, pgxRows, tt.userId)
 
 			repo := CreateNotePostgres(mockPool, mockMetrics)
-			_, err := repo.ReadAllNotes(context.Background(), tt.userId, int64(1), int64(0), []string{})
+			_, _, err := repo.ReadAllNotes(context.Background(), tt.userId, int64(1), int64(0), models.TagFilter{Mode: models.TagModeAll, Included: []string{}, Excluded: []string{}}, models.ArchiveFilterActive, models.SortUpdated, nil)
 
 			assert.Equal(t, tt.expectedErr, err)
 		})
@@ -82,7 +83,7 @@ func TestNoteRepo_ReadNote(t *testing.T) {
 				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
 			},
 			Id:          uuid.NewV4(),
-			columns:     []string{"id", "data", "create_time", "update_time", "owner_id", "parent", "children", "tags", "collaborators", "icon", "header", "favorite", "is_public"},
+			columns:     []string{"id", "data", "create_time", "update_time", "owner_id", "parent", "children", "tags", "collaborators", "icon", "header", "favorite", "is_public", "archived", "version"},
 			expectedErr: nil,
 		},
 		{
@@ -93,7 +94,7 @@ func TestNoteRepo_ReadNote(t *testing.T) {
 				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
 			},
 			Id:          uuid.NewV4(),
-			columns:     []string{"id", "data", "create_time", "update_time", "owner_id", "parent", "children", "tags", "collaborators", "icon", "header", "favorite", "is_public"},
+			columns:     []string{"id", "data", "create_time", "update_time", "owner_id", "parent", "children", "tags", "collaborators", "icon", "header", "favorite", "is_public", "archived", "version"},
 			expectedErr: nil,
 		},
 	}
@@ -104,7 +105,7 @@ func TestNoteRepo_ReadNote(t *testing.T) {
 			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
 			defer ctrl.Finish()
 
-			pgxRows := pgxpoolmock.NewRows(tt.columns).AddRow(uuid.NewV4(), "", time.Now(), time.Time{}, tt.Id, uuid.UUID{}, []uuid.UUID{}, []string{}, []uuid.UUID{}, "", "", false, false).ToPgxRows()
+			pgxRows := pgxpoolmock.NewRows(tt.columns).AddRow(uuid.NewV4(), "", time.Now(), time.Time{}, tt.Id, uuid.UUID{}, []uuid.UUID{}, []string{}, []uuid.UUID{}, "", "", false, false, false, int64(1)).ToPgxRows()
 
 			tt.mockRepoAction(mockPool, mockMetrics, pgxRows, tt.Id)
 
@@ -193,17 +194,27 @@ func TestNoteRepo_UpdateNote(t *testing.T) {
 			name: "UpdateNote_Success",
 			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
 				mockPool.EXPECT().Exec(gomock.Any(), updateNote,
-					"", currTime, Id,
-				).Return(nil, nil)
+					"", currTime, userId, Id, int64(1),
+				).Return(pgconn.CommandTag("UPDATE 1"), nil)
 				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
 			},
 			err: nil,
 		},
+		{
+			name: "UpdateNote_VersionConflict",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				mockPool.EXPECT().Exec(gomock.Any(), updateNote,
+					"", currTime, userId, Id, int64(1),
+				).Return(pgconn.CommandTag("UPDATE 0"), nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			err: errors.New(note.ErrVersionConflict),
+		},
 		{
 			name: "UpdateNote_Fail",
 			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
 				mockPool.EXPECT().Exec(gomock.Any(), updateNote,
-					"", currTime, Id,
+					"", currTime, userId, Id, int64(1),
 				).Return(nil, pgx.ErrNoRows)
 				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
 				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
@@ -235,7 +246,8 @@ func TestNoteRepo_UpdateNote(t *testing.T) {
 				Header:        "",
 				Favorite:      false,
 				Public:        false,
-			})
+				Version:       1,
+			}, userId)
 
 			assert.Equal(t, tt.err, err)
 		})
@@ -373,6 +385,116 @@ func TestNotePostgres_RemoveSubNote(t *testing.T) {
 	}
 }
 
+func TestNotePostgres_MoveNote(t *testing.T) {
+	noteId := uuid.NewV4()
+	childId := uuid.NewV4()
+	oldParentId := uuid.NewV4()
+	newParentId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		expected       []uuid.UUID
+		err            error
+	}{
+		{
+			name: "MoveNote_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows([]string{"id"}).AddRow(noteId).AddRow(childId).ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), moveNote, noteId, oldParentId, newParentId).Return(pgxRows, nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			expected: []uuid.UUID{noteId, childId},
+			err:      nil,
+		},
+		{
+			name: "MoveNote_Fail",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows([]string{"id"}).ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), moveNote, noteId, oldParentId, newParentId).Return(pgxRows, pgx.ErrNoRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+			expected: []uuid.UUID{},
+			err:      pgx.ErrNoRows,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			got, err := repo.MoveNote(context.Background(), noteId, oldParentId, newParentId)
+
+			assert.Equal(t, tt.err, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestNotePostgres_CreateSubNote(t *testing.T) {
+	ownerId := uuid.NewV4()
+	collaboratorId := uuid.NewV4()
+	subNote := models.Note{
+		Id:         uuid.NewV4(),
+		Data:       "{}",
+		CreateTime: time.Now().UTC(),
+		UpdateTime: time.Now().UTC(),
+		Parent:     uuid.NewV4(),
+	}
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		expected       models.Note
+		err            error
+	}{
+		{
+			name: "CreateSubNote_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows([]string{"owner_id", "collaborators"}).AddRow(ownerId, []uuid.UUID{collaboratorId}).ToPgxRows()
+				pgxRows.Next()
+
+				mockPool.EXPECT().QueryRow(gomock.Any(), createSubNote, subNote.Id, subNote.Data, subNote.CreateTime, subNote.UpdateTime, subNote.Parent, subNote.Icon, subNote.Header).Return(pgxRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			expected: models.Note{
+				Id:            subNote.Id,
+				Data:          subNote.Data,
+				CreateTime:    subNote.CreateTime,
+				UpdateTime:    subNote.UpdateTime,
+				OwnerId:       ownerId,
+				Parent:        subNote.Parent,
+				Collaborators: []uuid.UUID{collaboratorId},
+			},
+			err: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			got, err := repo.CreateSubNote(context.Background(), subNote)
+
+			assert.Equal(t, tt.err, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
 func TestNotePostgres_AddTag(t *testing.T) {
 	noteId := uuid.NewV4()
 
@@ -384,15 +506,23 @@ func TestNotePostgres_AddTag(t *testing.T) {
 		{
 			name: "AddTag_Success",
 			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
-				mockPool.EXPECT().Exec(gomock.Any(), addTag, "tag", noteId).Return(nil, nil)
+				mockPool.EXPECT().Exec(gomock.Any(), addTag, "tag", noteId, int64(1)).Return(pgconn.CommandTag("UPDATE 1"), nil)
 				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
 			},
 			err: nil,
 		},
+		{
+			name: "AddTag_VersionConflict",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				mockPool.EXPECT().Exec(gomock.Any(), addTag, "tag", noteId, int64(1)).Return(pgconn.CommandTag("UPDATE 0"), nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			err: errors.New(note.ErrVersionConflict),
+		},
 		{
 			name: "AddTag_Fail",
 			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
-				mockPool.EXPECT().Exec(gomock.Any(), addTag, "tag", noteId).Return(nil, pgx.ErrNoRows)
+				mockPool.EXPECT().Exec(gomock.Any(), addTag, "tag", noteId, int64(1)).Return(nil, pgx.ErrNoRows)
 				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
 				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
 			},
@@ -409,7 +539,7 @@ func TestNotePostgres_AddTag(t *testing.T) {
 			tt.mockRepoAction(mockPool, mockMetrics)
 
 			repo := CreateNotePostgres(mockPool, mockMetrics)
-			err := repo.AddTag(context.Background(), "tag", noteId)
+			err := repo.AddTag(context.Background(), "tag", noteId, 1)
 
 			assert.Equal(t, tt.err, err)
 		})
@@ -427,7 +557,7 @@ func TestNotePostgres_DeleteTag(t *testing.T) {
 		{
 			name: "DeleteTag_Success",
 			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
-				mockPool.EXPECT().Exec(gomock.Any(), deleteTag, "tag", noteId).Return(nil, nil)
+				mockPool.EXPECT().Exec(gomock.Any(), deleteTag, "tag", noteId, int64(1)).Return(pgconn.CommandTag("UPDATE 1"), nil)
 				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
 			},
 			err: nil,
@@ -435,7 +565,7 @@ func TestNotePostgres_DeleteTag(t *testing.T) {
 		{
 			name: "DeleteTag_Fail",
 			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
-				mockPool.EXPECT().Exec(gomock.Any(), deleteTag, "tag", noteId).Return(nil, pgx.ErrNoRows)
+				mockPool.EXPECT().Exec(gomock.Any(), deleteTag, "tag", noteId, int64(1)).Return(nil, pgx.ErrNoRows)
 				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
 				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
 			},
@@ -452,7 +582,7 @@ func TestNotePostgres_DeleteTag(t *testing.T) {
 			tt.mockRepoAction(mockPool, mockMetrics)
 
 			repo := CreateNotePostgres(mockPool, mockMetrics)
-			err := repo.DeleteTag(context.Background(), "tag", noteId)
+			err := repo.DeleteTag(context.Background(), "tag", noteId, 1)
 
 			assert.Equal(t, tt.err, err)
 		})
@@ -503,6 +633,67 @@ func TestNotePostgres_DeleteTag(t *testing.T) {
 //	}
 //}
 
+func TestNotePostgres_AddTagToNotes(t *testing.T) {
+	noteIds := []uuid.UUID{uuid.NewV4(), uuid.NewV4()}
+	type args struct {
+		tagName string
+		noteIds []uuid.UUID
+	}
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics, args)
+		args           args
+		expectedErr    error
+	}{
+		{
+			name: "Test_AddTagToNotes_Success",
+			args: args{
+				tagName: "tag",
+				noteIds: noteIds,
+			},
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics, args args) {
+				mockPool.EXPECT().Exec(gomock.Any(), addTagToNotes, args.tagName, args.noteIds).Return(nil, nil)
+
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+
+			expectedErr: nil,
+		},
+
+		{
+			name: "Test_AddTagToNotes_Fail_Error",
+			args: args{
+				tagName: "tag",
+				noteIds: noteIds,
+			},
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics, args args) {
+				mockPool.EXPECT().Exec(gomock.Any(), addTagToNotes, args.tagName, args.noteIds).Return(nil, errors.New("err"))
+
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+
+			expectedErr: errors.New("err"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics, tt.args)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			err := repo.AddTagToNotes(context.Background(), tt.args.tagName, tt.args.noteIds)
+
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
 func TestNotePostgres_GetTags(t *testing.T) {
 	userId := uuid.NewV4()
 	tests := []struct {
@@ -539,7 +730,7 @@ func TestNotePostgres_GetTags(t *testing.T) {
 			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
 			defer ctrl.Finish()
 
-			pgxRows := pgxpoolmock.NewRows([]string{"tags"}).AddRow("tag").ToPgxRows()
+			pgxRows := pgxpoolmock.NewRows([]string{"tag_name", "color", "emoji", "description", "notes_count"}).AddRow("tag", "", "", "", int64(1)).ToPgxRows()
 
 			tt.mockRepoAction(mockPool, mockMetrics, pgxRows, tt.userId)
 
@@ -551,6 +742,93 @@ func TestNotePostgres_GetTags(t *testing.T) {
 	}
 }
 
+func TestNotePostgres_ReconcileTags(t *testing.T) {
+	noteIds := []uuid.UUID{uuid.NewV4(), uuid.NewV4()}
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		expectedErr    error
+	}{
+		{
+			name: "ReconcileTags_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				mockPool.EXPECT().Exec(gomock.Any(), reconcileTags, noteIds).Return(pgconn.CommandTag("INSERT 0 2"), nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "ReconcileTags_Fail",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				mockPool.EXPECT().Exec(gomock.Any(), reconcileTags, noteIds).Return(nil, errors.New("err"))
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+			expectedErr: errors.New("err"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			err := repo.ReconcileTags(context.Background(), noteIds)
+
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func TestNotePostgres_SetTagInfo(t *testing.T) {
+	userId := uuid.NewV4()
+	tag := models.Tag{Name: "work", Color: "#ffffff", Emoji: "💼", Description: "job"}
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		expectedErr    error
+	}{
+		{
+			name: "SetTagInfo_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				mockPool.EXPECT().Exec(gomock.Any(), setTagInfo, tag.Name, userId, tag.Color, tag.Emoji, tag.Description).Return(pgconn.CommandTag("INSERT 0 1"), nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "SetTagInfo_Fail",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				mockPool.EXPECT().Exec(gomock.Any(), setTagInfo, tag.Name, userId, tag.Color, tag.Emoji, tag.Description).Return(nil, errors.New("err"))
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+			expectedErr: errors.New("err"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			err := repo.SetTagInfo(context.Background(), tag, userId)
+
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
 func TestNotePostgres_RememberTag(t *testing.T) {
 	userId := uuid.NewV4()
 	type args struct {
@@ -710,7 +988,7 @@ func TestNotePostgres_DeleteTagFromAllNotes(t *testing.T) {
 				tagName: "tag",
 			},
 			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics, pgxRows pgx.Rows, args args) {
-				mockPool.EXPECT().Exec(gomock.Any(), deleteTagFromAllNotes, args.tagName, args.userId).Return(nil, nil)
+				mockPool.EXPECT().Query(gomock.Any(), deleteTagFromAllNotes, args.tagName, args.userId).Return(pgxRows, nil)
 
 				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
 			},
@@ -725,7 +1003,7 @@ func TestNotePostgres_DeleteTagFromAllNotes(t *testing.T) {
 				tagName: "tag",
 			},
 			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics, pgxRows pgx.Rows, args args) {
-				mockPool.EXPECT().Exec(gomock.Any(), deleteTagFromAllNotes, args.tagName, args.userId).Return(nil, errors.New("err"))
+				mockPool.EXPECT().Query(gomock.Any(), deleteTagFromAllNotes, args.tagName, args.userId).Return(pgxRows, errors.New("err"))
 
 				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
 				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
@@ -742,12 +1020,12 @@ func TestNotePostgres_DeleteTagFromAllNotes(t *testing.T) {
 			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
 			defer ctrl.Finish()
 
-			pgxRows := pgxpoolmock.NewRows([]string{"tag_name", "user_id"}).AddRow("t", "0").ToPgxRows()
+			pgxRows := pgxpoolmock.NewRows([]string{"id"}).AddRow(uuid.NewV4()).ToPgxRows()
 
 			tt.mockRepoAction(mockPool, mockMetrics, pgxRows, tt.args)
 
 			repo := CreateNotePostgres(mockPool, mockMetrics)
-			err := repo.DeleteTagFromAllNotes(context.Background(), tt.args.tagName, tt.args.userId)
+			_, err := repo.DeleteTagFromAllNotes(context.Background(), tt.args.tagName, tt.args.userId)
 
 			assert.Equal(t, tt.expectedErr, err)
 		})
@@ -839,7 +1117,7 @@ func TestNotePostgres_SetIcon(t *testing.T) {
 				icon:   "icon",
 			},
 			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics, pgxRows pgx.Rows, args args) {
-				mockPool.EXPECT().Exec(gomock.Any(), setIcon, args.icon, args.noteId).Return(nil, nil)
+				mockPool.EXPECT().Exec(gomock.Any(), setIcon, args.icon, args.noteId, int64(1)).Return(pgconn.CommandTag("UPDATE 1"), nil)
 
 				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
 			},
@@ -854,7 +1132,7 @@ func TestNotePostgres_SetIcon(t *testing.T) {
 				icon:   "icon",
 			},
 			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics, pgxRows pgx.Rows, args args) {
-				mockPool.EXPECT().Exec(gomock.Any(), setIcon, args.icon, args.noteId).Return(nil, errors.New("err"))
+				mockPool.EXPECT().Exec(gomock.Any(), setIcon, args.icon, args.noteId, int64(1)).Return(nil, errors.New("err"))
 
 				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
 				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
@@ -876,7 +1154,7 @@ func TestNotePostgres_SetIcon(t *testing.T) {
 			tt.mockRepoAction(mockPool, mockMetrics, pgxRows, tt.args)
 
 			repo := CreateNotePostgres(mockPool, mockMetrics)
-			err := repo.SetIcon(context.Background(), tt.args.noteId, tt.args.icon)
+			err := repo.SetIcon(context.Background(), tt.args.noteId, tt.args.icon, 1)
 
 			assert.Equal(t, tt.expectedErr, err)
 		})
@@ -902,7 +1180,7 @@ func TestNotePostgres_SetHeader(t *testing.T) {
 				header: "header",
 			},
 			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics, args args) {
-				mockPool.EXPECT().Exec(gomock.Any(), setHeader, args.header, args.noteId).Return(nil, nil)
+				mockPool.EXPECT().Exec(gomock.Any(), setHeader, args.header, args.noteId, int64(1)).Return(pgconn.CommandTag("UPDATE 1"), nil)
 
 				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
 			},
@@ -917,7 +1195,7 @@ func TestNotePostgres_SetHeader(t *testing.T) {
 				header: "header",
 			},
 			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics, args args) {
-				mockPool.EXPECT().Exec(gomock.Any(), setHeader, args.header, args.noteId).Return(nil, errors.New("err"))
+				mockPool.EXPECT().Exec(gomock.Any(), setHeader, args.header, args.noteId, int64(1)).Return(nil, errors.New("err"))
 
 				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
 				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
@@ -937,7 +1215,7 @@ func TestNotePostgres_SetHeader(t *testing.T) {
 			tt.mockRepoAction(mockPool, mockMetrics, tt.args)
 
 			repo := CreateNotePostgres(mockPool, mockMetrics)
-			err := repo.SetHeader(context.Background(), tt.args.noteId, tt.args.header)
+			err := repo.SetHeader(context.Background(), tt.args.noteId, tt.args.header, 1)
 
 			assert.Equal(t, tt.expectedErr, err)
 		})
@@ -1068,11 +1346,12 @@ func TestNotePostgres_DelFav(t *testing.T) {
 	}
 }
 
-func TestNotePostgres_SetPublic(t *testing.T) {
-	noteId := uuid.NewV4()
-
+func TestNotePostgres_AddFavs(t *testing.T) {
+	noteIds := []uuid.UUID{uuid.NewV4(), uuid.NewV4()}
+	userId := uuid.NewV4()
 	type args struct {
-		noteId uuid.UUID
+		noteIds []uuid.UUID
+		userId  uuid.UUID
 	}
 	tests := []struct {
 		name           string
@@ -1081,12 +1360,13 @@ func TestNotePostgres_SetPublic(t *testing.T) {
 		expectedErr    error
 	}{
 		{
-			name: "Test_SetPublic_Success",
+			name: "Test_AddFavs_Success",
 			args: args{
-				noteId: noteId,
+				noteIds: noteIds,
+				userId:  userId,
 			},
 			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics, args args) {
-				mockPool.EXPECT().Exec(gomock.Any(), setPublic, args.noteId).Return(nil, nil)
+				mockPool.EXPECT().Exec(gomock.Any(), addFavs, args.noteIds, args.userId).Return(nil, nil)
 
 				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
 			},
@@ -1095,12 +1375,13 @@ func TestNotePostgres_SetPublic(t *testing.T) {
 		},
 
 		{
-			name: "Test_SetPublic_Fail_Error",
+			name: "Test_AddFavs_Fail_Error",
 			args: args{
-				noteId: noteId,
+				noteIds: noteIds,
+				userId:  userId,
 			},
 			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics, args args) {
-				mockPool.EXPECT().Exec(gomock.Any(), setPublic, args.noteId).Return(nil, errors.New("err"))
+				mockPool.EXPECT().Exec(gomock.Any(), addFavs, args.noteIds, args.userId).Return(nil, errors.New("err"))
 
 				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
 				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
@@ -1120,13 +1401,14 @@ func TestNotePostgres_SetPublic(t *testing.T) {
 			tt.mockRepoAction(mockPool, mockMetrics, tt.args)
 
 			repo := CreateNotePostgres(mockPool, mockMetrics)
-			err := repo.SetPublic(context.Background(), tt.args.noteId)
+			err := repo.AddFavs(context.Background(), tt.args.noteIds, tt.args.userId)
+
 			assert.Equal(t, tt.expectedErr, err)
 		})
 	}
 }
 
-func TestNotePostgres_SetPrivate(t *testing.T) {
+func TestNotePostgres_SetPublic(t *testing.T) {
 	noteId := uuid.NewV4()
 
 	type args struct {
@@ -1139,12 +1421,12 @@ func TestNotePostgres_SetPrivate(t *testing.T) {
 		expectedErr    error
 	}{
 		{
-			name: "Test_SetPrivate_Success",
+			name: "Test_SetPublic_Success",
 			args: args{
 				noteId: noteId,
 			},
 			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics, args args) {
-				mockPool.EXPECT().Exec(gomock.Any(), setPrivate, args.noteId).Return(nil, nil)
+				mockPool.EXPECT().Exec(gomock.Any(), setPublic, args.noteId).Return(nil, nil)
 
 				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
 			},
@@ -1153,12 +1435,12 @@ func TestNotePostgres_SetPrivate(t *testing.T) {
 		},
 
 		{
-			name: "Test_SetPrivate_Fail_Error",
+			name: "Test_SetPublic_Fail_Error",
 			args: args{
 				noteId: noteId,
 			},
 			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics, args args) {
-				mockPool.EXPECT().Exec(gomock.Any(), setPrivate, args.noteId).Return(nil, errors.New("err"))
+				mockPool.EXPECT().Exec(gomock.Any(), setPublic, args.noteId).Return(nil, errors.New("err"))
 
 				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
 				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
@@ -1178,41 +1460,99 @@ func TestNotePostgres_SetPrivate(t *testing.T) {
 			tt.mockRepoAction(mockPool, mockMetrics, tt.args)
 
 			repo := CreateNotePostgres(mockPool, mockMetrics)
-			err := repo.SetPrivate(context.Background(), tt.args.noteId)
+			err := repo.SetPublic(context.Background(), tt.args.noteId)
 			assert.Equal(t, tt.expectedErr, err)
 		})
 	}
 }
 
-func TestNotePostgres_GetAttachList(t *testing.T) {
+func TestNotePostgres_SetPrivate(t *testing.T) {
 	noteId := uuid.NewV4()
+
 	type args struct {
 		noteId uuid.UUID
 	}
 	tests := []struct {
-		args           args
 		name           string
 		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics, args)
-		userId         uuid.UUID
+		args           args
 		expectedErr    error
 	}{
 		{
-			name: "GetTags_Success",
+			name: "Test_SetPrivate_Success",
+			args: args{
+				noteId: noteId,
+			},
 			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics, args args) {
-				pgxRows := pgxpoolmock.NewRows([]string{"path"}).AddRow("path").ToPgxRows()
+				mockPool.EXPECT().Exec(gomock.Any(), setPrivate, args.noteId).Return(nil, nil)
 
-				mockPool.EXPECT().Query(gomock.Any(), getAttachList, args.noteId).Return(pgxRows, nil)
 				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
 			},
-			args: args{
-				noteId: noteId,
-			},
 
 			expectedErr: nil,
 		},
+
 		{
-			name: "GetTags_Fail",
-			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics, args args) {
+			name: "Test_SetPrivate_Fail_Error",
+			args: args{
+				noteId: noteId,
+			},
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics, args args) {
+				mockPool.EXPECT().Exec(gomock.Any(), setPrivate, args.noteId).Return(nil, errors.New("err"))
+
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+
+			expectedErr: errors.New("err"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics, tt.args)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			err := repo.SetPrivate(context.Background(), tt.args.noteId)
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func TestNotePostgres_GetAttachList(t *testing.T) {
+	noteId := uuid.NewV4()
+	type args struct {
+		noteId uuid.UUID
+	}
+	tests := []struct {
+		args           args
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics, args)
+		userId         uuid.UUID
+		expectedErr    error
+	}{
+		{
+			name: "GetTags_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics, args args) {
+				pgxRows := pgxpoolmock.NewRows([]string{"path"}).AddRow("path").ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), getAttachList, args.noteId).Return(pgxRows, nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			args: args{
+				noteId: noteId,
+			},
+
+			expectedErr: nil,
+		},
+		{
+			name: "GetTags_Fail",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics, args args) {
 				pgxRows := pgxpoolmock.NewRows([]string{"path"}).ToPgxRows()
 
 				mockPool.EXPECT().Query(gomock.Any(), getAttachList, args.noteId).Return(pgxRows, pgx.ErrNoRows)
@@ -1264,7 +1604,7 @@ func TestNotePostgres_UpdateTagOnAllNotes(t *testing.T) {
 				newName: "tag1",
 			},
 			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics, pgxRows pgx.Rows, args args) {
-				mockPool.EXPECT().Exec(gomock.Any(), updateTagInAllNotes, args.oldName, args.newName, args.userId).Return(nil, nil)
+				mockPool.EXPECT().Query(gomock.Any(), updateTagInAllNotes, args.oldName, args.newName, args.userId).Return(pgxRows, nil)
 
 				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
 			},
@@ -1280,7 +1620,7 @@ func TestNotePostgres_UpdateTagOnAllNotes(t *testing.T) {
 				newName: "tag1",
 			},
 			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics, pgxRows pgx.Rows, args args) {
-				mockPool.EXPECT().Exec(gomock.Any(), updateTagInAllNotes, args.oldName, args.newName, args.userId).Return(nil, errors.New("err"))
+				mockPool.EXPECT().Query(gomock.Any(), updateTagInAllNotes, args.oldName, args.newName, args.userId).Return(pgxRows, errors.New("err"))
 
 				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
 				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
@@ -1297,12 +1637,78 @@ func TestNotePostgres_UpdateTagOnAllNotes(t *testing.T) {
 			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
 			defer ctrl.Finish()
 
-			pgxRows := pgxpoolmock.NewRows([]string{"tag_name", "user_id"}).AddRow("t", "0").ToPgxRows()
+			pgxRows := pgxpoolmock.NewRows([]string{"id"}).AddRow(uuid.NewV4()).ToPgxRows()
+
+			tt.mockRepoAction(mockPool, mockMetrics, pgxRows, tt.args)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			_, err := repo.UpdateTagOnAllNotes(context.Background(), tt.args.oldName, tt.args.newName, tt.args.userId)
+
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func TestNotePostgres_MergeTags(t *testing.T) {
+	userId := uuid.NewV4()
+	type args struct {
+		userId  uuid.UUID
+		tags    []string
+		newName string
+	}
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics, pgx.Rows, args)
+		args           args
+		expectedErr    error
+	}{
+		{
+			name: "Test_MergeTags_Success",
+			args: args{
+				userId:  userId,
+				tags:    []string{"tag", "other"},
+				newName: "tag1",
+			},
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics, pgxRows pgx.Rows, args args) {
+				mockPool.EXPECT().Query(gomock.Any(), mergeTags, args.tags, args.newName, args.userId).Return(pgxRows, nil)
+
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+
+			expectedErr: nil,
+		},
+
+		{
+			name: "Test_MergeTags_Fail_Error",
+			args: args{
+				userId:  userId,
+				tags:    []string{"tag", "other"},
+				newName: "tag1",
+			},
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics, pgxRows pgx.Rows, args args) {
+				mockPool.EXPECT().Query(gomock.Any(), mergeTags, args.tags, args.newName, args.userId).Return(pgxRows, errors.New("err"))
+
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+
+			expectedErr: errors.New("err"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			pgxRows := pgxpoolmock.NewRows([]string{"id"}).AddRow(uuid.NewV4()).ToPgxRows()
 
 			tt.mockRepoAction(mockPool, mockMetrics, pgxRows, tt.args)
 
 			repo := CreateNotePostgres(mockPool, mockMetrics)
-			err := repo.UpdateTagOnAllNotes(context.Background(), tt.args.oldName, tt.args.newName, tt.args.userId)
+			_, err := repo.MergeTags(context.Background(), tt.args.tags, tt.args.newName, tt.args.userId)
 
 			assert.Equal(t, tt.expectedErr, err)
 		})
@@ -1358,3 +1764,2016 @@ func TestNoteRepo_GetOwnerInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestNotePostgres_GetRevisions(t *testing.T) {
+	noteId := uuid.NewV4()
+	columns := []string{"id", "note_id", "created", "author_id", "username"}
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		expectedErr    error
+	}{
+		{
+			name: "GetRevisions_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows(columns).AddRow(uuid.NewV4(), noteId, time.Now(), uuid.NewV4(), "username").ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), getRevisions, noteId, int64(10), int64(0)).Return(pgxRows, nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "GetRevisions_Fail",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows(columns).ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), getRevisions, noteId, int64(10), int64(0)).Return(pgxRows, pgx.ErrNoRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+			expectedErr: pgx.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			_, err := repo.GetRevisions(context.Background(), noteId, 10, 0)
+
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func TestNotePostgres_GetRevision(t *testing.T) {
+	revisionId := uuid.NewV4()
+	columns := []string{"id", "note_id", "created", "author_id", "username", "message_info"}
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		expectedErr    error
+	}{
+		{
+			name: "GetRevision_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows(columns).AddRow(revisionId, uuid.NewV4(), time.Now(), uuid.NewV4(), "username", "{}").ToPgxRows()
+				pgxRows.Next()
+
+				mockPool.EXPECT().QueryRow(gomock.Any(), getRevision, revisionId).Return(pgxRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			_, err := repo.GetRevision(context.Background(), revisionId)
+
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func TestNotePostgres_TrashNote(t *testing.T) {
+	noteId := uuid.NewV4()
+	userId := uuid.NewV4()
+	deletedAt := time.Now().UTC()
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		expected       []uuid.UUID
+		expectedErr    error
+	}{
+		{
+			name: "TrashNote_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows([]string{"id"}).AddRow(noteId).ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), trashNote, noteId, userId, deletedAt).Return(pgxRows, nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			expected:    []uuid.UUID{noteId},
+			expectedErr: nil,
+		},
+		{
+			name: "TrashNote_Fail",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows([]string{"id"}).ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), trashNote, noteId, userId, deletedAt).Return(pgxRows, pgx.ErrNoRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+			expected:    []uuid.UUID{},
+			expectedErr: pgx.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			got, err := repo.TrashNote(context.Background(), noteId, userId, deletedAt)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestNotePostgres_RestoreNote(t *testing.T) {
+	noteId := uuid.NewV4()
+	parentId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		expectedErr    error
+	}{
+		{
+			name: "RestoreNote_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows([]string{"id"}).AddRow(noteId).ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), restoreNote, noteId, parentId).Return(pgxRows, nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "RestoreNote_Fail",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows([]string{"id"}).ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), restoreNote, noteId, parentId).Return(pgxRows, pgx.ErrNoRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+			expectedErr: pgx.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			_, err := repo.RestoreNote(context.Background(), noteId, parentId)
+
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func TestNotePostgres_GetExpiredTrash(t *testing.T) {
+	before := time.Now().UTC()
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		expectedErr    error
+	}{
+		{
+			name: "GetExpiredTrash_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows([]string{"note_id"}).AddRow(uuid.NewV4()).ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), getExpiredTrash, before).Return(pgxRows, nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "GetExpiredTrash_Fail",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows([]string{"note_id"}).ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), getExpiredTrash, before).Return(pgxRows, pgx.ErrNoRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+			expectedErr: pgx.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			_, err := repo.GetExpiredTrash(context.Background(), before)
+
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func TestNotePostgres_ReadTrash(t *testing.T) {
+	userId := uuid.NewV4()
+	columns := []string{"id", "data", "create_time", "update_time", "owner_id", "parent", "children", "tags", "collaborators", "icon", "header", "is_public", "deleted_at"}
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		expectedErr    error
+	}{
+		{
+			name: "ReadTrash_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows(columns).AddRow(uuid.NewV4(), "", time.Now(), time.Now(), userId, uuid.UUID{}, []uuid.UUID{}, []string{}, []uuid.UUID{}, "", "", false, time.Now()).ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), getTrash, userId, int64(10), int64(0)).Return(pgxRows, nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "ReadTrash_Fail",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows(columns).ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), getTrash, userId, int64(10), int64(0)).Return(pgxRows, pgx.ErrNoRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+			expectedErr: pgx.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			_, err := repo.ReadTrash(context.Background(), userId, 10, 0)
+
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func TestNotePostgres_ReadTrashedNote(t *testing.T) {
+	noteId := uuid.NewV4()
+	columns := []string{"id", "data", "create_time", "update_time", "owner_id", "parent", "children", "tags", "collaborators", "icon", "header", "is_public", "deleted_at"}
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		expectedErr    error
+	}{
+		{
+			name: "ReadTrashedNote_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows(columns).AddRow(noteId, "", time.Now(), time.Now(), uuid.NewV4(), uuid.UUID{}, []uuid.UUID{}, []string{}, []uuid.UUID{}, "", "", false, time.Now()).ToPgxRows()
+				pgxRows.Next()
+
+				mockPool.EXPECT().QueryRow(gomock.Any(), getTrashedNote, noteId).Return(pgxRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			_, err := repo.ReadTrashedNote(context.Background(), noteId)
+
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func TestNotePostgres_GetSubtreeAttaches(t *testing.T) {
+	noteId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		expected       []string
+		expectedErr    error
+	}{
+		{
+			name: "GetSubtreeAttaches_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows([]string{"path"}).AddRow("attach.jpeg").ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), getSubtreeAttaches, noteId).Return(pgxRows, nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			expected:    []string{"attach.jpeg"},
+			expectedErr: nil,
+		},
+		{
+			name: "GetSubtreeAttaches_Fail",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows([]string{"path"}).ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), getSubtreeAttaches, noteId).Return(pgxRows, pgx.ErrNoRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+			expected:    []string{},
+			expectedErr: pgx.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			got, err := repo.GetSubtreeAttaches(context.Background(), noteId)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestNotePostgres_GetAttaches(t *testing.T) {
+	noteId := uuid.NewV4()
+	attachId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		expected       []models.Attach
+		expectedErr    error
+	}{
+		{
+			name: "GetAttaches_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows([]string{"id", "path", "note_id"}).AddRow(attachId, "attach.jpeg", noteId).ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), getAttaches, noteId).Return(pgxRows, nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			expected:    []models.Attach{{Id: attachId, Path: "attach.jpeg", NoteId: noteId}},
+			expectedErr: nil,
+		},
+		{
+			name: "GetAttaches_Fail",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows([]string{"id", "path", "note_id"}).ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), getAttaches, noteId).Return(pgxRows, pgx.ErrNoRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+			expected:    []models.Attach{},
+			expectedErr: pgx.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			got, err := repo.GetAttaches(context.Background(), noteId)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestNotePostgres_AddAttach(t *testing.T) {
+	attach := models.Attach{
+		Id:     uuid.NewV4(),
+		Path:   "attach.jpeg",
+		NoteId: uuid.NewV4(),
+	}
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		err            error
+	}{
+		{
+			name: "AddAttach_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				mockPool.EXPECT().Exec(gomock.Any(), addAttach, attach.Id, attach.Path, attach.NoteId).Return(nil, nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			err: nil,
+		},
+		{
+			name: "AddAttach_Fail",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				mockPool.EXPECT().Exec(gomock.Any(), addAttach, attach.Id, attach.Path, attach.NoteId).Return(nil, pgx.ErrNoRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+			err: pgx.ErrNoRows,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			err := repo.AddAttach(context.Background(), attach)
+
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestNotePostgres_SetTemplate(t *testing.T) {
+	noteId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		err            error
+	}{
+		{
+			name: "SetTemplate_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				mockPool.EXPECT().Exec(gomock.Any(), setTemplate, true, noteId).Return(nil, nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			err: nil,
+		},
+		{
+			name: "SetTemplate_Fail",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				mockPool.EXPECT().Exec(gomock.Any(), setTemplate, true, noteId).Return(nil, pgx.ErrNoRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+			err: pgx.ErrNoRows,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			err := repo.SetTemplate(context.Background(), noteId, true)
+
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestNotePostgres_SetNoteLinks(t *testing.T) {
+	noteId := uuid.NewV4()
+	userId := uuid.NewV4()
+	targets := []uuid.UUID{uuid.NewV4()}
+	titles := []string{"roadmap"}
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		expectedErr    error
+	}{
+		{
+			name: "SetNoteLinks_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				mockPool.EXPECT().Exec(gomock.Any(), setNoteLinks, noteId, targets, titles, userId).Return(nil, nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "SetNoteLinks_Fail",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				mockPool.EXPECT().Exec(gomock.Any(), setNoteLinks, noteId, targets, titles, userId).Return(nil, errors.New("err"))
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+			expectedErr: errors.New("err"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			err := repo.SetNoteLinks(context.Background(), noteId, userId, targets, titles)
+
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func TestNotePostgres_GetBacklinks(t *testing.T) {
+	noteId := uuid.NewV4()
+	userId := uuid.NewV4()
+	sourceId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		expected       []models.NoteLink
+		expectedErr    error
+	}{
+		{
+			name: "GetBacklinks_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows([]string{"id", "title", "icon"}).AddRow(sourceId, "source", "").ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), getBacklinks, noteId, userId).Return(pgxRows, nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			expected:    []models.NoteLink{{Id: sourceId, Title: "source"}},
+			expectedErr: nil,
+		},
+		{
+			name: "GetBacklinks_Fail",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows([]string{"id", "title", "icon"}).ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), getBacklinks, noteId, userId).Return(pgxRows, pgx.ErrNoRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+			expected:    []models.NoteLink{},
+			expectedErr: pgx.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			got, err := repo.GetBacklinks(context.Background(), noteId, userId)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestNotePostgres_GetNoteGraph(t *testing.T) {
+	userId := uuid.NewV4()
+	first := uuid.NewV4()
+	second := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		expected       models.NoteGraph
+		expectedErr    error
+	}{
+		{
+			name: "GetNoteGraph_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				nodes := pgxpoolmock.NewRows([]string{"id", "title", "icon"}).AddRow(first, "first", "").AddRow(second, "second", "").ToPgxRows()
+				edges := pgxpoolmock.NewRows([]string{"source_id", "target_id"}).AddRow(first, second).ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), getGraphNodes, userId).Return(nodes, nil)
+				mockPool.EXPECT().Query(gomock.Any(), getGraphEdges, userId).Return(edges, nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return().Times(2)
+			},
+			expected: models.NoteGraph{
+				Nodes: []models.NoteLink{{Id: first, Title: "first"}, {Id: second, Title: "second"}},
+				Edges: []models.NoteEdge{{Source: first, Target: second}},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "GetNoteGraph_FailOnEdges",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				nodes := pgxpoolmock.NewRows([]string{"id", "title", "icon"}).ToPgxRows()
+				edges := pgxpoolmock.NewRows([]string{"source_id", "target_id"}).ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), getGraphNodes, userId).Return(nodes, nil)
+				mockPool.EXPECT().Query(gomock.Any(), getGraphEdges, userId).Return(edges, pgx.ErrNoRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return().Times(2)
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+			expected:    models.NoteGraph{},
+			expectedErr: pgx.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			got, err := repo.GetNoteGraph(context.Background(), userId)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestNotePostgres_ReadTemplates(t *testing.T) {
+	userId := uuid.NewV4()
+	templateId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		expected       []models.Template
+		expectedErr    error
+	}{
+		{
+			name: "ReadTemplates_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows([]string{"id", "data", "system"}).AddRow(templateId, "{}", true).ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), getTemplates, userId).Return(pgxRows, nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			expected:    []models.Template{{Id: templateId, Data: "{}", System: true}},
+			expectedErr: nil,
+		},
+		{
+			name: "ReadTemplates_Fail",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows([]string{"id", "data", "system"}).ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), getTemplates, userId).Return(pgxRows, pgx.ErrNoRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+			expected:    []models.Template{},
+			expectedErr: pgx.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			got, err := repo.ReadTemplates(context.Background(), userId)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestNotePostgres_ReadTemplate(t *testing.T) {
+	templateId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		expected       models.Template
+		expectedErr    error
+	}{
+		{
+			name: "ReadTemplate_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows([]string{"id", "data", "system"}).AddRow(templateId, "{}", false).ToPgxRows()
+				pgxRows.Next()
+
+				mockPool.EXPECT().QueryRow(gomock.Any(), getTemplate, templateId).Return(pgxRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			expected:    models.Template{Id: templateId, Data: "{}", System: false},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			got, err := repo.ReadTemplate(context.Background(), templateId)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestNotePostgres_GetCollaboratorRole(t *testing.T) {
+	noteId := uuid.NewV4()
+	userId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		expected       string
+		expectedErr    error
+	}{
+		{
+			name: "GetCollaboratorRole_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows([]string{"role"}).AddRow(models.RoleViewer).ToPgxRows()
+				pgxRows.Next()
+
+				mockPool.EXPECT().QueryRow(gomock.Any(), getCollaboratorRole, noteId, userId).Return(pgxRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			expected:    models.RoleViewer,
+			expectedErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			got, err := repo.GetCollaboratorRole(context.Background(), noteId, userId)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestNotePostgres_CreateInvite(t *testing.T) {
+	invite := models.Invite{
+		Id:        uuid.NewV4(),
+		NoteId:    uuid.NewV4(),
+		InviterId: uuid.NewV4(),
+		InviteeId: uuid.NewV4(),
+		Role:      models.RoleViewer,
+		Created:   time.Now().UTC(),
+		ExpiresAt: time.Now().UTC(),
+	}
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		expected       string
+		expectedErr    error
+	}{
+		{
+			name: "CreateInvite_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows([]string{"title"}).AddRow("title").ToPgxRows()
+				pgxRows.Next()
+
+				mockPool.EXPECT().QueryRow(gomock.Any(), createInvite, invite.Id, invite.NoteId, invite.InviterId, invite.InviteeId, invite.Role, invite.Created, invite.ExpiresAt).Return(pgxRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			expected:    "title",
+			expectedErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			got, err := repo.CreateInvite(context.Background(), invite)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestNotePostgres_GetReceivedInvites(t *testing.T) {
+	userId := uuid.NewV4()
+	now := time.Now().UTC()
+	invite := models.Invite{
+		Id:        uuid.NewV4(),
+		NoteId:    uuid.NewV4(),
+		NoteTitle: "title",
+		InviterId: uuid.NewV4(),
+		Inviter:   "owner",
+		InviteeId: userId,
+		Invitee:   "user",
+		Role:      models.RoleViewer,
+		Created:   now,
+		ExpiresAt: now,
+	}
+	columns := []string{"id", "note_id", "title", "inviter_id", "inviter", "invitee_id", "invitee", "role", "created", "expires_at"}
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		expected       []models.Invite
+		expectedErr    error
+	}{
+		{
+			name: "GetReceivedInvites_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows(columns).AddRow(
+					invite.Id, invite.NoteId, invite.NoteTitle, invite.InviterId, invite.Inviter, invite.InviteeId, invite.Invitee, invite.Role, invite.Created, invite.ExpiresAt,
+				).ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), getReceivedInvites, userId, now).Return(pgxRows, nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			expected:    []models.Invite{invite},
+			expectedErr: nil,
+		},
+		{
+			name: "GetReceivedInvites_Fail",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows(columns).ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), getReceivedInvites, userId, now).Return(pgxRows, pgx.ErrNoRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+			expected:    []models.Invite{},
+			expectedErr: pgx.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			got, err := repo.GetReceivedInvites(context.Background(), userId, now)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestNotePostgres_DeleteInvite(t *testing.T) {
+	inviteId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		err            error
+	}{
+		{
+			name: "DeleteInvite_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				mockPool.EXPECT().Exec(gomock.Any(), deleteInvite, inviteId).Return(nil, nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			err: nil,
+		},
+		{
+			name: "DeleteInvite_Fail",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				mockPool.EXPECT().Exec(gomock.Any(), deleteInvite, inviteId).Return(nil, errors.New("error"))
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+			err: errors.New("error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			err := repo.DeleteInvite(context.Background(), inviteId)
+
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestNotePostgres_ProposeTransfer(t *testing.T) {
+	transfer := models.OwnershipTransfer{
+		NoteId:  uuid.NewV4(),
+		FromId:  uuid.NewV4(),
+		ToId:    uuid.NewV4(),
+		Created: time.Now().UTC(),
+	}
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		expected       string
+		expectedErr    error
+	}{
+		{
+			name: "ProposeTransfer_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows([]string{"title"}).AddRow("title").ToPgxRows()
+				pgxRows.Next()
+
+				mockPool.EXPECT().QueryRow(gomock.Any(), proposeTransfer, transfer.NoteId, transfer.FromId, transfer.ToId, transfer.Created).Return(pgxRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			expected:    "title",
+			expectedErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			got, err := repo.ProposeTransfer(context.Background(), transfer)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestNotePostgres_GetTransfer(t *testing.T) {
+	transfer := models.OwnershipTransfer{
+		NoteId:  uuid.NewV4(),
+		FromId:  uuid.NewV4(),
+		ToId:    uuid.NewV4(),
+		Created: time.Now().UTC(),
+	}
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		expected       models.OwnershipTransfer
+		expectedErr    error
+	}{
+		{
+			name: "GetTransfer_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows([]string{"note_id", "from_id", "to_id", "created"}).
+					AddRow(transfer.NoteId, transfer.FromId, transfer.ToId, transfer.Created).ToPgxRows()
+				pgxRows.Next()
+
+				mockPool.EXPECT().QueryRow(gomock.Any(), getTransfer, transfer.NoteId).Return(pgxRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			expected:    transfer,
+			expectedErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			got, err := repo.GetTransfer(context.Background(), transfer.NoteId)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestNotePostgres_TransferOwnership(t *testing.T) {
+	noteId := uuid.NewV4()
+	fromId := uuid.NewV4()
+	toId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		expected       []uuid.UUID
+		expectedErr    error
+	}{
+		{
+			name: "TransferOwnership_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows([]string{"id"}).AddRow(noteId).ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), transferOwnership, noteId, fromId, toId).Return(pgxRows, nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			expected:    []uuid.UUID{noteId},
+			expectedErr: nil,
+		},
+		{
+			name: "TransferOwnership_Fail",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows([]string{"id"}).ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), transferOwnership, noteId, fromId, toId).Return(pgxRows, pgx.ErrNoRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+			expected:    []uuid.UUID{},
+			expectedErr: pgx.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			got, err := repo.TransferOwnership(context.Background(), noteId, fromId, toId)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestNotePostgres_SetCollaboratorRole(t *testing.T) {
+	noteId := uuid.NewV4()
+	userId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		err            error
+	}{
+		{
+			name: "SetCollaboratorRole_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				mockPool.EXPECT().Exec(gomock.Any(), setCollaboratorRole, noteId, userId, models.RoleEditor).Return(nil, nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			err: nil,
+		},
+		{
+			name: "SetCollaboratorRole_Fail",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				mockPool.EXPECT().Exec(gomock.Any(), setCollaboratorRole, noteId, userId, models.RoleEditor).Return(nil, pgx.ErrNoRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+			err: pgx.ErrNoRows,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			err := repo.SetCollaboratorRole(context.Background(), noteId, userId, models.RoleEditor)
+
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestNotePostgres_RemoveCollaborator(t *testing.T) {
+	noteId := uuid.NewV4()
+	guestId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		expected       string
+		expectedErr    error
+	}{
+		{
+			name: "RemoveCollaborator_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows([]string{"title"}).AddRow("title").ToPgxRows()
+				pgxRows.Next()
+
+				mockPool.EXPECT().QueryRow(gomock.Any(), removeCollaborator, guestId, noteId).Return(pgxRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			expected:    "title",
+			expectedErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			got, err := repo.RemoveCollaborator(context.Background(), noteId, guestId)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestNotePostgres_CreateShareLink(t *testing.T) {
+	expiresAt := time.Now().UTC().Add(time.Hour)
+	link := models.ShareLink{
+		Id:             uuid.NewV4(),
+		Token:          "token",
+		NoteId:         uuid.NewV4(),
+		CreatorId:      uuid.NewV4(),
+		PasswordHash:   "hash",
+		IncludeSubtree: true,
+		Created:        time.Now().UTC(),
+		ExpiresAt:      &expiresAt,
+	}
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		err            error
+	}{
+		{
+			name: "CreateShareLink_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				mockPool.EXPECT().Exec(gomock.Any(), createShareLink, link.Id, link.Token, link.NoteId, link.CreatorId, link.PasswordHash, link.IncludeSubtree, link.Created, link.ExpiresAt).Return(nil, nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			err: nil,
+		},
+		{
+			name: "CreateShareLink_Fail",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				mockPool.EXPECT().Exec(gomock.Any(), createShareLink, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+			err: errors.New("error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			err := repo.CreateShareLink(context.Background(), link)
+
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestNotePostgres_GetShareLinks(t *testing.T) {
+	noteId := uuid.NewV4()
+	expiresAt := time.Now().UTC()
+	link := models.ShareLink{
+		Id:             uuid.NewV4(),
+		Token:          "token",
+		NoteId:         noteId,
+		CreatorId:      uuid.NewV4(),
+		PasswordHash:   "hash",
+		IncludeSubtree: true,
+		Created:        time.Now().UTC(),
+		ExpiresAt:      &expiresAt,
+	}
+	columns := []string{"id", "token", "note_id", "creator_id", "password_hash", "include_subtree", "created", "expires_at"}
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		expected       []models.ShareLink
+		expectedErr    error
+	}{
+		{
+			name: "GetShareLinks_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows(columns).AddRow(
+					link.Id, link.Token, link.NoteId, link.CreatorId, link.PasswordHash, link.IncludeSubtree, link.Created, link.ExpiresAt,
+				).ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), getShareLinks, noteId).Return(pgxRows, nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			expected:    []models.ShareLink{link},
+			expectedErr: nil,
+		},
+		{
+			name: "GetShareLinks_Fail",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows(columns).ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), getShareLinks, noteId).Return(pgxRows, pgx.ErrNoRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+			expected:    []models.ShareLink{},
+			expectedErr: pgx.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			got, err := repo.GetShareLinks(context.Background(), noteId)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestNotePostgres_GetShareLink(t *testing.T) {
+	noteId := uuid.NewV4()
+	var expiresAt *time.Time
+	link := models.ShareLink{
+		Id:        uuid.NewV4(),
+		Token:     "token",
+		NoteId:    uuid.NewV4(),
+		CreatorId: uuid.NewV4(),
+		Created:   time.Now().UTC(),
+		ExpiresAt: expiresAt,
+	}
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		expected       models.ShareLink
+		expectedErr    error
+	}{
+		{
+			name: "GetShareLink_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows([]string{"id", "token", "note_id", "creator_id", "password_hash", "include_subtree", "created", "expires_at"}).
+					AddRow(link.Id, link.Token, link.NoteId, link.CreatorId, link.PasswordHash, link.IncludeSubtree, link.Created, link.ExpiresAt).ToPgxRows()
+				pgxRows.Next()
+
+				mockPool.EXPECT().QueryRow(gomock.Any(), getShareLink, link.Token, noteId).Return(pgxRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			expected:    link,
+			expectedErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			got, err := repo.GetShareLink(context.Background(), link.Token, noteId)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestNotePostgres_DeleteShareLink(t *testing.T) {
+	noteId := uuid.NewV4()
+	linkId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		err            error
+	}{
+		{
+			name: "DeleteShareLink_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows([]string{"id"}).AddRow(linkId).ToPgxRows()
+				pgxRows.Next()
+
+				mockPool.EXPECT().QueryRow(gomock.Any(), deleteShareLink, linkId, noteId).Return(pgxRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			err: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			err := repo.DeleteShareLink(context.Background(), noteId, linkId)
+
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestNotePostgres_GetComments(t *testing.T) {
+	noteId := uuid.NewV4()
+	comment := models.Comment{
+		Id:       uuid.NewV4(),
+		NoteId:   noteId,
+		Parent:   uuid.Nil,
+		AuthorId: uuid.NewV4(),
+		Author:   "author",
+		BlockId:  "block",
+		Text:     "text",
+		Created:  time.Now().UTC(),
+		Updated:  time.Now().UTC(),
+		Resolved: false,
+	}
+	columns := []string{"id", "note_id", "parent", "author_id", "username", "block_id", "text", "created", "updated", "resolved", "deleted"}
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		expected       []models.Comment
+		expectedErr    error
+	}{
+		{
+			name: "GetComments_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows(columns).AddRow(
+					comment.Id, comment.NoteId, comment.Parent, comment.AuthorId, comment.Author, comment.BlockId, comment.Text, comment.Created, comment.Updated, comment.Resolved, comment.Deleted,
+				).ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), getComments, noteId).Return(pgxRows, nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			expected:    []models.Comment{comment},
+			expectedErr: nil,
+		},
+		{
+			name: "GetComments_Fail",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows(columns).ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), getComments, noteId).Return(pgxRows, pgx.ErrNoRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+			expected:    []models.Comment{},
+			expectedErr: pgx.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			got, err := repo.GetComments(context.Background(), noteId)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestNotePostgres_CreateComment(t *testing.T) {
+	comment := models.Comment{
+		Id:       uuid.NewV4(),
+		NoteId:   uuid.NewV4(),
+		Parent:   uuid.Nil,
+		AuthorId: uuid.NewV4(),
+		Text:     "text",
+		Created:  time.Now().UTC(),
+		Updated:  time.Now().UTC(),
+	}
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		expected       string
+		expectedErr    error
+	}{
+		{
+			name: "CreateComment_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows([]string{"username"}).AddRow("author").ToPgxRows()
+				pgxRows.Next()
+
+				mockPool.EXPECT().QueryRow(gomock.Any(), createComment,
+					comment.Id, comment.NoteId, comment.Parent, comment.AuthorId, comment.BlockId, comment.Text, comment.Created, comment.Updated, comment.Resolved,
+				).Return(pgxRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			expected:    "author",
+			expectedErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			got, err := repo.CreateComment(context.Background(), comment)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestNotePostgres_DeleteComment(t *testing.T) {
+	commentId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		err            error
+	}{
+		{
+			name: "DeleteComment_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				mockPool.EXPECT().Exec(gomock.Any(), deleteComment, commentId).Return(nil, nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			err: nil,
+		},
+		{
+			name: "DeleteComment_Fail",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				mockPool.EXPECT().Exec(gomock.Any(), deleteComment, commentId).Return(nil, pgx.ErrNoRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+			err: pgx.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			err := repo.DeleteComment(context.Background(), commentId)
+
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestNotePostgres_SetCommentResolved(t *testing.T) {
+	commentId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		err            error
+	}{
+		{
+			name: "SetCommentResolved_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				mockPool.EXPECT().Exec(gomock.Any(), setCommentResolved, true, commentId).Return(nil, nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			err: nil,
+		},
+		{
+			name: "SetCommentResolved_Fail",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				mockPool.EXPECT().Exec(gomock.Any(), setCommentResolved, true, commentId).Return(nil, pgx.ErrNoRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+			err: pgx.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			err := repo.SetCommentResolved(context.Background(), commentId, true)
+
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestNotePostgres_FireReminders(t *testing.T) {
+	now := time.Now().UTC()
+	reminder := models.Reminder{
+		Id:        uuid.NewV4(),
+		NoteId:    uuid.NewV4(),
+		NoteTitle: "title",
+		UserId:    uuid.NewV4(),
+		RemindAt:  now,
+		Repeat:    models.RepeatDaily,
+		Fired:     true,
+		Created:   now,
+	}
+	columns := []string{"id", "note_id", "title", "user_id", "remind_at", "repeat", "fired", "created"}
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		expected       []models.Reminder
+		expectedErr    error
+	}{
+		{
+			name: "FireReminders_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows(columns).AddRow(
+					reminder.Id, reminder.NoteId, reminder.NoteTitle, reminder.UserId, reminder.RemindAt, reminder.Repeat, reminder.Fired, reminder.Created,
+				).ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), fireReminders, now).Return(pgxRows, nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			expected:    []models.Reminder{reminder},
+			expectedErr: nil,
+		},
+		{
+			name: "FireReminders_Fail",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows(columns).ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), fireReminders, now).Return(pgxRows, pgx.ErrNoRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+			expected:    []models.Reminder{},
+			expectedErr: pgx.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			got, err := repo.FireReminders(context.Background(), now)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestNotePostgres_CreateReminder(t *testing.T) {
+	reminder := models.Reminder{
+		Id:       uuid.NewV4(),
+		NoteId:   uuid.NewV4(),
+		UserId:   uuid.NewV4(),
+		RemindAt: time.Now().UTC(),
+		Created:  time.Now().UTC(),
+	}
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		expected       string
+		expectedErr    error
+	}{
+		{
+			name: "CreateReminder_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows([]string{"title"}).AddRow("title").ToPgxRows()
+				pgxRows.Next()
+
+				mockPool.EXPECT().QueryRow(gomock.Any(), createReminder,
+					reminder.Id, reminder.NoteId, reminder.UserId, reminder.RemindAt, reminder.Repeat, reminder.Fired, reminder.Created,
+				).Return(pgxRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			expected:    "title",
+			expectedErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			got, err := repo.CreateReminder(context.Background(), reminder)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestNotePostgres_RescheduleReminder(t *testing.T) {
+	reminderId := uuid.NewV4()
+	remindAt := time.Now().UTC()
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		err            error
+	}{
+		{
+			name: "RescheduleReminder_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				mockPool.EXPECT().Exec(gomock.Any(), rescheduleReminder, remindAt, reminderId).Return(nil, nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			err: nil,
+		},
+		{
+			name: "RescheduleReminder_Fail",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				mockPool.EXPECT().Exec(gomock.Any(), rescheduleReminder, remindAt, reminderId).Return(nil, pgx.ErrNoRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+			err: pgx.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			err := repo.RescheduleReminder(context.Background(), reminderId, remindAt)
+
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestNotePostgres_ArchiveNote(t *testing.T) {
+	noteId := uuid.NewV4()
+	userId := uuid.NewV4()
+	archivedAt := time.Now().UTC()
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		err            error
+	}{
+		{
+			name: "ArchiveNote_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				mockPool.EXPECT().Exec(gomock.Any(), archiveNote, noteId, userId, archivedAt).Return(nil, nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			err: nil,
+		},
+		{
+			name: "ArchiveNote_Fail",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				mockPool.EXPECT().Exec(gomock.Any(), archiveNote, noteId, userId, archivedAt).Return(nil, pgx.ErrNoRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+			err: pgx.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			err := repo.ArchiveNote(context.Background(), noteId, userId, archivedAt)
+
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestNotePostgres_UnarchiveNote(t *testing.T) {
+	noteId := uuid.NewV4()
+	userId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		err            error
+	}{
+		{
+			name: "UnarchiveNote_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				mockPool.EXPECT().Exec(gomock.Any(), unarchiveNote, noteId, userId).Return(nil, nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			err: nil,
+		},
+		{
+			name: "UnarchiveNote_Fail",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				mockPool.EXPECT().Exec(gomock.Any(), unarchiveNote, noteId, userId).Return(nil, pgx.ErrNoRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+			err: pgx.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			err := repo.UnarchiveNote(context.Background(), noteId, userId)
+
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestNotePostgres_SetNotePositions(t *testing.T) {
+	userId := uuid.NewV4()
+	notes := []uuid.UUID{uuid.NewV4(), uuid.NewV4()}
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		err            error
+	}{
+		{
+			name: "SetNotePositions_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				mockPool.EXPECT().Exec(gomock.Any(), setNotePositions, userId, notes).Return(nil, nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			err: nil,
+		},
+		{
+			name: "SetNotePositions_Fail",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				mockPool.EXPECT().Exec(gomock.Any(), setNotePositions, userId, notes).Return(nil, pgx.ErrNoRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+			err: pgx.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			err := repo.SetNotePositions(context.Background(), userId, notes)
+
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestNotePostgres_GetNotePositions(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	columns := []string{"note_id", "position"}
+
+	tests := []struct {
+		name           string
+		mockRepoAction func(*pgxpoolmock.MockPgxPool, *mock_metrics.MockDBMetrics)
+		expected       map[uuid.UUID]int
+		expectedErr    error
+	}{
+		{
+			name: "GetNotePositions_Success",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows(columns).AddRow(noteId, 1).ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), getNotePositions, userId).Return(pgxRows, nil)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+			},
+			expected:    map[uuid.UUID]int{noteId: 1},
+			expectedErr: nil,
+		},
+		{
+			name: "GetNotePositions_Fail",
+			mockRepoAction: func(mockPool *pgxpoolmock.MockPgxPool, metr *mock_metrics.MockDBMetrics) {
+				pgxRows := pgxpoolmock.NewRows(columns).ToPgxRows()
+
+				mockPool.EXPECT().Query(gomock.Any(), getNotePositions, userId).Return(pgxRows, pgx.ErrNoRows)
+				metr.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+				metr.EXPECT().IncreaseErrors(gomock.Any()).Return()
+			},
+			expected:    map[uuid.UUID]int{},
+			expectedErr: pgx.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			tt.mockRepoAction(mockPool, mockMetrics)
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			got, err := repo.GetNotePositions(context.Background(), userId)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestNoteRepo_ReadAllNotesCursor(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	created := time.Now().UTC()
+	updated := time.Now().UTC()
+	cursor := &models.NoteCursor{Sort: models.SortTitle, Title: "a", OwnerId: userId, Updated: updated, Id: uuid.NewV4()}
+	columns := []string{"id", "data", "create_time", "update_time", "owner_id", "parent", "children", "tags", "collaborators", "icon", "header", "favorite", "is_public", "archived", "sort_position", "sort_title"}
+
+	ctrl := gomock.NewController(t)
+	mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+	mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+	defer ctrl.Finish()
+
+	pgxRows := pgxpoolmock.NewRows(columns).AddRow(noteId, "", created, updated, userId, uuid.UUID{}, []uuid.UUID{}, []string{}, []uuid.UUID{}, "", "", true, false, false, 0, "b").ToPgxRows()
+	mockPool.EXPECT().Query(gomock.Any(), getAllNotes, userId, int64(1), int64(0), []string{}, models.ArchiveFilterActive, models.SortTitle,
+		cursor.Favorite, cursor.Position, cursor.Created, cursor.Title, cursor.OwnerId, cursor.Updated, cursor.Id, models.TagModeAll, []string{}).Return(pgxRows, nil)
+	mockMetrics.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+
+	repo := CreateNotePostgres(mockPool, mockMetrics)
+	notes, last, err := repo.ReadAllNotes(context.Background(), userId, int64(1), int64(0), models.TagFilter{Mode: models.TagModeAll, Included: []string{}, Excluded: []string{}}, models.ArchiveFilterActive, models.SortTitle, cursor)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(notes))
+	assert.Equal(t, &models.NoteCursor{
+		Sort:     models.SortTitle,
+		Favorite: true,
+		Created:  created,
+		Title:    "b",
+		OwnerId:  userId,
+		Updated:  updated,
+		Id:       noteId,
+	}, last)
+}
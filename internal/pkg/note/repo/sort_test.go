@@ -0,0 +1,53 @@
+package repo
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/models"
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSorters(t *testing.T) {
+	tests := []struct {
+		name string
+		sort string
+		want string
+	}{
+		{
+			name: "updated",
+			sort: models.SortUpdated,
+			want: `[{"update_time":{"order":"desc"}},{"id":{"order":"asc"}}]`,
+		},
+		{
+			name: "owner",
+			sort: models.SortOwner,
+			want: `[{"_script":{"order":"asc","script":{"lang":"painless","params":{"userID":"00000000-0000-0000-0000-000000000000"},"source":"doc['owner_id'].value == params.userID ? 0 : 1"},"type":"number"}},{"update_time":{"order":"desc"}},{"id":{"order":"asc"}}]`,
+		},
+		{
+			name: "created",
+			sort: models.SortCreated,
+			want: `[{"create_time":{"order":"desc"}},{"update_time":{"order":"desc"}},{"id":{"order":"asc"}}]`,
+		},
+		{
+			name: "title",
+			sort: models.SortTitle,
+			want: `[{"sort_title":{"missing":"_last","order":"asc"}},{"update_time":{"order":"desc"}},{"id":{"order":"asc"}}]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sources := make([]interface{}, 0)
+			for _, sorter := range getSorters(uuid.UUID{}, tt.sort, nil) {
+				source, err := sorter.Source()
+				assert.NoError(t, err)
+				sources = append(sources, source)
+			}
+
+			got, err := json.Marshal(sources)
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(got))
+		})
+	}
+}
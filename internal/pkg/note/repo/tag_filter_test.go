@@ -0,0 +1,288 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/driftprogramming/pgxpoolmock"
+	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/models"
+	mock_metrics "github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/metrics/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/olivere/elastic/v7"
+	"github.com/satori/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// tagFilterSample - теги заметки и ожидаемый результат фильтра
+type tagFilterSample struct {
+	tags  []string
+	match bool
+}
+
+// общие случаи фильтра по тегам для postgres и elastic
+var tagFilterTests = []struct {
+	name     string
+	tags     []string
+	mode     string
+	included []string
+	excluded []string
+	elastic  string
+	samples  []tagFilterSample
+}{
+	{
+		name:     "empty",
+		tags:     []string{},
+		mode:     models.TagModeAll,
+		included: []string{},
+		excluded: []string{},
+		elastic:  `{"bool":{}}`,
+		samples: []tagFilterSample{
+			{tags: []string{}, match: true},
+			{tags: []string{"first"}, match: true},
+		},
+	},
+	{
+		name:     "all",
+		tags:     []string{"first", "second"},
+		mode:     models.TagModeAll,
+		included: []string{"first", "second"},
+		excluded: []string{},
+		elastic:  `{"bool":{"filter":[{"bool":{"should":[{"term":{"tags":"first"}},{"prefix":{"tags":"first/"}}]}},{"bool":{"should":[{"term":{"tags":"second"}},{"prefix":{"tags":"second/"}}]}}]}}`,
+		samples: []tagFilterSample{
+			{tags: []string{"first", "second"}, match: true},
+			{tags: []string{"first/x", "second"}, match: true},
+			{tags: []string{"first"}, match: false},
+			{tags: []string{"firstx", "second"}, match: false},
+			{tags: []string{}, match: false},
+		},
+	},
+	{
+		name:     "any",
+		tags:     []string{"first", "second"},
+		mode:     models.TagModeAny,
+		included: []string{"first", "second"},
+		excluded: []string{},
+		elastic:  `{"bool":{"filter":{"bool":{"should":[{"bool":{"should":[{"term":{"tags":"first"}},{"prefix":{"tags":"first/"}}]}},{"bool":{"should":[{"term":{"tags":"second"}},{"prefix":{"tags":"second/"}}]}}]}}}}`,
+		samples: []tagFilterSample{
+			{tags: []string{"first"}, match: true},
+			{tags: []string{"second/y"}, match: true},
+			{tags: []string{"third"}, match: false},
+			{tags: []string{"firstly"}, match: false},
+		},
+	},
+	{
+		name:     "none",
+		tags:     []string{"first", "second"},
+		mode:     models.TagModeNone,
+		included: []string{"first", "second"},
+		excluded: []string{},
+		elastic:  `{"bool":{"must_not":{"bool":{"should":[{"bool":{"should":[{"term":{"tags":"first"}},{"prefix":{"tags":"first/"}}]}},{"bool":{"should":[{"term":{"tags":"second"}},{"prefix":{"tags":"second/"}}]}}]}}}}`,
+		samples: []tagFilterSample{
+			{tags: []string{"third"}, match: true},
+			{tags: []string{}, match: true},
+			{tags: []string{"first/x"}, match: false},
+			{tags: []string{"second"}, match: false},
+		},
+	},
+	{
+		name:     "all with excluded",
+		tags:     []string{"first", "-second"},
+		mode:     models.TagModeAll,
+		included: []string{"first"},
+		excluded: []string{"second"},
+		elastic:  `{"bool":{"filter":{"bool":{"should":[{"term":{"tags":"first"}},{"prefix":{"tags":"first/"}}]}},"must_not":{"bool":{"should":{"bool":{"should":[{"term":{"tags":"second"}},{"prefix":{"tags":"second/"}}]}}}}}}`,
+		samples: []tagFilterSample{
+			{tags: []string{"first"}, match: true},
+			{tags: []string{"first/x", "third"}, match: true},
+			{tags: []string{"first", "second"}, match: false},
+			{tags: []string{"first", "second/x"}, match: false},
+			{tags: []string{"second"}, match: false},
+		},
+	},
+	{
+		name:     "nested",
+		tags:     []string{"work/clients", "-work/clients/acme"},
+		mode:     models.TagModeAll,
+		included: []string{"work/clients"},
+		excluded: []string{"work/clients/acme"},
+		elastic:  `{"bool":{"filter":{"bool":{"should":[{"term":{"tags":"work/clients"}},{"prefix":{"tags":"work/clients/"}}]}},"must_not":{"bool":{"should":{"bool":{"should":[{"term":{"tags":"work/clients/acme"}},{"prefix":{"tags":"work/clients/acme/"}}]}}}}}}`,
+		samples: []tagFilterSample{
+			{tags: []string{"work/clients"}, match: true},
+			{tags: []string{"work/clients/other"}, match: true},
+			{tags: []string{"work/clients/acme"}, match: false},
+			{tags: []string{"work/clients/b", "work/clients/acme/x"}, match: false},
+			{tags: []string{"work"}, match: false},
+		},
+	},
+	{
+		name:     "only excluded",
+		tags:     []string{"-first", "-second"},
+		mode:     models.TagModeAny,
+		included: []string{},
+		excluded: []string{"first", "second"},
+		elastic:  `{"bool":{"must_not":{"bool":{"should":[{"bool":{"should":[{"term":{"tags":"first"}},{"prefix":{"tags":"first/"}}]}},{"bool":{"should":[{"term":{"tags":"second"}},{"prefix":{"tags":"second/"}}]}}]}}}}`,
+		samples: []tagFilterSample{
+			{tags: []string{}, match: true},
+			{tags: []string{"third"}, match: true},
+			{tags: []string{"first"}, match: false},
+			{tags: []string{"second/x"}, match: false},
+		},
+	},
+}
+
+func TestNoteRepo_ReadAllNotesTagFilter(t *testing.T) {
+	columns := []string{"id", "data", "create_time", "update_time", "owner_id", "parent", "children", "tags", "collaborators", "icon", "header", "favorite", "is_public", "archived", "sort_position", "sort_title"}
+
+	for _, tt := range tagFilterTests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockPool := pgxpoolmock.NewMockPgxPool(ctrl)
+			mockMetrics := mock_metrics.NewMockDBMetrics(ctrl)
+			defer ctrl.Finish()
+
+			userId := uuid.NewV4()
+			filter, err := models.ParseTagFilter(tt.tags, tt.mode)
+			assert.Nil(t, err)
+
+			pgxRows := pgxpoolmock.NewRows(columns).AddRow(uuid.NewV4(), "", time.Now(), time.Time{}, userId, uuid.UUID{}, []uuid.UUID{}, []string{}, []uuid.UUID{}, "", "", false, false, false, 0, "").ToPgxRows()
+			mockPool.EXPECT().Query(gomock.Any(), getAllNotes, userId, int64(1), int64(0), tt.included, models.ArchiveFilterActive, models.SortUpdated,
+				nil, nil, nil, nil, nil, nil, nil, tt.mode, tt.excluded).Return(pgxRows, nil)
+			mockMetrics.EXPECT().ObserveResponseTime(gomock.Any(), gomock.Any()).Return()
+
+			repo := CreateNotePostgres(mockPool, mockMetrics)
+			_, _, err = repo.ReadAllNotes(context.Background(), userId, int64(1), int64(0), filter, models.ArchiveFilterActive, models.SortUpdated, nil)
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestWithTagFilter(t *testing.T) {
+	for _, tt := range tagFilterTests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := models.ParseTagFilter(tt.tags, tt.mode)
+			assert.Nil(t, err)
+
+			source, err := withTagFilter(elastic.NewBoolQuery(), filter).Source()
+			assert.Nil(t, err)
+
+			got, err := json.Marshal(source)
+			assert.Nil(t, err)
+			assert.JSONEq(t, tt.elastic, string(got))
+		})
+	}
+}
+
+func TestTagFilterSamples(t *testing.T) {
+	for _, tt := range tagFilterTests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := models.ParseTagFilter(tt.tags, tt.mode)
+			assert.Nil(t, err)
+
+			source, err := withTagFilter(elastic.NewBoolQuery(), filter).Source()
+			assert.Nil(t, err)
+			query := normalizeQuery(t, source)
+
+			for _, sample := range tt.samples {
+				assert.Equal(t, sample.match, matchElastic(t, query, sample.tags), "elastic: %v", sample.tags)
+				assert.Equal(t, sample.match, matchPostgres(sample.tags, filter.Included, filter.Mode, filter.Excluded), "postgres: %v", sample.tags)
+				assert.Equal(t, sample.match, filter.Matches(sample.tags), "filter: %v", sample.tags)
+			}
+		})
+	}
+}
+
+// matchPostgres - зеркало условий по тегам из getAllNotes: $4 - включенные теги, $14 - режим, $15 - исключенные
+func matchPostgres(noteTags []string, included []string, mode string, excluded []string) bool {
+	// tagMatches
+	matches := func(nt string, ft string) bool {
+		return nt == ft || strings.HasPrefix(nt, ft+"/")
+	}
+	exists := func(nts []string, fts []string) bool {
+		for _, nt := range nts {
+			for _, ft := range fts {
+				if matches(nt, ft) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	includedMatch := len(included) == 0
+	if !includedMatch {
+		switch mode {
+		case "any":
+			includedMatch = exists(noteTags, included)
+		case "none":
+			includedMatch = !exists(noteTags, included)
+		default:
+			includedMatch = true
+			for _, ft := range included {
+				if !exists(noteTags, []string{ft}) {
+					includedMatch = false
+				}
+			}
+		}
+	}
+
+	return includedMatch && (len(excluded) == 0 || !exists(noteTags, excluded))
+}
+
+func normalizeQuery(t *testing.T, source interface{}) interface{} {
+	data, err := json.Marshal(source)
+	assert.Nil(t, err)
+
+	var query interface{}
+	assert.Nil(t, json.Unmarshal(data, &query))
+	return query
+}
+
+func queryClauses(value interface{}) []interface{} {
+	switch clauses := value.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		return clauses
+	default:
+		return []interface{}{clauses}
+	}
+}
+
+// matchElastic - вычисляет запрос elastic из bool, term и prefix по полю tags на тегах заметки
+func matchElastic(t *testing.T, query interface{}, tags []string) bool {
+	for kind, body := range query.(map[string]interface{}) {
+		clause := body.(map[string]interface{})
+		switch kind {
+		case "term":
+			return slices.Contains(tags, clause["tags"].(string))
+		case "prefix":
+			prefix := clause["tags"].(string)
+			return slices.ContainsFunc(tags, func(tag string) bool { return strings.HasPrefix(tag, prefix) })
+		case "bool":
+			required := append(queryClauses(clause["filter"]), queryClauses(clause["must"])...)
+			for _, subquery := range required {
+				if !matchElastic(t, subquery, tags) {
+					return false
+				}
+			}
+			for _, subquery := range queryClauses(clause["must_not"]) {
+				if matchElastic(t, subquery, tags) {
+					return false
+				}
+			}
+			// без filter и must хотя бы одно условие should обязательно
+			should := queryClauses(clause["should"])
+			if len(should) == 0 || len(required) > 0 {
+				return true
+			}
+			return slices.ContainsFunc(should, func(subquery interface{}) bool { return matchElastic(t, subquery, tags) })
+		}
+	}
+
+	t.Fatalf("unsupported query: %v", query)
+	return false
+}
@@ -3,7 +3,10 @@ package usecase
 import (
 	"context"
 	"errors"
+	"io"
 	"log/slog"
+	"os"
+	"path"
 	"slices"
 	"sync"
 	"time"
@@ -11,7 +14,12 @@ import (
 
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/config"
 
+	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/utils/code"
+	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/utils/diff"
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/utils/log"
+	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/utils/passhash"
+	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/utils/placeholders"
+	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/utils/references"
 	"github.com/satori/uuid"
 
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/models"
@@ -36,20 +44,41 @@ func CreateNoteUsecase(baseRepo note.NoteBaseRepo, searchRepo note.NoteSearchRep
 	}
 }
 
-func (uc *NoteUsecase) GetAllNotes(ctx context.Context, userId uuid.UUID, count int64, offset int64, searchValue string, tags []string) ([]models.NoteResponse, error) {
+func (uc *NoteUsecase) GetAllNotes(ctx context.Context, userId uuid.UUID, count int64, offset int64, searchValue string, tags models.TagFilter, archived string, sort string, cursor string) ([]models.NoteResponse, string, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
 	var res []models.NoteResponse
+	var last *models.NoteCursor
 	var err error
 
-	if utf8.RuneCountInString(searchValue) < uc.cfg.ElasticSearchValueMinLength {
-		res, err = uc.baseRepo.ReadAllNotes(ctx, userId, count, offset, tags)
+	isSearch := utf8.RuneCountInString(searchValue) >= uc.cfg.ElasticSearchValueMinLength
+
+	var pageCursor *models.NoteCursor
+	if cursor != "" {
+		decoded, err := models.DecodeNoteCursor(cursor)
+		if err != nil || decoded.Sort != sort || (decoded.SearchAfter != nil) != isSearch {
+			logger.Error(note.ErrInvalidCursor)
+			return res, "", errors.New(note.ErrInvalidCursor)
+		}
+		pageCursor = &decoded
+	}
+
+	if !isSearch {
+		res, last, err = uc.baseRepo.ReadAllNotes(ctx, userId, count, offset, tags, archived, sort, pageCursor)
 	} else {
-		res, err = uc.searchRepo.SearchNotes(ctx, userId, count, offset, searchValue, tags)
+		var positions map[uuid.UUID]int
+		if sort == models.SortManual {
+			positions, err = uc.baseRepo.GetNotePositions(ctx, userId)
+			if err != nil {
+				logger.Error(err.Error())
+				return res, "", err
+			}
+		}
+		res, last, err = uc.searchRepo.SearchNotes(ctx, userId, count, offset, searchValue, tags, archived, sort, positions, pageCursor)
 	}
 	if err != nil {
 		logger.Error(err.Error())
-		return res, err
+		return res, "", err
 	}
 
 	for i, response := range res {
@@ -61,8 +90,14 @@ func (uc *NoteUsecase) GetAllNotes(ctx context.Context, userId uuid.UUID, count
 		res[i].OwnerInfo = info
 	}
 
+	// неполная страница — последняя
+	nextCursor := ""
+	if last != nil && int64(len(res)) == count {
+		nextCursor = last.Encode()
+	}
+
 	logger.Info("success")
-	return res, nil
+	return res, nextCursor, nil
 }
 
 func (uc *NoteUsecase) GetNote(ctx context.Context, noteId uuid.UUID, userId uuid.UUID) (models.NoteResponse, error) {
@@ -90,17 +125,34 @@ func (uc *NoteUsecase) GetNote(ctx context.Context, noteId uuid.UUID, userId uui
 	return resultNote, nil
 }
 
-func (uc *NoteUsecase) GetPublicNote(ctx context.Context, noteId uuid.UUID) (models.NoteResponse, error) {
+func (uc *NoteUsecase) checkShareLink(ctx context.Context, noteID uuid.UUID, token string, password string) error {
+	link, err := uc.baseRepo.GetShareLink(ctx, token, noteID)
+	if err != nil {
+		return errors.New("not found")
+	}
+
+	if link.ExpiresAt != nil && link.ExpiresAt.Before(time.Now().UTC()) {
+		return errors.New(note.ErrShareLinkExpired)
+	}
+
+	if link.PasswordHash != "" && !passhash.Check(link.PasswordHash, password) {
+		return errors.New(note.ErrWrongPassword)
+	}
+
+	return nil
+}
+
+func (uc *NoteUsecase) GetPublicNote(ctx context.Context, noteId uuid.UUID, token string, password string) (models.NoteResponse, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	resultNote, err := uc.baseRepo.ReadPublicNote(ctx, noteId)
-	if err != nil {
+	if err := uc.checkShareLink(ctx, noteId, token, password); err != nil {
 		logger.Error(err.Error())
-		return models.NoteResponse{}, errors.New("not found")
+		return models.NoteResponse{}, err
 	}
 
-	if !resultNote.Public {
-		logger.Error("not public")
+	resultNote, err := uc.baseRepo.ReadPublicNote(ctx, noteId)
+	if err != nil {
+		logger.Error(err.Error())
 		return models.NoteResponse{}, errors.New("not found")
 	}
 
@@ -149,7 +201,7 @@ func (uc *NoteUsecase) CreateNote(ctx context.Context, userId uuid.UUID, noteDat
 	return newNote, nil
 }
 
-func (uc *NoteUsecase) UpdateNote(ctx context.Context, noteId uuid.UUID, userId uuid.UUID, noteData string) (models.Note, error) {
+func (uc *NoteUsecase) UpdateNote(ctx context.Context, noteId uuid.UUID, userId uuid.UUID, noteData string, version int64) (models.Note, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
 	updatedNote, err := uc.baseRepo.ReadNote(ctx, noteId, userId)
@@ -158,9 +210,14 @@ func (uc *NoteUsecase) UpdateNote(ctx context.Context, noteId uuid.UUID, userId
 		return models.Note{}, errors.New("not found")
 	}
 
-	if updatedNote.OwnerId != userId && !slices.Contains(updatedNote.Collaborators, userId) {
-		logger.Error("not owner and not collaborator")
-		return models.Note{}, errors.New("not found")
+	if err := uc.checkRole(ctx, updatedNote.Note, userId, models.RoleEditor); err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, err
+	}
+
+	if err := checkVersion(updatedNote.Note, version); err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, err
 	}
 
 	if updatedNote.Data == noteData {
@@ -171,10 +228,11 @@ func (uc *NoteUsecase) UpdateNote(ctx context.Context, noteId uuid.UUID, userId
 	updatedNote.UpdateTime = time.Now().UTC()
 	updatedNote.Data = noteData
 
-	if err := uc.baseRepo.UpdateNote(ctx, updatedNote.Note); err != nil {
+	if err := uc.baseRepo.UpdateNote(ctx, updatedNote.Note, userId); err != nil {
 		logger.Error(err.Error())
 		return models.Note{}, err
 	}
+	updatedNote.Version++
 
 	uc.wg.Add(1)
 	go func() {
@@ -182,6 +240,11 @@ func (uc *NoteUsecase) UpdateNote(ctx context.Context, noteId uuid.UUID, userId
 		if err := uc.searchRepo.UpdateNote(ctx, updatedNote.Note); err != nil {
 			logger.Error(err.Error())
 		}
+
+		targets, titles := getLinks(noteData)
+		if err := uc.baseRepo.SetNoteLinks(ctx, noteId, userId, targets, titles); err != nil {
+			logger.Error(err.Error())
+		}
 	}()
 	uc.wg.Wait()
 
@@ -189,16 +252,72 @@ func (uc *NoteUsecase) UpdateNote(ctx context.Context, noteId uuid.UUID, userId
 	return updatedNote.Note, nil
 }
 
+// getLinks - ссылки на другие заметки из содержимого: id из data-noteid и заголовки из [[Заголовок]]
+func getLinks(data string) ([]uuid.UUID, []string) {
+	noteIDs := references.Notes(data)
+	targets := make([]uuid.UUID, 0, len(noteIDs))
+	for _, id := range noteIDs {
+		if target, err := uuid.FromString(id); err == nil {
+			targets = append(targets, target)
+		}
+	}
+
+	return targets, references.WikiTitles(data)
+}
+
+func (uc *NoteUsecase) GetBacklinks(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) ([]models.NoteLink, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	resultNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, errors.New("not found")
+	}
+
+	if err := uc.checkRole(ctx, resultNote.Note, userID, models.RoleViewer); err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	backlinks, err := uc.baseRepo.GetBacklinks(ctx, noteID, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return backlinks, nil
+}
+
+func (uc *NoteUsecase) GetNoteGraph(ctx context.Context, userID uuid.UUID) (models.NoteGraph, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	graph, err := uc.baseRepo.GetNoteGraph(ctx, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.NoteGraph{}, err
+	}
+
+	logger.Info("success")
+	return graph, nil
+}
+
 func (uc *NoteUsecase) DeleteNote(ctx context.Context, noteId uuid.UUID, ownerId uuid.UUID) error {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
 	deletedNote, err := uc.baseRepo.ReadNote(ctx, noteId, ownerId)
-	if err != nil || deletedNote.OwnerId != ownerId {
+	if err != nil {
 		logger.Error(err.Error())
 		return err
 	}
 
-	if err := uc.baseRepo.DeleteNote(ctx, noteId); err != nil {
+	if deletedNote.OwnerId != ownerId {
+		logger.Error("not owner")
+		return errors.New("not found")
+	}
+
+	trashedIDs, err := uc.baseRepo.TrashNote(ctx, noteId, ownerId, time.Now().UTC())
+	if err != nil {
 		logger.Error(err.Error())
 		return err
 	}
@@ -215,8 +334,10 @@ func (uc *NoteUsecase) DeleteNote(ctx context.Context, noteId uuid.UUID, ownerId
 	go func() {
 		defer uc.wg.Done()
 
-		if err := uc.searchRepo.DeleteNote(ctx, noteId); err != nil {
-			logger.Error(err.Error())
+		for _, id := range trashedIDs {
+			if err := uc.searchRepo.DeleteNote(ctx, id); err != nil {
+				logger.Error(err.Error())
+			}
 		}
 
 		if deletedNote.Parent != emptyID {
@@ -231,71 +352,53 @@ func (uc *NoteUsecase) DeleteNote(ctx context.Context, noteId uuid.UUID, ownerId
 	return nil
 }
 
-func (uc *NoteUsecase) getDepth(ctx context.Context, parentParentID uuid.UUID, currentDepth int, userId uuid.UUID) (int, error) {
-	emptyID := uuid.UUID{}
-	if parentParentID == emptyID {
-		return currentDepth, nil
-	}
+func (uc *NoteUsecase) GetTrash(ctx context.Context, userID uuid.UUID, count int64, offset int64) ([]models.TrashedNote, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	parent, err := uc.baseRepo.ReadNote(ctx, parentParentID, userId)
+	result, err := uc.baseRepo.ReadTrash(ctx, userID, count, offset)
 	if err != nil {
-		return -1, err
+		logger.Error(err.Error())
+		return []models.TrashedNote{}, err
 	}
 
-	return uc.getDepth(ctx, parent.Parent, currentDepth+1, userId)
+	logger.Info("success")
+	return result, nil
 }
 
-func (uc *NoteUsecase) CreateSubNote(ctx context.Context, userId uuid.UUID, noteData string, parentID uuid.UUID) (models.Note, error) {
+func (uc *NoteUsecase) RestoreNote(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) (models.Note, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	newNote := models.Note{
-		Id:            uuid.NewV4(),
-		Data:          noteData,
-		CreateTime:    time.Now().UTC(),
-		UpdateTime:    time.Now().UTC(),
-		OwnerId:       userId,
-		Parent:        parentID,
-		Children:      []uuid.UUID{},
-		Tags:          []string{},
-		Collaborators: []uuid.UUID{},
-		Favorite:      false,
-	}
-
-	parent, err := uc.baseRepo.ReadNote(ctx, parentID, userId)
+	trashedNote, err := uc.baseRepo.ReadTrashedNote(ctx, noteID)
 	if err != nil {
 		logger.Error(err.Error())
-		return models.Note{}, err
+		return models.Note{}, errors.New("not found")
 	}
 
-	if parent.OwnerId != userId && !slices.Contains(parent.Collaborators, userId) {
-		logger.Error("not owner and not collaborator")
+	if trashedNote.OwnerId != userID {
+		logger.Error("not owner")
 		return models.Note{}, errors.New("not found")
 	}
 
-	if len(parent.Children) >= uc.constraints.MaxSubnotes {
-		logger.Error(note.ErrTooManySubnotes)
-		return models.Note{}, errors.New(note.ErrTooManySubnotes)
+	emptyID := uuid.UUID{}
+	parentID := emptyID
+	if trashedNote.Parent != emptyID {
+		parent, err := uc.baseRepo.ReadNote(ctx, trashedNote.Parent, userID)
+		if err == nil && parent.OwnerId == trashedNote.OwnerId && len(parent.Children) < uc.constraints.MaxSubnotes {
+			parentID = parent.Id
+		} else {
+			logger.Info("original parent is unavailable, restoring as a root note")
+		}
 	}
 
-	depth, err := uc.getDepth(ctx, parent.Parent, 1, userId)
+	// заметка возвращается в children родителя и получает его соавторов тем же запросом
+	restoredIDs, err := uc.baseRepo.RestoreNote(ctx, noteID, parentID)
 	if err != nil {
 		logger.Error(err.Error())
 		return models.Note{}, err
 	}
-	if depth >= uc.constraints.MaxDepth {
-		logger.Error(note.ErrTooDeep)
-		return models.Note{}, errors.New(note.ErrTooDeep)
-	}
-
-	newNote.OwnerId = parent.OwnerId
-	newNote.Collaborators = parent.Collaborators
-
-	if err := uc.baseRepo.CreateNote(ctx, newNote); err != nil {
-		logger.Error(err.Error())
-		return models.Note{}, err
-	}
 
-	if err := uc.baseRepo.AddSubNote(ctx, parentID, newNote.Id); err != nil {
+	restoredNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+	if err != nil {
 		logger.Error(err.Error())
 		return models.Note{}, err
 	}
@@ -303,553 +406,2586 @@ func (uc *NoteUsecase) CreateSubNote(ctx context.Context, userId uuid.UUID, note
 	uc.wg.Add(1)
 	go func() {
 		defer uc.wg.Done()
-		if err := uc.searchRepo.AddSubNote(ctx, parentID, newNote.Id); err != nil {
-			logger.Error(err.Error())
+
+		for _, id := range restoredIDs {
+			restored, err := uc.baseRepo.ReadNote(ctx, id, userID)
+			if err != nil {
+				logger.Error(err.Error())
+				continue
+			}
+			if err := uc.searchRepo.CreateNote(ctx, restored.Note); err != nil {
+				logger.Error(err.Error())
+			}
+		}
+
+		if parentID != emptyID {
+			if err := uc.searchRepo.AddSubNote(ctx, parentID, noteID); err != nil {
+				logger.Error(err.Error())
+			}
+		}
+
+		if len(restoredNote.Collaborators) > 0 {
+			uc.reconcileTags(ctx, restoredIDs)
 		}
 	}()
 	uc.wg.Wait()
 
 	logger.Info("success")
-	return newNote, nil
+	return restoredNote.Note, nil
 }
 
-func (uc *NoteUsecase) addCollaboratorRecursive(ctx context.Context, noteID uuid.UUID, guestID uuid.UUID, userID uuid.UUID) error {
-	currentNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+func (uc *NoteUsecase) purgeNote(ctx context.Context, noteID uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	attaches, err := uc.baseRepo.GetSubtreeAttaches(ctx, noteID)
 	if err != nil {
 		return err
 	}
 
-	_, err = uc.baseRepo.AddCollaborator(ctx, noteID, guestID)
-	if err != nil {
+	if err := uc.baseRepo.DeleteNote(ctx, noteID); err != nil {
 		return err
 	}
 
-	for _, child := range currentNote.Children {
-		if err := uc.addCollaboratorRecursive(ctx, child, guestID, userID); err != nil {
-			return err
+	for _, attach := range attaches {
+		if err := os.Remove(path.Join(os.Getenv("ATTACHES_BASE_PATH"), attach)); err != nil {
+			logger.Error(err.Error())
 		}
 	}
 
 	return nil
 }
 
-func (uc *NoteUsecase) AddCollaborator(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, guestID uuid.UUID) (string, error) {
+func (uc *NoteUsecase) DeleteNotePermanently(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) error {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	currentNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+	trashedNote, err := uc.baseRepo.ReadTrashedNote(ctx, noteID)
 	if err != nil {
 		logger.Error(err.Error())
-		return "", err
+		return errors.New("not found")
 	}
 
-	if currentNote.OwnerId != userID {
+	if trashedNote.OwnerId != userID {
 		logger.Error("not owner")
-		return "", errors.New("not found")
+		return errors.New("not found")
 	}
 
-	emptyID := uuid.UUID{}
-	if currentNote.Parent != emptyID {
-		logger.Error("note has a parent")
-		return "", errors.New("not found")
+	if err := uc.purgeNote(ctx, noteID); err != nil {
+		logger.Error(err.Error())
+		return err
 	}
 
-	if slices.Contains(currentNote.Collaborators, guestID) {
-		logger.Error(note.ErrAlreadyCollaborator)
-		return "", errors.New(note.ErrAlreadyCollaborator)
-	}
+	logger.Info("success")
+	return nil
+}
 
-	if len(currentNote.Collaborators) >= uc.constraints.MaxCollaborators {
-		logger.Error(note.ErrTooManyCollaborators)
-		return "", errors.New(note.ErrTooManyCollaborators)
-	}
+func (uc *NoteUsecase) PurgeTrash(ctx context.Context, before time.Time) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	title, err := uc.baseRepo.AddCollaborator(ctx, noteID, guestID)
+	expired, err := uc.baseRepo.GetExpiredTrash(ctx, before)
 	if err != nil {
 		logger.Error(err.Error())
-		return "", err
+		return err
 	}
 
-	for _, child := range currentNote.Children {
-		if err := uc.addCollaboratorRecursive(ctx, child, guestID, userID); err != nil {
+	for _, noteID := range expired {
+		if err := uc.purgeNote(ctx, noteID); err != nil {
 			logger.Error(err.Error())
-			return "", err
 		}
 	}
 
-	uc.wg.Add(1)
-	go func() {
-		defer uc.wg.Done()
-		if err := uc.searchRepo.AddCollaborator(ctx, noteID, guestID); err != nil {
-			logger.Error(err.Error())
+	logger.Info("success")
+	return nil
+}
+
+func (uc *NoteUsecase) RunTrashPurger(ctx context.Context, cfg config.TrashConfig) {
+	t := time.NewTicker(cfg.PurgePeriod)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-t.C:
+			_ = uc.PurgeTrash(ctx, time.Now().UTC().Add(-cfg.Retention))
+		case <-ctx.Done():
+			return
 		}
-	}()
-	uc.wg.Wait()
+	}
+}
 
-	logger.Info("success")
-	return title, nil
+func (uc *NoteUsecase) getDepth(ctx context.Context, parentParentID uuid.UUID, currentDepth int, userId uuid.UUID) (int, error) {
+	emptyID := uuid.UUID{}
+	if parentParentID == emptyID {
+		return currentDepth, nil
+	}
+
+	parent, err := uc.baseRepo.ReadNote(ctx, parentParentID, userId)
+	if err != nil {
+		return -1, err
+	}
+
+	return uc.getDepth(ctx, parent.Parent, currentDepth+1, userId)
 }
 
-func (uc *NoteUsecase) AddTag(ctx context.Context, tagName string, noteId uuid.UUID, userId uuid.UUID) (models.Note, error) {
+func (uc *NoteUsecase) CreateSubNote(ctx context.Context, userId uuid.UUID, noteData string, parentID uuid.UUID) (models.Note, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	updatedNote, err := uc.baseRepo.ReadNote(ctx, noteId, userId)
+	newNote := models.Note{
+		Id:            uuid.NewV4(),
+		Data:          noteData,
+		CreateTime:    time.Now().UTC(),
+		UpdateTime:    time.Now().UTC(),
+		OwnerId:       userId,
+		Parent:        parentID,
+		Children:      []uuid.UUID{},
+		Tags:          []string{},
+		Collaborators: []uuid.UUID{},
+		Favorite:      false,
+	}
+
+	parent, err := uc.baseRepo.ReadNote(ctx, parentID, userId)
 	if err != nil {
 		logger.Error(err.Error())
 		return models.Note{}, err
 	}
 
-	if updatedNote.OwnerId != userId {
-		logger.Error("not owner")
-		return models.Note{}, errors.New("not found")
+	if err := uc.checkRole(ctx, parent.Note, userId, models.RoleEditor); err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, err
 	}
 
-	if len(updatedNote.Tags) >= uc.constraints.MaxTags {
-		logger.Error(note.ErrTooManyTags)
-		return models.Note{}, errors.New(note.ErrTooManyTags)
+	if len(parent.Children) >= uc.constraints.MaxSubnotes {
+		logger.Error(note.ErrTooManySubnotes)
+		return models.Note{}, errors.New(note.ErrTooManySubnotes)
 	}
 
-	if err := uc.baseRepo.AddTag(ctx, tagName, noteId); err != nil {
+	depth, err := uc.getDepth(ctx, parent.Parent, 1, userId)
+	if err != nil {
 		logger.Error(err.Error())
 		return models.Note{}, err
 	}
+	if depth >= uc.constraints.MaxDepth {
+		logger.Error(note.ErrTooDeep)
+		return models.Note{}, errors.New(note.ErrTooDeep)
+	}
 
-	updatedNote.Tags = append(updatedNote.Tags, tagName)
+	// владелец и соавторы берутся у родителя в базе, а не из прочитанной выше копии
+	newNote, err = uc.baseRepo.CreateSubNote(ctx, newNote)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, err
+	}
 
 	uc.wg.Add(1)
 	go func() {
 		defer uc.wg.Done()
-
-		if err := uc.searchRepo.AddTag(ctx, tagName, noteId); err != nil {
-			logger.Error(err.Error())
-		}
-
-		if err := uc.baseRepo.RememberTag(ctx, tagName, userId); err != nil {
+		if err := uc.searchRepo.AddSubNote(ctx, parentID, newNote.Id); err != nil {
 			logger.Error(err.Error())
 		}
 	}()
 	uc.wg.Wait()
 
 	logger.Info("success")
-	return updatedNote.Note, nil
+	return newNote, nil
+}
+
+func (uc *NoteUsecase) getHeight(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) (int, error) {
+	currentNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+	if err != nil {
+		return -1, err
+	}
+
+	height := 1
+	for _, child := range currentNote.Children {
+		childHeight, err := uc.getHeight(ctx, child, userID)
+		if err != nil {
+			return -1, err
+		}
+		height = max(height, childHeight+1)
+	}
+
+	return height, nil
 }
 
-func (uc *NoteUsecase) DeleteTag(ctx context.Context, tagName string, noteId uuid.UUID, userId uuid.UUID) (models.Note, error) {
+func (uc *NoteUsecase) MoveNote(ctx context.Context, noteID uuid.UUID, parentID uuid.UUID, userID uuid.UUID) (models.Note, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	updatedNote, err := uc.baseRepo.ReadNote(ctx, noteId, userId)
+	movedNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
 	if err != nil {
 		logger.Error(err.Error())
-		return models.Note{}, err
+		return models.Note{}, errors.New("not found")
 	}
 
-	if updatedNote.OwnerId != userId {
+	if movedNote.OwnerId != userID {
 		logger.Error("not owner")
 		return models.Note{}, errors.New("not found")
 	}
 
-	if err := uc.baseRepo.DeleteTag(ctx, tagName, noteId); err != nil {
+	if movedNote.Parent == parentID {
+		logger.Info("note already has this parent")
+		return movedNote.Note, nil
+	}
+
+	// поддерево получает соавторов нового родителя, в корне соавторов нет
+	collaborators := []uuid.UUID{}
+	emptyID := uuid.UUID{}
+	if parentID != emptyID {
+		if parentID == noteID {
+			logger.Error(note.ErrCyclicMove)
+			return models.Note{}, errors.New(note.ErrCyclicMove)
+		}
+
+		parent, err := uc.baseRepo.ReadNote(ctx, parentID, userID)
+		if err != nil {
+			logger.Error(err.Error())
+			return models.Note{}, errors.New("not found")
+		}
+
+		if parent.OwnerId != userID {
+			logger.Error("not owner of parent")
+			return models.Note{}, errors.New("not found")
+		}
+		collaborators = parent.Collaborators
+
+		if len(parent.Children) >= uc.constraints.MaxSubnotes {
+			logger.Error(note.ErrTooManySubnotes)
+			return models.Note{}, errors.New(note.ErrTooManySubnotes)
+		}
+
+		depth := 1
+		for ancestorID := parent.Parent; ancestorID != emptyID; depth++ {
+			if ancestorID == noteID {
+				logger.Error(note.ErrCyclicMove)
+				return models.Note{}, errors.New(note.ErrCyclicMove)
+			}
+
+			ancestor, err := uc.baseRepo.ReadNote(ctx, ancestorID, userID)
+			if err != nil {
+				logger.Error(err.Error())
+				return models.Note{}, err
+			}
+			ancestorID = ancestor.Parent
+		}
+
+		height, err := uc.getHeight(ctx, noteID, userID)
+		if err != nil {
+			logger.Error(err.Error())
+			return models.Note{}, err
+		}
+
+		if depth+height > uc.constraints.MaxDepth {
+			logger.Error(note.ErrTooDeep)
+			return models.Note{}, errors.New(note.ErrTooDeep)
+		}
+	}
+
+	oldParentID := movedNote.Parent
+	movedIDs, err := uc.baseRepo.MoveNote(ctx, noteID, oldParentID, parentID)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, err
+	}
+	movedNote.Parent = parentID
+	movedNote.Collaborators = collaborators
+
+	uc.wg.Add(1)
+	go func() {
+		defer uc.wg.Done()
+
+		if oldParentID != emptyID {
+			if err := uc.searchRepo.RemoveSubNote(ctx, oldParentID, noteID); err != nil {
+				logger.Error(err.Error())
+			}
+		}
+
+		if parentID != emptyID {
+			if err := uc.searchRepo.AddSubNote(ctx, parentID, noteID); err != nil {
+				logger.Error(err.Error())
+			}
+		}
+
+		if err := uc.searchRepo.SetParent(ctx, noteID, parentID); err != nil {
+			logger.Error(err.Error())
+		}
+
+		if err := uc.searchRepo.SetCollaborators(ctx, movedIDs, collaborators); err != nil {
+			logger.Error(err.Error())
+		}
+
+		if len(collaborators) > 0 {
+			uc.reconcileTags(ctx, movedIDs)
+		}
+	}()
+	uc.wg.Wait()
+
+	logger.Info("success")
+	return movedNote.Note, nil
+}
+
+func (uc *NoteUsecase) collectSubtree(ctx context.Context, source models.Note, userID uuid.UUID, withSubnotes bool, newIDs map[string]string, sources *[]models.Note) error {
+	newIDs[source.Id.String()] = uuid.NewV4().String()
+	*sources = append(*sources, source)
+
+	if !withSubnotes {
+		return nil
+	}
+
+	for _, child := range source.Children {
+		childNote, err := uc.baseRepo.ReadNote(ctx, child, userID)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.collectSubtree(ctx, childNote.Note, userID, withSubnotes, newIDs, sources); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func copyAttachFile(sourcePath string, targetPath string) error {
+	source, err := os.Open(sourcePath)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	target, err := os.Create(targetPath)
+	if err != nil {
+		return err
+	}
+	defer target.Close()
+
+	_, err = io.Copy(target, source)
+	return err
+}
+
+func (uc *NoteUsecase) copySubtree(ctx context.Context, source models.Note, parent models.Note, userID uuid.UUID, withSubnotes bool, prepareData func(string) string) (models.Note, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	emptyID := uuid.UUID{}
+	ownerID := userID
+	collaborators := make([]uuid.UUID, 0)
+	if parent.Id != emptyID {
+		ownerID = parent.OwnerId
+		collaborators = parent.Collaborators
+	}
+
+	newIDs := make(map[string]string)
+	sources := make([]models.Note, 0)
+	if err := uc.collectSubtree(ctx, source, userID, withSubnotes, newIDs, &sources); err != nil {
+		return models.Note{}, err
+	}
+
+	attachBasePath := os.Getenv("ATTACHES_BASE_PATH")
+	newAttaches := make([]models.Attach, 0)
+	removeCopiedFiles := func() {
+		for _, attach := range newAttaches {
+			if err := os.Remove(path.Join(attachBasePath, attach.Path)); err != nil {
+				logger.Error(err.Error())
+			}
+		}
+	}
+
+	for _, source := range sources {
+		attaches, err := uc.baseRepo.GetAttaches(ctx, source.Id)
+		if err != nil {
+			removeCopiedFiles()
+			return models.Note{}, err
+		}
+
+		for _, attach := range attaches {
+			newAttach := models.Attach{
+				Id:     uuid.NewV4(),
+				NoteId: uuid.FromStringOrNil(newIDs[source.Id.String()]),
+			}
+			newAttach.Path = newAttach.Id.String() + path.Ext(attach.Path)
+
+			if err := copyAttachFile(path.Join(attachBasePath, attach.Path), path.Join(attachBasePath, newAttach.Path)); err != nil {
+				removeCopiedFiles()
+				return models.Note{}, err
+			}
+
+			newIDs[attach.Id.String()] = newAttach.Id.String()
+			newAttaches = append(newAttaches, newAttach)
+		}
+	}
+
+	copies := make([]models.Note, len(sources))
+	for i, source := range sources {
+		children := make([]uuid.UUID, 0)
+		if withSubnotes {
+			for _, child := range source.Children {
+				children = append(children, uuid.FromStringOrNil(newIDs[child.String()]))
+			}
+		}
+
+		tags := make([]string, 0)
+		if source.OwnerId == ownerID {
+			tags = source.Tags
+		}
+
+		copies[i] = models.Note{
+			Id:            uuid.FromStringOrNil(newIDs[source.Id.String()]),
+			Data:          prepareData(references.Replace(source.Data, newIDs)),
+			CreateTime:    time.Now().UTC(),
+			UpdateTime:    time.Now().UTC(),
+			OwnerId:       ownerID,
+			Parent:        uuid.FromStringOrNil(newIDs[source.Parent.String()]),
+			Children:      children,
+			Tags:          tags,
+			Collaborators: collaborators,
+			Icon:          source.Icon,
+			Header:        source.Header,
+		}
+	}
+	copies[0].Parent = parent.Id
+
+	// при любой ошибке удаляем все созданные копии (вложения удаляются вместе с заметками) и скопированные файлы
+	createdIDs := make([]uuid.UUID, 0, len(copies))
+	rollback := func() {
+		for _, id := range createdIDs {
+			if err := uc.baseRepo.DeleteNote(ctx, id); err != nil {
+				logger.Error(err.Error())
+			}
+		}
+		removeCopiedFiles()
+	}
+
+	// сначала создаём подзаметки, чтобы при ошибке не осталось неполной копии корня
+	for i := len(copies) - 1; i >= 0; i-- {
+		if err := uc.baseRepo.CreateNote(ctx, copies[i]); err != nil {
+			rollback()
+			return models.Note{}, err
+		}
+		createdIDs = append(createdIDs, copies[i].Id)
+	}
+
+	for _, attach := range newAttaches {
+		if err := uc.baseRepo.AddAttach(ctx, attach); err != nil {
+			rollback()
+			return models.Note{}, err
+		}
+	}
+
+	if parent.Id != emptyID {
+		if err := uc.baseRepo.AddSubNote(ctx, parent.Id, copies[0].Id); err != nil {
+			rollback()
+			return models.Note{}, err
+		}
+	}
+
+	uc.wg.Add(1)
+	go func() {
+		defer uc.wg.Done()
+
+		for _, copiedNote := range copies {
+			if err := uc.searchRepo.CreateNote(ctx, copiedNote); err != nil {
+				logger.Error(err.Error())
+			}
+		}
+
+		if parent.Id != emptyID {
+			if err := uc.searchRepo.AddSubNote(ctx, parent.Id, copies[0].Id); err != nil {
+				logger.Error(err.Error())
+			}
+		}
+
+		if len(collaborators) > 0 {
+			uc.reconcileTags(ctx, createdIDs)
+		}
+	}()
+	uc.wg.Wait()
+
+	return copies[0], nil
+}
+
+func (uc *NoteUsecase) ReorderNotes(ctx context.Context, userID uuid.UUID, parentID uuid.UUID, notes []uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	emptyID := uuid.UUID{}
+	if parentID != emptyID {
+		parent, err := uc.baseRepo.ReadNote(ctx, parentID, userID)
+		if err != nil {
+			logger.Error(err.Error())
+			return errors.New("not found")
+		}
+
+		if err := uc.checkRole(ctx, parent.Note, userID, models.RoleViewer); err != nil {
+			logger.Error(err.Error())
+			return err
+		}
+
+		for _, noteID := range notes {
+			if !slices.Contains(parent.Children, noteID) {
+				logger.Error(note.ErrWrongParent)
+				return errors.New(note.ErrWrongParent)
+			}
+		}
+	} else {
+		for _, noteID := range notes {
+			currentNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+			if err != nil {
+				logger.Error(err.Error())
+				return errors.New("not found")
+			}
+
+			if _, err := uc.getRole(ctx, currentNote.Note, userID); err != nil {
+				logger.Error(err.Error())
+				return errors.New("not found")
+			}
+
+			if currentNote.Parent != emptyID {
+				logger.Error(note.ErrWrongParent)
+				return errors.New(note.ErrWrongParent)
+			}
+		}
+	}
+
+	if err := uc.baseRepo.SetNotePositions(ctx, userID, notes); err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	logger.Info("success")
+	return nil
+}
+
+func (uc *NoteUsecase) DuplicateNote(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, withSubnotes bool) (models.Note, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	sourceNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, errors.New("not found")
+	}
+
+	if sourceNote.OwnerId != userID && !slices.Contains(sourceNote.Collaborators, userID) {
+		logger.Error("not owner and not collaborator")
+		return models.Note{}, errors.New("not found")
+	}
+
+	parent := models.NoteResponse{}
+	if sourceNote.Parent != (uuid.UUID{}) && sourceNote.OwnerId == userID {
+		parent, err = uc.baseRepo.ReadNote(ctx, sourceNote.Parent, userID)
+		if err != nil {
+			logger.Error(err.Error())
+			return models.Note{}, err
+		}
+
+		if len(parent.Children) >= uc.constraints.MaxSubnotes {
+			logger.Error(note.ErrTooManySubnotes)
+			return models.Note{}, errors.New(note.ErrTooManySubnotes)
+		}
+	}
+
+	copiedNote, err := uc.copySubtree(ctx, sourceNote.Note, parent.Note, userID, withSubnotes, func(data string) string {
+		return data
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, err
+	}
+
+	logger.Info("success")
+	return copiedNote, nil
+}
+
+func (uc *NoteUsecase) SetTemplate(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, isTemplate bool) (models.Note, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	resultNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, errors.New("not found")
+	}
+
+	if resultNote.OwnerId != userID {
+		logger.Error("not owner")
+		return models.Note{}, errors.New("not found")
+	}
+
+	if err := uc.baseRepo.SetTemplate(ctx, noteID, isTemplate); err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, err
+	}
+
+	logger.Info("success")
+	return resultNote.Note, nil
+}
+
+func (uc *NoteUsecase) GetTemplates(ctx context.Context, userID uuid.UUID) ([]models.Template, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	templates, err := uc.baseRepo.ReadTemplates(ctx, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return []models.Template{}, err
+	}
+
+	logger.Info("success")
+	return templates, nil
+}
+
+func getTemplateValues(username string) map[string]string {
+	now := time.Now()
+	return map[string]string{
+		"date":     now.Format("02.01.2006"),
+		"time":     now.Format("15:04"),
+		"username": username,
+	}
+}
+
+func (uc *NoteUsecase) CreateFromTemplate(ctx context.Context, templateID uuid.UUID, parentID uuid.UUID, userID uuid.UUID, username string) (models.Note, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	template, err := uc.baseRepo.ReadTemplate(ctx, templateID)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, errors.New("not found")
+	}
+
+	values := getTemplateValues(username)
+	emptyID := uuid.UUID{}
+
+	if template.System {
+		var createdNote models.Note
+		if parentID == emptyID {
+			createdNote, err = uc.CreateNote(ctx, userID, placeholders.Fill(template.Data, values))
+		} else {
+			createdNote, err = uc.CreateSubNote(ctx, userID, placeholders.Fill(template.Data, values), parentID)
+		}
+		if err != nil {
+			logger.Error(err.Error())
+			return models.Note{}, err
+		}
+
+		logger.Info("success")
+		return createdNote, nil
+	}
+
+	templateNote, err := uc.baseRepo.ReadNote(ctx, templateID, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, errors.New("not found")
+	}
+
+	if templateNote.OwnerId != userID && !slices.Contains(templateNote.Collaborators, userID) {
+		logger.Error("not owner and not collaborator")
+		return models.Note{}, errors.New("not found")
+	}
+
+	parent := models.NoteResponse{}
+	if parentID != emptyID {
+		parent, err = uc.baseRepo.ReadNote(ctx, parentID, userID)
+		if err != nil {
+			logger.Error(err.Error())
+			return models.Note{}, errors.New("not found")
+		}
+
+		if err := uc.checkRole(ctx, parent.Note, userID, models.RoleEditor); err != nil {
+			logger.Error(err.Error())
+			return models.Note{}, err
+		}
+
+		if len(parent.Children) >= uc.constraints.MaxSubnotes {
+			logger.Error(note.ErrTooManySubnotes)
+			return models.Note{}, errors.New(note.ErrTooManySubnotes)
+		}
+
+		depth, err := uc.getDepth(ctx, parent.Parent, 1, userID)
+		if err != nil {
+			logger.Error(err.Error())
+			return models.Note{}, err
+		}
+
+		height, err := uc.getHeight(ctx, templateID, userID)
+		if err != nil {
+			logger.Error(err.Error())
+			return models.Note{}, err
+		}
+
+		if depth+height > uc.constraints.MaxDepth {
+			logger.Error(note.ErrTooDeep)
+			return models.Note{}, errors.New(note.ErrTooDeep)
+		}
+	}
+
+	createdNote, err := uc.copySubtree(ctx, templateNote.Note, parent.Note, userID, true, func(data string) string {
+		return placeholders.Fill(data, values)
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, err
+	}
+
+	logger.Info("success")
+	return createdNote, nil
+}
+
+func (uc *NoteUsecase) getRole(ctx context.Context, currentNote models.Note, userID uuid.UUID) (string, error) {
+	if currentNote.OwnerId == userID {
+		return models.RoleOwner, nil
+	}
+
+	if !slices.Contains(currentNote.Collaborators, userID) {
+		return "", errors.New("not found")
+	}
+
+	return uc.baseRepo.GetCollaboratorRole(ctx, currentNote.Id, userID)
+}
+
+func (uc *NoteUsecase) checkRole(ctx context.Context, currentNote models.Note, userID uuid.UUID, required string) error {
+	role, err := uc.getRole(ctx, currentNote, userID)
+	if err != nil {
+		return err
+	}
+
+	if !models.HasRole(role, required) {
+		return errors.New(note.ErrNotEnoughRights)
+	}
+
+	return nil
+}
+
+// checkVersion - ожидаемая версия 0 означает, что клиент не передал If-Match и проверка не нужна
+func checkVersion(currentNote models.Note, expected int64) error {
+	if expected != 0 && expected != currentNote.Version {
+		return errors.New(note.ErrVersionConflict)
+	}
+
+	return nil
+}
+
+func (uc *NoteUsecase) addCollaboratorRecursive(ctx context.Context, noteID uuid.UUID, guestID uuid.UUID, userID uuid.UUID, addedTo *[]uuid.UUID) error {
+	currentNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = uc.baseRepo.AddCollaborator(ctx, noteID, guestID)
+	if err != nil {
+		return err
+	}
+	*addedTo = append(*addedTo, noteID)
+
+	for _, child := range currentNote.Children {
+		if err := uc.addCollaboratorRecursive(ctx, child, guestID, userID, addedTo); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (uc *NoteUsecase) addCollaborator(ctx context.Context, rootNote models.Note, guestID uuid.UUID, role string) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	if _, err := uc.baseRepo.AddCollaborator(ctx, rootNote.Id, guestID); err != nil {
+		return err
+	}
+	addedTo := []uuid.UUID{rootNote.Id}
+
+	for _, child := range rootNote.Children {
+		if err := uc.addCollaboratorRecursive(ctx, child, guestID, rootNote.OwnerId, &addedTo); err != nil {
+			return err
+		}
+	}
+
+	// роль хранится только для корня, подзаметки наследуют её
+	if err := uc.baseRepo.SetCollaboratorRole(ctx, rootNote.Id, guestID, role); err != nil {
+		return err
+	}
+
+	uc.wg.Add(1)
+	go func() {
+		defer uc.wg.Done()
+		if err := uc.searchRepo.AddCollaborator(ctx, rootNote.Id, guestID); err != nil {
+			logger.Error(err.Error())
+		}
+
+		uc.reconcileTags(ctx, addedTo)
+	}()
+	uc.wg.Wait()
+
+	return nil
+}
+
+// соавтор добавляется только после того, как примет приглашение
+func (uc *NoteUsecase) AddCollaborator(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, guestID uuid.UUID, role string) (models.Invite, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	currentNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.Invite{}, err
+	}
+
+	if currentNote.OwnerId != userID {
+		logger.Error("not owner")
+		return models.Invite{}, errors.New("not found")
+	}
+
+	emptyID := uuid.UUID{}
+	if currentNote.Parent != emptyID {
+		logger.Error("note has a parent")
+		return models.Invite{}, errors.New("not found")
+	}
+
+	if slices.Contains(currentNote.Collaborators, guestID) {
+		logger.Error(note.ErrAlreadyCollaborator)
+		return models.Invite{}, errors.New(note.ErrAlreadyCollaborator)
+	}
+
+	if len(currentNote.Collaborators) >= uc.constraints.MaxCollaborators {
+		logger.Error(note.ErrTooManyCollaborators)
+		return models.Invite{}, errors.New(note.ErrTooManyCollaborators)
+	}
+
+	now := time.Now().UTC()
+	invite := models.Invite{
+		Id:        uuid.NewV4(),
+		NoteId:    noteID,
+		InviterId: userID,
+		InviteeId: guestID,
+		Role:      role,
+		Created:   now,
+		ExpiresAt: now.Add(uc.constraints.InviteTtl),
+	}
+
+	invite.NoteTitle, err = uc.baseRepo.CreateInvite(ctx, invite)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.Invite{}, err
+	}
+
+	logger.Info("success")
+	return invite, nil
+}
+
+func (uc *NoteUsecase) GetSentInvites(ctx context.Context, userID uuid.UUID) ([]models.Invite, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	invites, err := uc.baseRepo.GetSentInvites(ctx, userID, time.Now().UTC())
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return invites, nil
+}
+
+func (uc *NoteUsecase) GetReceivedInvites(ctx context.Context, userID uuid.UUID) ([]models.Invite, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	invites, err := uc.baseRepo.GetReceivedInvites(ctx, userID, time.Now().UTC())
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return invites, nil
+}
+
+func (uc *NoteUsecase) AcceptInvite(ctx context.Context, inviteID uuid.UUID, userID uuid.UUID) (models.Invite, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	invite, err := uc.baseRepo.GetInvite(ctx, inviteID)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.Invite{}, errors.New("not found")
+	}
+
+	if invite.InviteeId != userID {
+		logger.Error("invite is sent to another user")
+		return models.Invite{}, errors.New("not found")
+	}
+
+	if invite.ExpiresAt.Before(time.Now().UTC()) {
+		logger.Error(note.ErrInviteExpired)
+		return models.Invite{}, errors.New(note.ErrInviteExpired)
+	}
+
+	currentNote, err := uc.baseRepo.ReadNote(ctx, invite.NoteId, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.Invite{}, err
+	}
+
+	if currentNote.OwnerId != invite.InviterId {
+		logger.Error("note owner has changed")
+		return models.Invite{}, errors.New("not found")
+	}
+
+	if slices.Contains(currentNote.Collaborators, userID) {
+		logger.Error(note.ErrAlreadyCollaborator)
+		return models.Invite{}, errors.New(note.ErrAlreadyCollaborator)
+	}
+
+	if len(currentNote.Collaborators) >= uc.constraints.MaxCollaborators {
+		logger.Error(note.ErrTooManyCollaborators)
+		return models.Invite{}, errors.New(note.ErrTooManyCollaborators)
+	}
+
+	if err := uc.addCollaborator(ctx, currentNote.Note, userID, invite.Role); err != nil {
+		logger.Error(err.Error())
+		return models.Invite{}, err
+	}
+
+	if err := uc.baseRepo.DeleteInvite(ctx, inviteID); err != nil {
+		logger.Error(err.Error())
+		return models.Invite{}, err
+	}
+
+	logger.Info("success")
+	return invite, nil
+}
+
+func (uc *NoteUsecase) DeclineInvite(ctx context.Context, inviteID uuid.UUID, userID uuid.UUID) (models.Invite, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	invite, err := uc.baseRepo.GetInvite(ctx, inviteID)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.Invite{}, errors.New("not found")
+	}
+
+	if invite.InviteeId != userID {
+		logger.Error("invite is sent to another user")
+		return models.Invite{}, errors.New("not found")
+	}
+
+	if err := uc.baseRepo.DeleteInvite(ctx, inviteID); err != nil {
+		logger.Error(err.Error())
+		return models.Invite{}, err
+	}
+
+	logger.Info("success")
+	return invite, nil
+}
+
+func (uc *NoteUsecase) RevokeInvite(ctx context.Context, inviteID uuid.UUID, userID uuid.UUID) (models.Invite, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	invite, err := uc.baseRepo.GetInvite(ctx, inviteID)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.Invite{}, errors.New("not found")
+	}
+
+	if invite.InviterId != userID {
+		logger.Error("invite is sent by another user")
+		return models.Invite{}, errors.New("not found")
+	}
+
+	if err := uc.baseRepo.DeleteInvite(ctx, inviteID); err != nil {
+		logger.Error(err.Error())
+		return models.Invite{}, err
+	}
+
+	logger.Info("success")
+	return invite, nil
+}
+
+func (uc *NoteUsecase) SetCollaboratorRole(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, guestID uuid.UUID, role string) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	currentNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	if currentNote.OwnerId != userID {
+		logger.Error("not owner")
+		return errors.New("not found")
+	}
+
+	emptyID := uuid.UUID{}
+	if currentNote.Parent != emptyID {
+		logger.Error("note has a parent")
+		return errors.New("not found")
+	}
+
+	if !slices.Contains(currentNote.Collaborators, guestID) {
+		logger.Error(note.ErrNotCollaborator)
+		return errors.New(note.ErrNotCollaborator)
+	}
+
+	if err := uc.baseRepo.SetCollaboratorRole(ctx, noteID, guestID, role); err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	logger.Info("success")
+	return nil
+}
+
+func (uc *NoteUsecase) removeCollaboratorRecursive(ctx context.Context, noteID uuid.UUID, guestID uuid.UUID, userID uuid.UUID, removedFrom *[]uuid.UUID) error {
+	currentNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := uc.baseRepo.RemoveCollaborator(ctx, noteID, guestID); err != nil {
+		return err
+	}
+	*removedFrom = append(*removedFrom, noteID)
+
+	for _, child := range currentNote.Children {
+		if err := uc.removeCollaboratorRecursive(ctx, child, guestID, userID, removedFrom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (uc *NoteUsecase) removeCollaborator(ctx context.Context, rootNote models.Note, guestID uuid.UUID, userID uuid.UUID) (string, []uuid.UUID, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	title, err := uc.baseRepo.RemoveCollaborator(ctx, rootNote.Id, guestID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	removedFrom := []uuid.UUID{rootNote.Id}
+	for _, child := range rootNote.Children {
+		if err := uc.removeCollaboratorRecursive(ctx, child, guestID, userID, &removedFrom); err != nil {
+			return "", nil, err
+		}
+	}
+
+	uc.wg.Add(1)
+	go func() {
+		defer uc.wg.Done()
+		for _, id := range removedFrom {
+			if err := uc.searchRepo.RemoveCollaborator(ctx, id, guestID); err != nil {
+				logger.Error(err.Error())
+			}
+		}
+	}()
+	uc.wg.Wait()
+
+	return title, removedFrom, nil
+}
+
+func (uc *NoteUsecase) RemoveCollaborator(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, guestID uuid.UUID) (string, []uuid.UUID, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	currentNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return "", nil, err
+	}
+
+	if currentNote.OwnerId != userID {
+		logger.Error("not owner")
+		return "", nil, errors.New("not found")
+	}
+
+	emptyID := uuid.UUID{}
+	if currentNote.Parent != emptyID {
+		logger.Error("note has a parent")
+		return "", nil, errors.New("not found")
+	}
+
+	if !slices.Contains(currentNote.Collaborators, guestID) {
+		logger.Error(note.ErrNotCollaborator)
+		return "", nil, errors.New(note.ErrNotCollaborator)
+	}
+
+	title, removedFrom, err := uc.removeCollaborator(ctx, currentNote.Note, guestID, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return "", nil, err
+	}
+
+	logger.Info("success")
+	return title, removedFrom, nil
+}
+
+func (uc *NoteUsecase) LeaveNote(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) ([]uuid.UUID, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	currentNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	if !slices.Contains(currentNote.Collaborators, userID) {
+		logger.Error(note.ErrNotCollaborator)
+		return nil, errors.New(note.ErrNotCollaborator)
+	}
+
+	// покинуть можно только корневую заметку, к которой пользователя пригласили
+	emptyID := uuid.UUID{}
+	if currentNote.Parent != emptyID {
+		logger.Error("note has a parent")
+		return nil, errors.New("not found")
+	}
+
+	_, removedFrom, err := uc.removeCollaborator(ctx, currentNote.Note, userID, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return removedFrom, nil
+}
+
+func (uc *NoteUsecase) ProposeTransfer(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, newOwnerID uuid.UUID) (string, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	currentNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return "", err
+	}
+
+	if currentNote.OwnerId != userID {
+		logger.Error("not owner")
+		return "", errors.New("not found")
+	}
+
+	emptyID := uuid.UUID{}
+	if currentNote.Parent != emptyID {
+		logger.Error("note has a parent")
+		return "", errors.New("not found")
+	}
+
+	if newOwnerID == userID {
+		logger.Error(note.ErrAlreadyOwner)
+		return "", errors.New(note.ErrAlreadyOwner)
+	}
+
+	// после передачи прежний владелец становится соавтором
+	if !slices.Contains(currentNote.Collaborators, newOwnerID) && len(currentNote.Collaborators) >= uc.constraints.MaxCollaborators {
+		logger.Error(note.ErrTooManyCollaborators)
+		return "", errors.New(note.ErrTooManyCollaborators)
+	}
+
+	title, err := uc.baseRepo.ProposeTransfer(ctx, models.OwnershipTransfer{
+		NoteId:  noteID,
+		FromId:  userID,
+		ToId:    newOwnerID,
+		Created: time.Now().UTC(),
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		return "", err
+	}
+
+	logger.Info("success")
+	return title, nil
+}
+
+func (uc *NoteUsecase) AcceptTransfer(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) (models.OwnershipTransfer, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	transfer, err := uc.baseRepo.GetTransfer(ctx, noteID)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.OwnershipTransfer{}, errors.New("not found")
+	}
+
+	if transfer.ToId != userID {
+		logger.Error("transfer is proposed to another user")
+		return models.OwnershipTransfer{}, errors.New("not found")
+	}
+
+	currentNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.OwnershipTransfer{}, err
+	}
+
+	if currentNote.OwnerId != transfer.FromId {
+		logger.Error("note owner has changed")
+		return models.OwnershipTransfer{}, errors.New("not found")
+	}
+
+	transferred, err := uc.baseRepo.TransferOwnership(ctx, noteID, transfer.FromId, transfer.ToId)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.OwnershipTransfer{}, err
+	}
+
+	uc.wg.Add(1)
+	go func() {
+		defer uc.wg.Done()
+		for _, id := range transferred {
+			if err := uc.searchRepo.SetOwner(ctx, id, transfer.FromId, transfer.ToId); err != nil {
+				logger.Error(err.Error())
+			}
+		}
+
+		uc.reconcileTags(ctx, transferred)
+	}()
+	uc.wg.Wait()
+
+	logger.Info("success")
+	return transfer, nil
+}
+
+func (uc *NoteUsecase) AddTag(ctx context.Context, tagName string, noteId uuid.UUID, userId uuid.UUID, version int64) (models.Note, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	updatedNote, err := uc.baseRepo.ReadNote(ctx, noteId, userId)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, err
+	}
+
+	if err := uc.checkRole(ctx, updatedNote.Note, userId, models.RoleEditor); err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, err
+	}
+
+	if err := checkVersion(updatedNote.Note, version); err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, err
+	}
+
+	if len(updatedNote.Tags) >= uc.constraints.MaxTags {
+		logger.Error(note.ErrTooManyTags)
+		return models.Note{}, errors.New(note.ErrTooManyTags)
+	}
+
+	if err := uc.baseRepo.AddTag(ctx, tagName, noteId, updatedNote.Version); err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, err
+	}
+
+	updatedNote.Tags = append(updatedNote.Tags, tagName)
+	updatedNote.Version++
+
+	uc.wg.Add(1)
+	go func() {
+		defer uc.wg.Done()
+
+		if err := uc.searchRepo.AddTag(ctx, tagName, noteId); err != nil {
+			logger.Error(err.Error())
+		}
+
+		if err := uc.baseRepo.RememberTag(ctx, tagName, userId); err != nil {
+			logger.Error(err.Error())
+		}
+
+		if len(updatedNote.Collaborators) > 0 {
+			uc.reconcileTags(ctx, []uuid.UUID{noteId})
+		}
+	}()
+	uc.wg.Wait()
+
+	logger.Info("success")
+	return updatedNote.Note, nil
+}
+
+func (uc *NoteUsecase) DeleteTag(ctx context.Context, tagName string, noteId uuid.UUID, userId uuid.UUID, version int64) (models.Note, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	updatedNote, err := uc.baseRepo.ReadNote(ctx, noteId, userId)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, err
+	}
+
+	if err := uc.checkRole(ctx, updatedNote.Note, userId, models.RoleEditor); err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, err
+	}
+
+	if err := checkVersion(updatedNote.Note, version); err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, err
+	}
+
+	if err := uc.baseRepo.DeleteTag(ctx, tagName, noteId, updatedNote.Version); err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, err
+	}
+
+	newTags := make([]string, 0)
+	for i := range updatedNote.Tags {
+		if updatedNote.Tags[i] != tagName {
+			newTags = append(newTags, updatedNote.Tags[i])
+		}
+	}
+	updatedNote.Tags = newTags
+	updatedNote.Version++
+
+	uc.wg.Add(1)
+	go func() {
+		defer uc.wg.Done()
+		if err := uc.searchRepo.DeleteTag(ctx, tagName, noteId); err != nil {
+			logger.Error(err.Error())
+		}
+	}()
+	uc.wg.Wait()
+
+	logger.Info("success")
+	return updatedNote.Note, nil
+}
+
+func (uc *NoteUsecase) RememberTag(ctx context.Context, tagName string, userID uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	if err := uc.baseRepo.RememberTag(ctx, tagName, userID); err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	logger.Info("success")
+	return nil
+}
+
+func (uc *NoteUsecase) ForgetTag(ctx context.Context, tagName string, userID uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	if err := uc.baseRepo.ForgetTag(ctx, tagName, userID); err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	noteIDs, err := uc.baseRepo.DeleteTagFromAllNotes(ctx, tagName, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	uc.wg.Add(1)
+	go func() {
+		defer uc.wg.Done()
+		if err := uc.searchRepo.DeleteTagFromAllNotes(ctx, tagName, noteIDs); err != nil {
+			logger.Error(err.Error())
+		}
+	}()
+	uc.wg.Wait()
+
+	logger.Info("success")
+	return nil
+}
+
+func (uc *NoteUsecase) UpdateTag(ctx context.Context, oldTag string, newTag string, userID uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	if oldTag == newTag {
+		logger.Info("success")
+		return nil
+	}
+
+	if err := uc.baseRepo.UpdateTag(ctx, oldTag, newTag, userID); err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+	noteIDs, err := uc.baseRepo.UpdateTagOnAllNotes(ctx, oldTag, newTag, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	uc.wg.Add(1)
+	go func() {
+		defer uc.wg.Done()
+		if err := uc.searchRepo.UpdateTagOnAllNotes(ctx, oldTag, newTag, noteIDs); err != nil {
+			logger.Error(err.Error())
+		}
+
+		uc.reconcileTags(ctx, noteIDs)
+	}()
+	uc.wg.Wait()
+
+	logger.Info("success")
+	return nil
+}
+
+func (uc *NoteUsecase) MergeTags(ctx context.Context, tags []string, newTag string, userID uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	sources := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if tag != newTag && !slices.Contains(sources, tag) {
+			sources = append(sources, tag)
+		}
+	}
+	if len(sources) == 0 {
+		logger.Info("success")
+		return nil
+	}
+
+	noteIDs, err := uc.baseRepo.MergeTags(ctx, sources, newTag, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	uc.wg.Add(1)
+	go func() {
+		defer uc.wg.Done()
+		for _, tag := range sources {
+			if err := uc.searchRepo.UpdateTagOnAllNotes(ctx, tag, newTag, noteIDs); err != nil {
+				logger.Error(err.Error())
+			}
+		}
+
+		uc.reconcileTags(ctx, noteIDs)
+	}()
+	uc.wg.Wait()
+
+	logger.Info("success")
+	return nil
+}
+
+// reconcileTags - теги заметок, которые видят другие пользователи, добавляются и в их списки тегов.
+// Вызывается при изменениях, из-за которых у пользователя могут появиться незнакомые теги
+func (uc *NoteUsecase) reconcileTags(ctx context.Context, noteIDs []uuid.UUID) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	if len(noteIDs) == 0 {
+		return
+	}
+
+	if err := uc.baseRepo.ReconcileTags(ctx, noteIDs); err != nil {
+		logger.Error(err.Error())
+	}
+}
+
+func (uc *NoteUsecase) GetTags(ctx context.Context, userID uuid.UUID) ([]models.Tag, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	tags, err := uc.baseRepo.GetTags(ctx, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return []models.Tag{}, err
+	}
+
+	logger.Info("success")
+	return tags, nil
+}
+
+func (uc *NoteUsecase) SetTagInfo(ctx context.Context, tag models.Tag, userID uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	if err := uc.baseRepo.SetTagInfo(ctx, tag, userID); err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	logger.Info("success")
+	return nil
+}
+
+func (uc *NoteUsecase) SetIcon(ctx context.Context, noteID uuid.UUID, icon string, userID uuid.UUID, version int64) (models.Note, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	resultNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, errors.New("not found")
+	}
+
+	if err := uc.checkRole(ctx, resultNote.Note, userID, models.RoleEditor); err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, err
+	}
+
+	if err := checkVersion(resultNote.Note, version); err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, err
+	}
+
+	if err := uc.baseRepo.SetIcon(ctx, noteID, icon, resultNote.Version); err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, err
+	}
+	resultNote.Icon = icon
+	resultNote.Version++
+
+	uc.wg.Add(1)
+	go func() {
+		defer uc.wg.Done()
+		if err := uc.searchRepo.SetIcon(ctx, noteID, icon); err != nil {
+			logger.Error(err.Error())
+		}
+	}()
+	uc.wg.Wait()
+
+	logger.Info("success")
+	return resultNote.Note, nil
+}
+
+func (uc *NoteUsecase) SetHeader(ctx context.Context, noteID uuid.UUID, header string, userID uuid.UUID, version int64) (models.Note, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	resultNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, errors.New("not found")
+	}
+
+	if err := uc.checkRole(ctx, resultNote.Note, userID, models.RoleEditor); err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, err
+	}
+
+	if err := checkVersion(resultNote.Note, version); err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, err
+	}
+
+	if err := uc.baseRepo.SetHeader(ctx, noteID, header, resultNote.Version); err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, err
+	}
+	resultNote.Header = header
+	resultNote.Version++
+
+	uc.wg.Add(1)
+	go func() {
+		defer uc.wg.Done()
+		if err := uc.searchRepo.SetHeader(ctx, noteID, header); err != nil {
+			logger.Error(err.Error())
+		}
+	}()
+	uc.wg.Wait()
+
+	logger.Info("success")
+	return resultNote.Note, nil
+}
+func (uc *NoteUsecase) AddFav(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) (models.Note, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	resultNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, errors.New("not found")
+	}
+
+	if resultNote.OwnerId != userID && !slices.Contains(resultNote.Collaborators, userID) {
+		logger.Error("not owner and not collaborator")
+		return models.Note{}, errors.New("not owner and not collaborator")
+	}
+	if resultNote.Favorite {
+		return resultNote.Note, nil
+	}
+	if err := uc.baseRepo.AddFav(ctx, noteID, userID); err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, err
+	}
+	resultNote.Favorite = true
+
+	uc.wg.Add(1)
+	go func() {
+		defer uc.wg.Done()
+		if err := uc.searchRepo.ChangeFlag(ctx, noteID, true); err != nil {
+			logger.Error(err.Error())
+		}
+	}()
+	uc.wg.Wait()
+
+	logger.Info("success")
+	return resultNote.Note, nil
+}
+
+func (uc *NoteUsecase) DelFav(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) (models.Note, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	resultNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, errors.New("not found")
+	}
+
+	if resultNote.OwnerId != userID && !slices.Contains(resultNote.Collaborators, userID) {
+		logger.Error("not owner and not collaborator")
+		return models.Note{}, errors.New("not owner and not collaborator")
+	}
+	if !resultNote.Favorite {
+		return resultNote.Note, nil
+	}
+	if err := uc.baseRepo.DelFav(ctx, noteID, userID); err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, err
+	}
+	resultNote.Favorite = false
+
+	uc.wg.Add(1)
+	go func() {
+		defer uc.wg.Done()
+		if err := uc.searchRepo.ChangeFlag(ctx, noteID, false); err != nil {
+			logger.Error(err.Error())
+		}
+	}()
+	uc.wg.Wait()
+
+	logger.Info("success")
+	return resultNote.Note, nil
+}
+
+func (uc *NoteUsecase) ArchiveNote(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) (models.Note, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	resultNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, errors.New("not found")
+	}
+
+	if err := uc.checkRole(ctx, resultNote.Note, userID, models.RoleViewer); err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, err
+	}
+	if resultNote.Archived {
+		return resultNote.Note, nil
+	}
+	if err := uc.baseRepo.ArchiveNote(ctx, noteID, userID, time.Now().UTC()); err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, err
+	}
+	resultNote.Archived = true
+
+	uc.wg.Add(1)
+	go func() {
+		defer uc.wg.Done()
+		if err := uc.searchRepo.ArchiveNote(ctx, noteID, userID); err != nil {
+			logger.Error(err.Error())
+		}
+	}()
+	uc.wg.Wait()
+
+	logger.Info("success")
+	return resultNote.Note, nil
+}
+
+func (uc *NoteUsecase) UnarchiveNote(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) (models.Note, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	resultNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, errors.New("not found")
+	}
+
+	if err := uc.checkRole(ctx, resultNote.Note, userID, models.RoleViewer); err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, err
+	}
+	if !resultNote.Archived {
+		return resultNote.Note, nil
+	}
+	if err := uc.baseRepo.UnarchiveNote(ctx, noteID, userID); err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, err
+	}
+	resultNote.Archived = false
+
+	uc.wg.Add(1)
+	go func() {
+		defer uc.wg.Done()
+		if err := uc.searchRepo.UnarchiveNote(ctx, noteID, userID); err != nil {
+			logger.Error(err.Error())
+		}
+	}()
+	uc.wg.Wait()
+
+	logger.Info("success")
+	return resultNote.Note, nil
+}
+
+func (uc *NoteUsecase) changeModeRecursive(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, isPublic bool) error {
+	currentNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+	if err != nil {
+		return err
+	}
+
+	if isPublic {
+		if err = uc.baseRepo.SetPublic(ctx, noteID); err != nil {
+			return err
+		}
+	} else {
+		if err = uc.baseRepo.SetPrivate(ctx, noteID); err != nil {
+			return err
+		}
+	}
+
+	for _, child := range currentNote.Children {
+		if err := uc.changeModeRecursive(ctx, child, userID, isPublic); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ensureOpenShareLink - создает ссылку без пароля и срока действия на все поддерево, если такой еще нет
+func (uc *NoteUsecase) ensureOpenShareLink(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) error {
+	links, err := uc.baseRepo.GetShareLinks(ctx, noteID)
+	if err != nil {
+		return err
+	}
+
+	for _, link := range links {
+		if link.PasswordHash == "" && link.ExpiresAt == nil && link.IncludeSubtree {
+			return nil
+		}
+	}
+
+	return uc.baseRepo.CreateShareLink(ctx, models.ShareLink{
+		Id:             uuid.NewV4(),
+		Token:          string(code.GenerateSecret()),
+		NoteId:         noteID,
+		CreatorId:      userID,
+		IncludeSubtree: true,
+		Created:        time.Now().UTC(),
+	})
+}
+
+// SetPublic - устаревший переключатель, оставлен для старых клиентов. Публичный доступ
+// теперь выдается через ссылку доступа, поэтому заметка получает открытую ссылку (см. CreateShareLink)
+func (uc *NoteUsecase) SetPublic(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) (models.Note, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	resultNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, errors.New("not found")
+	}
+
+	if err := uc.checkRole(ctx, resultNote.Note, userID, models.RoleEditor); err != nil {
 		logger.Error(err.Error())
 		return models.Note{}, err
 	}
 
-	newTags := make([]string, 0)
-	for i := range updatedNote.Tags {
-		if updatedNote.Tags[i] != tagName {
-			newTags = append(newTags, updatedNote.Tags[i])
+	if err := uc.baseRepo.SetPublic(ctx, noteID); err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, err
+	}
+	resultNote.Public = true
+
+	for _, child := range resultNote.Children {
+		if err := uc.changeModeRecursive(ctx, child, userID, true); err != nil {
+			logger.Error(err.Error())
+			return models.Note{}, err
 		}
 	}
-	updatedNote.Tags = newTags
+
+	if err := uc.ensureOpenShareLink(ctx, noteID, userID); err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, err
+	}
 
 	uc.wg.Add(1)
 	go func() {
 		defer uc.wg.Done()
-		if err := uc.searchRepo.DeleteTag(ctx, tagName, noteId); err != nil {
+		if err := uc.searchRepo.SetPublic(ctx, noteID); err != nil {
 			logger.Error(err.Error())
 		}
 	}()
 	uc.wg.Wait()
 
 	logger.Info("success")
-	return updatedNote.Note, nil
+	return resultNote.Note, nil
 }
 
-func (uc *NoteUsecase) RememberTag(ctx context.Context, tagName string, userID uuid.UUID) error {
+// SetPrivate - устаревший переключатель, оставлен для старых клиентов. Отзывает все ссылки доступа
+// на заметку и ее поддерево
+func (uc *NoteUsecase) SetPrivate(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) (models.Note, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	if err := uc.baseRepo.RememberTag(ctx, tagName, userID); err != nil {
+	resultNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+	if err != nil {
 		logger.Error(err.Error())
-		return err
+		return models.Note{}, errors.New("not found")
+	}
+
+	if err := uc.checkRole(ctx, resultNote.Note, userID, models.RoleEditor); err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, err
+	}
+
+	if err := uc.baseRepo.SetPrivate(ctx, noteID); err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, err
 	}
+	resultNote.Public = false
+
+	for _, child := range resultNote.Children {
+		if err := uc.changeModeRecursive(ctx, child, userID, false); err != nil {
+			logger.Error(err.Error())
+			return models.Note{}, err
+		}
+	}
+
+	uc.wg.Add(1)
+	go func() {
+		defer uc.wg.Done()
+		if err := uc.searchRepo.SetPrivate(ctx, noteID); err != nil {
+			logger.Error(err.Error())
+		}
+	}()
+	uc.wg.Wait()
 
 	logger.Info("success")
-	return nil
+	return resultNote.Note, nil
 }
 
-func (uc *NoteUsecase) ForgetTag(ctx context.Context, tagName string, userID uuid.UUID) error {
+// BulkUpdate - применяет операции по порядку ко всем заметкам. Права проверяются для каждой заметки отдельно,
+// заметка, на которой операция не удалась, в следующих операциях не участвует
+func (uc *NoteUsecase) BulkUpdate(ctx context.Context, noteIDs []uuid.UUID, operations []models.BulkOperation, userID uuid.UUID) ([]models.BulkResult, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	if err := uc.baseRepo.ForgetTag(ctx, tagName, userID); err != nil {
-		logger.Error(err.Error())
-		return err
+	results := make([]models.BulkResult, len(noteIDs))
+	notes := make([]models.Note, len(noteIDs))
+	for i, noteID := range noteIDs {
+		results[i] = models.BulkResult{NoteID: noteID, Success: true}
+
+		resultNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+		if err != nil {
+			logger.Error(err.Error())
+			results[i] = models.BulkResult{NoteID: noteID, Error: "not found"}
+			continue
+		}
+		notes[i] = resultNote.Note
+	}
+
+	for _, operation := range operations {
+		switch operation.Type {
+		case models.BulkAddTag, models.BulkDeleteTag, models.BulkAddFav, models.BulkDelFav:
+			uc.bulkBatch(ctx, operation, notes, results, userID)
+		default:
+			for i := range results {
+				if !results[i].Success {
+					continue
+				}
+
+				if err := uc.bulkSingle(ctx, operation, noteIDs[i], userID); err != nil {
+					logger.Error(err.Error())
+					results[i] = models.BulkResult{NoteID: noteIDs[i], Error: err.Error()}
+				}
+			}
+		}
+	}
+
+	logger.Info("success")
+	return results, nil
+}
+
+// bulkSingle - операции, затрагивающие поддерево или родителя, выполняются по одной заметке теми же методами, что и без пакета
+func (uc *NoteUsecase) bulkSingle(ctx context.Context, operation models.BulkOperation, noteID uuid.UUID, userID uuid.UUID) error {
+	var err error
+	switch operation.Type {
+	case models.BulkSetPublic:
+		_, err = uc.SetPublic(ctx, noteID, userID)
+	case models.BulkSetPrivate:
+		_, err = uc.SetPrivate(ctx, noteID, userID)
+	case models.BulkMove:
+		_, err = uc.MoveNote(ctx, noteID, operation.ParentID(), userID)
+	case models.BulkDelete:
+		err = uc.DeleteNote(ctx, noteID, userID)
+	default:
+		err = errors.New("unknown operation: " + operation.Type)
+	}
+	return err
+}
+
+// bulkBatch - операции с тегами и избранным пишутся в postgres одним запросом на все подходящие заметки,
+// в elastic - одним bulk-запросом
+func (uc *NoteUsecase) bulkBatch(ctx context.Context, operation models.BulkOperation, notes []models.Note, results []models.BulkResult, userID uuid.UUID) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	changed := make([]int, 0, len(notes))
+	for i := range notes {
+		if !results[i].Success {
+			continue
+		}
+
+		isCollaborator := notes[i].OwnerId == userID || slices.Contains(notes[i].Collaborators, userID)
+		hasTag := slices.Contains(notes[i].Tags, operation.Value)
+
+		var err error
+		switch operation.Type {
+		case models.BulkAddTag, models.BulkDeleteTag:
+			err = uc.checkRole(ctx, notes[i], userID, models.RoleEditor)
+		case models.BulkAddFav, models.BulkDelFav:
+			if !isCollaborator {
+				err = errors.New("not owner and not collaborator")
+			}
+		}
+		if err == nil && operation.Type == models.BulkAddTag && !hasTag && len(notes[i].Tags) >= uc.constraints.MaxTags {
+			err = errors.New(note.ErrTooManyTags)
+		}
+		if err != nil {
+			logger.Error(err.Error())
+			results[i] = models.BulkResult{NoteID: results[i].NoteID, Error: err.Error()}
+			continue
+		}
+
+		// уже применённая операция ничего не меняет, но считается успешной
+		switch operation.Type {
+		case models.BulkAddTag:
+			if hasTag {
+				continue
+			}
+		case models.BulkDeleteTag:
+			if !hasTag {
+				continue
+			}
+		case models.BulkAddFav:
+			if notes[i].Favorite {
+				continue
+			}
+		case models.BulkDelFav:
+			if !notes[i].Favorite {
+				continue
+			}
+		}
+		changed = append(changed, i)
+	}
+
+	if len(changed) == 0 {
+		return
 	}
 
-	if err := uc.baseRepo.DeleteTagFromAllNotes(ctx, tagName, userID); err != nil {
+	changedIDs := make([]uuid.UUID, len(changed))
+	sharedIDs := make([]uuid.UUID, 0)
+	for j, i := range changed {
+		changedIDs[j] = notes[i].Id
+		if len(notes[i].Collaborators) > 0 {
+			sharedIDs = append(sharedIDs, notes[i].Id)
+		}
+	}
+
+	var err error
+	switch operation.Type {
+	case models.BulkAddTag:
+		err = uc.baseRepo.AddTagToNotes(ctx, operation.Value, changedIDs)
+	case models.BulkDeleteTag:
+		err = uc.baseRepo.DeleteTagFromNotes(ctx, operation.Value, changedIDs)
+	case models.BulkAddFav:
+		err = uc.baseRepo.AddFavs(ctx, changedIDs, userID)
+	case models.BulkDelFav:
+		err = uc.baseRepo.DelFavs(ctx, changedIDs, userID)
+	}
+	if err != nil {
 		logger.Error(err.Error())
-		return err
+		for _, i := range changed {
+			results[i] = models.BulkResult{NoteID: results[i].NoteID, Error: err.Error()}
+		}
+		return
+	}
+
+	for _, i := range changed {
+		switch operation.Type {
+		case models.BulkAddTag:
+			notes[i].Tags = append(notes[i].Tags, operation.Value)
+		case models.BulkDeleteTag:
+			newTags := make([]string, 0, len(notes[i].Tags))
+			for _, tag := range notes[i].Tags {
+				if tag != operation.Value {
+					newTags = append(newTags, tag)
+				}
+			}
+			notes[i].Tags = newTags
+		case models.BulkAddFav, models.BulkDelFav:
+			notes[i].Favorite = operation.Type == models.BulkAddFav
+		}
 	}
 
 	uc.wg.Add(1)
 	go func() {
 		defer uc.wg.Done()
-		if err := uc.searchRepo.DeleteTagFromAllNotes(ctx, tagName, userID); err != nil {
+
+		var err error
+		switch operation.Type {
+		case models.BulkAddTag:
+			err = uc.searchRepo.AddTagToNotes(ctx, operation.Value, changedIDs)
+			if rememberErr := uc.baseRepo.RememberTag(ctx, operation.Value, userID); rememberErr != nil {
+				logger.Error(rememberErr.Error())
+			}
+			uc.reconcileTags(ctx, sharedIDs)
+		case models.BulkDeleteTag:
+			err = uc.searchRepo.DeleteTagFromAllNotes(ctx, operation.Value, changedIDs)
+		case models.BulkAddFav, models.BulkDelFav:
+			err = uc.searchRepo.ChangeFlagOnNotes(ctx, changedIDs, operation.Type == models.BulkAddFav)
+		}
+		if err != nil {
 			logger.Error(err.Error())
 		}
 	}()
 	uc.wg.Wait()
+}
+
+func (uc *NoteUsecase) CreateShareLink(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, password string, expiresAt *time.Time, includeSubtree bool) (models.ShareLink, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	resultNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.ShareLink{}, errors.New("not found")
+	}
+
+	if err := uc.checkRole(ctx, resultNote.Note, userID, models.RoleEditor); err != nil {
+		logger.Error(err.Error())
+		return models.ShareLink{}, err
+	}
+
+	link := models.ShareLink{
+		Id:             uuid.NewV4(),
+		Token:          string(code.GenerateSecret()),
+		NoteId:         noteID,
+		CreatorId:      userID,
+		IncludeSubtree: includeSubtree,
+		Created:        time.Now().UTC(),
+		ExpiresAt:      expiresAt,
+	}
+	if password != "" {
+		link.PasswordHash, err = passhash.Hash(password)
+		if err != nil {
+			logger.Error(err.Error())
+			return models.ShareLink{}, err
+		}
+	}
+
+	if err := uc.baseRepo.CreateShareLink(ctx, link); err != nil {
+		logger.Error(err.Error())
+		return models.ShareLink{}, err
+	}
+
+	logger.Info("success")
+	return link, nil
+}
+
+func (uc *NoteUsecase) GetShareLinks(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) ([]models.ShareLink, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	resultNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, errors.New("not found")
+	}
+
+	if err := uc.checkRole(ctx, resultNote.Note, userID, models.RoleEditor); err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	links, err := uc.baseRepo.GetShareLinks(ctx, noteID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return links, nil
+}
+
+func (uc *NoteUsecase) RevokeShareLink(ctx context.Context, noteID uuid.UUID, linkID uuid.UUID, userID uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	resultNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return errors.New("not found")
+	}
+
+	if err := uc.checkRole(ctx, resultNote.Note, userID, models.RoleEditor); err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	if err := uc.baseRepo.DeleteShareLink(ctx, noteID, linkID); err != nil {
+		logger.Error(err.Error())
+		return errors.New("not found")
+	}
 
 	logger.Info("success")
 	return nil
 }
-func (uc *NoteUsecase) UpdateTag(ctx context.Context, oldTag string, newTag string, userID uuid.UUID) error {
+
+func (uc *NoteUsecase) GetComments(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) ([]models.Comment, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	resultNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, errors.New("not found")
+	}
+
+	if err := uc.checkRole(ctx, resultNote.Note, userID, models.RoleViewer); err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	comments, err := uc.baseRepo.GetComments(ctx, noteID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Info("success")
+	return comments, nil
+}
+
+func (uc *NoteUsecase) AddComment(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, parentID uuid.UUID, blockID string, text string) (models.Comment, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	resultNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.Comment{}, errors.New("not found")
+	}
+
+	if err := uc.checkRole(ctx, resultNote.Note, userID, models.RoleCommenter); err != nil {
+		logger.Error(err.Error())
+		return models.Comment{}, err
+	}
+
+	if parentID != uuid.Nil {
+		parent, err := uc.baseRepo.GetComment(ctx, parentID)
+		if err != nil || parent.NoteId != noteID {
+			logger.Error("parent comment not found")
+			return models.Comment{}, errors.New("not found")
+		}
+
+		// ответы всегда крепятся к корню ветки и наследуют его якорь
+		if parent.Parent != uuid.Nil {
+			parentID = parent.Parent
+		}
+		blockID = ""
+	}
+
+	now := time.Now().UTC()
+	comment := models.Comment{
+		Id:       uuid.NewV4(),
+		NoteId:   noteID,
+		Parent:   parentID,
+		AuthorId: userID,
+		BlockId:  blockID,
+		Text:     text,
+		Created:  now,
+		Updated:  now,
+	}
+
+	author, err := uc.baseRepo.CreateComment(ctx, comment)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.Comment{}, err
+	}
+	comment.Author = author
+
+	logger.Info("success")
+	return comment, nil
+}
+
+func (uc *NoteUsecase) getOwnComment(ctx context.Context, noteID uuid.UUID, commentID uuid.UUID, userID uuid.UUID) (models.Comment, error) {
+	resultNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+	if err != nil {
+		return models.Comment{}, errors.New("not found")
+	}
+
+	if err := uc.checkRole(ctx, resultNote.Note, userID, models.RoleViewer); err != nil {
+		return models.Comment{}, err
+	}
+
+	comment, err := uc.baseRepo.GetComment(ctx, commentID)
+	if err != nil || comment.NoteId != noteID || comment.Deleted {
+		return models.Comment{}, errors.New("not found")
+	}
+
+	if comment.AuthorId != userID {
+		return models.Comment{}, errors.New(note.ErrNotEnoughRights)
+	}
+
+	return comment, nil
+}
+
+func (uc *NoteUsecase) UpdateComment(ctx context.Context, noteID uuid.UUID, commentID uuid.UUID, userID uuid.UUID, text string) (models.Comment, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	comment, err := uc.getOwnComment(ctx, noteID, commentID, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.Comment{}, err
+	}
+
+	comment.Text = text
+	comment.Updated = time.Now().UTC()
+
+	if err := uc.baseRepo.UpdateComment(ctx, commentID, comment.Text, comment.Updated); err != nil {
+		logger.Error(err.Error())
+		return models.Comment{}, err
+	}
+
+	logger.Info("success")
+	return comment, nil
+}
+
+func (uc *NoteUsecase) DeleteComment(ctx context.Context, noteID uuid.UUID, commentID uuid.UUID, userID uuid.UUID) error {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	if err := uc.baseRepo.UpdateTag(ctx, oldTag, newTag, userID); err != nil {
+	if _, err := uc.getOwnComment(ctx, noteID, commentID, userID); err != nil {
 		logger.Error(err.Error())
 		return err
 	}
-	if err := uc.baseRepo.UpdateTagOnAllNotes(ctx, oldTag, newTag, userID); err != nil {
+
+	if err := uc.baseRepo.DeleteComment(ctx, commentID); err != nil {
 		logger.Error(err.Error())
 		return err
 	}
 
-	uc.wg.Add(1)
-	go func() {
-		defer uc.wg.Done()
-		if err := uc.searchRepo.UpdateTagOnAllNotes(ctx, oldTag, newTag, userID); err != nil {
-			logger.Error(err.Error())
-		}
-	}()
-	uc.wg.Wait()
-
 	logger.Info("success")
 	return nil
 }
 
-func (uc *NoteUsecase) GetTags(ctx context.Context, userID uuid.UUID) ([]string, error) {
+func (uc *NoteUsecase) SetCommentResolved(ctx context.Context, noteID uuid.UUID, commentID uuid.UUID, userID uuid.UUID, resolved bool) (models.Comment, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	tags, err := uc.baseRepo.GetTags(ctx, userID)
+	resultNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
 	if err != nil {
 		logger.Error(err.Error())
-		return []string{}, err
+		return models.Comment{}, errors.New("not found")
 	}
 
-	logger.Info("success")
-	return tags, nil
-}
-
-func (uc *NoteUsecase) SetIcon(ctx context.Context, noteID uuid.UUID, icon string, userID uuid.UUID) (models.Note, error) {
-	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
-
-	resultNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
-	if err != nil {
+	if err := uc.checkRole(ctx, resultNote.Note, userID, models.RoleCommenter); err != nil {
 		logger.Error(err.Error())
-		return models.Note{}, errors.New("not found")
+		return models.Comment{}, err
 	}
 
-	if resultNote.OwnerId != userID && !slices.Contains(resultNote.Collaborators, userID) {
-		logger.Error("not owner and not collaborator")
-		return models.Note{}, errors.New("not owner and not collaborator")
+	comment, err := uc.baseRepo.GetComment(ctx, commentID)
+	if err != nil || comment.NoteId != noteID {
+		logger.Error("comment not found")
+		return models.Comment{}, errors.New("not found")
 	}
 
-	if err := uc.baseRepo.SetIcon(ctx, noteID, icon); err != nil {
-		logger.Error(err.Error())
-		return models.Note{}, err
+	// решается только ветка целиком
+	if comment.Parent != uuid.Nil {
+		logger.Error("comment is a reply")
+		return models.Comment{}, errors.New("not found")
 	}
-	resultNote.Icon = icon
 
-	uc.wg.Add(1)
-	go func() {
-		defer uc.wg.Done()
-		if err := uc.searchRepo.SetIcon(ctx, noteID, icon); err != nil {
-			logger.Error(err.Error())
-		}
-	}()
-	uc.wg.Wait()
+	if err := uc.baseRepo.SetCommentResolved(ctx, commentID, resolved); err != nil {
+		logger.Error(err.Error())
+		return models.Comment{}, err
+	}
+	comment.Resolved = resolved
 
 	logger.Info("success")
-	return resultNote.Note, nil
+	return comment, nil
 }
 
-func (uc *NoteUsecase) SetHeader(ctx context.Context, noteID uuid.UUID, header string, userID uuid.UUID) (models.Note, error) {
+func (uc *NoteUsecase) AddReminder(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, remindAt time.Time, repeat string) (models.Reminder, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
 	resultNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
 	if err != nil {
 		logger.Error(err.Error())
-		return models.Note{}, errors.New("not found")
+		return models.Reminder{}, errors.New("not found")
 	}
 
-	if resultNote.OwnerId != userID && !slices.Contains(resultNote.Collaborators, userID) {
-		logger.Error("not owner and not collaborator")
-		return models.Note{}, errors.New("not owner and not collaborator")
+	if err := uc.checkRole(ctx, resultNote.Note, userID, models.RoleViewer); err != nil {
+		logger.Error(err.Error())
+		return models.Reminder{}, err
 	}
 
-	if err := uc.baseRepo.SetHeader(ctx, noteID, header); err != nil {
-		logger.Error(err.Error())
-		return models.Note{}, err
+	reminder := models.Reminder{
+		Id:       uuid.NewV4(),
+		NoteId:   noteID,
+		UserId:   userID,
+		RemindAt: remindAt.UTC(),
+		Repeat:   repeat,
+		Created:  time.Now().UTC(),
 	}
-	resultNote.Header = header
 
-	uc.wg.Add(1)
-	go func() {
-		defer uc.wg.Done()
-		if err := uc.searchRepo.SetHeader(ctx, noteID, header); err != nil {
-			logger.Error(err.Error())
-		}
-	}()
-	uc.wg.Wait()
+	title, err := uc.baseRepo.CreateReminder(ctx, reminder)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.Reminder{}, err
+	}
+	reminder.NoteTitle = title
 
 	logger.Info("success")
-	return resultNote.Note, nil
+	return reminder, nil
 }
-func (uc *NoteUsecase) AddFav(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) (models.Note, error) {
+
+func (uc *NoteUsecase) GetReminders(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) ([]models.Reminder, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
 	resultNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
 	if err != nil {
 		logger.Error(err.Error())
-		return models.Note{}, errors.New("not found")
+		return nil, errors.New("not found")
 	}
 
-	if resultNote.OwnerId != userID && !slices.Contains(resultNote.Collaborators, userID) {
-		logger.Error("not owner and not collaborator")
-		return models.Note{}, errors.New("not owner and not collaborator")
-	}
-	if resultNote.Favorite {
-		return resultNote.Note, nil
-	}
-	if err := uc.baseRepo.AddFav(ctx, noteID, userID); err != nil {
+	if err := uc.checkRole(ctx, resultNote.Note, userID, models.RoleViewer); err != nil {
 		logger.Error(err.Error())
-		return models.Note{}, err
+		return nil, err
 	}
-	resultNote.Favorite = true
 
-	uc.wg.Add(1)
-	go func() {
-		defer uc.wg.Done()
-		if err := uc.searchRepo.ChangeFlag(ctx, noteID, true); err != nil {
-			logger.Error(err.Error())
-		}
-	}()
-	uc.wg.Wait()
+	reminders, err := uc.baseRepo.GetReminders(ctx, noteID, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
 
 	logger.Info("success")
-	return resultNote.Note, nil
+	return reminders, nil
 }
 
-func (uc *NoteUsecase) DelFav(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) (models.Note, error) {
+func (uc *NoteUsecase) GetUpcomingReminders(ctx context.Context, userID uuid.UUID, count int64, offset int64) ([]models.Reminder, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	resultNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+	reminders, err := uc.baseRepo.GetUpcomingReminders(ctx, userID, count, offset)
 	if err != nil {
 		logger.Error(err.Error())
-		return models.Note{}, errors.New("not found")
+		return nil, err
 	}
 
-	if resultNote.OwnerId != userID && !slices.Contains(resultNote.Collaborators, userID) {
-		logger.Error("not owner and not collaborator")
-		return models.Note{}, errors.New("not owner and not collaborator")
-	}
-	if !resultNote.Favorite {
-		return resultNote.Note, nil
+	logger.Info("success")
+	return reminders, nil
+}
+
+func (uc *NoteUsecase) getOwnReminder(ctx context.Context, reminderID uuid.UUID, userID uuid.UUID) (models.Reminder, error) {
+	reminder, err := uc.baseRepo.GetReminder(ctx, reminderID)
+	if err != nil || reminder.UserId != userID {
+		return models.Reminder{}, errors.New("not found")
 	}
-	if err := uc.baseRepo.DelFav(ctx, noteID, userID); err != nil {
+
+	return reminder, nil
+}
+
+func (uc *NoteUsecase) DeleteReminder(ctx context.Context, reminderID uuid.UUID, userID uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	if _, err := uc.getOwnReminder(ctx, reminderID, userID); err != nil {
 		logger.Error(err.Error())
-		return models.Note{}, err
+		return err
 	}
-	resultNote.Favorite = false
 
-	uc.wg.Add(1)
-	go func() {
-		defer uc.wg.Done()
-		if err := uc.searchRepo.ChangeFlag(ctx, noteID, false); err != nil {
-			logger.Error(err.Error())
-		}
-	}()
-	uc.wg.Wait()
+	if err := uc.baseRepo.DeleteReminder(ctx, reminderID); err != nil {
+		logger.Error(err.Error())
+		return err
+	}
 
 	logger.Info("success")
-	return resultNote.Note, nil
+	return nil
 }
 
-func (uc *NoteUsecase) changeModeRecursive(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, isPublic bool) error {
-	currentNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+func (uc *NoteUsecase) AcknowledgeReminder(ctx context.Context, reminderID uuid.UUID, userID uuid.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	reminder, err := uc.getOwnReminder(ctx, reminderID, userID)
 	if err != nil {
+		logger.Error(err.Error())
 		return err
 	}
 
-	if isPublic {
-		if err = uc.baseRepo.SetPublic(ctx, noteID); err != nil {
+	if !reminder.Fired {
+		logger.Error("reminder is not fired yet")
+		return errors.New("not found")
+	}
+
+	// разовое напоминание после подтверждения больше не нужно, повторяющееся переносится на следующий срок
+	if reminder.Repeat == models.RepeatNone {
+		if err := uc.baseRepo.DeleteReminder(ctx, reminderID); err != nil {
+			logger.Error(err.Error())
 			return err
 		}
 	} else {
-		if err = uc.baseRepo.SetPrivate(ctx, noteID); err != nil {
-			return err
-		}
-	}
-
-	for _, child := range currentNote.Children {
-		if err := uc.changeModeRecursive(ctx, child, userID, isPublic); err != nil {
+		next := models.NextRemindTime(reminder.RemindAt, reminder.Repeat, time.Now().UTC())
+		if err := uc.baseRepo.RescheduleReminder(ctx, reminderID, next); err != nil {
+			logger.Error(err.Error())
 			return err
 		}
 	}
 
+	logger.Info("success")
 	return nil
 }
 
-func (uc *NoteUsecase) SetPublic(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) (models.Note, error) {
+func (uc *NoteUsecase) GetAttachList(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) ([]string, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
 	resultNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
 	if err != nil {
 		logger.Error(err.Error())
-		return models.Note{}, errors.New("not found")
+		return []string{}, errors.New("not found")
 	}
 
 	if resultNote.OwnerId != userID && !slices.Contains(resultNote.Collaborators, userID) {
 		logger.Error("not owner and not collaborator")
-		return models.Note{}, errors.New("not owner and not collaborator")
+		return []string{}, errors.New("not owner and not collaborator")
 	}
 
-	if err := uc.baseRepo.SetPublic(ctx, noteID); err != nil {
+	paths, err := uc.baseRepo.GetAttachList(ctx, noteID)
+	if err != nil {
 		logger.Error(err.Error())
-		return models.Note{}, err
+		return []string{}, err
 	}
-	resultNote.Public = true
 
-	for _, child := range resultNote.Children {
-		if err := uc.changeModeRecursive(ctx, child, userID, true); err != nil {
-			logger.Error(err.Error())
-			return models.Note{}, err
-		}
+	logger.Info("success")
+	return paths, nil
+}
+
+func (uc *NoteUsecase) GetSharedAttachList(ctx context.Context, noteID uuid.UUID, token string, password string) ([]string, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	if err := uc.checkShareLink(ctx, noteID, token, password); err != nil {
+		logger.Error(err.Error())
+		return []string{}, err
 	}
 
-	uc.wg.Add(1)
-	go func() {
-		defer uc.wg.Done()
-		if err := uc.searchRepo.SetPublic(ctx, noteID); err != nil {
-			logger.Error(err.Error())
-		}
-	}()
-	uc.wg.Wait()
+	if _, err := uc.baseRepo.ReadPublicNote(ctx, noteID); err != nil {
+		logger.Error(err.Error())
+		return []string{}, errors.New("not found")
+	}
+
+	paths, err := uc.baseRepo.GetAttachList(ctx, noteID)
+	if err != nil {
+		logger.Error(err.Error())
+		return []string{}, err
+	}
 
 	logger.Info("success")
-	return resultNote.Note, nil
+	return paths, nil
 }
 
-func (uc *NoteUsecase) SetPrivate(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) (models.Note, error) {
+func (uc *NoteUsecase) CheckPermissions(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) (string, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
 	resultNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
 	if err != nil {
 		logger.Error(err.Error())
-		return models.Note{}, errors.New("not found")
+		return "", errors.New("not found")
 	}
 
-	if resultNote.OwnerId != userID && !slices.Contains(resultNote.Collaborators, userID) {
-		logger.Error("not owner and not collaborator")
-		return models.Note{}, errors.New("not owner and not collaborator")
+	role, err := uc.getRole(ctx, resultNote.Note, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return "", err
 	}
 
-	if err := uc.baseRepo.SetPrivate(ctx, noteID); err != nil {
+	logger.Info("success")
+	return role, nil
+}
+
+func (uc *NoteUsecase) GetRevisions(ctx context.Context, noteID uuid.UUID, userID uuid.UUID, count int64, offset int64) ([]models.NoteRevision, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
+
+	resultNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+	if err != nil {
 		logger.Error(err.Error())
-		return models.Note{}, err
+		return []models.NoteRevision{}, errors.New("not found")
 	}
-	resultNote.Public = false
 
-	for _, child := range resultNote.Children {
-		if err := uc.changeModeRecursive(ctx, child, userID, false); err != nil {
-			logger.Error(err.Error())
-			return models.Note{}, err
-		}
+	if _, err := uc.getRole(ctx, resultNote.Note, userID); err != nil {
+		logger.Error(err.Error())
+		return []models.NoteRevision{}, errors.New("not found")
 	}
 
-	uc.wg.Add(1)
-	go func() {
-		defer uc.wg.Done()
-		if err := uc.searchRepo.SetPrivate(ctx, noteID); err != nil {
-			logger.Error(err.Error())
-		}
-	}()
-	uc.wg.Wait()
+	revisions, err := uc.baseRepo.GetRevisions(ctx, noteID, count, offset)
+	if err != nil {
+		logger.Error(err.Error())
+		return []models.NoteRevision{}, err
+	}
 
 	logger.Info("success")
-	return resultNote.Note, nil
+	return revisions, nil
 }
 
-func (uc *NoteUsecase) GetAttachList(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) ([]string, error) {
+func (uc *NoteUsecase) readRevision(ctx context.Context, noteID uuid.UUID, revisionID uuid.UUID) (models.NoteRevision, error) {
+	revision, err := uc.baseRepo.GetRevision(ctx, revisionID)
+	if err != nil {
+		return models.NoteRevision{}, err
+	}
+
+	if revision.NoteId != noteID {
+		return models.NoteRevision{}, errors.New("revision belongs to another note")
+	}
+
+	return revision, nil
+}
+
+func (uc *NoteUsecase) GetRevision(ctx context.Context, noteID uuid.UUID, revisionID uuid.UUID, userID uuid.UUID) (models.NoteRevision, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
 	resultNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
 	if err != nil {
 		logger.Error(err.Error())
-		return []string{}, errors.New("not found")
+		return models.NoteRevision{}, errors.New("not found")
 	}
 
-	if resultNote.OwnerId != userID && !slices.Contains(resultNote.Collaborators, userID) {
-		logger.Error("not owner and not collaborator")
-		return []string{}, errors.New("not owner and not collaborator")
+	if _, err := uc.getRole(ctx, resultNote.Note, userID); err != nil {
+		logger.Error(err.Error())
+		return models.NoteRevision{}, errors.New("not found")
 	}
 
-	paths, err := uc.baseRepo.GetAttachList(ctx, noteID)
+	revision, err := uc.readRevision(ctx, noteID, revisionID)
 	if err != nil {
 		logger.Error(err.Error())
-		return []string{}, err
+		return models.NoteRevision{}, errors.New("not found")
 	}
 
 	logger.Info("success")
-	return paths, nil
+	return revision, nil
 }
 
-func (uc *NoteUsecase) GetSharedAttachList(ctx context.Context, noteID uuid.UUID) ([]string, error) {
+func (uc *NoteUsecase) GetRevisionDiff(ctx context.Context, noteID uuid.UUID, fromID uuid.UUID, toID uuid.UUID, userID uuid.UUID) (models.RevisionDiff, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	resultNote, err := uc.baseRepo.ReadPublicNote(ctx, noteID)
+	resultNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
 	if err != nil {
 		logger.Error(err.Error())
-		return []string{}, errors.New("not found")
+		return models.RevisionDiff{}, errors.New("not found")
 	}
 
-	if !resultNote.Public {
-		logger.Error("not public note")
-		return []string{}, errors.New("not found")
+	if _, err := uc.getRole(ctx, resultNote.Note, userID); err != nil {
+		logger.Error(err.Error())
+		return models.RevisionDiff{}, errors.New("not found")
 	}
 
-	paths, err := uc.baseRepo.GetAttachList(ctx, noteID)
+	from, err := uc.readRevision(ctx, noteID, fromID)
 	if err != nil {
 		logger.Error(err.Error())
-		return []string{}, err
+		return models.RevisionDiff{}, errors.New("not found")
 	}
 
+	to, err := uc.readRevision(ctx, noteID, toID)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.RevisionDiff{}, errors.New("not found")
+	}
+
+	result, err := diff.CompareNotes(from.Data, to.Data)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.RevisionDiff{}, err
+	}
+	result.From = fromID
+	result.To = toID
+
 	logger.Info("success")
-	return paths, nil
+	return result, nil
 }
 
-func (uc *NoteUsecase) CheckPermissions(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) (bool, error) {
+func (uc *NoteUsecase) RestoreRevision(ctx context.Context, noteID uuid.UUID, revisionID uuid.UUID, userID uuid.UUID) (models.Note, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GFN()))
 
-	resultNote, err := uc.baseRepo.ReadNote(ctx, noteID, userID)
+	revision, err := uc.readRevision(ctx, noteID, revisionID)
 	if err != nil {
 		logger.Error(err.Error())
-		return false, errors.New("not found")
+		return models.Note{}, errors.New("not found")
 	}
 
-	if resultNote.OwnerId != userID && !slices.Contains(resultNote.Collaborators, userID) {
-		logger.Error("not owner and not collaborator")
-		return false, errors.New("not owner and not collaborator")
+	result, err := uc.UpdateNote(ctx, noteID, userID, revision.Data, 0)
+	if err != nil {
+		logger.Error(err.Error())
+		return models.Note{}, err
 	}
 
 	logger.Info("success")
-	return true, nil
+	return result, nil
 }
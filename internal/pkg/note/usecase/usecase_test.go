@@ -3,14 +3,20 @@ package usecase
 import (
 	"context"
 	"errors"
+	"os"
+	"path"
 	"reflect"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/models"
 	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/config"
+	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/note"
 	mock_note "github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/note/mocks"
+	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/utils/passhash"
+	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/utils/responses"
 	"github.com/golang/mock/gomock"
 	"github.com/satori/uuid"
 	"github.com/stretchr/testify/assert"
@@ -69,7 +75,7 @@ func TestNoteUsecase_GetAllNotes(t *testing.T) {
 					},
 				}
 
-				baseRepo.EXPECT().ReadAllNotes(ctx, uId, int64(count), int64(offset), []string{"first"}).Return(mockResp, nil).Times(1)
+				baseRepo.EXPECT().ReadAllNotes(ctx, uId, int64(count), int64(offset), models.TagFilter{Mode: models.TagModeAll, Included: []string{"first"}}, models.ArchiveFilterActive, models.SortUpdated, nil).Return(mockResp, nil, nil).Times(1)
 				baseRepo.EXPECT().GetOwnerInfo(gomock.Any(), gomock.Any()).Return(models.OwnerInfo{}, nil).Times(2)
 			},
 			args: args{
@@ -111,7 +117,7 @@ func TestNoteUsecase_GetAllNotes(t *testing.T) {
 
 				}
 
-				baseRepo.EXPECT().ReadAllNotes(ctx, uId, int64(count), int64(offset), []string{"first"}).Return(mockResp, errors.New("repo error")).Times(1)
+				baseRepo.EXPECT().ReadAllNotes(ctx, uId, int64(count), int64(offset), models.TagFilter{Mode: models.TagModeAll, Included: []string{"first"}}, models.ArchiveFilterActive, models.SortUpdated, nil).Return(mockResp, nil, errors.New("repo error")).Times(1)
 			},
 			args: args{
 
@@ -134,7 +140,7 @@ func TestNoteUsecase_GetAllNotes(t *testing.T) {
 			Usecase := CreateNoteUsecase(baseRepo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
 
 			tt.repoMocker(context.Background(), baseRepo, searchRepo, tt.args.userId, tt.args.count, tt.args.offset)
-			got, err := Usecase.GetAllNotes(context.Background(), tt.args.userId, tt.args.count, tt.args.offset, "", []string{"first"})
+			got, _, err := Usecase.GetAllNotes(context.Background(), tt.args.userId, tt.args.count, tt.args.offset, "", models.TagFilter{Mode: models.TagModeAll, Included: []string{"first"}}, models.ArchiveFilterActive, models.SortUpdated, "")
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("NoteUsecase.GetAllNotes() error = %v, wantErr %v", err, tt.wantErr)
@@ -251,6 +257,9 @@ func TestNoteUsecase_GetNote(t *testing.T) {
 }
 
 func TestNoteUsecase_GetPublicNote(t *testing.T) {
+	sharePasswordHash, err := passhash.Hash("password")
+	assert.NoError(t, err)
+
 	elasticConfig := config.ElasticConfig{
 		ElasticIndexName:            "notes",
 		ElasticSearchValueMinLength: 2,
@@ -264,8 +273,10 @@ func TestNoteUsecase_GetPublicNote(t *testing.T) {
 	}
 
 	type args struct {
-		ctx    context.Context
-		noteId uuid.UUID
+		ctx      context.Context
+		noteId   uuid.UUID
+		token    string
+		password string
 	}
 	tests := []struct {
 		name       string
@@ -277,6 +288,11 @@ func TestNoteUsecase_GetPublicNote(t *testing.T) {
 		{
 			name: "TestSuccess",
 			repoMocker: func(ctx context.Context, repo *mock_note.MockNoteBaseRepo, args args) {
+				repo.EXPECT().GetShareLink(ctx, args.token, args.noteId).Return(models.ShareLink{
+					NoteId:       args.noteId,
+					PasswordHash: sharePasswordHash,
+				}, nil).Times(1)
+
 				mockResp := models.NoteResponse{
 					Note: models.Note{ //мок ответа от уровня репозитория
 						Id:         uuid.FromStringOrNil("c80e3ea8-0813-4731-b6ee-b41604c56f95"),
@@ -296,6 +312,8 @@ func TestNoteUsecase_GetPublicNote(t *testing.T) {
 			args: args{
 				context.Background(),
 				uuid.FromStringOrNil("c80e3ea8-0813-4731-b6ee-b41604c56f95"),
+				"token",
+				"password",
 			},
 			want: models.NoteResponse{
 				Note: models.Note{
@@ -315,6 +333,8 @@ func TestNoteUsecase_GetPublicNote(t *testing.T) {
 		{
 			name: "TestFail",
 			repoMocker: func(ctx context.Context, repo *mock_note.MockNoteBaseRepo, args args) {
+				repo.EXPECT().GetShareLink(ctx, args.token, args.noteId).Return(models.ShareLink{NoteId: args.noteId}, nil).Times(1)
+
 				mockResp := models.NoteResponse{ //мок ответа от уровня репозитория
 
 				}
@@ -324,6 +344,60 @@ func TestNoteUsecase_GetPublicNote(t *testing.T) {
 			args: args{
 				context.Background(),
 				uuid.FromStringOrNil("c80e3ea8-0813-4731-b6ee-b41604c56f95"),
+				"token",
+				"",
+			},
+			want: models.NoteResponse{},
+
+			wantErr: true,
+		},
+		{
+			name: "TestFail_NoLink",
+			repoMocker: func(ctx context.Context, repo *mock_note.MockNoteBaseRepo, args args) {
+				repo.EXPECT().GetShareLink(ctx, args.token, args.noteId).Return(models.ShareLink{}, errors.New("error")).Times(1)
+			},
+			args: args{
+				context.Background(),
+				uuid.FromStringOrNil("c80e3ea8-0813-4731-b6ee-b41604c56f95"),
+				"token",
+				"",
+			},
+			want: models.NoteResponse{},
+
+			wantErr: true,
+		},
+		{
+			name: "TestFail_Expired",
+			repoMocker: func(ctx context.Context, repo *mock_note.MockNoteBaseRepo, args args) {
+				expiresAt := time.Now().UTC().Add(-time.Hour)
+				repo.EXPECT().GetShareLink(ctx, args.token, args.noteId).Return(models.ShareLink{
+					NoteId:    args.noteId,
+					ExpiresAt: &expiresAt,
+				}, nil).Times(1)
+			},
+			args: args{
+				context.Background(),
+				uuid.FromStringOrNil("c80e3ea8-0813-4731-b6ee-b41604c56f95"),
+				"token",
+				"",
+			},
+			want: models.NoteResponse{},
+
+			wantErr: true,
+		},
+		{
+			name: "TestFail_WrongPassword",
+			repoMocker: func(ctx context.Context, repo *mock_note.MockNoteBaseRepo, args args) {
+				repo.EXPECT().GetShareLink(ctx, args.token, args.noteId).Return(models.ShareLink{
+					NoteId:       args.noteId,
+					PasswordHash: responses.GetHash("password"),
+				}, nil).Times(1)
+			},
+			args: args{
+				context.Background(),
+				uuid.FromStringOrNil("c80e3ea8-0813-4731-b6ee-b41604c56f95"),
+				"token",
+				"wrong",
 			},
 			want: models.NoteResponse{},
 
@@ -340,7 +414,7 @@ func TestNoteUsecase_GetPublicNote(t *testing.T) {
 
 			tt.repoMocker(context.Background(), repo, tt.args)
 
-			got, err := uc.GetPublicNote(tt.args.ctx, tt.args.noteId)
+			got, err := uc.GetPublicNote(tt.args.ctx, tt.args.noteId, tt.args.token, tt.args.password)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("NoteUsecase.GetPublicNote() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -430,11 +504,14 @@ func TestNoteUsecase_UpdateNote(t *testing.T) {
 	}
 
 	id := uuid.NewV4()
+	collaboratorId := uuid.NewV4()
+	linkedId := uuid.NewV4()
 
 	type args struct {
 		ctx      context.Context
 		userId   uuid.UUID
 		noteData string
+		version  int64
 	}
 	tests := []struct {
 		name       string
@@ -446,9 +523,10 @@ func TestNoteUsecase_UpdateNote(t *testing.T) {
 		{
 			name: "TestSuccess",
 			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
-				baseRepo.EXPECT().UpdateNote(ctx, gomock.Any()).Return(nil).Times(1)
+				baseRepo.EXPECT().UpdateNote(ctx, gomock.Any(), gomock.Any()).Return(nil).Times(1)
 				baseRepo.EXPECT().ReadNote(ctx, gomock.Any(), gomock.Any()).Return(models.NoteResponse{}, nil).Times(1)
 				searchRepo.EXPECT().UpdateNote(ctx, gomock.Any()).Return(nil).Times(1)
+				baseRepo.EXPECT().SetNoteLinks(ctx, id, gomock.Any(), []uuid.UUID{}, []string{}).Return(nil)
 			},
 			args: args{
 				ctx:      context.Background(),
@@ -458,6 +536,94 @@ func TestNoteUsecase_UpdateNote(t *testing.T) {
 			wantErr: false,
 			want:    models.Note{},
 		},
+		{
+			name: "TestSuccess_Links",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, id, collaboratorId).Return(models.NoteResponse{
+					Note: models.Note{Id: id, OwnerId: collaboratorId},
+				}, nil).Times(1)
+				baseRepo.EXPECT().UpdateNote(ctx, gomock.Any(), collaboratorId).Return(nil).Times(1)
+				searchRepo.EXPECT().UpdateNote(ctx, gomock.Any()).Return(nil).Times(1)
+				baseRepo.EXPECT().SetNoteLinks(ctx, id, collaboratorId, []uuid.UUID{linkedId}, []string{"roadmap"}).Return(errors.New("error"))
+			},
+			args: args{
+				ctx:      context.Background(),
+				userId:   collaboratorId,
+				noteData: `{"title":"title","content":[{"attributes":{"data-noteid":"` + linkedId.String() + `"}},{"content":"see [[Roadmap]]"}]}`,
+			},
+			wantErr: false,
+			want:    models.Note{},
+		},
+		{
+			name: "TestSuccess_Editor",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, id, collaboratorId).Return(models.NoteResponse{
+					Note: models.Note{Id: id, OwnerId: uuid.NewV4(), Collaborators: []uuid.UUID{collaboratorId}},
+				}, nil).Times(1)
+				baseRepo.EXPECT().GetCollaboratorRole(ctx, id, collaboratorId).Return(models.RoleEditor, nil)
+				baseRepo.EXPECT().UpdateNote(ctx, gomock.Any(), collaboratorId).Return(nil).Times(1)
+				searchRepo.EXPECT().UpdateNote(ctx, gomock.Any()).Return(nil).Times(1)
+				baseRepo.EXPECT().SetNoteLinks(ctx, id, collaboratorId, []uuid.UUID{}, []string{}).Return(nil)
+			},
+			args: args{
+				ctx:      context.Background(),
+				userId:   collaboratorId,
+				noteData: "{\"title\":\"title\"}",
+			},
+			wantErr: false,
+			want:    models.Note{},
+		},
+		{
+			name: "TestSuccess_Version",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, id, collaboratorId).Return(models.NoteResponse{
+					Note: models.Note{Id: id, OwnerId: collaboratorId, Version: 3},
+				}, nil).Times(1)
+				baseRepo.EXPECT().UpdateNote(ctx, gomock.Any(), collaboratorId).Return(nil).Times(1)
+				searchRepo.EXPECT().UpdateNote(ctx, gomock.Any()).Return(nil).Times(1)
+				baseRepo.EXPECT().SetNoteLinks(ctx, id, collaboratorId, []uuid.UUID{}, []string{}).Return(nil)
+			},
+			args: args{
+				ctx:      context.Background(),
+				userId:   collaboratorId,
+				noteData: "{\"title\":\"title\"}",
+				version:  3,
+			},
+			wantErr: false,
+			want:    models.Note{Id: id, OwnerId: collaboratorId, Version: 4},
+		},
+		{
+			name: "TestFail_VersionConflict",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, id, collaboratorId).Return(models.NoteResponse{
+					Note: models.Note{Id: id, OwnerId: collaboratorId, Version: 4},
+				}, nil).Times(1)
+			},
+			args: args{
+				ctx:      context.Background(),
+				userId:   collaboratorId,
+				noteData: "{\"title\":\"title\"}",
+				version:  3,
+			},
+			wantErr: true,
+			want:    models.Note{},
+		},
+		{
+			name: "TestFail_Viewer",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, id, collaboratorId).Return(models.NoteResponse{
+					Note: models.Note{Id: id, OwnerId: uuid.NewV4(), Collaborators: []uuid.UUID{collaboratorId}},
+				}, nil).Times(1)
+				baseRepo.EXPECT().GetCollaboratorRole(ctx, id, collaboratorId).Return(models.RoleViewer, nil)
+			},
+			args: args{
+				ctx:      context.Background(),
+				userId:   collaboratorId,
+				noteData: "{\"title\":\"title\"}",
+			},
+			wantErr: true,
+			want:    models.Note{},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -469,7 +635,7 @@ func TestNoteUsecase_UpdateNote(t *testing.T) {
 
 			tt.repoMocker(context.Background(), repo, searchRepo)
 
-			got, err := uc.UpdateNote(tt.args.ctx, id, tt.args.userId, tt.args.noteData)
+			got, err := uc.UpdateNote(tt.args.ctx, id, tt.args.userId, tt.args.noteData, tt.args.version)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("NoteUsecase.UpdateNote() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -497,6 +663,8 @@ func TestNoteUsecase_DeleteNote(t *testing.T) {
 	}
 
 	id := uuid.NewV4()
+	childId := uuid.NewV4()
+	parentId := uuid.NewV4()
 
 	type args struct {
 		ctx      context.Context
@@ -513,9 +681,9 @@ func TestNoteUsecase_DeleteNote(t *testing.T) {
 		{
 			name: "TestSuccess",
 			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
-				baseRepo.EXPECT().DeleteNote(ctx, gomock.Any()).Return(nil).Times(1)
 				baseRepo.EXPECT().ReadNote(ctx, gomock.Any(), gomock.Any()).Return(models.NoteResponse{}, nil).Times(1)
-				searchRepo.EXPECT().DeleteNote(ctx, gomock.Any()).Return(nil).Times(1)
+				baseRepo.EXPECT().TrashNote(ctx, id, gomock.Any(), gomock.Any()).Return([]uuid.UUID{id, childId}, nil).Times(1)
+				searchRepo.EXPECT().DeleteNote(ctx, gomock.Any()).Return(nil).Times(2)
 			},
 			args: args{
 				ctx:      context.Background(),
@@ -525,6 +693,55 @@ func TestNoteUsecase_DeleteNote(t *testing.T) {
 			wantErr: false,
 			want:    models.Note{},
 		},
+		{
+			name: "TestSuccess_Subnote",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, gomock.Any(), gomock.Any()).Return(models.NoteResponse{Note: models.Note{Parent: parentId}}, nil).Times(1)
+				baseRepo.EXPECT().TrashNote(ctx, id, gomock.Any(), gomock.Any()).Return([]uuid.UUID{id}, nil).Times(1)
+				baseRepo.EXPECT().RemoveSubNote(ctx, parentId, id).Return(nil).Times(1)
+				searchRepo.EXPECT().DeleteNote(ctx, id).Return(nil).Times(1)
+				searchRepo.EXPECT().RemoveSubNote(ctx, parentId, id).Return(nil).Times(1)
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: uuid.FromStringOrNil("a233ea8-0813-4731-b12e-b41604c56f95"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "TestNotOwner",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, gomock.Any(), gomock.Any()).Return(models.NoteResponse{Note: models.Note{OwnerId: uuid.NewV4()}}, nil).Times(1)
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: uuid.FromStringOrNil("a233ea8-0813-4731-b12e-b41604c56f95"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "TestFailOnRead",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, gomock.Any(), gomock.Any()).Return(models.NoteResponse{}, errors.New("err")).Times(1)
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: uuid.FromStringOrNil("a233ea8-0813-4731-b12e-b41604c56f95"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "TestFailOnTrash",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, gomock.Any(), gomock.Any()).Return(models.NoteResponse{}, nil).Times(1)
+				baseRepo.EXPECT().TrashNote(ctx, id, gomock.Any(), gomock.Any()).Return(nil, errors.New("err")).Times(1)
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: uuid.FromStringOrNil("a233ea8-0813-4731-b12e-b41604c56f95"),
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -570,14 +787,14 @@ func TestNoteUsecase_GetTags(t *testing.T) {
 		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo)
 		args       args
 		wantErr    bool
-		want       []string
+		want       []models.Tag
 	}{
 		{
 			name: "TestSuccess",
 			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
-				baseRepo.EXPECT().GetTags(ctx, userId).Return([]string{"tag1", "tag2"}, nil)
+				baseRepo.EXPECT().GetTags(ctx, userId).Return([]models.Tag{{Name: "tag1", NotesCount: 2}, {Name: "tag2", Color: "#ffffff"}}, nil)
 			},
-			want: []string{"tag1", "tag2"},
+			want: []models.Tag{{Name: "tag1", NotesCount: 2}, {Name: "tag2", Color: "#ffffff"}},
 			args: args{
 				ctx:    context.Background(),
 				userId: userId,
@@ -586,14 +803,14 @@ func TestNoteUsecase_GetTags(t *testing.T) {
 		{
 			name: "TestFail",
 			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
-				baseRepo.EXPECT().GetTags(ctx, userId).Return([]string{}, errors.New("error"))
+				baseRepo.EXPECT().GetTags(ctx, userId).Return([]models.Tag{}, errors.New("error"))
 			},
 			args: args{
 				ctx:    context.Background(),
 				userId: userId,
 			},
 			wantErr: true,
-			want:    []string{},
+			want:    []models.Tag{},
 		},
 	}
 	for _, tt := range tests {
@@ -631,6 +848,7 @@ func TestNoteUsecase_DeleteTag(t *testing.T) {
 
 	noteId := uuid.NewV4()
 	userId := uuid.NewV4()
+	ownerId := uuid.NewV4()
 
 	type args struct {
 		ctx     context.Context
@@ -648,7 +866,7 @@ func TestNoteUsecase_DeleteTag(t *testing.T) {
 		{
 			name: "Test_DeleteTag_Success",
 			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
-				baseRepo.EXPECT().DeleteTag(ctx, "tag1", noteId).Return(nil).Times(1)
+				baseRepo.EXPECT().DeleteTag(ctx, "tag1", noteId, gomock.Any()).Return(nil).Times(1)
 				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
 					Note: models.Note{
 						OwnerId: userId,
@@ -670,12 +888,13 @@ func TestNoteUsecase_DeleteTag(t *testing.T) {
 				OwnerId: userId,
 				Id:      noteId,
 				Tags:    []string{"tag2"},
+				Version: 1,
 			},
 		},
 		{
 			name: "Test_DeleteTag_FailOnDelete",
 			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
-				baseRepo.EXPECT().DeleteTag(ctx, "tag1", noteId).Return(errors.New("error")).Times(1)
+				baseRepo.EXPECT().DeleteTag(ctx, "tag1", noteId, gomock.Any()).Return(errors.New("error")).Times(1)
 				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
 					Note: models.Note{
 						OwnerId: userId,
@@ -725,6 +944,58 @@ func TestNoteUsecase_DeleteTag(t *testing.T) {
 			wantErr: true,
 			want:    models.Note{},
 		},
+		{
+			name: "Test_DeleteTag_Editor",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId:       ownerId,
+						Id:            noteId,
+						Tags:          []string{"tag1"},
+						Collaborators: []uuid.UUID{userId},
+					},
+				}, nil).Times(1)
+				baseRepo.EXPECT().GetCollaboratorRole(ctx, noteId, userId).Return(models.RoleEditor, nil).Times(1)
+				baseRepo.EXPECT().DeleteTag(ctx, "tag1", noteId, gomock.Any()).Return(nil).Times(1)
+				searchRepo.EXPECT().DeleteTag(ctx, "tag1", noteId).Return(nil)
+			},
+			args: args{
+				ctx:     context.Background(),
+				userId:  userId,
+				noteId:  noteId,
+				tagName: "tag1",
+			},
+			wantErr: false,
+			want: models.Note{
+				OwnerId:       ownerId,
+				Id:            noteId,
+				Tags:          []string{},
+				Collaborators: []uuid.UUID{userId},
+				Version:       1,
+			},
+		},
+		{
+			name: "Test_DeleteTag_Viewer",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId:       ownerId,
+						Id:            noteId,
+						Tags:          []string{"tag1"},
+						Collaborators: []uuid.UUID{userId},
+					},
+				}, nil).Times(1)
+				baseRepo.EXPECT().GetCollaboratorRole(ctx, noteId, userId).Return(models.RoleViewer, nil).Times(1)
+			},
+			args: args{
+				ctx:     context.Background(),
+				userId:  userId,
+				noteId:  noteId,
+				tagName: "tag1",
+			},
+			wantErr: true,
+			want:    models.Note{},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -736,7 +1007,7 @@ func TestNoteUsecase_DeleteTag(t *testing.T) {
 
 			tt.repoMocker(context.Background(), repo, searchRepo)
 
-			got, err := uc.DeleteTag(tt.args.ctx, tt.args.tagName, tt.args.noteId, tt.args.userId)
+			got, err := uc.DeleteTag(tt.args.ctx, tt.args.tagName, tt.args.noteId, tt.args.userId, 0)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("NoteUsecase.DeleteTag() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -761,6 +1032,7 @@ func TestNoteUsecase_AddTag(t *testing.T) {
 
 	noteId := uuid.NewV4()
 	userId := uuid.NewV4()
+	ownerId := uuid.NewV4()
 
 	type args struct {
 		ctx     context.Context
@@ -778,7 +1050,7 @@ func TestNoteUsecase_AddTag(t *testing.T) {
 		{
 			name: "Test_AddTag_Success",
 			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
-				baseRepo.EXPECT().AddTag(ctx, "tag1", noteId).Return(nil).Times(1)
+				baseRepo.EXPECT().AddTag(ctx, "tag1", noteId, gomock.Any()).Return(nil).Times(1)
 				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
 					Note: models.Note{
 						OwnerId: userId,
@@ -800,12 +1072,13 @@ func TestNoteUsecase_AddTag(t *testing.T) {
 				OwnerId: userId,
 				Id:      noteId,
 				Tags:    []string{"tag2", "tag1"},
+				Version: 1,
 			},
 		},
 		{
 			name: "Test_AddTag_FailOnAdd",
 			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
-				baseRepo.EXPECT().AddTag(ctx, "tag1", noteId).Return(errors.New("error")).Times(1)
+				baseRepo.EXPECT().AddTag(ctx, "tag1", noteId, gomock.Any()).Return(errors.New("error")).Times(1)
 				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
 					Note: models.Note{
 						OwnerId: userId,
@@ -876,6 +1149,60 @@ func TestNoteUsecase_AddTag(t *testing.T) {
 			wantErr: true,
 			want:    models.Note{},
 		},
+		{
+			name: "Test_AddTag_Editor",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId:       ownerId,
+						Id:            noteId,
+						Tags:          []string{"tag2"},
+						Collaborators: []uuid.UUID{userId},
+					},
+				}, nil).Times(1)
+				baseRepo.EXPECT().GetCollaboratorRole(ctx, noteId, userId).Return(models.RoleEditor, nil).Times(1)
+				baseRepo.EXPECT().AddTag(ctx, "tag1", noteId, gomock.Any()).Return(nil).Times(1)
+				searchRepo.EXPECT().AddTag(ctx, "tag1", noteId).Return(nil)
+				baseRepo.EXPECT().RememberTag(gomock.Any(), "tag1", userId).Return(nil).Times(1)
+				baseRepo.EXPECT().ReconcileTags(gomock.Any(), []uuid.UUID{noteId}).Return(nil).Times(1)
+			},
+			args: args{
+				ctx:     context.Background(),
+				userId:  userId,
+				noteId:  noteId,
+				tagName: "tag1",
+			},
+			wantErr: false,
+			want: models.Note{
+				OwnerId:       ownerId,
+				Id:            noteId,
+				Tags:          []string{"tag2", "tag1"},
+				Collaborators: []uuid.UUID{userId},
+				Version:       1,
+			},
+		},
+		{
+			name: "Test_AddTag_Viewer",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId:       ownerId,
+						Id:            noteId,
+						Tags:          []string{"tag2"},
+						Collaborators: []uuid.UUID{userId},
+					},
+				}, nil).Times(1)
+				baseRepo.EXPECT().GetCollaboratorRole(ctx, noteId, userId).Return(models.RoleViewer, nil).Times(1)
+			},
+			args: args{
+				ctx:     context.Background(),
+				userId:  userId,
+				noteId:  noteId,
+				tagName: "tag1",
+			},
+			wantErr: true,
+			want:    models.Note{},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -887,7 +1214,7 @@ func TestNoteUsecase_AddTag(t *testing.T) {
 
 			tt.repoMocker(context.Background(), repo, searchRepo)
 
-			got, err := uc.AddTag(tt.args.ctx, tt.args.tagName, tt.args.noteId, tt.args.userId)
+			got, err := uc.AddTag(tt.args.ctx, tt.args.tagName, tt.args.noteId, tt.args.userId, 0)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("NoteUsecase.AddTag error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -981,7 +1308,8 @@ func TestNoteUsecase_addCollaboratorRecursive(t *testing.T) {
 
 			tt.repoMocker(context.Background(), repo, searchRepo)
 
-			err := uc.addCollaboratorRecursive(context.Background(), noteId, guestId, uuid.NewV4())
+			addedTo := make([]uuid.UUID, 0)
+			err := uc.addCollaboratorRecursive(context.Background(), noteId, guestId, uuid.NewV4(), &addedTo)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("NoteUsecase.addCollaboratorReqursive error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -1079,7 +1407,7 @@ func TestNoteUsecase_AddCollaborator(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "Test_AddCollaborator_AddErr",
+			name: "Test_AddCollaborator_CreateInviteErr",
 			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
 				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
 					Note: models.Note{
@@ -1088,12 +1416,26 @@ func TestNoteUsecase_AddCollaborator(t *testing.T) {
 						Parent:  uuid.UUID{},
 					},
 				}, nil).Times(1)
-				baseRepo.EXPECT().AddCollaborator(gomock.Any(), noteId, guestId).Return("", errors.New("error"))
-
+				baseRepo.EXPECT().CreateInvite(gomock.Any(), gomock.Any()).Return("", errors.New("error"))
 			},
 
 			wantErr: true,
 		},
+		{
+			name: "Test_AddCollaborator_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						Id:      noteId,
+						OwnerId: userId,
+						Parent:  uuid.UUID{},
+					},
+				}, nil).Times(1)
+				baseRepo.EXPECT().CreateInvite(gomock.Any(), gomock.Any()).Return("title", nil)
+			},
+
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1105,7 +1447,7 @@ func TestNoteUsecase_AddCollaborator(t *testing.T) {
 
 			tt.repoMocker(context.Background(), repo, searchRepo)
 
-			_, err := uc.AddCollaborator(context.Background(), noteId, userId, guestId)
+			_, err := uc.AddCollaborator(context.Background(), noteId, userId, guestId, models.RoleViewer)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("NoteUsecase.AddCollaborator error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -1114,47 +1456,123 @@ func TestNoteUsecase_AddCollaborator(t *testing.T) {
 	}
 }
 
-func TestNoteUsecase_getDepth(t *testing.T) {
-	elasticConfig := config.ElasticConfig{
-		ElasticIndexName:            "notes",
-		ElasticSearchValueMinLength: 2,
-	}
+func TestNoteUsecase_AcceptInvite(t *testing.T) {
+	elasticConfig := config.ElasticConfig{}
 
 	constraintsConfig := config.ConstraintsConfig{
-		MaxDepth:         3,
-		MaxCollaborators: 10,
-		MaxTags:          4,
-		MaxSubnotes:      10,
+		MaxCollaborators: 1,
+	}
+
+	inviteId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	ownerId := uuid.NewV4()
+	userId := uuid.NewV4()
+
+	invite := models.Invite{
+		Id:        inviteId,
+		NoteId:    noteId,
+		InviterId: ownerId,
+		InviteeId: userId,
+		Role:      models.RoleViewer,
+		ExpiresAt: time.Now().UTC().Add(time.Hour),
 	}
 
-	parentId := uuid.NewV4()
-	childId := uuid.NewV4()
 	tests := []struct {
-		parentId   uuid.UUID
-		childId    uuid.UUID
 		name       string
 		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo)
+		want       models.Invite
 		wantErr    bool
-		currDepth  int
 	}{
 		{
-			name:      "Test_getDepth",
-			parentId:  uuid.UUID{},
-			childId:   uuid.UUID{},
-			currDepth: 1,
-			wantErr:   false,
-			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+			name: "Test_AcceptInvite_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().GetInvite(ctx, inviteId).Return(invite, nil)
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						Id:      noteId,
+						OwnerId: ownerId,
+					},
+				}, nil)
+				baseRepo.EXPECT().AddCollaborator(ctx, noteId, userId).Return("title", nil)
+				baseRepo.EXPECT().SetCollaboratorRole(ctx, noteId, userId, models.RoleViewer).Return(nil)
+				searchRepo.EXPECT().AddCollaborator(ctx, noteId, userId).Return(nil)
+				baseRepo.EXPECT().ReconcileTags(ctx, []uuid.UUID{noteId}).Return(nil)
+				baseRepo.EXPECT().DeleteInvite(ctx, inviteId).Return(nil)
 			},
+			want:    invite,
+			wantErr: false,
 		},
 		{
-			name:      "Test_ReadErr",
-			parentId:  parentId,
-			childId:   childId,
-			currDepth: 1,
-			wantErr:   true,
-			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
-				baseRepo.EXPECT().ReadNote(gomock.Any(), childId, gomock.Any()).Return(models.NoteResponse{}, errors.New("err"))
+			name: "Test_AcceptInvite_NotFound",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().GetInvite(ctx, inviteId).Return(models.Invite{}, errors.New("error"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test_AcceptInvite_AnotherUser",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().GetInvite(ctx, inviteId).Return(models.Invite{
+					Id:        inviteId,
+					InviteeId: uuid.NewV4(),
+					ExpiresAt: time.Now().UTC().Add(time.Hour),
+				}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test_AcceptInvite_Expired",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().GetInvite(ctx, inviteId).Return(models.Invite{
+					Id:        inviteId,
+					InviteeId: userId,
+					ExpiresAt: time.Now().UTC().Add(-time.Hour),
+				}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test_AcceptInvite_TooMany",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().GetInvite(ctx, inviteId).Return(invite, nil)
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						Id:            noteId,
+						OwnerId:       ownerId,
+						Collaborators: []uuid.UUID{uuid.NewV4()},
+					},
+				}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test_AcceptInvite_AddErr",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().GetInvite(ctx, inviteId).Return(invite, nil)
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						Id:      noteId,
+						OwnerId: ownerId,
+					},
+				}, nil)
+				baseRepo.EXPECT().AddCollaborator(ctx, noteId, userId).Return("", errors.New("error"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test_AcceptInvite_SetRoleErr",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().GetInvite(ctx, inviteId).Return(invite, nil)
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						Id:      noteId,
+						OwnerId: ownerId,
+					},
+				}, nil)
+				baseRepo.EXPECT().AddCollaborator(ctx, noteId, userId).Return("title", nil)
+				baseRepo.EXPECT().SetCollaboratorRole(ctx, noteId, userId, models.RoleViewer).Return(errors.New("error"))
 			},
+			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
@@ -1167,88 +1585,59 @@ func TestNoteUsecase_getDepth(t *testing.T) {
 
 			tt.repoMocker(context.Background(), repo, searchRepo)
 
-			_, err := uc.getDepth(context.Background(), tt.childId, tt.currDepth, uuid.UUID{})
+			got, err := uc.AcceptInvite(context.Background(), inviteId, userId)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("NoteUsecase.getDepth error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("NoteUsecase.AcceptInvite error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-
+			assert.Equal(t, tt.want, got)
 		})
 	}
 }
 
-func TestNoteUsecase_CreateSubNote(t *testing.T) {
-	elasticConfig := config.ElasticConfig{
-		ElasticIndexName:            "notes",
-		ElasticSearchValueMinLength: 2,
-	}
+func TestNoteUsecase_DeclineInvite(t *testing.T) {
+	inviteId := uuid.NewV4()
+	userId := uuid.NewV4()
 
-	constraintsConfig := config.ConstraintsConfig{
-		MaxDepth:         3,
-		MaxCollaborators: 10,
-		MaxTags:          4,
-		MaxSubnotes:      10,
+	invite := models.Invite{
+		Id:        inviteId,
+		InviterId: uuid.NewV4(),
+		InviteeId: userId,
 	}
 
-	parentId := uuid.NewV4()
-	userId := uuid.NewV4()
-	childId := uuid.NewV4()
 	tests := []struct {
-		parentId   uuid.UUID
-		childId    uuid.UUID
-		userId     uuid.UUID
 		name       string
-		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo)
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo)
+		want       models.Invite
 		wantErr    bool
-		currDepth  int
 	}{
 		{
-			name:      "Test_CreateSubnote_readError",
-			parentId:  parentId,
-			childId:   childId,
-			userId:    userId,
-			currDepth: 1,
-			wantErr:   true,
-			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
-				baseRepo.EXPECT().ReadNote(gomock.Any(), parentId, userId).Return(models.NoteResponse{}, errors.New("error"))
+			name: "Test_DeclineInvite_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().GetInvite(ctx, inviteId).Return(invite, nil)
+				baseRepo.EXPECT().DeleteInvite(ctx, inviteId).Return(nil)
 			},
+			want:    invite,
+			wantErr: false,
 		},
 		{
-			name:      "Test_CreateSubnote_TooManyChildren",
-			parentId:  parentId,
-			childId:   childId,
-			userId:    userId,
-			currDepth: 1,
-			wantErr:   true,
-			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
-				baseRepo.EXPECT().ReadNote(gomock.Any(), parentId, userId).Return(models.NoteResponse{
-					Note: models.Note{
-						Id:       parentId,
-						OwnerId:  userId,
-						Children: make([]uuid.UUID, 20),
-					},
+			name: "Test_DeclineInvite_AnotherUser",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().GetInvite(ctx, inviteId).Return(models.Invite{
+					Id:        inviteId,
+					InviterId: userId,
+					InviteeId: uuid.NewV4(),
 				}, nil)
-
 			},
+			wantErr: true,
 		},
 		{
-			name:      "Test_CreateSubnote_getDepthErr",
-			parentId:  parentId,
-			childId:   childId,
-			userId:    userId,
-			currDepth: 1,
-			wantErr:   true,
-			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
-				baseRepo.EXPECT().ReadNote(gomock.Any(), parentId, userId).Return(models.NoteResponse{
-					Note: models.Note{
-						Id:      parentId,
-						OwnerId: userId,
-						Parent:  uuid.NewV4(),
-					},
-				}, nil)
-				baseRepo.EXPECT().ReadNote(gomock.Any(), gomock.Any(), gomock.Any()).Return(models.NoteResponse{}, errors.New("error"))
-
+			name: "Test_DeclineInvite_DeleteErr",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().GetInvite(ctx, inviteId).Return(invite, nil)
+				baseRepo.EXPECT().DeleteInvite(ctx, inviteId).Return(errors.New("error"))
 			},
+			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
@@ -1257,77 +1646,107 @@ func TestNoteUsecase_CreateSubNote(t *testing.T) {
 			defer ctl.Finish()
 			repo := mock_note.NewMockNoteBaseRepo(ctl)
 			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
-			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+			uc := CreateNoteUsecase(repo, searchRepo, config.ElasticConfig{}, config.ConstraintsConfig{}, &sync.WaitGroup{})
 
-			tt.repoMocker(context.Background(), repo, searchRepo)
+			tt.repoMocker(context.Background(), repo)
 
-			_, err := uc.CreateSubNote(context.Background(), tt.userId, "", tt.parentId)
+			got, err := uc.DeclineInvite(context.Background(), inviteId, userId)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("NoteUsecase.CreateSubnote error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("NoteUsecase.DeclineInvite error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-
+			assert.Equal(t, tt.want, got)
 		})
 	}
 }
 
-func TestNoteUsecase_CheckPermissions(t *testing.T) {
-	elasticConfig := config.ElasticConfig{
-		ElasticIndexName:            "notes",
-		ElasticSearchValueMinLength: 2,
+func TestNoteUsecase_RevokeInvite(t *testing.T) {
+	inviteId := uuid.NewV4()
+	userId := uuid.NewV4()
+
+	invite := models.Invite{
+		Id:        inviteId,
+		InviterId: userId,
+		InviteeId: uuid.NewV4(),
 	}
 
-	constraintsConfig := config.ConstraintsConfig{
-		MaxDepth:         3,
-		MaxCollaborators: 10,
-		MaxTags:          10,
-		MaxSubnotes:      10,
+	tests := []struct {
+		name       string
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo)
+		want       models.Invite
+		wantErr    bool
+	}{
+		{
+			name: "Test_RevokeInvite_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().GetInvite(ctx, inviteId).Return(invite, nil)
+				baseRepo.EXPECT().DeleteInvite(ctx, inviteId).Return(nil)
+			},
+			want:    invite,
+			wantErr: false,
+		},
+		{
+			name: "Test_RevokeInvite_NotInviter",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().GetInvite(ctx, inviteId).Return(models.Invite{
+					Id:        inviteId,
+					InviterId: uuid.NewV4(),
+					InviteeId: userId,
+				}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test_RevokeInvite_NotFound",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().GetInvite(ctx, inviteId).Return(models.Invite{}, errors.New("error"))
+			},
+			wantErr: true,
+		},
 	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, config.ElasticConfig{}, config.ConstraintsConfig{}, &sync.WaitGroup{})
 
-	noteId := uuid.NewV4()
-	userId := uuid.NewV4()
+			tt.repoMocker(context.Background(), repo)
 
-	type args struct {
-		ctx    context.Context
-		userId uuid.UUID
-		noteId uuid.UUID
+			got, err := uc.RevokeInvite(context.Background(), inviteId, userId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NoteUsecase.RevokeInvite error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
 	}
+}
+
+func TestNoteUsecase_GetReceivedInvites(t *testing.T) {
+	userId := uuid.NewV4()
+
 	tests := []struct {
 		name       string
-		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo)
-		args       args
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo)
+		want       []models.Invite
 		wantErr    bool
-		want       bool
 	}{
 		{
-			name: "TestSuccess",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
-				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
-					Note: models.Note{
-						OwnerId: userId,
-					},
-				}, nil).Times(1)
-			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-				noteId: noteId,
+			name: "Test_GetReceivedInvites_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().GetReceivedInvites(ctx, userId, gomock.Any()).Return([]models.Invite{{InviteeId: userId}}, nil)
 			},
+			want:    []models.Invite{{InviteeId: userId}},
 			wantErr: false,
-			want:    true,
 		},
 		{
-			name: "TestFail",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
-				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{}, errors.New("error")).Times(1)
-			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-				noteId: noteId,
+			name: "Test_GetReceivedInvites_Fail",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().GetReceivedInvites(ctx, userId, gomock.Any()).Return(nil, errors.New("error"))
 			},
 			wantErr: true,
-			want:    false,
 		},
 	}
 	for _, tt := range tests {
@@ -1336,13 +1755,13 @@ func TestNoteUsecase_CheckPermissions(t *testing.T) {
 			defer ctl.Finish()
 			repo := mock_note.NewMockNoteBaseRepo(ctl)
 			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
-			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+			uc := CreateNoteUsecase(repo, searchRepo, config.ElasticConfig{}, config.ConstraintsConfig{}, &sync.WaitGroup{})
 
-			tt.repoMocker(context.Background(), repo, searchRepo)
+			tt.repoMocker(context.Background(), repo)
 
-			got, err := uc.CheckPermissions(tt.args.ctx, tt.args.noteId, tt.args.userId)
+			got, err := uc.GetReceivedInvites(context.Background(), userId)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("NoteUsecase.CheckPermissions() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("NoteUsecase.GetReceivedInvites error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			assert.Equal(t, tt.want, got)
@@ -1350,114 +1769,87 @@ func TestNoteUsecase_CheckPermissions(t *testing.T) {
 	}
 }
 
-func TestNoteUsecase_SetIcon(t *testing.T) {
-	elasticConfig := config.ElasticConfig{}
+func TestNoteUsecase_SetCollaboratorRole(t *testing.T) {
+	elasticConfig := config.ElasticConfig{
+		ElasticIndexName:            "notes",
+		ElasticSearchValueMinLength: 2,
+	}
 
-	constraintsConfig := config.ConstraintsConfig{}
+	constraintsConfig := config.ConstraintsConfig{
+		MaxDepth:         3,
+		MaxCollaborators: 10,
+		MaxTags:          4,
+		MaxSubnotes:      10,
+	}
 
 	noteId := uuid.NewV4()
 	userId := uuid.NewV4()
+	guestId := uuid.NewV4()
 
-	type args struct {
-		ctx    context.Context
-		userId uuid.UUID
-		noteId uuid.UUID
-		icon   string
-	}
 	tests := []struct {
 		name       string
-		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args)
-		args       args
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo)
 		wantErr    bool
-		want       models.Note
 	}{
 		{
-			name: "Test_SetIcon_Success",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
-
+			name: "Test_SetCollaboratorRole_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
 				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
 					Note: models.Note{
-						OwnerId: args.userId,
-						Id:      args.noteId,
-						Icon:    args.icon,
+						Id:            noteId,
+						OwnerId:       userId,
+						Collaborators: []uuid.UUID{guestId},
 					},
-				}, nil).Times(1)
-				baseRepo.EXPECT().SetIcon(ctx, args.noteId, args.icon).Return(nil)
-				searchRepo.EXPECT().SetIcon(ctx, args.noteId, args.icon).Return(nil)
-
-			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-				noteId: noteId,
-				icon:   "icon",
+				}, nil)
+				baseRepo.EXPECT().SetCollaboratorRole(ctx, noteId, guestId, models.RoleCommenter).Return(nil)
 			},
 			wantErr: false,
-			want: models.Note{
-				OwnerId: userId,
-				Id:      noteId,
-				Icon:    "icon",
+		},
+		{
+			name: "Test_SetCollaboratorRole_FailOnRead",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{}, errors.New("error"))
 			},
+			wantErr: true,
 		},
 		{
-			name: "Test_SetIcon_FailOnSet",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+			name: "Test_SetCollaboratorRole_NotOwner",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
 				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
 					Note: models.Note{
-						OwnerId: args.userId,
-						Id:      args.noteId,
-						Icon:    args.icon,
+						Id:            noteId,
+						OwnerId:       uuid.NewV4(),
+						Collaborators: []uuid.UUID{guestId, userId},
 					},
-				}, nil).Times(1)
-				baseRepo.EXPECT().SetIcon(ctx, args.noteId, args.icon).Return(errors.New("err"))
-
-			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-				noteId: noteId,
-				icon:   "icon",
+				}, nil)
 			},
 			wantErr: true,
-			want:    models.Note{},
 		},
-
 		{
-			name: "Test_SetIcon_FailOnRead",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+			name: "Test_SetCollaboratorRole_HasParent",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
 				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
 					Note: models.Note{
-						OwnerId: args.userId,
-						Id:      args.noteId,
-						Icon:    args.icon,
+						Id:            noteId,
+						OwnerId:       userId,
+						Parent:        uuid.NewV4(),
+						Collaborators: []uuid.UUID{guestId},
 					},
-				}, errors.New("err")).Times(1)
-			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-				noteId: noteId,
+				}, nil)
 			},
 			wantErr: true,
-			want:    models.Note{},
 		},
 		{
-			name: "Test_SetIcon_NotOwner",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+			name: "Test_SetCollaboratorRole_NotCollaborator",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
 				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
 					Note: models.Note{
-						OwnerId: uuid.NewV4(),
-						Id:      args.noteId,
+						Id:      noteId,
+						OwnerId: userId,
 					},
-				}, nil).Times(1)
-			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-				noteId: noteId,
+				}, nil)
 			},
 			wantErr: true,
-			want:    models.Note{},
 		},
 	}
 	for _, tt := range tests {
@@ -1468,126 +1860,98 @@ func TestNoteUsecase_SetIcon(t *testing.T) {
 			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
 			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
 
-			tt.repoMocker(context.Background(), repo, searchRepo, tt.args)
+			tt.repoMocker(context.Background(), repo)
 
-			got, err := uc.SetIcon(tt.args.ctx, tt.args.noteId, tt.args.icon, tt.args.userId)
+			err := uc.SetCollaboratorRole(context.Background(), noteId, userId, guestId, models.RoleCommenter)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("NoteUsecase.SetIcon error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("NoteUsecase.SetCollaboratorRole error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			assert.Equal(t, tt.want, got)
 		})
 	}
 }
 
-func TestNoteUsecase_SetHeader(t *testing.T) {
+func TestNoteUsecase_RemoveCollaborator(t *testing.T) {
 	elasticConfig := config.ElasticConfig{}
 
-	constraintsConfig := config.ConstraintsConfig{}
+	constraintsConfig := config.ConstraintsConfig{
+		MaxDepth:         3,
+		MaxCollaborators: 10,
+		MaxTags:          4,
+		MaxSubnotes:      10,
+	}
 
 	noteId := uuid.NewV4()
+	childId := uuid.NewV4()
 	userId := uuid.NewV4()
+	guestId := uuid.NewV4()
 
-	type args struct {
-		ctx    context.Context
-		userId uuid.UUID
-		noteId uuid.UUID
-		header string
-	}
 	tests := []struct {
-		name       string
-		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args)
-		args       args
-		wantErr    bool
-		want       models.Note
+		name        string
+		repoMocker  func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo)
+		wantRemoved []uuid.UUID
+		wantErr     bool
 	}{
 		{
-			name: "Test_SetHeader_Success",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
-
+			name: "Test_RemoveCollaborator_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
 				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
 					Note: models.Note{
-						OwnerId: args.userId,
-						Id:      args.noteId,
-						Header:  args.header,
+						Id:            noteId,
+						OwnerId:       userId,
+						Children:      []uuid.UUID{childId},
+						Collaborators: []uuid.UUID{guestId},
 					},
-				}, nil).Times(1)
-				baseRepo.EXPECT().SetHeader(ctx, args.noteId, args.header).Return(nil)
-				searchRepo.EXPECT().SetHeader(ctx, args.noteId, args.header).Return(nil)
-
-			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-				noteId: noteId,
-				header: "header",
-			},
-			wantErr: false,
-			want: models.Note{
-				OwnerId: userId,
-				Id:      noteId,
-				Header:  "header",
+				}, nil)
+				baseRepo.EXPECT().RemoveCollaborator(ctx, noteId, guestId).Return("title", nil)
+				baseRepo.EXPECT().ReadNote(ctx, childId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: childId, OwnerId: userId, Parent: noteId},
+				}, nil)
+				baseRepo.EXPECT().RemoveCollaborator(ctx, childId, guestId).Return("", nil)
+				searchRepo.EXPECT().RemoveCollaborator(ctx, noteId, guestId).Return(nil)
+				searchRepo.EXPECT().RemoveCollaborator(ctx, childId, guestId).Return(nil)
 			},
+			wantRemoved: []uuid.UUID{noteId, childId},
+			wantErr:     false,
 		},
 		{
-			name: "Test_SetHeader_FailOnSet",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+			name: "Test_RemoveCollaborator_NotOwner",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
 				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
 					Note: models.Note{
-						OwnerId: args.userId,
-						Id:      args.noteId,
-						Header:  args.header,
+						Id:            noteId,
+						OwnerId:       uuid.NewV4(),
+						Collaborators: []uuid.UUID{guestId, userId},
 					},
-				}, nil).Times(1)
-				baseRepo.EXPECT().SetHeader(ctx, args.noteId, args.header).Return(errors.New("err"))
-
-			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-				noteId: noteId,
-				header: "header",
+				}, nil)
 			},
 			wantErr: true,
-			want:    models.Note{},
 		},
-
 		{
-			name: "Test_SetHeader_FailOnRead",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+			name: "Test_RemoveCollaborator_NotCollaborator",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
 				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
 					Note: models.Note{
-						OwnerId: args.userId,
-						Id:      args.noteId,
-						Header:  args.header,
+						Id:      noteId,
+						OwnerId: userId,
 					},
-				}, errors.New("err")).Times(1)
-			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-				noteId: noteId,
+				}, nil)
 			},
 			wantErr: true,
-			want:    models.Note{},
 		},
 		{
-			name: "Test_SetHeader_NotOwner",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+			name: "Test_RemoveCollaborator_RemoveErr",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
 				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
 					Note: models.Note{
-						OwnerId: uuid.NewV4(),
-						Id:      args.noteId,
+						Id:            noteId,
+						OwnerId:       userId,
+						Collaborators: []uuid.UUID{guestId},
 					},
-				}, nil).Times(1)
-			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-				noteId: noteId,
+				}, nil)
+				baseRepo.EXPECT().RemoveCollaborator(ctx, noteId, guestId).Return("", errors.New("error"))
 			},
 			wantErr: true,
-			want:    models.Note{},
 		},
 	}
 	for _, tt := range tests {
@@ -1598,19 +1962,19 @@ func TestNoteUsecase_SetHeader(t *testing.T) {
 			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
 			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
 
-			tt.repoMocker(context.Background(), repo, searchRepo, tt.args)
+			tt.repoMocker(context.Background(), repo, searchRepo)
 
-			got, err := uc.SetHeader(tt.args.ctx, tt.args.noteId, tt.args.header, tt.args.userId)
+			_, removed, err := uc.RemoveCollaborator(context.Background(), noteId, userId, guestId)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("NoteUsecase.SetHeader error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("NoteUsecase.RemoveCollaborator error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantRemoved, removed)
 		})
 	}
 }
 
-func TestNoteUsecase_AddFav(t *testing.T) {
+func TestNoteUsecase_LeaveNote(t *testing.T) {
 	elasticConfig := config.ElasticConfig{}
 
 	constraintsConfig := config.ConstraintsConfig{}
@@ -1618,124 +1982,60 @@ func TestNoteUsecase_AddFav(t *testing.T) {
 	noteId := uuid.NewV4()
 	userId := uuid.NewV4()
 
-	type args struct {
-		ctx    context.Context
-		userId uuid.UUID
-		noteId uuid.UUID
-	}
 	tests := []struct {
-		name       string
-		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args)
-		args       args
-		wantErr    bool
-		want       models.Note
+		name        string
+		repoMocker  func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo)
+		wantRemoved []uuid.UUID
+		wantErr     bool
 	}{
 		{
-			name: "Test_AddFav_Success",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
-
+			name: "Test_LeaveNote_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
 				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
 					Note: models.Note{
-						OwnerId:  args.userId,
-						Id:       args.noteId,
-						Favorite: false,
+						Id:            noteId,
+						OwnerId:       uuid.NewV4(),
+						Collaborators: []uuid.UUID{userId},
 					},
-				}, nil).Times(1)
-				baseRepo.EXPECT().AddFav(ctx, args.noteId, args.userId).Return(nil)
-				searchRepo.EXPECT().ChangeFlag(ctx, args.noteId, true).Return(nil)
-
-			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-				noteId: noteId,
-			},
-			wantErr: false,
-			want: models.Note{
-				OwnerId:  userId,
-				Id:       noteId,
-				Favorite: true,
+				}, nil)
+				baseRepo.EXPECT().RemoveCollaborator(ctx, noteId, userId).Return("title", nil)
+				searchRepo.EXPECT().RemoveCollaborator(ctx, noteId, userId).Return(nil)
 			},
+			wantRemoved: []uuid.UUID{noteId},
+			wantErr:     false,
 		},
 		{
-			name: "Test_AddFav_FailOnSet",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+			name: "Test_LeaveNote_NotCollaborator",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
 				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
 					Note: models.Note{
-						OwnerId: args.userId,
-						Id:      args.noteId,
+						Id:      noteId,
+						OwnerId: userId,
 					},
-				}, nil).Times(1)
-				baseRepo.EXPECT().AddFav(args.ctx, args.noteId, args.userId).Return(errors.New("err"))
-
-			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-				noteId: noteId,
+				}, nil)
 			},
 			wantErr: true,
-			want:    models.Note{},
 		},
-
 		{
-			name: "Test_AddFav_FailOnRead",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+			name: "Test_LeaveNote_Subnote",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
 				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
 					Note: models.Note{
-						OwnerId: args.userId,
-						Id:      args.noteId,
+						Id:            noteId,
+						OwnerId:       uuid.NewV4(),
+						Parent:        uuid.NewV4(),
+						Collaborators: []uuid.UUID{userId},
 					},
-				}, errors.New("err")).Times(1)
-			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-				noteId: noteId,
+				}, nil)
 			},
 			wantErr: true,
-			want:    models.Note{},
 		},
 		{
-			name: "Test_AddFav_NotOwner",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
-				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
-					Note: models.Note{
-						OwnerId: uuid.NewV4(),
-						Id:      args.noteId,
-					},
-				}, nil).Times(1)
-			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-				noteId: noteId,
+			name: "Test_LeaveNote_ReadErr",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{}, errors.New("error"))
 			},
 			wantErr: true,
-			want:    models.Note{},
-		},
-		{
-			name: "Test_AddFav_AlreadyTrue",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
-				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
-					Note: models.Note{
-						OwnerId:  args.userId,
-						Id:       args.noteId,
-						Favorite: true,
-					},
-				}, nil).Times(1)
-			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-				noteId: noteId,
-			},
-			wantErr: false,
-			want: models.Note{
-				OwnerId:  userId,
-				Id:       noteId,
-				Favorite: true,
-			},
 		},
 	}
 	for _, tt := range tests {
@@ -1746,145 +2046,106 @@ func TestNoteUsecase_AddFav(t *testing.T) {
 			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
 			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
 
-			tt.repoMocker(context.Background(), repo, searchRepo, tt.args)
+			tt.repoMocker(context.Background(), repo, searchRepo)
 
-			got, err := uc.AddFav(context.Background(), tt.args.noteId, tt.args.userId)
+			removed, err := uc.LeaveNote(context.Background(), noteId, userId)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("NoteUsecase.AddFav error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("NoteUsecase.LeaveNote error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantRemoved, removed)
 		})
 	}
 }
 
-func TestNoteUsecase_DelFav(t *testing.T) {
+func TestNoteUsecase_ProposeTransfer(t *testing.T) {
 	elasticConfig := config.ElasticConfig{}
 
-	constraintsConfig := config.ConstraintsConfig{}
+	constraintsConfig := config.ConstraintsConfig{
+		MaxCollaborators: 1,
+	}
 
 	noteId := uuid.NewV4()
 	userId := uuid.NewV4()
+	newOwnerId := uuid.NewV4()
 
-	type args struct {
-		ctx    context.Context
-		userId uuid.UUID
-		noteId uuid.UUID
-	}
 	tests := []struct {
 		name       string
-		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args)
-		args       args
+		newOwnerId uuid.UUID
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo)
+		wantTitle  string
 		wantErr    bool
-		want       models.Note
 	}{
 		{
-			name: "Test_DelFav_Success",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
-
+			name:       "Test_ProposeTransfer_Success",
+			newOwnerId: newOwnerId,
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
 				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
 					Note: models.Note{
-						OwnerId:  args.userId,
-						Id:       args.noteId,
-						Favorite: true,
+						Id:            noteId,
+						OwnerId:       userId,
+						Collaborators: []uuid.UUID{newOwnerId},
 					},
-				}, nil).Times(1)
-				baseRepo.EXPECT().DelFav(ctx, args.noteId, args.userId).Return(nil)
-				searchRepo.EXPECT().ChangeFlag(ctx, args.noteId, false).Return(nil)
-
-			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-				noteId: noteId,
-			},
-			wantErr: false,
-			want: models.Note{
-				OwnerId:  userId,
-				Id:       noteId,
-				Favorite: false,
+				}, nil)
+				baseRepo.EXPECT().ProposeTransfer(ctx, gomock.Any()).Return("title", nil)
 			},
+			wantTitle: "title",
+			wantErr:   false,
 		},
 		{
-			name: "Test_DelFav_FailOnDel",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+			name:       "Test_ProposeTransfer_NotOwner",
+			newOwnerId: newOwnerId,
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
 				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
 					Note: models.Note{
-						OwnerId:  args.userId,
-						Id:       args.noteId,
-						Favorite: true,
+						Id:            noteId,
+						OwnerId:       newOwnerId,
+						Collaborators: []uuid.UUID{userId},
 					},
-				}, nil).Times(1)
-				baseRepo.EXPECT().DelFav(args.ctx, args.noteId, args.userId).Return(errors.New("err"))
-
-			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-				noteId: noteId,
+				}, nil)
 			},
 			wantErr: true,
-			want:    models.Note{},
 		},
-
 		{
-			name: "Test_DelFav_FailOnRead",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+			name:       "Test_ProposeTransfer_Subnote",
+			newOwnerId: newOwnerId,
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
 				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
 					Note: models.Note{
-						OwnerId: args.userId,
-						Id:      args.noteId,
+						Id:      noteId,
+						OwnerId: userId,
+						Parent:  uuid.NewV4(),
 					},
-				}, errors.New("err")).Times(1)
-			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-				noteId: noteId,
+				}, nil)
 			},
 			wantErr: true,
-			want:    models.Note{},
 		},
 		{
-			name: "Test_DelFav_NotOwner",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+			name:       "Test_ProposeTransfer_AlreadyOwner",
+			newOwnerId: userId,
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
 				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
 					Note: models.Note{
-						OwnerId: uuid.NewV4(),
-						Id:      args.noteId,
+						Id:      noteId,
+						OwnerId: userId,
 					},
-				}, nil).Times(1)
-			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-				noteId: noteId,
+				}, nil)
 			},
 			wantErr: true,
-			want:    models.Note{},
 		},
 		{
-			name: "Test_DelFav_AlreadyTrue",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+			name:       "Test_ProposeTransfer_TooManyCollaborators",
+			newOwnerId: newOwnerId,
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
 				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
 					Note: models.Note{
-						OwnerId:  args.userId,
-						Id:       args.noteId,
-						Favorite: false,
+						Id:            noteId,
+						OwnerId:       userId,
+						Collaborators: []uuid.UUID{uuid.NewV4()},
 					},
-				}, nil).Times(1)
-			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-				noteId: noteId,
-			},
-			wantErr: false,
-			want: models.Note{
-				OwnerId:  userId,
-				Id:       noteId,
-				Favorite: false,
+				}, nil)
 			},
+			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
@@ -1895,121 +2156,103 @@ func TestNoteUsecase_DelFav(t *testing.T) {
 			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
 			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
 
-			tt.repoMocker(context.Background(), repo, searchRepo, tt.args)
+			tt.repoMocker(context.Background(), repo)
 
-			got, err := uc.DelFav(context.Background(), tt.args.noteId, tt.args.userId)
+			title, err := uc.ProposeTransfer(context.Background(), noteId, userId, tt.newOwnerId)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("NoteUsecase.DelFav error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("NoteUsecase.ProposeTransfer error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantTitle, title)
 		})
 	}
 }
 
-func TestNoteUsecase_SetPublic(t *testing.T) {
+func TestNoteUsecase_AcceptTransfer(t *testing.T) {
 	elasticConfig := config.ElasticConfig{}
 
 	constraintsConfig := config.ConstraintsConfig{}
 
 	noteId := uuid.NewV4()
+	childId := uuid.NewV4()
+	oldOwnerId := uuid.NewV4()
 	userId := uuid.NewV4()
 
-	type args struct {
-		ctx    context.Context
-		userId uuid.UUID
-		noteId uuid.UUID
+	transfer := models.OwnershipTransfer{
+		NoteId: noteId,
+		FromId: oldOwnerId,
+		ToId:   userId,
 	}
+
 	tests := []struct {
 		name       string
-		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args)
-		args       args
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo)
+		want       models.OwnershipTransfer
 		wantErr    bool
-		want       models.Note
 	}{
 		{
-			name: "Test_SetPublic_Success",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
-
+			name: "Test_AcceptTransfer_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().GetTransfer(ctx, noteId).Return(transfer, nil)
 				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
 					Note: models.Note{
-						OwnerId: args.userId,
-						Id:      args.noteId,
-						Public:  false,
+						Id:       noteId,
+						OwnerId:  oldOwnerId,
+						Children: []uuid.UUID{childId},
 					},
-				}, nil).Times(1)
-				baseRepo.EXPECT().SetPublic(ctx, args.noteId).Return(nil)
-				searchRepo.EXPECT().SetPublic(ctx, args.noteId).Return(nil)
-			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-				noteId: noteId,
+				}, nil)
+				baseRepo.EXPECT().TransferOwnership(ctx, noteId, oldOwnerId, userId).Return([]uuid.UUID{noteId, childId}, nil)
+				searchRepo.EXPECT().SetOwner(ctx, noteId, oldOwnerId, userId).Return(nil)
+				searchRepo.EXPECT().SetOwner(ctx, childId, oldOwnerId, userId).Return(nil)
+				baseRepo.EXPECT().ReconcileTags(ctx, []uuid.UUID{noteId, childId}).Return(nil)
 			},
+			want:    transfer,
 			wantErr: false,
-			want: models.Note{
-				OwnerId: userId,
-				Id:      noteId,
-				Public:  true,
-			},
 		},
 		{
-			name: "Test_SetPublic_FailOnSet",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
-				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
-					Note: models.Note{
-						OwnerId:  args.userId,
-						Id:       args.noteId,
-						Favorite: true,
-					},
-				}, nil).Times(1)
-				baseRepo.EXPECT().SetPublic(ctx, args.noteId).Return(errors.New("err"))
-
+			name: "Test_AcceptTransfer_NoTransfer",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().GetTransfer(ctx, noteId).Return(models.OwnershipTransfer{}, errors.New("error"))
 			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-				noteId: noteId,
+			wantErr: true,
+		},
+		{
+			name: "Test_AcceptTransfer_AnotherUser",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().GetTransfer(ctx, noteId).Return(models.OwnershipTransfer{
+					NoteId: noteId,
+					FromId: oldOwnerId,
+					ToId:   uuid.NewV4(),
+				}, nil)
 			},
 			wantErr: true,
-			want:    models.Note{},
 		},
-
 		{
-			name: "Test_SetPublic_FailOnRead",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+			name: "Test_AcceptTransfer_OwnerChanged",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().GetTransfer(ctx, noteId).Return(transfer, nil)
 				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
 					Note: models.Note{
-						OwnerId: args.userId,
-						Id:      args.noteId,
+						Id:      noteId,
+						OwnerId: uuid.NewV4(),
 					},
-				}, errors.New("err")).Times(1)
-			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-				noteId: noteId,
+				}, nil)
 			},
 			wantErr: true,
-			want:    models.Note{},
 		},
 		{
-			name: "Test_SetPublic_NotOwner",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+			name: "Test_AcceptTransfer_TransferErr",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().GetTransfer(ctx, noteId).Return(transfer, nil)
 				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
 					Note: models.Note{
-						OwnerId: uuid.NewV4(),
-						Id:      args.noteId,
+						Id:      noteId,
+						OwnerId: oldOwnerId,
 					},
-				}, nil).Times(1)
-			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-				noteId: noteId,
+				}, nil)
+				baseRepo.EXPECT().TransferOwnership(ctx, noteId, oldOwnerId, userId).Return(nil, errors.New("error"))
 			},
 			wantErr: true,
-			want:    models.Note{},
 		},
 	}
 	for _, tt := range tests {
@@ -2020,11 +2263,11 @@ func TestNoteUsecase_SetPublic(t *testing.T) {
 			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
 			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
 
-			tt.repoMocker(context.Background(), repo, searchRepo, tt.args)
+			tt.repoMocker(context.Background(), repo, searchRepo)
 
-			got, err := uc.SetPublic(context.Background(), tt.args.noteId, tt.args.userId)
+			got, err := uc.AcceptTransfer(context.Background(), noteId, userId)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("NoteUsecase.SetPublic error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("NoteUsecase.AcceptTransfer error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			assert.Equal(t, tt.want, got)
@@ -2032,109 +2275,3656 @@ func TestNoteUsecase_SetPublic(t *testing.T) {
 	}
 }
 
-func TestNoteUsecase_SetPrivate(t *testing.T) {
-	elasticConfig := config.ElasticConfig{}
-
-	constraintsConfig := config.ConstraintsConfig{}
-
-	noteId := uuid.NewV4()
-	userId := uuid.NewV4()
+func TestNoteUsecase_getDepth(t *testing.T) {
+	elasticConfig := config.ElasticConfig{
+		ElasticIndexName:            "notes",
+		ElasticSearchValueMinLength: 2,
+	}
 
-	type args struct {
-		ctx    context.Context
-		userId uuid.UUID
-		noteId uuid.UUID
+	constraintsConfig := config.ConstraintsConfig{
+		MaxDepth:         3,
+		MaxCollaborators: 10,
+		MaxTags:          4,
+		MaxSubnotes:      10,
 	}
+
+	parentId := uuid.NewV4()
+	childId := uuid.NewV4()
 	tests := []struct {
+		parentId   uuid.UUID
+		childId    uuid.UUID
 		name       string
-		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args)
-		args       args
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo)
 		wantErr    bool
-		want       models.Note
+		currDepth  int
 	}{
 		{
-			name: "Test_SetPrivate_Success",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
-
-				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
-					Note: models.Note{
-						OwnerId: args.userId,
-						Id:      args.noteId,
-						Public:  true,
-					},
-				}, nil).Times(1)
-				baseRepo.EXPECT().SetPrivate(ctx, args.noteId).Return(nil)
-				searchRepo.EXPECT().SetPrivate(ctx, args.noteId).Return(nil)
-			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-				noteId: noteId,
-			},
-			wantErr: false,
-			want: models.Note{
-				OwnerId: userId,
-				Id:      noteId,
-				Public:  false,
+			name:      "Test_getDepth",
+			parentId:  uuid.UUID{},
+			childId:   uuid.UUID{},
+			currDepth: 1,
+			wantErr:   false,
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
 			},
 		},
 		{
-			name: "Test_SetPrivate_FailOnSet",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
-				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
-					Note: models.Note{
-						OwnerId:  args.userId,
-						Id:       args.noteId,
-						Favorite: true,
-					},
-				}, nil).Times(1)
-				baseRepo.EXPECT().SetPrivate(ctx, args.noteId).Return(errors.New("err"))
+			name:      "Test_ReadErr",
+			parentId:  parentId,
+			childId:   childId,
+			currDepth: 1,
+			wantErr:   true,
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), childId, gomock.Any()).Return(models.NoteResponse{}, errors.New("err"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo, searchRepo)
+
+			_, err := uc.getDepth(context.Background(), tt.childId, tt.currDepth, uuid.UUID{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NoteUsecase.getDepth error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+		})
+	}
+}
 
+func TestNoteUsecase_CreateSubNote(t *testing.T) {
+	elasticConfig := config.ElasticConfig{
+		ElasticIndexName:            "notes",
+		ElasticSearchValueMinLength: 2,
+	}
+
+	constraintsConfig := config.ConstraintsConfig{
+		MaxDepth:         3,
+		MaxCollaborators: 10,
+		MaxTags:          4,
+		MaxSubnotes:      10,
+	}
+
+	parentId := uuid.NewV4()
+	userId := uuid.NewV4()
+	childId := uuid.NewV4()
+	collaboratorId := uuid.NewV4()
+	tests := []struct {
+		parentId          uuid.UUID
+		childId           uuid.UUID
+		userId            uuid.UUID
+		name              string
+		repoMocker        func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo)
+		wantErr           bool
+		currDepth         int
+		wantCollaborators []uuid.UUID
+	}{
+		{
+			name:      "Test_CreateSubnote_readError",
+			parentId:  parentId,
+			childId:   childId,
+			userId:    userId,
+			currDepth: 1,
+			wantErr:   true,
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), parentId, userId).Return(models.NoteResponse{}, errors.New("error"))
 			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-				noteId: noteId,
+		},
+		{
+			name:      "Test_CreateSubnote_TooManyChildren",
+			parentId:  parentId,
+			childId:   childId,
+			userId:    userId,
+			currDepth: 1,
+			wantErr:   true,
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), parentId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						Id:       parentId,
+						OwnerId:  userId,
+						Children: make([]uuid.UUID, 20),
+					},
+				}, nil)
+
 			},
-			wantErr: true,
-			want:    models.Note{},
 		},
+		{
+			name:      "Test_CreateSubnote_getDepthErr",
+			parentId:  parentId,
+			childId:   childId,
+			userId:    userId,
+			currDepth: 1,
+			wantErr:   true,
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), parentId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						Id:      parentId,
+						OwnerId: userId,
+						Parent:  uuid.NewV4(),
+					},
+				}, nil)
+				baseRepo.EXPECT().ReadNote(gomock.Any(), gomock.Any(), gomock.Any()).Return(models.NoteResponse{}, errors.New("error"))
 
+			},
+		},
 		{
-			name: "Test_SetPublic_FailOnRead",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
-				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+			name:      "Test_CreateSubnote_InheritsCollaborators",
+			parentId:  parentId,
+			childId:   childId,
+			userId:    userId,
+			currDepth: 1,
+			wantErr:   false,
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), parentId, userId).Return(models.NoteResponse{
 					Note: models.Note{
-						OwnerId: args.userId,
-						Id:      args.noteId,
+						Id:      parentId,
+						OwnerId: userId,
 					},
-				}, errors.New("err")).Times(1)
+				}, nil)
+				baseRepo.EXPECT().CreateSubNote(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, subNote models.Note) (models.Note, error) {
+					subNote.OwnerId = userId
+					subNote.Collaborators = []uuid.UUID{collaboratorId}
+					return subNote, nil
+				})
+				searchRepo.EXPECT().AddSubNote(gomock.Any(), parentId, gomock.Any()).Return(nil)
+			},
+			wantCollaborators: []uuid.UUID{collaboratorId},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo, searchRepo)
+
+			got, err := uc.CreateSubNote(context.Background(), tt.userId, "", tt.parentId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NoteUsecase.CreateSubnote error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				assert.Equal(t, tt.wantCollaborators, got.Collaborators)
+			}
+
+		})
+	}
+}
+
+func TestNoteUsecase_MoveNote(t *testing.T) {
+	elasticConfig := config.ElasticConfig{
+		ElasticIndexName:            "notes",
+		ElasticSearchValueMinLength: 2,
+	}
+
+	constraintsConfig := config.ConstraintsConfig{
+		MaxDepth:         3,
+		MaxCollaborators: 10,
+		MaxTags:          4,
+		MaxSubnotes:      10,
+	}
+
+	noteId := uuid.NewV4()
+	oldParentId := uuid.NewV4()
+	newParentId := uuid.NewV4()
+	grandParentId := uuid.NewV4()
+	childId := uuid.NewV4()
+	userId := uuid.NewV4()
+	collaboratorId := uuid.NewV4()
+
+	tests := []struct {
+		name       string
+		parentId   uuid.UUID
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo)
+		wantErr    error
+	}{
+		{
+			name:     "Test_MoveNote_Success",
+			parentId: newParentId,
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: noteId, OwnerId: userId, Parent: oldParentId},
+				}, nil).Times(2)
+				baseRepo.EXPECT().ReadNote(gomock.Any(), newParentId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: newParentId, OwnerId: userId, Collaborators: []uuid.UUID{collaboratorId}},
+				}, nil)
+				baseRepo.EXPECT().MoveNote(gomock.Any(), noteId, oldParentId, newParentId).Return([]uuid.UUID{noteId, childId}, nil)
+				searchRepo.EXPECT().RemoveSubNote(gomock.Any(), oldParentId, noteId).Return(nil)
+				searchRepo.EXPECT().AddSubNote(gomock.Any(), newParentId, noteId).Return(nil)
+				searchRepo.EXPECT().SetParent(gomock.Any(), noteId, newParentId).Return(nil)
+				searchRepo.EXPECT().SetCollaborators(gomock.Any(), []uuid.UUID{noteId, childId}, []uuid.UUID{collaboratorId}).Return(nil)
+				baseRepo.EXPECT().ReconcileTags(gomock.Any(), []uuid.UUID{noteId, childId}).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:     "Test_MoveNote_ToTopLevel",
+			parentId: uuid.UUID{},
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: noteId, OwnerId: userId, Parent: oldParentId},
+				}, nil)
+				baseRepo.EXPECT().MoveNote(gomock.Any(), noteId, oldParentId, uuid.UUID{}).Return([]uuid.UUID{noteId}, nil)
+				searchRepo.EXPECT().RemoveSubNote(gomock.Any(), oldParentId, noteId).Return(nil)
+				searchRepo.EXPECT().SetParent(gomock.Any(), noteId, uuid.UUID{}).Return(nil)
+				searchRepo.EXPECT().SetCollaborators(gomock.Any(), []uuid.UUID{noteId}, []uuid.UUID{}).Return(nil)
 			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-				noteId: noteId,
+			wantErr: nil,
+		},
+		{
+			name:     "Test_MoveNote_SameParent",
+			parentId: oldParentId,
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: noteId, OwnerId: userId, Parent: oldParentId},
+				}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:     "Test_MoveNote_NotFound",
+			parentId: newParentId,
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, userId).Return(models.NoteResponse{}, errors.New("error"))
+			},
+			wantErr: errors.New("not found"),
+		},
+		{
+			name:     "Test_MoveNote_NotOwner",
+			parentId: newParentId,
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: noteId, OwnerId: uuid.NewV4(), Collaborators: []uuid.UUID{userId}},
+				}, nil)
+			},
+			wantErr: errors.New("not found"),
+		},
+		{
+			name:     "Test_MoveNote_IntoItself",
+			parentId: noteId,
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: noteId, OwnerId: userId},
+				}, nil)
+			},
+			wantErr: errors.New(note.ErrCyclicMove),
+		},
+		{
+			name:     "Test_MoveNote_IntoSubnote",
+			parentId: childId,
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: noteId, OwnerId: userId, Children: []uuid.UUID{childId}},
+				}, nil)
+				baseRepo.EXPECT().ReadNote(gomock.Any(), childId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: childId, OwnerId: userId, Parent: noteId},
+				}, nil)
+			},
+			wantErr: errors.New(note.ErrCyclicMove),
+		},
+		{
+			name:     "Test_MoveNote_ParentNotOwned",
+			parentId: newParentId,
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: noteId, OwnerId: userId},
+				}, nil)
+				baseRepo.EXPECT().ReadNote(gomock.Any(), newParentId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: newParentId, OwnerId: uuid.NewV4()},
+				}, nil)
+			},
+			wantErr: errors.New("not found"),
+		},
+		{
+			name:     "Test_MoveNote_TooManySubnotes",
+			parentId: newParentId,
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: noteId, OwnerId: userId},
+				}, nil)
+				baseRepo.EXPECT().ReadNote(gomock.Any(), newParentId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: newParentId, OwnerId: userId, Children: make([]uuid.UUID, 10)},
+				}, nil)
+			},
+			wantErr: errors.New(note.ErrTooManySubnotes),
+		},
+		{
+			name:     "Test_MoveNote_TooDeep",
+			parentId: newParentId,
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: noteId, OwnerId: userId, Children: []uuid.UUID{childId}},
+				}, nil).Times(2)
+				baseRepo.EXPECT().ReadNote(gomock.Any(), newParentId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: newParentId, OwnerId: userId, Parent: grandParentId},
+				}, nil)
+				baseRepo.EXPECT().ReadNote(gomock.Any(), grandParentId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: grandParentId, OwnerId: userId},
+				}, nil)
+				baseRepo.EXPECT().ReadNote(gomock.Any(), childId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: childId, OwnerId: userId, Parent: noteId},
+				}, nil)
+			},
+			wantErr: errors.New(note.ErrTooDeep),
+		},
+		{
+			name:     "Test_MoveNote_RepoError",
+			parentId: uuid.UUID{},
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: noteId, OwnerId: userId, Parent: oldParentId},
+				}, nil)
+				baseRepo.EXPECT().MoveNote(gomock.Any(), noteId, oldParentId, uuid.UUID{}).Return([]uuid.UUID{}, errors.New("error"))
+			},
+			wantErr: errors.New("error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo, searchRepo)
+
+			got, err := uc.MoveNote(context.Background(), noteId, tt.parentId, userId)
+			assert.Equal(t, tt.wantErr, err)
+			if err == nil {
+				assert.Equal(t, tt.parentId, got.Parent)
+			}
+		})
+	}
+}
+
+func TestNoteUsecase_DuplicateNote(t *testing.T) {
+	elasticConfig := config.ElasticConfig{
+		ElasticIndexName:            "notes",
+		ElasticSearchValueMinLength: 2,
+	}
+
+	constraintsConfig := config.ConstraintsConfig{
+		MaxDepth:         3,
+		MaxCollaborators: 10,
+		MaxTags:          4,
+		MaxSubnotes:      10,
+	}
+
+	attachesPath := t.TempDir()
+	t.Setenv("ATTACHES_BASE_PATH", attachesPath)
+
+	noteId := uuid.NewV4()
+	parentId := uuid.NewV4()
+	childId := uuid.NewV4()
+	attachId := uuid.NewV4()
+	userId := uuid.NewV4()
+
+	if err := os.WriteFile(path.Join(attachesPath, attachId.String()+".webp"), []byte("image"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	rootNote := models.Note{
+		Id:       noteId,
+		OwnerId:  userId,
+		Children: []uuid.UUID{childId},
+		Tags:     []string{"tag"},
+		Data:     `{"content":[{"attributes":{"data-noteid":"` + childId.String() + `"}}]}`,
+	}
+	childNote := models.Note{
+		Id:      childId,
+		OwnerId: userId,
+		Parent:  noteId,
+		Data:    `{"content":[{"attributes":{"data-imgid":"` + attachId.String() + `"}}]}`,
+	}
+	attach := models.Attach{
+		Id:     attachId,
+		Path:   attachId.String() + ".webp",
+		NoteId: childId,
+	}
+
+	tests := []struct {
+		name         string
+		withSubnotes bool
+		repoMocker   func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo)
+		wantErr      error
+		wantParent   uuid.UUID
+	}{
+		{
+			name:         "Test_DuplicateNote_WithSubnotes",
+			withSubnotes: true,
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, userId).Return(models.NoteResponse{Note: rootNote}, nil)
+				baseRepo.EXPECT().ReadNote(gomock.Any(), childId, userId).Return(models.NoteResponse{Note: childNote}, nil)
+				baseRepo.EXPECT().GetAttaches(gomock.Any(), noteId).Return([]models.Attach{}, nil)
+				baseRepo.EXPECT().GetAttaches(gomock.Any(), childId).Return([]models.Attach{attach}, nil)
+				baseRepo.EXPECT().CreateNote(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				baseRepo.EXPECT().AddAttach(gomock.Any(), gomock.Any()).Return(nil)
+				searchRepo.EXPECT().CreateNote(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+			},
+			wantErr:    nil,
+			wantParent: uuid.UUID{},
+		},
+		{
+			name:         "Test_DuplicateNote_SameParent",
+			withSubnotes: false,
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				subNote := rootNote
+				subNote.Parent = parentId
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, userId).Return(models.NoteResponse{Note: subNote}, nil)
+				baseRepo.EXPECT().ReadNote(gomock.Any(), parentId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: parentId, OwnerId: userId, Children: []uuid.UUID{noteId}},
+				}, nil)
+				baseRepo.EXPECT().GetAttaches(gomock.Any(), noteId).Return([]models.Attach{}, nil)
+				baseRepo.EXPECT().CreateNote(gomock.Any(), gomock.Any()).Return(nil)
+				baseRepo.EXPECT().AddSubNote(gomock.Any(), parentId, gomock.Any()).Return(nil)
+				searchRepo.EXPECT().CreateNote(gomock.Any(), gomock.Any()).Return(nil)
+				searchRepo.EXPECT().AddSubNote(gomock.Any(), parentId, gomock.Any()).Return(nil)
+			},
+			wantErr:    nil,
+			wantParent: parentId,
+		},
+		{
+			name:         "Test_DuplicateNote_NotFound",
+			withSubnotes: true,
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, userId).Return(models.NoteResponse{}, errors.New("error"))
+			},
+			wantErr: errors.New("not found"),
+		},
+		{
+			name:         "Test_DuplicateNote_NoAccess",
+			withSubnotes: true,
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: noteId, OwnerId: uuid.NewV4()},
+				}, nil)
+			},
+			wantErr: errors.New("not found"),
+		},
+		{
+			name:         "Test_DuplicateNote_TooManySubnotes",
+			withSubnotes: false,
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: noteId, OwnerId: userId, Parent: parentId},
+				}, nil)
+				baseRepo.EXPECT().ReadNote(gomock.Any(), parentId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: parentId, OwnerId: userId, Children: make([]uuid.UUID, 10)},
+				}, nil)
+			},
+			wantErr: errors.New(note.ErrTooManySubnotes),
+		},
+		{
+			name:         "Test_DuplicateNote_GetAttachesError",
+			withSubnotes: false,
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, userId).Return(models.NoteResponse{Note: rootNote}, nil)
+				baseRepo.EXPECT().GetAttaches(gomock.Any(), noteId).Return(nil, errors.New("error"))
+			},
+			wantErr: errors.New("error"),
+		},
+		{
+			name:         "Test_DuplicateNote_MissingFile",
+			withSubnotes: false,
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, userId).Return(models.NoteResponse{Note: rootNote}, nil)
+				baseRepo.EXPECT().GetAttaches(gomock.Any(), noteId).Return([]models.Attach{{Id: uuid.NewV4(), Path: "missing.webp", NoteId: noteId}}, nil)
+			},
+			wantErr: errors.New("open " + path.Join(attachesPath, "missing.webp") + ": no such file or directory"),
+		},
+		{
+			name:         "Test_DuplicateNote_CreateError",
+			withSubnotes: false,
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, userId).Return(models.NoteResponse{Note: rootNote}, nil)
+				baseRepo.EXPECT().GetAttaches(gomock.Any(), noteId).Return([]models.Attach{}, nil)
+				baseRepo.EXPECT().CreateNote(gomock.Any(), gomock.Any()).Return(errors.New("error"))
+			},
+			wantErr: errors.New("error"),
+		},
+		{
+			name:         "Test_DuplicateNote_CreateRootError",
+			withSubnotes: true,
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, userId).Return(models.NoteResponse{Note: rootNote}, nil)
+				baseRepo.EXPECT().ReadNote(gomock.Any(), childId, userId).Return(models.NoteResponse{Note: childNote}, nil)
+				baseRepo.EXPECT().GetAttaches(gomock.Any(), noteId).Return([]models.Attach{}, nil)
+				baseRepo.EXPECT().GetAttaches(gomock.Any(), childId).Return([]models.Attach{attach}, nil)
+				gomock.InOrder(
+					baseRepo.EXPECT().CreateNote(gomock.Any(), gomock.Any()).Return(nil),
+					baseRepo.EXPECT().CreateNote(gomock.Any(), gomock.Any()).Return(errors.New("error")),
+				)
+				baseRepo.EXPECT().DeleteNote(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantErr: errors.New("error"),
+		},
+		{
+			name:         "Test_DuplicateNote_AddAttachError",
+			withSubnotes: true,
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, userId).Return(models.NoteResponse{Note: rootNote}, nil)
+				baseRepo.EXPECT().ReadNote(gomock.Any(), childId, userId).Return(models.NoteResponse{Note: childNote}, nil)
+				baseRepo.EXPECT().GetAttaches(gomock.Any(), noteId).Return([]models.Attach{}, nil)
+				baseRepo.EXPECT().GetAttaches(gomock.Any(), childId).Return([]models.Attach{attach}, nil)
+				baseRepo.EXPECT().CreateNote(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				baseRepo.EXPECT().AddAttach(gomock.Any(), gomock.Any()).Return(errors.New("error"))
+				baseRepo.EXPECT().DeleteNote(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+			},
+			wantErr: errors.New("error"),
+		},
+		{
+			name:         "Test_DuplicateNote_AddSubNoteError",
+			withSubnotes: false,
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				subNote := rootNote
+				subNote.Parent = parentId
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, userId).Return(models.NoteResponse{Note: subNote}, nil)
+				baseRepo.EXPECT().ReadNote(gomock.Any(), parentId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: parentId, OwnerId: userId, Children: []uuid.UUID{noteId}},
+				}, nil)
+				baseRepo.EXPECT().GetAttaches(gomock.Any(), noteId).Return([]models.Attach{}, nil)
+				baseRepo.EXPECT().CreateNote(gomock.Any(), gomock.Any()).Return(nil)
+				baseRepo.EXPECT().AddSubNote(gomock.Any(), parentId, gomock.Any()).Return(errors.New("error"))
+				baseRepo.EXPECT().DeleteNote(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantErr: errors.New("error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo, searchRepo)
+
+			filesBefore, err := os.ReadDir(attachesPath)
+			assert.Nil(t, err)
+
+			got, err := uc.DuplicateNote(context.Background(), noteId, userId, tt.withSubnotes)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+
+				filesAfter, err := os.ReadDir(attachesPath)
+				assert.Nil(t, err)
+				assert.Len(t, filesAfter, len(filesBefore))
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.NotEqual(t, noteId, got.Id)
+			assert.Equal(t, userId, got.OwnerId)
+			assert.Equal(t, tt.wantParent, got.Parent)
+			assert.Equal(t, []string{"tag"}, got.Tags)
+			if tt.withSubnotes {
+				assert.Len(t, got.Children, 1)
+				assert.NotEqual(t, childId, got.Children[0])
+				assert.False(t, strings.Contains(got.Data, childId.String()))
+				assert.True(t, strings.Contains(got.Data, got.Children[0].String()))
+
+				files, _ := os.ReadDir(attachesPath)
+				assert.Len(t, files, 2)
+			} else {
+				assert.Empty(t, got.Children)
+			}
+		})
+	}
+}
+
+func TestNoteUsecase_SetTemplate(t *testing.T) {
+	elasticConfig := config.ElasticConfig{
+		ElasticIndexName:            "notes",
+		ElasticSearchValueMinLength: 2,
+	}
+
+	constraintsConfig := config.ConstraintsConfig{
+		MaxDepth:         3,
+		MaxCollaborators: 10,
+		MaxTags:          4,
+		MaxSubnotes:      10,
+	}
+
+	noteId := uuid.NewV4()
+	userId := uuid.NewV4()
+
+	tests := []struct {
+		name       string
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo)
+		wantErr    error
+	}{
+		{
+			name: "Test_SetTemplate_Success",
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: noteId, OwnerId: userId},
+				}, nil)
+				baseRepo.EXPECT().SetTemplate(gomock.Any(), noteId, true).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Test_SetTemplate_NotFound",
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, userId).Return(models.NoteResponse{}, errors.New("error"))
+			},
+			wantErr: errors.New("not found"),
+		},
+		{
+			name: "Test_SetTemplate_NotOwner",
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: noteId, OwnerId: uuid.NewV4(), Collaborators: []uuid.UUID{userId}},
+				}, nil)
+			},
+			wantErr: errors.New("not found"),
+		},
+		{
+			name: "Test_SetTemplate_RepoError",
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: noteId, OwnerId: userId},
+				}, nil)
+				baseRepo.EXPECT().SetTemplate(gomock.Any(), noteId, true).Return(errors.New("error"))
+			},
+			wantErr: errors.New("error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo, searchRepo)
+
+			_, err := uc.SetTemplate(context.Background(), noteId, userId, true)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestNoteUsecase_GetTemplates(t *testing.T) {
+	elasticConfig := config.ElasticConfig{
+		ElasticIndexName:            "notes",
+		ElasticSearchValueMinLength: 2,
+	}
+
+	constraintsConfig := config.ConstraintsConfig{
+		MaxDepth:         3,
+		MaxCollaborators: 10,
+		MaxTags:          4,
+		MaxSubnotes:      10,
+	}
+
+	userId := uuid.NewV4()
+	templates := []models.Template{{Id: uuid.NewV4(), Data: "{}", System: true}}
+
+	tests := []struct {
+		name       string
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo)
+		want       []models.Template
+		wantErr    bool
+	}{
+		{
+			name: "Test_GetTemplates_Success",
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadTemplates(gomock.Any(), userId).Return(templates, nil)
+			},
+			want:    templates,
+			wantErr: false,
+		},
+		{
+			name: "Test_GetTemplates_Fail",
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadTemplates(gomock.Any(), userId).Return(nil, errors.New("error"))
+			},
+			want:    []models.Template{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo, searchRepo)
+
+			got, err := uc.GetTemplates(context.Background(), userId)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNoteUsecase_CreateFromTemplate(t *testing.T) {
+	elasticConfig := config.ElasticConfig{
+		ElasticIndexName:            "notes",
+		ElasticSearchValueMinLength: 2,
+	}
+
+	constraintsConfig := config.ConstraintsConfig{
+		MaxDepth:         3,
+		MaxCollaborators: 10,
+		MaxTags:          4,
+		MaxSubnotes:      10,
+	}
+
+	t.Setenv("ATTACHES_BASE_PATH", t.TempDir())
+
+	templateId := uuid.NewV4()
+	parentId := uuid.NewV4()
+	childId := uuid.NewV4()
+	userId := uuid.NewV4()
+	username := "username"
+
+	tests := []struct {
+		name       string
+		parentId   uuid.UUID
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo)
+		wantData   string
+		wantErr    error
+	}{
+		{
+			name:     "Test_CreateFromTemplate_System",
+			parentId: uuid.UUID{},
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadTemplate(gomock.Any(), templateId).Return(models.Template{
+					Id: templateId, Data: `{"title":"Привет, {{username}}!"}`, System: true,
+				}, nil)
+				baseRepo.EXPECT().CreateNote(gomock.Any(), gomock.Any()).Return(nil)
+				searchRepo.EXPECT().CreateNote(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantData: `{"title":"Привет, username!"}`,
+			wantErr:  nil,
+		},
+		{
+			name:     "Test_CreateFromTemplate_SystemSubnote",
+			parentId: parentId,
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadTemplate(gomock.Any(), templateId).Return(models.Template{
+					Id: templateId, Data: `{"title":"{{username}}"}`, System: true,
+				}, nil)
+				baseRepo.EXPECT().ReadNote(gomock.Any(), parentId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: parentId, OwnerId: userId},
+				}, nil)
+				baseRepo.EXPECT().CreateSubNote(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, subNote models.Note) (models.Note, error) {
+					subNote.OwnerId = userId
+					return subNote, nil
+				})
+				searchRepo.EXPECT().AddSubNote(gomock.Any(), parentId, gomock.Any()).Return(nil)
+			},
+			wantData: `{"title":"username"}`,
+			wantErr:  nil,
+		},
+		{
+			name:     "Test_CreateFromTemplate_User",
+			parentId: uuid.UUID{},
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadTemplate(gomock.Any(), templateId).Return(models.Template{
+					Id: templateId, Data: `{"title":"{{username}}"}`, System: false,
+				}, nil)
+				baseRepo.EXPECT().ReadNote(gomock.Any(), templateId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: templateId, OwnerId: userId, Data: `{"title":"{{username}}"}`, Children: []uuid.UUID{childId}},
+				}, nil)
+				baseRepo.EXPECT().ReadNote(gomock.Any(), childId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: childId, OwnerId: userId, Parent: templateId},
+				}, nil)
+				baseRepo.EXPECT().GetAttaches(gomock.Any(), gomock.Any()).Return([]models.Attach{}, nil).Times(2)
+				baseRepo.EXPECT().CreateNote(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				searchRepo.EXPECT().CreateNote(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+			},
+			wantData: `{"title":"username"}`,
+			wantErr:  nil,
+		},
+		{
+			name:     "Test_CreateFromTemplate_NotFound",
+			parentId: uuid.UUID{},
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadTemplate(gomock.Any(), templateId).Return(models.Template{}, errors.New("error"))
+			},
+			wantErr: errors.New("not found"),
+		},
+		{
+			name:     "Test_CreateFromTemplate_NoAccess",
+			parentId: uuid.UUID{},
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadTemplate(gomock.Any(), templateId).Return(models.Template{Id: templateId}, nil)
+				baseRepo.EXPECT().ReadNote(gomock.Any(), templateId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: templateId, OwnerId: uuid.NewV4()},
+				}, nil)
+			},
+			wantErr: errors.New("not found"),
+		},
+		{
+			name:     "Test_CreateFromTemplate_TooManySubnotes",
+			parentId: parentId,
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadTemplate(gomock.Any(), templateId).Return(models.Template{Id: templateId}, nil)
+				baseRepo.EXPECT().ReadNote(gomock.Any(), templateId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: templateId, OwnerId: userId},
+				}, nil)
+				baseRepo.EXPECT().ReadNote(gomock.Any(), parentId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: parentId, OwnerId: userId, Children: make([]uuid.UUID, 10)},
+				}, nil)
+			},
+			wantErr: errors.New(note.ErrTooManySubnotes),
+		},
+		{
+			name:     "Test_CreateFromTemplate_TooDeep",
+			parentId: parentId,
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				grandParentId := uuid.NewV4()
+				baseRepo.EXPECT().ReadTemplate(gomock.Any(), templateId).Return(models.Template{Id: templateId}, nil)
+				baseRepo.EXPECT().ReadNote(gomock.Any(), templateId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: templateId, OwnerId: userId, Children: []uuid.UUID{childId}},
+				}, nil).Times(2)
+				baseRepo.EXPECT().ReadNote(gomock.Any(), parentId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: parentId, OwnerId: userId, Parent: grandParentId},
+				}, nil)
+				baseRepo.EXPECT().ReadNote(gomock.Any(), grandParentId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: grandParentId, OwnerId: userId},
+				}, nil)
+				baseRepo.EXPECT().ReadNote(gomock.Any(), childId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: childId, OwnerId: userId, Parent: templateId},
+				}, nil)
+			},
+			wantErr: errors.New(note.ErrTooDeep),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo, searchRepo)
+
+			got, err := uc.CreateFromTemplate(context.Background(), templateId, tt.parentId, userId, username)
+			assert.Equal(t, tt.wantErr, err)
+			if err == nil {
+				assert.NotEqual(t, templateId, got.Id)
+				assert.Equal(t, userId, got.OwnerId)
+				assert.Equal(t, tt.parentId, got.Parent)
+				assert.Equal(t, tt.wantData, got.Data)
+			}
+		})
+	}
+}
+
+func TestNoteUsecase_CheckPermissions(t *testing.T) {
+	elasticConfig := config.ElasticConfig{
+		ElasticIndexName:            "notes",
+		ElasticSearchValueMinLength: 2,
+	}
+
+	constraintsConfig := config.ConstraintsConfig{
+		MaxDepth:         3,
+		MaxCollaborators: 10,
+		MaxTags:          10,
+		MaxSubnotes:      10,
+	}
+
+	noteId := uuid.NewV4()
+	userId := uuid.NewV4()
+
+	type args struct {
+		ctx    context.Context
+		userId uuid.UUID
+		noteId uuid.UUID
+	}
+	tests := []struct {
+		name       string
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo)
+		args       args
+		wantErr    bool
+		want       string
+	}{
+		{
+			name: "TestSuccess",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId: userId,
+					},
+				}, nil).Times(1)
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+			},
+			wantErr: false,
+			want:    models.RoleOwner,
+		},
+		{
+			name: "TestSuccess_Collaborator",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						Id:            noteId,
+						OwnerId:       uuid.NewV4(),
+						Collaborators: []uuid.UUID{userId},
+					},
+				}, nil).Times(1)
+				baseRepo.EXPECT().GetCollaboratorRole(ctx, noteId, userId).Return(models.RoleViewer, nil)
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+			},
+			wantErr: false,
+			want:    models.RoleViewer,
+		},
+		{
+			name: "TestFail_NotCollaborator",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						Id:      noteId,
+						OwnerId: uuid.NewV4(),
+					},
+				}, nil).Times(1)
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+			},
+			wantErr: true,
+			want:    "",
+		},
+		{
+			name: "TestFail",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{}, errors.New("error")).Times(1)
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+			},
+			wantErr: true,
+			want:    "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo, searchRepo)
+
+			got, err := uc.CheckPermissions(tt.args.ctx, tt.args.noteId, tt.args.userId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NoteUsecase.CheckPermissions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNoteUsecase_SetIcon(t *testing.T) {
+	elasticConfig := config.ElasticConfig{}
+
+	constraintsConfig := config.ConstraintsConfig{}
+
+	noteId := uuid.NewV4()
+	userId := uuid.NewV4()
+
+	type args struct {
+		ctx    context.Context
+		userId uuid.UUID
+		noteId uuid.UUID
+		icon   string
+	}
+	tests := []struct {
+		name       string
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args)
+		args       args
+		wantErr    bool
+		want       models.Note
+	}{
+		{
+			name: "Test_SetIcon_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId: args.userId,
+						Id:      args.noteId,
+						Icon:    args.icon,
+					},
+				}, nil).Times(1)
+				baseRepo.EXPECT().SetIcon(ctx, args.noteId, args.icon, gomock.Any()).Return(nil)
+				searchRepo.EXPECT().SetIcon(ctx, args.noteId, args.icon).Return(nil)
+
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+				icon:   "icon",
+			},
+			wantErr: false,
+			want: models.Note{
+				OwnerId: userId,
+				Id:      noteId,
+				Icon:    "icon",
+				Version: 1,
+			},
+		},
+		{
+			name: "Test_SetIcon_FailOnSet",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId: args.userId,
+						Id:      args.noteId,
+						Icon:    args.icon,
+					},
+				}, nil).Times(1)
+				baseRepo.EXPECT().SetIcon(ctx, args.noteId, args.icon, gomock.Any()).Return(errors.New("err"))
+
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+				icon:   "icon",
+			},
+			wantErr: true,
+			want:    models.Note{},
+		},
+
+		{
+			name: "Test_SetIcon_FailOnRead",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId: args.userId,
+						Id:      args.noteId,
+						Icon:    args.icon,
+					},
+				}, errors.New("err")).Times(1)
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+			},
+			wantErr: true,
+			want:    models.Note{},
+		},
+		{
+			name: "Test_SetIcon_NotOwner",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId: uuid.NewV4(),
+						Id:      args.noteId,
+					},
+				}, nil).Times(1)
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+			},
+			wantErr: true,
+			want:    models.Note{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo, searchRepo, tt.args)
+
+			got, err := uc.SetIcon(tt.args.ctx, tt.args.noteId, tt.args.icon, tt.args.userId, 0)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NoteUsecase.SetIcon error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNoteUsecase_SetHeader(t *testing.T) {
+	elasticConfig := config.ElasticConfig{}
+
+	constraintsConfig := config.ConstraintsConfig{}
+
+	noteId := uuid.NewV4()
+	userId := uuid.NewV4()
+
+	type args struct {
+		ctx    context.Context
+		userId uuid.UUID
+		noteId uuid.UUID
+		header string
+	}
+	tests := []struct {
+		name       string
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args)
+		args       args
+		wantErr    bool
+		want       models.Note
+	}{
+		{
+			name: "Test_SetHeader_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId: args.userId,
+						Id:      args.noteId,
+						Header:  args.header,
+					},
+				}, nil).Times(1)
+				baseRepo.EXPECT().SetHeader(ctx, args.noteId, args.header, gomock.Any()).Return(nil)
+				searchRepo.EXPECT().SetHeader(ctx, args.noteId, args.header).Return(nil)
+
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+				header: "header",
+			},
+			wantErr: false,
+			want: models.Note{
+				OwnerId: userId,
+				Id:      noteId,
+				Header:  "header",
+				Version: 1,
+			},
+		},
+		{
+			name: "Test_SetHeader_FailOnSet",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId: args.userId,
+						Id:      args.noteId,
+						Header:  args.header,
+					},
+				}, nil).Times(1)
+				baseRepo.EXPECT().SetHeader(ctx, args.noteId, args.header, gomock.Any()).Return(errors.New("err"))
+
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+				header: "header",
+			},
+			wantErr: true,
+			want:    models.Note{},
+		},
+
+		{
+			name: "Test_SetHeader_FailOnRead",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId: args.userId,
+						Id:      args.noteId,
+						Header:  args.header,
+					},
+				}, errors.New("err")).Times(1)
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+			},
+			wantErr: true,
+			want:    models.Note{},
+		},
+		{
+			name: "Test_SetHeader_NotOwner",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId: uuid.NewV4(),
+						Id:      args.noteId,
+					},
+				}, nil).Times(1)
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+			},
+			wantErr: true,
+			want:    models.Note{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo, searchRepo, tt.args)
+
+			got, err := uc.SetHeader(tt.args.ctx, tt.args.noteId, tt.args.header, tt.args.userId, 0)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NoteUsecase.SetHeader error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNoteUsecase_AddFav(t *testing.T) {
+	elasticConfig := config.ElasticConfig{}
+
+	constraintsConfig := config.ConstraintsConfig{}
+
+	noteId := uuid.NewV4()
+	userId := uuid.NewV4()
+
+	type args struct {
+		ctx    context.Context
+		userId uuid.UUID
+		noteId uuid.UUID
+	}
+	tests := []struct {
+		name       string
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args)
+		args       args
+		wantErr    bool
+		want       models.Note
+	}{
+		{
+			name: "Test_AddFav_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId:  args.userId,
+						Id:       args.noteId,
+						Favorite: false,
+					},
+				}, nil).Times(1)
+				baseRepo.EXPECT().AddFav(ctx, args.noteId, args.userId).Return(nil)
+				searchRepo.EXPECT().ChangeFlag(ctx, args.noteId, true).Return(nil)
+
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+			},
+			wantErr: false,
+			want: models.Note{
+				OwnerId:  userId,
+				Id:       noteId,
+				Favorite: true,
+			},
+		},
+		{
+			name: "Test_AddFav_FailOnSet",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId: args.userId,
+						Id:      args.noteId,
+					},
+				}, nil).Times(1)
+				baseRepo.EXPECT().AddFav(args.ctx, args.noteId, args.userId).Return(errors.New("err"))
+
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+			},
+			wantErr: true,
+			want:    models.Note{},
+		},
+
+		{
+			name: "Test_AddFav_FailOnRead",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId: args.userId,
+						Id:      args.noteId,
+					},
+				}, errors.New("err")).Times(1)
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+			},
+			wantErr: true,
+			want:    models.Note{},
+		},
+		{
+			name: "Test_AddFav_NotOwner",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId: uuid.NewV4(),
+						Id:      args.noteId,
+					},
+				}, nil).Times(1)
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+			},
+			wantErr: true,
+			want:    models.Note{},
+		},
+		{
+			name: "Test_AddFav_AlreadyTrue",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId:  args.userId,
+						Id:       args.noteId,
+						Favorite: true,
+					},
+				}, nil).Times(1)
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+			},
+			wantErr: false,
+			want: models.Note{
+				OwnerId:  userId,
+				Id:       noteId,
+				Favorite: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo, searchRepo, tt.args)
+
+			got, err := uc.AddFav(context.Background(), tt.args.noteId, tt.args.userId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NoteUsecase.AddFav error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNoteUsecase_DelFav(t *testing.T) {
+	elasticConfig := config.ElasticConfig{}
+
+	constraintsConfig := config.ConstraintsConfig{}
+
+	noteId := uuid.NewV4()
+	userId := uuid.NewV4()
+
+	type args struct {
+		ctx    context.Context
+		userId uuid.UUID
+		noteId uuid.UUID
+	}
+	tests := []struct {
+		name       string
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args)
+		args       args
+		wantErr    bool
+		want       models.Note
+	}{
+		{
+			name: "Test_DelFav_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId:  args.userId,
+						Id:       args.noteId,
+						Favorite: true,
+					},
+				}, nil).Times(1)
+				baseRepo.EXPECT().DelFav(ctx, args.noteId, args.userId).Return(nil)
+				searchRepo.EXPECT().ChangeFlag(ctx, args.noteId, false).Return(nil)
+
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+			},
+			wantErr: false,
+			want: models.Note{
+				OwnerId:  userId,
+				Id:       noteId,
+				Favorite: false,
+			},
+		},
+		{
+			name: "Test_DelFav_FailOnDel",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId:  args.userId,
+						Id:       args.noteId,
+						Favorite: true,
+					},
+				}, nil).Times(1)
+				baseRepo.EXPECT().DelFav(args.ctx, args.noteId, args.userId).Return(errors.New("err"))
+
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+			},
+			wantErr: true,
+			want:    models.Note{},
+		},
+
+		{
+			name: "Test_DelFav_FailOnRead",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId: args.userId,
+						Id:      args.noteId,
+					},
+				}, errors.New("err")).Times(1)
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+			},
+			wantErr: true,
+			want:    models.Note{},
+		},
+		{
+			name: "Test_DelFav_NotOwner",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId: uuid.NewV4(),
+						Id:      args.noteId,
+					},
+				}, nil).Times(1)
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+			},
+			wantErr: true,
+			want:    models.Note{},
+		},
+		{
+			name: "Test_DelFav_AlreadyTrue",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId:  args.userId,
+						Id:       args.noteId,
+						Favorite: false,
+					},
+				}, nil).Times(1)
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+			},
+			wantErr: false,
+			want: models.Note{
+				OwnerId:  userId,
+				Id:       noteId,
+				Favorite: false,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo, searchRepo, tt.args)
+
+			got, err := uc.DelFav(context.Background(), tt.args.noteId, tt.args.userId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NoteUsecase.DelFav error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNoteUsecase_SetPublic(t *testing.T) {
+	elasticConfig := config.ElasticConfig{}
+
+	constraintsConfig := config.ConstraintsConfig{}
+
+	noteId := uuid.NewV4()
+	userId := uuid.NewV4()
+
+	type args struct {
+		ctx    context.Context
+		userId uuid.UUID
+		noteId uuid.UUID
+	}
+	tests := []struct {
+		name       string
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args)
+		args       args
+		wantErr    bool
+		want       models.Note
+	}{
+		{
+			name: "Test_SetPublic_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId: args.userId,
+						Id:      args.noteId,
+						Public:  false,
+					},
+				}, nil).Times(1)
+				baseRepo.EXPECT().SetPublic(ctx, args.noteId).Return(nil)
+				baseRepo.EXPECT().GetShareLinks(ctx, args.noteId).Return([]models.ShareLink{
+					{NoteId: args.noteId, PasswordHash: "hash", IncludeSubtree: true},
+				}, nil)
+				baseRepo.EXPECT().CreateShareLink(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, link models.ShareLink) error {
+					assert.Equal(t, args.noteId, link.NoteId)
+					assert.Empty(t, link.PasswordHash)
+					assert.Nil(t, link.ExpiresAt)
+					assert.True(t, link.IncludeSubtree)
+					return nil
+				})
+				searchRepo.EXPECT().SetPublic(ctx, args.noteId).Return(nil)
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+			},
+			wantErr: false,
+			want: models.Note{
+				OwnerId: userId,
+				Id:      noteId,
+				Public:  true,
+			},
+		},
+		{
+			name: "Test_SetPublic_OpenLinkExists",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId: args.userId,
+						Id:      args.noteId,
+					},
+				}, nil).Times(1)
+				baseRepo.EXPECT().SetPublic(ctx, args.noteId).Return(nil)
+				baseRepo.EXPECT().GetShareLinks(ctx, args.noteId).Return([]models.ShareLink{
+					{NoteId: args.noteId, IncludeSubtree: true},
+				}, nil)
+				searchRepo.EXPECT().SetPublic(ctx, args.noteId).Return(nil)
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+			},
+			wantErr: false,
+			want: models.Note{
+				OwnerId: userId,
+				Id:      noteId,
+				Public:  true,
+			},
+		},
+		{
+			name: "Test_SetPublic_FailOnShareLink",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId: args.userId,
+						Id:      args.noteId,
+					},
+				}, nil).Times(1)
+				baseRepo.EXPECT().SetPublic(ctx, args.noteId).Return(nil)
+				baseRepo.EXPECT().GetShareLinks(ctx, args.noteId).Return(nil, errors.New("err"))
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+			},
+			wantErr: true,
+			want:    models.Note{},
+		},
+		{
+			name: "Test_SetPublic_FailOnSet",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId:  args.userId,
+						Id:       args.noteId,
+						Favorite: true,
+					},
+				}, nil).Times(1)
+				baseRepo.EXPECT().SetPublic(ctx, args.noteId).Return(errors.New("err"))
+
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+			},
+			wantErr: true,
+			want:    models.Note{},
+		},
+
+		{
+			name: "Test_SetPublic_FailOnRead",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId: args.userId,
+						Id:      args.noteId,
+					},
+				}, errors.New("err")).Times(1)
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+			},
+			wantErr: true,
+			want:    models.Note{},
+		},
+		{
+			name: "Test_SetPublic_NotOwner",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId: uuid.NewV4(),
+						Id:      args.noteId,
+					},
+				}, nil).Times(1)
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+			},
+			wantErr: true,
+			want:    models.Note{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo, searchRepo, tt.args)
+
+			got, err := uc.SetPublic(context.Background(), tt.args.noteId, tt.args.userId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NoteUsecase.SetPublic error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNoteUsecase_SetPrivate(t *testing.T) {
+	elasticConfig := config.ElasticConfig{}
+
+	constraintsConfig := config.ConstraintsConfig{}
+
+	noteId := uuid.NewV4()
+	userId := uuid.NewV4()
+
+	type args struct {
+		ctx    context.Context
+		userId uuid.UUID
+		noteId uuid.UUID
+	}
+	tests := []struct {
+		name       string
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args)
+		args       args
+		wantErr    bool
+		want       models.Note
+	}{
+		{
+			name: "Test_SetPrivate_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId: args.userId,
+						Id:      args.noteId,
+						Public:  true,
+					},
+				}, nil).Times(1)
+				baseRepo.EXPECT().SetPrivate(ctx, args.noteId).Return(nil)
+				searchRepo.EXPECT().SetPrivate(ctx, args.noteId).Return(nil)
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+			},
+			wantErr: false,
+			want: models.Note{
+				OwnerId: userId,
+				Id:      noteId,
+				Public:  false,
+			},
+		},
+		{
+			name: "Test_SetPrivate_FailOnSet",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId:  args.userId,
+						Id:       args.noteId,
+						Favorite: true,
+					},
+				}, nil).Times(1)
+				baseRepo.EXPECT().SetPrivate(ctx, args.noteId).Return(errors.New("err"))
+
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+			},
+			wantErr: true,
+			want:    models.Note{},
+		},
+
+		{
+			name: "Test_SetPublic_FailOnRead",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId: args.userId,
+						Id:      args.noteId,
+					},
+				}, errors.New("err")).Times(1)
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+			},
+			wantErr: true,
+			want:    models.Note{},
+		},
+		{
+			name: "Test_SetPrivate_NotOwner",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId: uuid.NewV4(),
+						Id:      args.noteId,
+					},
+				}, nil).Times(1)
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+			},
+			wantErr: true,
+			want:    models.Note{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo, searchRepo, tt.args)
+
+			got, err := uc.SetPrivate(context.Background(), tt.args.noteId, tt.args.userId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NoteUsecase.SetPrivate error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNoteUsecase_GetAttachList(t *testing.T) {
+	elasticConfig := config.ElasticConfig{}
+
+	constraintsConfig := config.ConstraintsConfig{}
+
+	noteId := uuid.NewV4()
+	userId := uuid.NewV4()
+
+	type args struct {
+		ctx    context.Context
+		userId uuid.UUID
+		noteId uuid.UUID
+	}
+	tests := []struct {
+		name       string
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args)
+		args       args
+		wantErr    bool
+		want       []string
+	}{
+		{
+			name: "Test_GetAttachList_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId: args.userId,
+						Id:      args.noteId,
+						Public:  true,
+					},
+				}, nil).Times(1)
+				baseRepo.EXPECT().GetAttachList(ctx, args.noteId).Return([]string{"1", "2"}, nil)
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+			},
+			wantErr: false,
+			want:    []string{"1", "2"},
+		},
+		{
+			name: "Test_GetAttachList_FailOnGet",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId:  args.userId,
+						Id:       args.noteId,
+						Favorite: true,
+					},
+				}, nil).Times(1)
+				baseRepo.EXPECT().GetAttachList(ctx, args.noteId).Return([]string{}, errors.New("err"))
+
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+			},
+			wantErr: true,
+			want:    []string{},
+		},
+
+		{
+			name: "Test_GetAttachList_FailOnRead",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId: args.userId,
+						Id:      args.noteId,
+					},
+				}, errors.New("err")).Times(1)
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+			},
+			wantErr: true,
+			want:    []string{},
+		},
+		{
+			name: "Test_GetAttachList_NotOwner",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{
+						OwnerId: uuid.NewV4(),
+						Id:      args.noteId,
+					},
+				}, nil).Times(1)
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+				noteId: noteId,
+			},
+			wantErr: true,
+			want:    []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo, searchRepo, tt.args)
+
+			got, err := uc.GetAttachList(context.Background(), tt.args.noteId, tt.args.userId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NoteUsecase.GetAttachList error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNoteUsecase_RememberTag(t *testing.T) {
+	elasticConfig := config.ElasticConfig{}
+
+	constraintsConfig := config.ConstraintsConfig{}
+
+	userId := uuid.NewV4()
+
+	type args struct {
+		ctx    context.Context
+		userId uuid.UUID
+
+		tagName string
+	}
+	tests := []struct {
+		name       string
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args)
+		args       args
+		wantErr    bool
+	}{
+		{
+			name: "Test_RememberTag_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().RememberTag(ctx, args.tagName, args.userId).Return(nil).Times(1)
+
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+
+				tagName: "tag1",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test_RememberTag_FailOnAdd",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().RememberTag(ctx, args.tagName, args.userId).Return(errors.New("error")).Times(1)
+
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+
+				tagName: "tag1",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo, searchRepo, tt.args)
+
+			err := uc.RememberTag(tt.args.ctx, tt.args.tagName, tt.args.userId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NoteUsecase.RememberTag error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestNoteUsecase_ForgetTag(t *testing.T) {
+	elasticConfig := config.ElasticConfig{}
+
+	constraintsConfig := config.ConstraintsConfig{}
+
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+
+	type args struct {
+		ctx    context.Context
+		userId uuid.UUID
+
+		tagName string
+	}
+	tests := []struct {
+		name       string
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args)
+		args       args
+		wantErr    bool
+	}{
+		{
+			name: "Test_ForgetTag_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().ForgetTag(ctx, args.tagName, args.userId).Return(nil).Times(1)
+				baseRepo.EXPECT().DeleteTagFromAllNotes(ctx, args.tagName, args.userId).Return([]uuid.UUID{noteId}, nil)
+				searchRepo.EXPECT().DeleteTagFromAllNotes(ctx, args.tagName, []uuid.UUID{noteId}).Return(nil)
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+
+				tagName: "tag1",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test_ForgetTag_FailOnForget",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().ForgetTag(ctx, args.tagName, args.userId).Return(errors.New("error")).Times(1)
+
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+
+				tagName: "tag1",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test_ForgetTag_FailOnForget",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().ForgetTag(ctx, args.tagName, args.userId).Return(nil).Times(1)
+				baseRepo.EXPECT().DeleteTagFromAllNotes(ctx, args.tagName, args.userId).Return(nil, errors.New("err"))
+
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+
+				tagName: "tag1",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo, searchRepo, tt.args)
+
+			err := uc.ForgetTag(tt.args.ctx, tt.args.tagName, tt.args.userId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NoteUsecase.RememberTag error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestNoteUsecase_UpdateTag(t *testing.T) {
+	elasticConfig := config.ElasticConfig{}
+
+	constraintsConfig := config.ConstraintsConfig{}
+
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+
+	type args struct {
+		ctx    context.Context
+		userId uuid.UUID
+
+		tagName string
+	}
+	tests := []struct {
+		name       string
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args)
+		args       args
+		wantErr    bool
+	}{
+		{
+			name: "Test_UpdateTag_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().UpdateTag(ctx, "", args.tagName, args.userId).Return(nil)
+				searchRepo.EXPECT().UpdateTagOnAllNotes(ctx, "", args.tagName, []uuid.UUID{noteId}).Return(nil)
+				baseRepo.EXPECT().UpdateTagOnAllNotes(ctx, "", args.tagName, args.userId).Return([]uuid.UUID{noteId}, nil)
+				baseRepo.EXPECT().ReconcileTags(ctx, []uuid.UUID{noteId}).Return(nil)
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+
+				tagName: "tag1",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test_UpdatetTag_FailOnUpdate",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().UpdateTag(ctx, "", args.tagName, args.userId).Return(errors.New("error")).Times(1)
+
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+
+				tagName: "tag1",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test_UpdatetTag_FailOnUpdateAll",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().UpdateTag(ctx, "", args.tagName, args.userId).Return(nil).Times(1)
+				baseRepo.EXPECT().UpdateTagOnAllNotes(ctx, "", args.tagName, args.userId).Return(nil, errors.New("err"))
+
+			},
+			args: args{
+				ctx:    context.Background(),
+				userId: userId,
+
+				tagName: "tag1",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo, searchRepo, tt.args)
+
+			err := uc.UpdateTag(tt.args.ctx, "", tt.args.tagName, tt.args.userId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NoteUsecase.UpdateTag error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestNoteUsecase_GetSharedAttachList(t *testing.T) {
+	elasticConfig := config.ElasticConfig{}
+
+	constraintsConfig := config.ConstraintsConfig{}
+
+	noteId := uuid.NewV4()
+	type args struct {
+		noteID uuid.UUID
+	}
+	tests := []struct {
+		name       string
+		args       args
+		repoMocker func(baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args)
+		want       []string
+		wantErr    bool
+	}{
+		{
+			name: "Test_GetSharedAttachList_Success",
+			args: args{
+				noteID: noteId,
+			},
+			repoMocker: func(baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().GetShareLink(gomock.Any(), "token", args.noteID).Return(models.ShareLink{NoteId: args.noteID}, nil)
+				baseRepo.EXPECT().ReadPublicNote(gomock.Any(), args.noteID).Return(models.NoteResponse{
+					Note: models.Note{
+						Id: args.noteID,
+					},
+				}, nil)
+				baseRepo.EXPECT().GetAttachList(gomock.Any(), args.noteID).Return([]string{"1", "2"}, nil)
+			},
+			want:    []string{"1", "2"},
+			wantErr: false,
+		},
+		{
+			name: "Test_GetSharedAttachList_NoLink",
+			args: args{
+				noteID: noteId,
+			},
+			repoMocker: func(baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().GetShareLink(gomock.Any(), "token", args.noteID).Return(models.ShareLink{}, errors.New("err"))
+			},
+			want:    []string{},
+			wantErr: true,
+		},
+		{
+			name: "Test_GetSharedAttachList_WrongPassword",
+			args: args{
+				noteID: noteId,
+			},
+			repoMocker: func(baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().GetShareLink(gomock.Any(), "token", args.noteID).Return(models.ShareLink{
+					NoteId:       args.noteID,
+					PasswordHash: responses.GetHash("another"),
+				}, nil)
+			},
+			want:    []string{},
+			wantErr: true,
+		},
+		{
+			name: "Test_GetSharedAttachList_FailRead",
+			args: args{
+				noteID: noteId,
+			},
+			repoMocker: func(baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().GetShareLink(gomock.Any(), "token", args.noteID).Return(models.ShareLink{NoteId: args.noteID}, nil)
+				baseRepo.EXPECT().ReadPublicNote(gomock.Any(), args.noteID).Return(models.NoteResponse{}, errors.New("err"))
+
+			},
+			want:    []string{},
+			wantErr: true,
+		},
+		{
+			name: "Test_GetSharedAttachList_FailGetList",
+			args: args{
+				noteID: noteId,
+			},
+			repoMocker: func(baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
+				baseRepo.EXPECT().GetShareLink(gomock.Any(), "token", args.noteID).Return(models.ShareLink{NoteId: args.noteID}, nil)
+				baseRepo.EXPECT().ReadPublicNote(gomock.Any(), args.noteID).Return(models.NoteResponse{}, nil)
+				baseRepo.EXPECT().GetAttachList(gomock.Any(), args.noteID).Return([]string{}, errors.New("err"))
+
+			},
+			want:    []string{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+
+			tt.repoMocker(repo, searchRepo, tt.args)
+			got, err := uc.GetSharedAttachList(context.Background(), tt.args.noteID, "token", "password")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NoteUsecase.GetSharedAttachList() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNoteUsecase_CreateShareLink(t *testing.T) {
+	noteId := uuid.NewV4()
+	userId := uuid.NewV4()
+	expiresAt := time.Now().UTC().Add(time.Hour)
+
+	tests := []struct {
+		name       string
+		password   string
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo)
+		wantErr    bool
+		errString  string
+	}{
+		{
+			name:     "Test_CreateShareLink_Success",
+			password: "password",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: userId}}, nil)
+				baseRepo.EXPECT().CreateShareLink(ctx, gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test_CreateShareLink_Viewer",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{
+					Id:            noteId,
+					OwnerId:       uuid.NewV4(),
+					Collaborators: []uuid.UUID{userId},
+				}}, nil)
+				baseRepo.EXPECT().GetCollaboratorRole(ctx, noteId, userId).Return(models.RoleViewer, nil)
+			},
+			wantErr:   true,
+			errString: note.ErrNotEnoughRights,
+		},
+		{
+			name: "Test_CreateShareLink_NotFound",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{}, errors.New("error"))
+			},
+			wantErr:   true,
+			errString: "not found",
+		},
+		{
+			name: "Test_CreateShareLink_FailCreate",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: userId}}, nil)
+				baseRepo.EXPECT().CreateShareLink(ctx, gomock.Any()).Return(errors.New("error"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, config.ElasticConfig{}, config.ConstraintsConfig{}, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo)
+
+			got, err := uc.CreateShareLink(context.Background(), noteId, userId, tt.password, &expiresAt, true)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NoteUsecase.CreateShareLink error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				if tt.errString != "" {
+					assert.Equal(t, tt.errString, err.Error())
+				}
+				return
+			}
+			assert.Equal(t, noteId, got.NoteId)
+			assert.Equal(t, userId, got.CreatorId)
+			assert.NotEqual(t, responses.GetHash(tt.password), got.PasswordHash)
+			assert.True(t, passhash.Check(got.PasswordHash, tt.password))
+			assert.Equal(t, &expiresAt, got.ExpiresAt)
+			assert.True(t, got.IncludeSubtree)
+			assert.NotEmpty(t, got.Token)
+		})
+	}
+}
+
+func TestNoteUsecase_GetShareLinks(t *testing.T) {
+	noteId := uuid.NewV4()
+	userId := uuid.NewV4()
+
+	tests := []struct {
+		name       string
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo)
+		want       []models.ShareLink
+		wantErr    bool
+	}{
+		{
+			name: "Test_GetShareLinks_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: userId}}, nil)
+				baseRepo.EXPECT().GetShareLinks(ctx, noteId).Return([]models.ShareLink{{NoteId: noteId}}, nil)
+			},
+			want:    []models.ShareLink{{NoteId: noteId}},
+			wantErr: false,
+		},
+		{
+			name: "Test_GetShareLinks_NotCollaborator",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: uuid.NewV4()}}, nil)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, config.ElasticConfig{}, config.ConstraintsConfig{}, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo)
+
+			got, err := uc.GetShareLinks(context.Background(), noteId, userId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NoteUsecase.GetShareLinks error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNoteUsecase_RevokeShareLink(t *testing.T) {
+	noteId := uuid.NewV4()
+	linkId := uuid.NewV4()
+	userId := uuid.NewV4()
+
+	tests := []struct {
+		name       string
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo)
+		wantErr    bool
+	}{
+		{
+			name: "Test_RevokeShareLink_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: userId}}, nil)
+				baseRepo.EXPECT().DeleteShareLink(ctx, noteId, linkId).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test_RevokeShareLink_LinkNotFound",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: userId}}, nil)
+				baseRepo.EXPECT().DeleteShareLink(ctx, noteId, linkId).Return(errors.New("error"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test_RevokeShareLink_NotFound",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{}, errors.New("error"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, config.ElasticConfig{}, config.ConstraintsConfig{}, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo)
+
+			err := uc.RevokeShareLink(context.Background(), noteId, linkId, userId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NoteUsecase.RevokeShareLink error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNoteUsecase_changeModeRecursive(t *testing.T) {
+	elasticConfig := config.ElasticConfig{
+		ElasticIndexName:            "notes",
+		ElasticSearchValueMinLength: 2,
+	}
+
+	constraintsConfig := config.ConstraintsConfig{
+		MaxDepth:         3,
+		MaxCollaborators: 10,
+		MaxTags:          4,
+		MaxSubnotes:      10,
+	}
+
+	noteId := uuid.NewV4()
+	guestId := uuid.NewV4()
+
+	tests := []struct {
+		name       string
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo)
+		isPublic   bool
+		wantErr    bool
+	}{
+		{
+			name:    "Test_changeModeRecursive_ReadError",
+			wantErr: true,
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, gomock.Any()).Return(models.NoteResponse{}, errors.New("error"))
+			},
+			isPublic: true,
+		},
+		{
+			name:    "Test_changeModeRecursive_Public_Error",
+			wantErr: true,
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, gomock.Any()).Return(models.NoteResponse{
+					Note: models.Note{
+						Id: noteId,
+					},
+				}, nil)
+				baseRepo.EXPECT().SetPublic(gomock.Any(), noteId).Return(errors.New("error"))
+			},
+			isPublic: true,
+		},
+		{
+			name:    "Test_changeModeRecursive_Private_Error",
+			wantErr: true,
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, gomock.Any()).Return(models.NoteResponse{
+					Note: models.Note{
+						Id: noteId,
+					},
+				}, nil)
+				baseRepo.EXPECT().SetPrivate(gomock.Any(), noteId).Return(errors.New("error"))
+			},
+			isPublic: false,
+		},
+		{
+			name:    "Test_changeModeRecursive_NoChildren",
+			wantErr: false,
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, gomock.Any()).Return(models.NoteResponse{
+					Note: models.Note{
+						Id: noteId,
+					},
+				}, nil)
+				baseRepo.EXPECT().SetPublic(gomock.Any(), noteId).Return(nil)
+			},
+			isPublic: true,
+		},
+		{
+			name:    "Test_changeModeRecursive_Public_Success",
+			wantErr: false,
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, gomock.Any()).Return(models.NoteResponse{
+					Note: models.Note{
+						Id:       noteId,
+						Children: []uuid.UUID{noteId},
+					},
+				}, nil)
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, gomock.Any()).Return(models.NoteResponse{
+					Note: models.Note{
+						Id: noteId,
+					},
+				}, nil)
+				baseRepo.EXPECT().SetPublic(gomock.Any(), noteId).Return(nil).Times(2)
+			},
+			isPublic: true,
+		},
+		{
+			name:    "Test_changeModeRecursive_Private_Success",
+			wantErr: false,
+			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, gomock.Any()).Return(models.NoteResponse{
+					Note: models.Note{
+						Id:       noteId,
+						Children: []uuid.UUID{noteId},
+					},
+				}, nil)
+				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, gomock.Any()).Return(models.NoteResponse{
+					Note: models.Note{
+						Id: noteId,
+					},
+				}, nil)
+				baseRepo.EXPECT().SetPrivate(gomock.Any(), noteId).Return(nil).Times(2)
+			},
+			isPublic: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo, searchRepo)
+
+			err := uc.changeModeRecursive(context.Background(), noteId, guestId, tt.isPublic)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NoteUsecase.changeModeRecursive error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+		})
+	}
+}
+
+func TestNoteUsecase_GetRevisions(t *testing.T) {
+	elasticConfig := config.ElasticConfig{}
+
+	constraintsConfig := config.ConstraintsConfig{}
+
+	noteId := uuid.NewV4()
+	userId := uuid.NewV4()
+
+	tests := []struct {
+		name       string
+		repoMocker func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo)
+		wantErr    bool
+		want       []models.NoteRevision
+	}{
+		{
+			name: "Test_GetRevisions_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: noteId, OwnerId: userId},
+				}, nil)
+				baseRepo.EXPECT().GetRevisions(ctx, noteId, int64(10), int64(0)).Return([]models.NoteRevision{{NoteId: noteId}}, nil)
+			},
+			wantErr: false,
+			want:    []models.NoteRevision{{NoteId: noteId}},
+		},
+		{
+			name: "Test_GetRevisions_Collaborator",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: noteId, OwnerId: uuid.NewV4(), Collaborators: []uuid.UUID{userId}},
+				}, nil)
+				baseRepo.EXPECT().GetCollaboratorRole(ctx, noteId, userId).Return(models.RoleViewer, nil)
+				baseRepo.EXPECT().GetRevisions(ctx, noteId, int64(10), int64(0)).Return([]models.NoteRevision{}, nil)
+			},
+			wantErr: false,
+			want:    []models.NoteRevision{},
+		},
+		{
+			name: "Test_GetRevisions_FailOnRead",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{}, errors.New("err"))
+			},
+			wantErr: true,
+			want:    []models.NoteRevision{},
+		},
+		{
+			name: "Test_GetRevisions_NotOwner",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: noteId, OwnerId: uuid.NewV4()},
+				}, nil)
+			},
+			wantErr: true,
+			want:    []models.NoteRevision{},
+		},
+		{
+			name: "Test_GetRevisions_FailOnGet",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: noteId, OwnerId: userId},
+				}, nil)
+				baseRepo.EXPECT().GetRevisions(ctx, noteId, int64(10), int64(0)).Return(nil, errors.New("err"))
+			},
+			wantErr: true,
+			want:    []models.NoteRevision{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo)
+
+			got, err := uc.GetRevisions(context.Background(), noteId, userId, 10, 0)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NoteUsecase.GetRevisions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNoteUsecase_GetRevision(t *testing.T) {
+	elasticConfig := config.ElasticConfig{}
+
+	constraintsConfig := config.ConstraintsConfig{}
+
+	noteId := uuid.NewV4()
+	userId := uuid.NewV4()
+	revisionId := uuid.NewV4()
+
+	tests := []struct {
+		name       string
+		repoMocker func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo)
+		wantErr    bool
+		want       models.NoteRevision
+	}{
+		{
+			name: "Test_GetRevision_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: noteId, OwnerId: userId},
+				}, nil)
+				baseRepo.EXPECT().GetRevision(ctx, revisionId).Return(models.NoteRevision{Id: revisionId, NoteId: noteId, Data: "{}"}, nil)
+			},
+			wantErr: false,
+			want:    models.NoteRevision{Id: revisionId, NoteId: noteId, Data: "{}"},
+		},
+		{
+			name: "Test_GetRevision_FailOnRead",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{}, errors.New("err"))
+			},
+			wantErr: true,
+			want:    models.NoteRevision{},
+		},
+		{
+			name: "Test_GetRevision_NotOwner",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: noteId, OwnerId: uuid.NewV4()},
+				}, nil)
+			},
+			wantErr: true,
+			want:    models.NoteRevision{},
+		},
+		{
+			name: "Test_GetRevision_FailOnGet",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: noteId, OwnerId: userId},
+				}, nil)
+				baseRepo.EXPECT().GetRevision(ctx, revisionId).Return(models.NoteRevision{}, errors.New("err"))
+			},
+			wantErr: true,
+			want:    models.NoteRevision{},
+		},
+		{
+			name: "Test_GetRevision_AnotherNote",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: noteId, OwnerId: userId},
+				}, nil)
+				baseRepo.EXPECT().GetRevision(ctx, revisionId).Return(models.NoteRevision{Id: revisionId, NoteId: uuid.NewV4()}, nil)
+			},
+			wantErr: true,
+			want:    models.NoteRevision{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo)
+
+			got, err := uc.GetRevision(context.Background(), noteId, revisionId, userId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NoteUsecase.GetRevision() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNoteUsecase_GetRevisionDiff(t *testing.T) {
+	elasticConfig := config.ElasticConfig{}
+
+	constraintsConfig := config.ConstraintsConfig{}
+
+	noteId := uuid.NewV4()
+	userId := uuid.NewV4()
+	fromId := uuid.NewV4()
+	toId := uuid.NewV4()
+
+	tests := []struct {
+		name       string
+		repoMocker func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo)
+		wantErr    bool
+		want       models.RevisionDiff
+	}{
+		{
+			name: "Test_GetRevisionDiff_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: noteId, OwnerId: userId},
+				}, nil)
+				baseRepo.EXPECT().GetRevision(ctx, fromId).Return(models.NoteRevision{Id: fromId, NoteId: noteId, Data: `{"title":"old","content":[{"content":"a"}]}`}, nil)
+				baseRepo.EXPECT().GetRevision(ctx, toId).Return(models.NoteRevision{Id: toId, NoteId: noteId, Data: `{"title":"new","content":[{"content":"b"}]}`}, nil)
+			},
+			wantErr: false,
+			want: models.RevisionDiff{
+				From:     fromId,
+				To:       toId,
+				OldTitle: "old",
+				NewTitle: "new",
+				Blocks: []models.BlockChange{
+					{Type: models.BlockRemoved, Block: `{"content":"a"}`},
+					{Type: models.BlockAdded, Block: `{"content":"b"}`},
+				},
+			},
+		},
+		{
+			name: "Test_GetRevisionDiff_NotOwner",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: noteId, OwnerId: uuid.NewV4()},
+				}, nil)
+			},
+			wantErr: true,
+			want:    models.RevisionDiff{},
+		},
+		{
+			name: "Test_GetRevisionDiff_FailOnFrom",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: noteId, OwnerId: userId},
+				}, nil)
+				baseRepo.EXPECT().GetRevision(ctx, fromId).Return(models.NoteRevision{}, errors.New("err"))
+			},
+			wantErr: true,
+			want:    models.RevisionDiff{},
+		},
+		{
+			name: "Test_GetRevisionDiff_FailOnTo",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: noteId, OwnerId: userId},
+				}, nil)
+				baseRepo.EXPECT().GetRevision(ctx, fromId).Return(models.NoteRevision{Id: fromId, NoteId: noteId}, nil)
+				baseRepo.EXPECT().GetRevision(ctx, toId).Return(models.NoteRevision{Id: toId, NoteId: uuid.NewV4()}, nil)
+			},
+			wantErr: true,
+			want:    models.RevisionDiff{},
+		},
+		{
+			name: "Test_GetRevisionDiff_InvalidData",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: noteId, OwnerId: userId},
+				}, nil)
+				baseRepo.EXPECT().GetRevision(ctx, fromId).Return(models.NoteRevision{Id: fromId, NoteId: noteId, Data: "{"}, nil)
+				baseRepo.EXPECT().GetRevision(ctx, toId).Return(models.NoteRevision{Id: toId, NoteId: noteId, Data: "{}"}, nil)
+			},
+			wantErr: true,
+			want:    models.RevisionDiff{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo)
+
+			got, err := uc.GetRevisionDiff(context.Background(), noteId, fromId, toId, userId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NoteUsecase.GetRevisionDiff() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNoteUsecase_RestoreRevision(t *testing.T) {
+	elasticConfig := config.ElasticConfig{}
+
+	constraintsConfig := config.ConstraintsConfig{}
+
+	noteId := uuid.NewV4()
+	userId := uuid.NewV4()
+	revisionId := uuid.NewV4()
+
+	tests := []struct {
+		name       string
+		repoMocker func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo)
+		wantErr    bool
+		wantData   string
+	}{
+		{
+			name: "Test_RestoreRevision_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().GetRevision(ctx, revisionId).Return(models.NoteRevision{Id: revisionId, NoteId: noteId, Data: `{"title":"old"}`}, nil)
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: noteId, OwnerId: userId, Data: `{"title":"new"}`},
+				}, nil)
+				baseRepo.EXPECT().UpdateNote(ctx, gomock.Any(), userId).Return(nil)
+				searchRepo.EXPECT().UpdateNote(ctx, gomock.Any()).Return(nil)
+				baseRepo.EXPECT().SetNoteLinks(ctx, noteId, userId, []uuid.UUID{}, []string{}).Return(nil)
+			},
+			wantErr:  false,
+			wantData: `{"title":"old"}`,
+		},
+		{
+			name: "Test_RestoreRevision_FailOnGet",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().GetRevision(ctx, revisionId).Return(models.NoteRevision{}, errors.New("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test_RestoreRevision_NotOwner",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().GetRevision(ctx, revisionId).Return(models.NoteRevision{Id: revisionId, NoteId: noteId, Data: `{"title":"old"}`}, nil)
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
+					Note: models.Note{Id: noteId, OwnerId: uuid.NewV4()},
+				}, nil)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo, searchRepo)
+
+			got, err := uc.RestoreRevision(context.Background(), noteId, revisionId, userId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NoteUsecase.RestoreRevision() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.wantData, got.Data)
+		})
+	}
+}
+
+func TestNoteUsecase_GetTrash(t *testing.T) {
+	elasticConfig := config.ElasticConfig{}
+
+	constraintsConfig := config.ConstraintsConfig{}
+
+	userId := uuid.NewV4()
+
+	tests := []struct {
+		name       string
+		repoMocker func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo)
+		wantErr    bool
+		want       []models.TrashedNote
+	}{
+		{
+			name: "Test_GetTrash_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadTrash(ctx, userId, int64(10), int64(0)).Return([]models.TrashedNote{{Note: models.Note{OwnerId: userId}}}, nil)
+			},
+			wantErr: false,
+			want:    []models.TrashedNote{{Note: models.Note{OwnerId: userId}}},
+		},
+		{
+			name: "Test_GetTrash_Fail",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadTrash(ctx, userId, int64(10), int64(0)).Return(nil, errors.New("err"))
+			},
+			wantErr: true,
+			want:    []models.TrashedNote{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo)
+
+			got, err := uc.GetTrash(context.Background(), userId, 10, 0)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NoteUsecase.GetTrash() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNoteUsecase_RestoreNote(t *testing.T) {
+	elasticConfig := config.ElasticConfig{}
+
+	constraintsConfig := config.ConstraintsConfig{
+		MaxSubnotes: 2,
+	}
+
+	noteId := uuid.NewV4()
+	childId := uuid.NewV4()
+	parentId := uuid.NewV4()
+	userId := uuid.NewV4()
+	guestId := uuid.NewV4()
+
+	tests := []struct {
+		name              string
+		repoMocker        func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo)
+		wantErr           bool
+		wantParent        uuid.UUID
+		wantCollaborators []uuid.UUID
+	}{
+		{
+			name: "Test_RestoreNote_Root",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadTrashedNote(ctx, noteId).Return(models.TrashedNote{Note: models.Note{Id: noteId, OwnerId: userId}}, nil)
+				baseRepo.EXPECT().RestoreNote(ctx, noteId, uuid.UUID{}).Return([]uuid.UUID{noteId, childId}, nil)
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: userId}}, nil).Times(2)
+				baseRepo.EXPECT().ReadNote(ctx, childId, userId).Return(models.NoteResponse{Note: models.Note{Id: childId, OwnerId: userId, Parent: noteId}}, nil)
+				searchRepo.EXPECT().CreateNote(ctx, gomock.Any()).Return(nil).Times(2)
+			},
+			wantErr:    false,
+			wantParent: uuid.UUID{},
+		},
+		{
+			name: "Test_RestoreNote_ToParent",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadTrashedNote(ctx, noteId).Return(models.TrashedNote{Note: models.Note{Id: noteId, OwnerId: userId, Parent: parentId}}, nil)
+				baseRepo.EXPECT().ReadNote(ctx, parentId, userId).Return(models.NoteResponse{Note: models.Note{Id: parentId, OwnerId: userId}}, nil)
+				baseRepo.EXPECT().RestoreNote(ctx, noteId, parentId).Return([]uuid.UUID{noteId}, nil)
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: userId, Parent: parentId}}, nil).Times(2)
+				searchRepo.EXPECT().CreateNote(ctx, gomock.Any()).Return(nil)
+				searchRepo.EXPECT().AddSubNote(ctx, parentId, noteId).Return(nil)
+			},
+			wantErr:    false,
+			wantParent: parentId,
+		},
+		{
+			// соавтора убрали из родителя, пока подзаметка лежала в корзине: после восстановления у нее соавторы родителя
+			name: "Test_RestoreNote_CollaboratorRemovedFromParent",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadTrashedNote(ctx, noteId).Return(models.TrashedNote{Note: models.Note{
+					Id: noteId, OwnerId: userId, Parent: parentId, Collaborators: []uuid.UUID{guestId},
+				}}, nil)
+				baseRepo.EXPECT().ReadNote(ctx, parentId, userId).Return(models.NoteResponse{Note: models.Note{
+					Id: parentId, OwnerId: userId, Collaborators: []uuid.UUID{},
+				}}, nil)
+				baseRepo.EXPECT().RestoreNote(ctx, noteId, parentId).Return([]uuid.UUID{noteId}, nil)
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{
+					Id: noteId, OwnerId: userId, Parent: parentId, Collaborators: []uuid.UUID{},
+				}}, nil).Times(2)
+				searchRepo.EXPECT().CreateNote(ctx, models.Note{
+					Id: noteId, OwnerId: userId, Parent: parentId, Collaborators: []uuid.UUID{},
+				}).Return(nil)
+				searchRepo.EXPECT().AddSubNote(ctx, parentId, noteId).Return(nil)
+			},
+			wantErr:           false,
+			wantParent:        parentId,
+			wantCollaborators: []uuid.UUID{},
+		},
+		{
+			name: "Test_RestoreNote_ParentDeleted",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadTrashedNote(ctx, noteId).Return(models.TrashedNote{Note: models.Note{Id: noteId, OwnerId: userId, Parent: parentId}}, nil)
+				baseRepo.EXPECT().ReadNote(ctx, parentId, userId).Return(models.NoteResponse{}, errors.New("err"))
+				baseRepo.EXPECT().RestoreNote(ctx, noteId, uuid.UUID{}).Return([]uuid.UUID{noteId}, nil)
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: userId}}, nil).Times(2)
+				searchRepo.EXPECT().CreateNote(ctx, gomock.Any()).Return(nil)
+			},
+			wantErr:    false,
+			wantParent: uuid.UUID{},
+		},
+		{
+			name: "Test_RestoreNote_ParentFull",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadTrashedNote(ctx, noteId).Return(models.TrashedNote{Note: models.Note{Id: noteId, OwnerId: userId, Parent: parentId}}, nil)
+				baseRepo.EXPECT().ReadNote(ctx, parentId, userId).Return(models.NoteResponse{Note: models.Note{Id: parentId, OwnerId: userId, Children: []uuid.UUID{uuid.NewV4(), uuid.NewV4()}}}, nil)
+				baseRepo.EXPECT().RestoreNote(ctx, noteId, uuid.UUID{}).Return([]uuid.UUID{noteId}, nil)
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: userId}}, nil).Times(2)
+				searchRepo.EXPECT().CreateNote(ctx, gomock.Any()).Return(nil)
+			},
+			wantErr:    false,
+			wantParent: uuid.UUID{},
+		},
+		{
+			name: "Test_RestoreNote_NotInTrash",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadTrashedNote(ctx, noteId).Return(models.TrashedNote{}, errors.New("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test_RestoreNote_NotOwner",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadTrashedNote(ctx, noteId).Return(models.TrashedNote{Note: models.Note{Id: noteId, OwnerId: uuid.NewV4()}}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test_RestoreNote_FailOnRestore",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadTrashedNote(ctx, noteId).Return(models.TrashedNote{Note: models.Note{Id: noteId, OwnerId: userId}}, nil)
+				baseRepo.EXPECT().RestoreNote(ctx, noteId, uuid.UUID{}).Return(nil, errors.New("err"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo, searchRepo)
+
+			got, err := uc.RestoreNote(context.Background(), noteId, userId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NoteUsecase.RestoreNote() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.wantParent, got.Parent)
+			if tt.wantCollaborators != nil {
+				assert.Equal(t, tt.wantCollaborators, got.Collaborators)
+			}
+		})
+	}
+}
+
+func TestNoteUsecase_DeleteNotePermanently(t *testing.T) {
+	elasticConfig := config.ElasticConfig{}
+
+	constraintsConfig := config.ConstraintsConfig{}
+
+	noteId := uuid.NewV4()
+	userId := uuid.NewV4()
+
+	tests := []struct {
+		name       string
+		repoMocker func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo)
+		wantErr    bool
+	}{
+		{
+			name: "Test_DeleteNotePermanently_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadTrashedNote(ctx, noteId).Return(models.TrashedNote{Note: models.Note{Id: noteId, OwnerId: userId}}, nil)
+				baseRepo.EXPECT().GetSubtreeAttaches(ctx, noteId).Return([]string{}, nil)
+				baseRepo.EXPECT().DeleteNote(ctx, noteId).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test_DeleteNotePermanently_NotInTrash",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadTrashedNote(ctx, noteId).Return(models.TrashedNote{}, errors.New("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test_DeleteNotePermanently_NotOwner",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadTrashedNote(ctx, noteId).Return(models.TrashedNote{Note: models.Note{Id: noteId, OwnerId: uuid.NewV4()}}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test_DeleteNotePermanently_FailOnAttaches",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadTrashedNote(ctx, noteId).Return(models.TrashedNote{Note: models.Note{Id: noteId, OwnerId: userId}}, nil)
+				baseRepo.EXPECT().GetSubtreeAttaches(ctx, noteId).Return(nil, errors.New("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test_DeleteNotePermanently_FailOnDelete",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadTrashedNote(ctx, noteId).Return(models.TrashedNote{Note: models.Note{Id: noteId, OwnerId: userId}}, nil)
+				baseRepo.EXPECT().GetSubtreeAttaches(ctx, noteId).Return([]string{}, nil)
+				baseRepo.EXPECT().DeleteNote(ctx, noteId).Return(errors.New("err"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo)
+
+			err := uc.DeleteNotePermanently(context.Background(), noteId, userId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NoteUsecase.DeleteNotePermanently() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestNoteUsecase_PurgeTrash(t *testing.T) {
+	elasticConfig := config.ElasticConfig{}
+
+	constraintsConfig := config.ConstraintsConfig{}
+
+	before := time.Now().UTC()
+	firstId := uuid.NewV4()
+	secondId := uuid.NewV4()
+
+	tests := []struct {
+		name       string
+		repoMocker func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo)
+		wantErr    bool
+	}{
+		{
+			name: "Test_PurgeTrash_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().GetExpiredTrash(ctx, before).Return([]uuid.UUID{firstId, secondId}, nil)
+				baseRepo.EXPECT().GetSubtreeAttaches(ctx, firstId).Return([]string{}, nil)
+				baseRepo.EXPECT().DeleteNote(ctx, firstId).Return(errors.New("err"))
+				baseRepo.EXPECT().GetSubtreeAttaches(ctx, secondId).Return([]string{}, nil)
+				baseRepo.EXPECT().DeleteNote(ctx, secondId).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test_PurgeTrash_Fail",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().GetExpiredTrash(ctx, before).Return(nil, errors.New("err"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo)
+
+			err := uc.PurgeTrash(context.Background(), before)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NoteUsecase.PurgeTrash() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestNoteUsecase_AddComment(t *testing.T) {
+	noteId := uuid.NewV4()
+	userId := uuid.NewV4()
+	rootId := uuid.NewV4()
+	replyId := uuid.NewV4()
+
+	tests := []struct {
+		name           string
+		parentId       uuid.UUID
+		repoMocker     func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo)
+		expectedParent uuid.UUID
+		wantErr        bool
+	}{
+		{
+			name:     "Test_AddComment_Success",
+			parentId: uuid.Nil,
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: userId}}, nil)
+				baseRepo.EXPECT().CreateComment(ctx, gomock.Any()).Return("author", nil)
+			},
+			expectedParent: uuid.Nil,
+			wantErr:        false,
+		},
+		{
+			name:     "Test_AddComment_ReplyToReply",
+			parentId: replyId,
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: userId}}, nil)
+				baseRepo.EXPECT().GetComment(ctx, replyId).Return(models.Comment{Id: replyId, NoteId: noteId, Parent: rootId}, nil)
+				baseRepo.EXPECT().CreateComment(ctx, gomock.Any()).Return("author", nil)
+			},
+			expectedParent: rootId,
+			wantErr:        false,
+		},
+		{
+			name:     "Test_AddComment_ParentFromOtherNote",
+			parentId: rootId,
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: userId}}, nil)
+				baseRepo.EXPECT().GetComment(ctx, rootId).Return(models.Comment{Id: rootId, NoteId: uuid.NewV4()}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:     "Test_AddComment_Viewer",
+			parentId: uuid.Nil,
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: uuid.NewV4(), Collaborators: []uuid.UUID{userId}}}, nil)
+				baseRepo.EXPECT().GetCollaboratorRole(ctx, noteId, userId).Return(models.RoleViewer, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:     "Test_AddComment_NotFound",
+			parentId: uuid.Nil,
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{}, errors.New("error"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, config.ElasticConfig{}, config.ConstraintsConfig{}, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo)
+
+			got, err := uc.AddComment(context.Background(), noteId, userId, tt.parentId, "block", "text")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NoteUsecase.AddComment error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				assert.Equal(t, tt.expectedParent, got.Parent)
+				assert.Equal(t, "author", got.Author)
+			}
+		})
+	}
+}
+
+func TestNoteUsecase_UpdateComment(t *testing.T) {
+	noteId := uuid.NewV4()
+	commentId := uuid.NewV4()
+	userId := uuid.NewV4()
+
+	tests := []struct {
+		name       string
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo)
+		wantErr    bool
+	}{
+		{
+			name: "Test_UpdateComment_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: userId}}, nil)
+				baseRepo.EXPECT().GetComment(ctx, commentId).Return(models.Comment{Id: commentId, NoteId: noteId, AuthorId: userId}, nil)
+				baseRepo.EXPECT().UpdateComment(ctx, commentId, "new text", gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test_UpdateComment_NotAuthor",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: userId}}, nil)
+				baseRepo.EXPECT().GetComment(ctx, commentId).Return(models.Comment{Id: commentId, NoteId: noteId, AuthorId: uuid.NewV4()}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test_UpdateComment_CommentNotFound",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: userId}}, nil)
+				baseRepo.EXPECT().GetComment(ctx, commentId).Return(models.Comment{}, errors.New("error"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, config.ElasticConfig{}, config.ConstraintsConfig{}, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo)
+
+			_, err := uc.UpdateComment(context.Background(), noteId, commentId, userId, "new text")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NoteUsecase.UpdateComment error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNoteUsecase_DeleteComment(t *testing.T) {
+	noteId := uuid.NewV4()
+	commentId := uuid.NewV4()
+	userId := uuid.NewV4()
+
+	tests := []struct {
+		name       string
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo)
+		wantErr    bool
+	}{
+		{
+			name: "Test_DeleteComment_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: userId}}, nil)
+				baseRepo.EXPECT().GetComment(ctx, commentId).Return(models.Comment{Id: commentId, NoteId: noteId, AuthorId: userId}, nil)
+				baseRepo.EXPECT().DeleteComment(ctx, commentId).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test_DeleteComment_NotAuthor",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: userId}}, nil)
+				baseRepo.EXPECT().GetComment(ctx, commentId).Return(models.Comment{Id: commentId, NoteId: noteId, AuthorId: uuid.NewV4()}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test_DeleteComment_AlreadyDeleted",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: userId}}, nil)
+				baseRepo.EXPECT().GetComment(ctx, commentId).Return(models.Comment{Id: commentId, NoteId: noteId, AuthorId: userId, Deleted: true}, nil)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, config.ElasticConfig{}, config.ConstraintsConfig{}, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo)
+
+			err := uc.DeleteComment(context.Background(), noteId, commentId, userId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NoteUsecase.DeleteComment error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNoteUsecase_SetCommentResolved(t *testing.T) {
+	noteId := uuid.NewV4()
+	commentId := uuid.NewV4()
+	userId := uuid.NewV4()
+
+	tests := []struct {
+		name       string
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo)
+		wantErr    bool
+	}{
+		{
+			name: "Test_SetCommentResolved_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: userId}}, nil)
+				baseRepo.EXPECT().GetComment(ctx, commentId).Return(models.Comment{Id: commentId, NoteId: noteId, AuthorId: uuid.NewV4()}, nil)
+				baseRepo.EXPECT().SetCommentResolved(ctx, commentId, true).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test_SetCommentResolved_Reply",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: userId}}, nil)
+				baseRepo.EXPECT().GetComment(ctx, commentId).Return(models.Comment{Id: commentId, NoteId: noteId, Parent: uuid.NewV4()}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test_SetCommentResolved_Viewer",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: uuid.NewV4(), Collaborators: []uuid.UUID{userId}}}, nil)
+				baseRepo.EXPECT().GetCollaboratorRole(ctx, noteId, userId).Return(models.RoleViewer, nil)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, config.ElasticConfig{}, config.ConstraintsConfig{}, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo)
+
+			got, err := uc.SetCommentResolved(context.Background(), noteId, commentId, userId, true)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NoteUsecase.SetCommentResolved error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				assert.True(t, got.Resolved)
+			}
+		})
+	}
+}
+
+func TestNoteUsecase_AddReminder(t *testing.T) {
+	noteId := uuid.NewV4()
+	userId := uuid.NewV4()
+	remindAt := time.Now().Add(time.Hour).UTC()
+
+	tests := []struct {
+		name       string
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo)
+		wantErr    bool
+	}{
+		{
+			name: "Test_AddReminder_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: uuid.NewV4(), Collaborators: []uuid.UUID{userId}}}, nil)
+				baseRepo.EXPECT().GetCollaboratorRole(ctx, noteId, userId).Return(models.RoleViewer, nil)
+				baseRepo.EXPECT().CreateReminder(ctx, gomock.Any()).Return("title", nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test_AddReminder_NotCollaborator",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: uuid.NewV4()}}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test_AddReminder_CreateError",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: userId}}, nil)
+				baseRepo.EXPECT().CreateReminder(ctx, gomock.Any()).Return("", errors.New("error"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, config.ElasticConfig{}, config.ConstraintsConfig{}, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo)
+
+			got, err := uc.AddReminder(context.Background(), noteId, userId, remindAt, models.RepeatNone)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NoteUsecase.AddReminder error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				assert.Equal(t, "title", got.NoteTitle)
+				assert.Equal(t, remindAt, got.RemindAt)
+			}
+		})
+	}
+}
+
+func TestNoteUsecase_AcknowledgeReminder(t *testing.T) {
+	reminderId := uuid.NewV4()
+	userId := uuid.NewV4()
+	remindAt := time.Now().Add(-time.Hour).UTC()
+
+	tests := []struct {
+		name       string
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo)
+		wantErr    bool
+	}{
+		{
+			name: "Test_AcknowledgeReminder_Once",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().GetReminder(ctx, reminderId).Return(models.Reminder{Id: reminderId, UserId: userId, RemindAt: remindAt, Fired: true}, nil)
+				baseRepo.EXPECT().DeleteReminder(ctx, reminderId).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test_AcknowledgeReminder_Repeat",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().GetReminder(ctx, reminderId).Return(models.Reminder{Id: reminderId, UserId: userId, RemindAt: remindAt, Repeat: models.RepeatDaily, Fired: true}, nil)
+				baseRepo.EXPECT().RescheduleReminder(ctx, reminderId, remindAt.AddDate(0, 0, 1)).Return(nil)
 			},
-			wantErr: true,
-			want:    models.Note{},
+			wantErr: false,
 		},
 		{
-			name: "Test_SetPrivate_NotOwner",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
-				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
-					Note: models.Note{
-						OwnerId: uuid.NewV4(),
-						Id:      args.noteId,
-					},
-				}, nil).Times(1)
+			name: "Test_AcknowledgeReminder_NotFired",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().GetReminder(ctx, reminderId).Return(models.Reminder{Id: reminderId, UserId: userId, RemindAt: remindAt}, nil)
 			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-				noteId: noteId,
+			wantErr: true,
+		},
+		{
+			name: "Test_AcknowledgeReminder_OtherUser",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().GetReminder(ctx, reminderId).Return(models.Reminder{Id: reminderId, UserId: uuid.NewV4(), Fired: true}, nil)
 			},
 			wantErr: true,
-			want:    models.Note{},
 		},
 	}
 	for _, tt := range tests {
@@ -2143,118 +5933,121 @@ func TestNoteUsecase_SetPrivate(t *testing.T) {
 			defer ctl.Finish()
 			repo := mock_note.NewMockNoteBaseRepo(ctl)
 			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
-			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+			uc := CreateNoteUsecase(repo, searchRepo, config.ElasticConfig{}, config.ConstraintsConfig{}, &sync.WaitGroup{})
 
-			tt.repoMocker(context.Background(), repo, searchRepo, tt.args)
+			tt.repoMocker(context.Background(), repo)
 
-			got, err := uc.SetPrivate(context.Background(), tt.args.noteId, tt.args.userId)
+			err := uc.AcknowledgeReminder(context.Background(), reminderId, userId)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("NoteUsecase.SetPrivate error = %v, wantErr %v", err, tt.wantErr)
-				return
+				t.Errorf("NoteUsecase.AcknowledgeReminder error = %v, wantErr %v", err, tt.wantErr)
 			}
-			assert.Equal(t, tt.want, got)
 		})
 	}
 }
 
-func TestNoteUsecase_GetAttachList(t *testing.T) {
-	elasticConfig := config.ElasticConfig{}
-
-	constraintsConfig := config.ConstraintsConfig{}
-
+func TestNoteUsecase_ArchiveNote(t *testing.T) {
 	noteId := uuid.NewV4()
 	userId := uuid.NewV4()
 
-	type args struct {
-		ctx    context.Context
-		userId uuid.UUID
-		noteId uuid.UUID
-	}
 	tests := []struct {
 		name       string
-		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args)
-		args       args
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo)
 		wantErr    bool
-		want       []string
 	}{
 		{
-			name: "Test_GetAttachList_Success",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
-
-				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
-					Note: models.Note{
-						OwnerId: args.userId,
-						Id:      args.noteId,
-						Public:  true,
-					},
-				}, nil).Times(1)
-				baseRepo.EXPECT().GetAttachList(ctx, args.noteId).Return([]string{"1", "2"}, nil)
+			name: "Test_ArchiveNote_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: userId}}, nil)
+				baseRepo.EXPECT().ArchiveNote(ctx, noteId, userId, gomock.Any()).Return(nil)
+				searchRepo.EXPECT().ArchiveNote(ctx, noteId, userId).Return(nil)
 			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-				noteId: noteId,
+			wantErr: false,
+		},
+		{
+			name: "Test_ArchiveNote_AlreadyArchived",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: userId, Archived: true}}, nil)
 			},
 			wantErr: false,
-			want:    []string{"1", "2"},
 		},
 		{
-			name: "Test_GetAttachList_FailOnGet",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
-				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
-					Note: models.Note{
-						OwnerId:  args.userId,
-						Id:       args.noteId,
-						Favorite: true,
-					},
-				}, nil).Times(1)
-				baseRepo.EXPECT().GetAttachList(ctx, args.noteId).Return([]string{}, errors.New("err"))
-
+			name: "Test_ArchiveNote_Collaborator",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: uuid.NewV4(), Collaborators: []uuid.UUID{userId}}}, nil)
+				baseRepo.EXPECT().GetCollaboratorRole(ctx, noteId, userId).Return(models.RoleViewer, nil)
+				baseRepo.EXPECT().ArchiveNote(ctx, noteId, userId, gomock.Any()).Return(nil)
+				searchRepo.EXPECT().ArchiveNote(ctx, noteId, userId).Return(nil)
 			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-				noteId: noteId,
+			wantErr: false,
+		},
+		{
+			name: "Test_ArchiveNote_NotCollaborator",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: uuid.NewV4()}}, nil)
 			},
 			wantErr: true,
-			want:    []string{},
 		},
-
 		{
-			name: "Test_GetAttachList_FailOnRead",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
-				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
-					Note: models.Note{
-						OwnerId: args.userId,
-						Id:      args.noteId,
-					},
-				}, errors.New("err")).Times(1)
-			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-				noteId: noteId,
+			name: "Test_ArchiveNote_NotFound",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{}, errors.New("not found"))
 			},
 			wantErr: true,
-			want:    []string{},
 		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, config.ElasticConfig{}, config.ConstraintsConfig{}, &sync.WaitGroup{})
+
+			tt.repoMocker(context.Background(), repo, searchRepo)
+
+			got, err := uc.ArchiveNote(context.Background(), noteId, userId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NoteUsecase.ArchiveNote error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				assert.True(t, got.Archived)
+			}
+		})
+	}
+}
+
+func TestNoteUsecase_UnarchiveNote(t *testing.T) {
+	noteId := uuid.NewV4()
+	userId := uuid.NewV4()
+
+	tests := []struct {
+		name       string
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo)
+		wantErr    bool
+	}{
 		{
-			name: "Test_GetAttachList_NotOwner",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
-				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{
-					Note: models.Note{
-						OwnerId: uuid.NewV4(),
-						Id:      args.noteId,
-					},
-				}, nil).Times(1)
+			name: "Test_UnarchiveNote_Success",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: userId, Archived: true}}, nil)
+				baseRepo.EXPECT().UnarchiveNote(ctx, noteId, userId).Return(nil)
+				searchRepo.EXPECT().UnarchiveNote(ctx, noteId, userId).Return(nil)
 			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-				noteId: noteId,
+			wantErr: false,
+		},
+		{
+			name: "Test_UnarchiveNote_NotArchived",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: userId}}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test_UnarchiveNote_Fail",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: userId, Archived: true}}, nil)
+				baseRepo.EXPECT().UnarchiveNote(ctx, noteId, userId).Return(errors.New("error"))
 			},
 			wantErr: true,
-			want:    []string{},
 		},
 	}
 	for _, tt := range tests {
@@ -2263,64 +6056,80 @@ func TestNoteUsecase_GetAttachList(t *testing.T) {
 			defer ctl.Finish()
 			repo := mock_note.NewMockNoteBaseRepo(ctl)
 			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
-			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+			uc := CreateNoteUsecase(repo, searchRepo, config.ElasticConfig{}, config.ConstraintsConfig{}, &sync.WaitGroup{})
 
-			tt.repoMocker(context.Background(), repo, searchRepo, tt.args)
+			tt.repoMocker(context.Background(), repo, searchRepo)
 
-			got, err := uc.GetAttachList(context.Background(), tt.args.noteId, tt.args.userId)
+			got, err := uc.UnarchiveNote(context.Background(), noteId, userId)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("NoteUsecase.GetAttachList error = %v, wantErr %v", err, tt.wantErr)
-				return
+				t.Errorf("NoteUsecase.UnarchiveNote error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				assert.False(t, got.Archived)
 			}
-			assert.Equal(t, tt.want, got)
 		})
 	}
 }
 
-func TestNoteUsecase_RememberTag(t *testing.T) {
-	elasticConfig := config.ElasticConfig{}
-
-	constraintsConfig := config.ConstraintsConfig{}
-
+func TestNoteUsecase_ReorderNotes(t *testing.T) {
 	userId := uuid.NewV4()
+	parentId := uuid.NewV4()
+	first := uuid.NewV4()
+	second := uuid.NewV4()
 
-	type args struct {
-		ctx    context.Context
-		userId uuid.UUID
-
-		tagName string
-	}
 	tests := []struct {
 		name       string
-		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args)
-		args       args
+		parentId   uuid.UUID
+		notes      []uuid.UUID
+		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo)
 		wantErr    bool
 	}{
 		{
-			name: "Test_RememberTag_Success",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
-				baseRepo.EXPECT().RememberTag(ctx, args.tagName, args.userId).Return(nil).Times(1)
-
+			name:     "Test_ReorderNotes_Root",
+			parentId: uuid.UUID{},
+			notes:    []uuid.UUID{second, first},
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, second, userId).Return(models.NoteResponse{Note: models.Note{Id: second, OwnerId: userId}}, nil)
+				baseRepo.EXPECT().ReadNote(ctx, first, userId).Return(models.NoteResponse{Note: models.Note{Id: first, OwnerId: userId}}, nil)
+				baseRepo.EXPECT().SetNotePositions(ctx, userId, []uuid.UUID{second, first}).Return(nil)
 			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-
-				tagName: "tag1",
+			wantErr: false,
+		},
+		{
+			name:     "Test_ReorderNotes_RootWithSubnote",
+			parentId: uuid.UUID{},
+			notes:    []uuid.UUID{first},
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, first, userId).Return(models.NoteResponse{Note: models.Note{Id: first, OwnerId: userId, Parent: parentId}}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:     "Test_ReorderNotes_Children",
+			parentId: parentId,
+			notes:    []uuid.UUID{second, first},
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, parentId, userId).Return(models.NoteResponse{Note: models.Note{Id: parentId, OwnerId: uuid.NewV4(), Collaborators: []uuid.UUID{userId}, Children: []uuid.UUID{first, second}}}, nil)
+				baseRepo.EXPECT().GetCollaboratorRole(ctx, parentId, userId).Return(models.RoleViewer, nil)
+				baseRepo.EXPECT().SetNotePositions(ctx, userId, []uuid.UUID{second, first}).Return(nil)
 			},
 			wantErr: false,
 		},
 		{
-			name: "Test_RememberTag_FailOnAdd",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
-				baseRepo.EXPECT().RememberTag(ctx, args.tagName, args.userId).Return(errors.New("error")).Times(1)
-
+			name:     "Test_ReorderNotes_NotChild",
+			parentId: parentId,
+			notes:    []uuid.UUID{second},
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, parentId, userId).Return(models.NoteResponse{Note: models.Note{Id: parentId, OwnerId: userId, Children: []uuid.UUID{first}}}, nil)
 			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-
-				tagName: "tag1",
+			wantErr: true,
+		},
+		{
+			name:     "Test_ReorderNotes_NotCollaborator",
+			parentId: parentId,
+			notes:    []uuid.UUID{first},
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, parentId, userId).Return(models.NoteResponse{Note: models.Note{Id: parentId, OwnerId: uuid.NewV4(), Children: []uuid.UUID{first}}}, nil)
 			},
 			wantErr: true,
 		},
@@ -2331,79 +6140,96 @@ func TestNoteUsecase_RememberTag(t *testing.T) {
 			defer ctl.Finish()
 			repo := mock_note.NewMockNoteBaseRepo(ctl)
 			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
-			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+			uc := CreateNoteUsecase(repo, searchRepo, config.ElasticConfig{}, config.ConstraintsConfig{}, &sync.WaitGroup{})
 
-			tt.repoMocker(context.Background(), repo, searchRepo, tt.args)
+			tt.repoMocker(context.Background(), repo)
 
-			err := uc.RememberTag(tt.args.ctx, tt.args.tagName, tt.args.userId)
+			err := uc.ReorderNotes(context.Background(), userId, tt.parentId, tt.notes)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("NoteUsecase.RememberTag error = %v, wantErr %v", err, tt.wantErr)
-				return
+				t.Errorf("NoteUsecase.ReorderNotes error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 	}
 }
 
-func TestNoteUsecase_ForgetTag(t *testing.T) {
-	elasticConfig := config.ElasticConfig{}
-
-	constraintsConfig := config.ConstraintsConfig{}
-
+func TestNoteUsecase_GetAllNotesManualSearch(t *testing.T) {
 	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	positions := map[uuid.UUID]int{noteId: 1}
+
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	repo := mock_note.NewMockNoteBaseRepo(ctl)
+	searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+	uc := CreateNoteUsecase(repo, searchRepo, config.ElasticConfig{ElasticSearchValueMinLength: 2}, config.ConstraintsConfig{}, &sync.WaitGroup{})
+
+	ctx := context.Background()
+	repo.EXPECT().GetNotePositions(ctx, userId).Return(positions, nil)
+	searchRepo.EXPECT().SearchNotes(ctx, userId, int64(10), int64(0), "search", models.TagFilter{}, models.ArchiveFilterActive, models.SortManual, positions, nil).Return([]models.NoteResponse{{Note: models.Note{Id: noteId, OwnerId: userId}}}, nil, nil)
+	repo.EXPECT().GetOwnerInfo(ctx, userId).Return(models.OwnerInfo{Username: "user"}, nil)
+
+	got, _, err := uc.GetAllNotes(ctx, userId, 10, 0, "search", models.TagFilter{}, models.ArchiveFilterActive, models.SortManual, "")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(got))
+	assert.Equal(t, "user", got[0].Username)
+}
 
-	type args struct {
-		ctx    context.Context
-		userId uuid.UUID
+func TestNoteUsecase_GetAllNotesCursor(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+	cursor := models.NoteCursor{Sort: models.SortUpdated, Updated: time.Now().UTC(), Id: uuid.NewV4()}
+	last := &models.NoteCursor{Sort: models.SortUpdated, Id: noteId}
 
-		tagName string
-	}
 	tests := []struct {
 		name       string
-		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args)
-		args       args
+		count      int64
+		search     string
+		cursor     string
+		repoMocker func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo)
+		wantCursor string
 		wantErr    bool
 	}{
 		{
-			name: "Test_ForgetTag_Success",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
-				baseRepo.EXPECT().ForgetTag(ctx, args.tagName, args.userId).Return(nil).Times(1)
-				baseRepo.EXPECT().DeleteTagFromAllNotes(ctx, args.tagName, args.userId)
-				searchRepo.EXPECT().DeleteTagFromAllNotes(ctx, args.tagName, args.userId)
-			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-
-				tagName: "tag1",
+			name:   "Test_GetAllNotesCursor_FullPage",
+			count:  1,
+			cursor: cursor.Encode(),
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadAllNotes(ctx, userId, int64(1), int64(0), models.TagFilter{}, models.ArchiveFilterActive, models.SortUpdated, &cursor).Return([]models.NoteResponse{{Note: models.Note{Id: noteId, OwnerId: userId}}}, last, nil)
+				baseRepo.EXPECT().GetOwnerInfo(ctx, userId).Return(models.OwnerInfo{}, nil)
 			},
-			wantErr: false,
+			wantCursor: last.Encode(),
 		},
 		{
-			name: "Test_ForgetTag_FailOnForget",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
-				baseRepo.EXPECT().ForgetTag(ctx, args.tagName, args.userId).Return(errors.New("error")).Times(1)
-
+			name:  "Test_GetAllNotesCursor_LastPage",
+			count: 2,
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadAllNotes(ctx, userId, int64(2), int64(0), models.TagFilter{}, models.ArchiveFilterActive, models.SortUpdated, nil).Return([]models.NoteResponse{{Note: models.Note{Id: noteId, OwnerId: userId}}}, last, nil)
+				baseRepo.EXPECT().GetOwnerInfo(ctx, userId).Return(models.OwnerInfo{}, nil)
 			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-
-				tagName: "tag1",
+			wantCursor: "",
+		},
+		{
+			name:   "Test_GetAllNotesCursor_Broken",
+			count:  1,
+			cursor: "broken",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
 			},
 			wantErr: true,
 		},
 		{
-			name: "Test_ForgetTag_FailOnForget",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
-				baseRepo.EXPECT().ForgetTag(ctx, args.tagName, args.userId).Return(nil).Times(1)
-				baseRepo.EXPECT().DeleteTagFromAllNotes(ctx, args.tagName, args.userId).Return(errors.New("err"))
-
+			name:   "Test_GetAllNotesCursor_OtherSort",
+			count:  1,
+			cursor: models.NoteCursor{Sort: models.SortTitle}.Encode(),
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
 			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-
-				tagName: "tag1",
+			wantErr: true,
+		},
+		{
+			name:   "Test_GetAllNotesCursor_SearchWithListCursor",
+			count:  1,
+			search: "search",
+			cursor: cursor.Encode(),
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
 			},
 			wantErr: true,
 		},
@@ -2414,79 +6240,91 @@ func TestNoteUsecase_ForgetTag(t *testing.T) {
 			defer ctl.Finish()
 			repo := mock_note.NewMockNoteBaseRepo(ctl)
 			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
-			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+			uc := CreateNoteUsecase(repo, searchRepo, config.ElasticConfig{ElasticSearchValueMinLength: 2}, config.ConstraintsConfig{}, &sync.WaitGroup{})
 
-			tt.repoMocker(context.Background(), repo, searchRepo, tt.args)
+			tt.repoMocker(context.Background(), repo, searchRepo)
 
-			err := uc.ForgetTag(tt.args.ctx, tt.args.tagName, tt.args.userId)
+			_, nextCursor, err := uc.GetAllNotes(context.Background(), userId, tt.count, 0, tt.search, models.TagFilter{}, models.ArchiveFilterActive, models.SortUpdated, tt.cursor)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("NoteUsecase.RememberTag error = %v, wantErr %v", err, tt.wantErr)
-				return
+				t.Errorf("NoteUsecase.GetAllNotes error = %v, wantErr %v", err, tt.wantErr)
 			}
+			assert.Equal(t, tt.wantCursor, nextCursor)
 		})
 	}
 }
 
-func TestNoteUsecase_UpdateTag(t *testing.T) {
-	elasticConfig := config.ElasticConfig{}
-
-	constraintsConfig := config.ConstraintsConfig{}
-
+func TestNoteUsecase_SetTagInfo(t *testing.T) {
 	userId := uuid.NewV4()
+	tag := models.Tag{Name: "work", Color: "#ffffff", Emoji: "💼", Description: "job"}
 
-	type args struct {
-		ctx    context.Context
-		userId uuid.UUID
-
-		tagName string
-	}
 	tests := []struct {
 		name       string
-		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args)
-		args       args
+		repoMocker func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo)
 		wantErr    bool
 	}{
 		{
-			name: "Test_UpdateTag_Success",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
-				baseRepo.EXPECT().UpdateTag(ctx, "", args.tagName, args.userId).Return(nil)
-				searchRepo.EXPECT().UpdateTagOnAllNotes(ctx, "", args.tagName, args.userId).Return(nil)
-				baseRepo.EXPECT().UpdateTagOnAllNotes(ctx, "", args.tagName, args.userId).Return(nil)
-			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-
-				tagName: "tag1",
+			name: "TestSuccess",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().SetTagInfo(ctx, tag, userId).Return(nil)
 			},
-			wantErr: false,
 		},
 		{
-			name: "Test_UpdatetTag_FailOnUpdate",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
-				baseRepo.EXPECT().UpdateTag(ctx, "", args.tagName, args.userId).Return(errors.New("error")).Times(1)
-
+			name: "TestFail",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().SetTagInfo(ctx, tag, userId).Return(errors.New("error"))
 			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			repo := mock_note.NewMockNoteBaseRepo(ctl)
+			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
+			uc := CreateNoteUsecase(repo, searchRepo, config.ElasticConfig{ElasticSearchValueMinLength: 2}, config.ConstraintsConfig{}, &sync.WaitGroup{})
 
-				tagName: "tag1",
+			ctx := context.Background()
+			tt.repoMocker(ctx, repo)
+
+			err := uc.SetTagInfo(ctx, tag, userId)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestNoteUsecase_MergeTags(t *testing.T) {
+	userId := uuid.NewV4()
+	noteId := uuid.NewV4()
+
+	tests := []struct {
+		name       string
+		tags       []string
+		repoMocker func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo)
+		wantErr    bool
+	}{
+		{
+			name: "TestSuccess",
+			tags: []string{"first", "target", "second"},
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().MergeTags(ctx, []string{"first", "second"}, "target", userId).Return([]uuid.UUID{noteId}, nil)
+				for _, tag := range []string{"first", "second"} {
+					searchRepo.EXPECT().UpdateTagOnAllNotes(ctx, tag, "target", []uuid.UUID{noteId}).Return(nil)
+				}
+				baseRepo.EXPECT().ReconcileTags(ctx, []uuid.UUID{noteId}).Return(nil)
 			},
-			wantErr: true,
 		},
 		{
-			name: "Test_UpdatetTag_FailOnUpdateAll",
-			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
-				baseRepo.EXPECT().UpdateTag(ctx, "", args.tagName, args.userId).Return(nil).Times(1)
-				baseRepo.EXPECT().UpdateTagOnAllNotes(ctx, "", args.tagName, args.userId).Return(errors.New("err"))
-
+			name: "TestSuccess_OnlyTarget",
+			tags: []string{"target"},
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
 			},
-			args: args{
-				ctx:    context.Background(),
-				userId: userId,
-
-				tagName: "tag1",
+		},
+		{
+			name: "TestFail",
+			tags: []string{"first", "second", "first"},
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().MergeTags(ctx, []string{"first", "second"}, "target", userId).Return([]uuid.UUID{}, errors.New("error"))
 			},
 			wantErr: true,
 		},
@@ -2497,96 +6335,114 @@ func TestNoteUsecase_UpdateTag(t *testing.T) {
 			defer ctl.Finish()
 			repo := mock_note.NewMockNoteBaseRepo(ctl)
 			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
-			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+			uc := CreateNoteUsecase(repo, searchRepo, config.ElasticConfig{ElasticSearchValueMinLength: 2}, config.ConstraintsConfig{}, &sync.WaitGroup{})
 
-			tt.repoMocker(context.Background(), repo, searchRepo, tt.args)
+			ctx := context.Background()
+			tt.repoMocker(ctx, repo, searchRepo)
 
-			err := uc.UpdateTag(tt.args.ctx, "", tt.args.tagName, tt.args.userId)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("NoteUsecase.UpdateTag error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
+			err := uc.MergeTags(ctx, tt.tags, "target", userId)
+			assert.Equal(t, tt.wantErr, err != nil)
 		})
 	}
 }
 
-func TestNoteUsecase_GetSharedAttachList(t *testing.T) {
-	elasticConfig := config.ElasticConfig{}
+func TestNoteUsecase_BulkUpdate(t *testing.T) {
+	userId := uuid.NewV4()
+	ownNoteId := uuid.NewV4()
+	sharedNoteId := uuid.NewV4()
+	missingNoteId := uuid.NewV4()
 
-	constraintsConfig := config.ConstraintsConfig{}
+	ownNote := models.NoteResponse{Note: models.Note{Id: ownNoteId, OwnerId: userId, Tags: []string{"old"}}}
+	sharedNote := models.NoteResponse{Note: models.Note{Id: sharedNoteId, OwnerId: uuid.NewV4(), Collaborators: []uuid.UUID{userId}}}
 
-	noteId := uuid.NewV4()
-	type args struct {
-		noteID uuid.UUID
-	}
 	tests := []struct {
 		name       string
-		args       args
-		repoMocker func(baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args)
-		want       []string
-		wantErr    bool
+		notes      []uuid.UUID
+		operations []models.BulkOperation
+		repoMocker func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo)
+		want       []models.BulkResult
 	}{
 		{
-			name: "Test_GetSharedAttachList_Success",
-			args: args{
-				noteID: noteId,
+			name:  "TestSuccess",
+			notes: []uuid.UUID{ownNoteId, sharedNoteId},
+			operations: []models.BulkOperation{
+				{Type: models.BulkAddFav},
+				{Type: models.BulkDeleteTag, Value: "old"},
 			},
-			repoMocker: func(baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
-				baseRepo.EXPECT().ReadPublicNote(gomock.Any(), args.noteID).Return(models.NoteResponse{
-					Note: models.Note{
-						Public: true,
-						Id:     args.noteID,
-					},
-				}, nil)
-				baseRepo.EXPECT().GetAttachList(gomock.Any(), args.noteID).Return([]string{"1", "2"}, nil)
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, ownNoteId, userId).Return(ownNote, nil)
+				baseRepo.EXPECT().ReadNote(ctx, sharedNoteId, userId).Return(sharedNote, nil)
+				baseRepo.EXPECT().AddFavs(ctx, []uuid.UUID{ownNoteId, sharedNoteId}, userId).Return(nil)
+				searchRepo.EXPECT().ChangeFlagOnNotes(ctx, []uuid.UUID{ownNoteId, sharedNoteId}, true).Return(nil)
+				baseRepo.EXPECT().GetCollaboratorRole(ctx, sharedNoteId, userId).Return(models.RoleViewer, nil)
+				baseRepo.EXPECT().DeleteTagFromNotes(ctx, "old", []uuid.UUID{ownNoteId}).Return(nil)
+				searchRepo.EXPECT().DeleteTagFromAllNotes(ctx, "old", []uuid.UUID{ownNoteId}).Return(nil)
+			},
+			want: []models.BulkResult{
+				{NoteID: ownNoteId, Success: true},
+				{NoteID: sharedNoteId, Error: note.ErrNotEnoughRights},
 			},
-			want:    []string{"1", "2"},
-			wantErr: false,
 		},
 		{
-			name: "Test_GetSharedAttachList_NotPublic",
-			args: args{
-				noteID: noteId,
+			name:       "TestTagByEditor",
+			notes:      []uuid.UUID{sharedNoteId},
+			operations: []models.BulkOperation{{Type: models.BulkAddTag, Value: "new"}},
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, sharedNoteId, userId).Return(sharedNote, nil)
+				baseRepo.EXPECT().GetCollaboratorRole(ctx, sharedNoteId, userId).Return(models.RoleEditor, nil)
+				baseRepo.EXPECT().AddTagToNotes(ctx, "new", []uuid.UUID{sharedNoteId}).Return(nil)
+				searchRepo.EXPECT().AddTagToNotes(ctx, "new", []uuid.UUID{sharedNoteId}).Return(nil)
+				baseRepo.EXPECT().RememberTag(ctx, "new", userId).Return(nil)
+				baseRepo.EXPECT().ReconcileTags(ctx, []uuid.UUID{sharedNoteId}).Return(nil)
 			},
-			repoMocker: func(baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
-				baseRepo.EXPECT().ReadPublicNote(gomock.Any(), args.noteID).Return(models.NoteResponse{
-					Note: models.Note{
-						Public: false,
-					},
-				}, nil)
-
+			want: []models.BulkResult{
+				{NoteID: sharedNoteId, Success: true},
 			},
-			want:    []string{},
-			wantErr: true,
 		},
 		{
-			name: "Test_GetSharedAttachList_FailRead",
-			args: args{
-				noteID: noteId,
+			name:       "TestAddTag",
+			notes:      []uuid.UUID{ownNoteId, missingNoteId},
+			operations: []models.BulkOperation{{Type: models.BulkAddTag, Value: "new"}, {Type: models.BulkAddTag, Value: "newest"}},
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, ownNoteId, userId).Return(ownNote, nil)
+				baseRepo.EXPECT().ReadNote(ctx, missingNoteId, userId).Return(models.NoteResponse{}, errors.New("error"))
+				baseRepo.EXPECT().AddTagToNotes(ctx, "new", []uuid.UUID{ownNoteId}).Return(nil)
+				searchRepo.EXPECT().AddTagToNotes(ctx, "new", []uuid.UUID{ownNoteId}).Return(nil)
+				baseRepo.EXPECT().RememberTag(ctx, "new", userId).Return(nil)
 			},
-			repoMocker: func(baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
-				baseRepo.EXPECT().ReadPublicNote(gomock.Any(), args.noteID).Return(models.NoteResponse{}, errors.New("err"))
-
+			want: []models.BulkResult{
+				{NoteID: ownNoteId, Error: note.ErrTooManyTags},
+				{NoteID: missingNoteId, Error: "not found"},
 			},
-			want:    []string{},
-			wantErr: true,
 		},
 		{
-			name: "Test_GetSharedAttachList_FailGetList",
-			args: args{
-				noteID: noteId,
+			name:       "TestFailOnWrite",
+			notes:      []uuid.UUID{ownNoteId, sharedNoteId},
+			operations: []models.BulkOperation{{Type: models.BulkAddFav}, {Type: models.BulkDelete}},
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, ownNoteId, userId).Return(ownNote, nil)
+				baseRepo.EXPECT().ReadNote(ctx, sharedNoteId, userId).Return(sharedNote, nil)
+				baseRepo.EXPECT().AddFavs(ctx, []uuid.UUID{ownNoteId, sharedNoteId}, userId).Return(errors.New("error"))
 			},
-			repoMocker: func(baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo, args args) {
-				baseRepo.EXPECT().ReadPublicNote(gomock.Any(), args.noteID).Return(models.NoteResponse{
-					Note: models.Note{
-						Public: true,
-					},
-				}, nil)
-				baseRepo.EXPECT().GetAttachList(gomock.Any(), args.noteID).Return([]string{}, errors.New("err"))
-
+			want: []models.BulkResult{
+				{NoteID: ownNoteId, Error: "error"},
+				{NoteID: sharedNoteId, Error: "error"},
+			},
+		},
+		{
+			name:       "TestDelete",
+			notes:      []uuid.UUID{ownNoteId, sharedNoteId},
+			operations: []models.BulkOperation{{Type: models.BulkDelete}},
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, ownNoteId, userId).Return(ownNote, nil).Times(2)
+				baseRepo.EXPECT().ReadNote(ctx, sharedNoteId, userId).Return(sharedNote, nil).Times(2)
+				baseRepo.EXPECT().TrashNote(ctx, ownNoteId, userId, gomock.Any()).Return([]uuid.UUID{ownNoteId}, nil)
+				searchRepo.EXPECT().DeleteNote(ctx, ownNoteId).Return(nil)
+			},
+			want: []models.BulkResult{
+				{NoteID: ownNoteId, Success: true},
+				{NoteID: sharedNoteId, Error: "not found"},
 			},
-			want:    []string{},
-			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
@@ -2595,125 +6451,58 @@ func TestNoteUsecase_GetSharedAttachList(t *testing.T) {
 			defer ctl.Finish()
 			repo := mock_note.NewMockNoteBaseRepo(ctl)
 			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
-			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+			uc := CreateNoteUsecase(repo, searchRepo, config.ElasticConfig{ElasticSearchValueMinLength: 2}, config.ConstraintsConfig{MaxTags: 2}, &sync.WaitGroup{})
 
-			tt.repoMocker(repo, searchRepo, tt.args)
-			got, err := uc.GetSharedAttachList(context.Background(), tt.args.noteID)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("NoteUsecase.GetSharedAttachList() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
+			ctx := context.Background()
+			tt.repoMocker(ctx, repo, searchRepo)
+
+			got, err := uc.BulkUpdate(ctx, tt.notes, tt.operations, userId)
+			assert.NoError(t, err)
 			assert.Equal(t, tt.want, got)
 		})
 	}
 }
 
-func TestNoteUsecase_changeModeRecursive(t *testing.T) {
-	elasticConfig := config.ElasticConfig{
-		ElasticIndexName:            "notes",
-		ElasticSearchValueMinLength: 2,
-	}
-
-	constraintsConfig := config.ConstraintsConfig{
-		MaxDepth:         3,
-		MaxCollaborators: 10,
-		MaxTags:          4,
-		MaxSubnotes:      10,
-	}
-
+func TestNoteUsecase_GetBacklinks(t *testing.T) {
 	noteId := uuid.NewV4()
-	guestId := uuid.NewV4()
+	userId := uuid.NewV4()
+	backlinks := []models.NoteLink{{Id: uuid.NewV4(), Title: "source"}}
 
 	tests := []struct {
 		name       string
-		repoMocker func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo)
-		isPublic   bool
+		repoMocker func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo)
+		want       []models.NoteLink
 		wantErr    bool
 	}{
 		{
-			name:    "Test_changeModeRecursive_ReadError",
-			wantErr: true,
-			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
-				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, gomock.Any()).Return(models.NoteResponse{}, errors.New("error"))
+			name: "TestSuccess",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: userId}}, nil)
+				baseRepo.EXPECT().GetBacklinks(ctx, noteId, userId).Return(backlinks, nil)
 			},
-			isPublic: true,
+			want: backlinks,
 		},
 		{
-			name:    "Test_changeModeRecursive_Public_Error",
-			wantErr: true,
-			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
-				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, gomock.Any()).Return(models.NoteResponse{
-					Note: models.Note{
-						Id: noteId,
-					},
-				}, nil)
-				baseRepo.EXPECT().SetPublic(gomock.Any(), noteId).Return(errors.New("error"))
+			name: "TestNotCollaborator",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: uuid.NewV4()}}, nil)
 			},
-			isPublic: true,
-		},
-		{
-			name:    "Test_changeModeRecursive_Private_Error",
 			wantErr: true,
-			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
-				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, gomock.Any()).Return(models.NoteResponse{
-					Note: models.Note{
-						Id: noteId,
-					},
-				}, nil)
-				baseRepo.EXPECT().SetPrivate(gomock.Any(), noteId).Return(errors.New("error"))
-			},
-			isPublic: false,
-		},
-		{
-			name:    "Test_changeModeRecursive_NoChildren",
-			wantErr: false,
-			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
-				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, gomock.Any()).Return(models.NoteResponse{
-					Note: models.Note{
-						Id: noteId,
-					},
-				}, nil)
-				baseRepo.EXPECT().SetPublic(gomock.Any(), noteId).Return(nil)
-			},
-			isPublic: true,
 		},
 		{
-			name:    "Test_changeModeRecursive_Public_Success",
-			wantErr: false,
-			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
-				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, gomock.Any()).Return(models.NoteResponse{
-					Note: models.Note{
-						Id:       noteId,
-						Children: []uuid.UUID{noteId},
-					},
-				}, nil)
-				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, gomock.Any()).Return(models.NoteResponse{
-					Note: models.Note{
-						Id: noteId,
-					},
-				}, nil)
-				baseRepo.EXPECT().SetPublic(gomock.Any(), noteId).Return(nil).Times(2)
+			name: "TestFailOnRead",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{}, errors.New("error"))
 			},
-			isPublic: true,
+			wantErr: true,
 		},
 		{
-			name:    "Test_changeModeRecursive_Private_Success",
-			wantErr: false,
-			repoMocker: func(context context.Context, baseRepo *mock_note.MockNoteBaseRepo, searchRepo *mock_note.MockNoteSearchRepo) {
-				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, gomock.Any()).Return(models.NoteResponse{
-					Note: models.Note{
-						Id:       noteId,
-						Children: []uuid.UUID{noteId},
-					},
-				}, nil)
-				baseRepo.EXPECT().ReadNote(gomock.Any(), noteId, gomock.Any()).Return(models.NoteResponse{
-					Note: models.Note{
-						Id: noteId,
-					},
-				}, nil)
-				baseRepo.EXPECT().SetPrivate(gomock.Any(), noteId).Return(nil).Times(2)
+			name: "TestFailOnBacklinks",
+			repoMocker: func(ctx context.Context, baseRepo *mock_note.MockNoteBaseRepo) {
+				baseRepo.EXPECT().ReadNote(ctx, noteId, userId).Return(models.NoteResponse{Note: models.Note{Id: noteId, OwnerId: userId}}, nil)
+				baseRepo.EXPECT().GetBacklinks(ctx, noteId, userId).Return(nil, errors.New("error"))
 			},
-			isPublic: false,
+			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
@@ -2722,16 +6511,14 @@ func TestNoteUsecase_changeModeRecursive(t *testing.T) {
 			defer ctl.Finish()
 			repo := mock_note.NewMockNoteBaseRepo(ctl)
 			searchRepo := mock_note.NewMockNoteSearchRepo(ctl)
-			uc := CreateNoteUsecase(repo, searchRepo, elasticConfig, constraintsConfig, &sync.WaitGroup{})
+			uc := CreateNoteUsecase(repo, searchRepo, config.ElasticConfig{ElasticSearchValueMinLength: 2}, config.ConstraintsConfig{}, &sync.WaitGroup{})
 
-			tt.repoMocker(context.Background(), repo, searchRepo)
-
-			err := uc.changeModeRecursive(context.Background(), noteId, guestId, tt.isPublic)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("NoteUsecase.changeModeRecursive error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
+			ctx := context.Background()
+			tt.repoMocker(ctx, repo)
 
+			got, err := uc.GetBacklinks(ctx, noteId, userId)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, got)
 		})
 	}
 }
@@ -0,0 +1,234 @@
+package collab
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+
+	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/models"
+)
+
+const (
+	ErrUnknownOperation = "unknown operation"
+	ErrOutOfRange       = "operation index out of range"
+	ErrIncorrectBlock   = "incorrect block format"
+	ErrStaleRevision    = "revision is too old"
+	ErrFutureRevision   = "revision is ahead of document"
+)
+
+// maxHistory - сколько последних операций хранится для трансформации запоздавших правок
+const maxHistory = 1000
+
+// Document - документ заметки в сессии совместного редактирования.
+// Операции упорядочивает сервер: пришедшая правка трансформируется против всех операций,
+// примененных после ее BaseRevision, поэтому все клиенты сходятся к одному состоянию
+type Document struct {
+	Title    string
+	Content  []json.RawMessage
+	Revision int64
+
+	rest    map[string]json.RawMessage
+	history []models.EditOperation
+}
+
+func NewDocument(data string) (*Document, error) {
+	doc := &Document{}
+	if err := doc.load(data); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+func (doc *Document) load(data string) error {
+	fields := make(map[string]json.RawMessage)
+	if data != "" {
+		if err := json.Unmarshal([]byte(data), &fields); err != nil {
+			return err
+		}
+	}
+
+	title := ""
+	if raw, ok := fields["title"]; ok {
+		if err := json.Unmarshal(raw, &title); err != nil {
+			return err
+		}
+	}
+
+	content := make([]json.RawMessage, 0)
+	if raw, ok := fields["content"]; ok {
+		if err := json.Unmarshal(raw, &content); err != nil {
+			return err
+		}
+	}
+
+	delete(fields, "title")
+	delete(fields, "content")
+
+	doc.Title = title
+	doc.Content = content
+	doc.rest = fields
+	return nil
+}
+
+// Reset - замена документа целиком (например, после сохранения через REST), запоздавшие правки после этого отклоняются
+func (doc *Document) Reset(data string) error {
+	if err := doc.load(data); err != nil {
+		return err
+	}
+
+	doc.Revision++
+	doc.history = nil
+	return nil
+}
+
+func (doc *Document) Data() (string, error) {
+	fields := make(map[string]interface{}, len(doc.rest)+2)
+	for key, value := range doc.rest {
+		fields[key] = value
+	}
+	fields["title"] = doc.Title
+	fields["content"] = doc.Content
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// Receive - прием операции клиента, созданной поверх BaseRevision; возвращает операцию в том виде, в котором она была применена
+func (doc *Document) Receive(operation models.EditOperation, baseRevision int64) (models.EditOperation, error) {
+	if baseRevision > doc.Revision {
+		return models.EditOperation{}, errors.New(ErrFutureRevision)
+	}
+
+	missed := doc.Revision - baseRevision
+	if missed > int64(len(doc.history)) {
+		return models.EditOperation{}, errors.New(ErrStaleRevision)
+	}
+
+	for _, applied := range doc.history[len(doc.history)-int(missed):] {
+		_, operation = Transform(applied, operation)
+	}
+
+	if err := doc.Apply(operation); err != nil {
+		return models.EditOperation{}, err
+	}
+
+	doc.history = append(doc.history, operation)
+	if len(doc.history) > maxHistory {
+		doc.history = slices.Delete(doc.history, 0, len(doc.history)-maxHistory)
+	}
+	doc.Revision++
+
+	return operation, nil
+}
+
+// Apply - применение уже упорядоченной операции без трансформации
+func (doc *Document) Apply(operation models.EditOperation) error {
+	switch operation.Type {
+	case models.OperationNoop:
+		return nil
+
+	case models.OperationTitle:
+		doc.Title = operation.Title
+		return nil
+
+	case models.OperationInsert:
+		if operation.Index < 0 || operation.Index > len(doc.Content) {
+			return errors.New(ErrOutOfRange)
+		}
+		if !json.Valid(operation.Block) {
+			return errors.New(ErrIncorrectBlock)
+		}
+		doc.Content = slices.Insert(doc.Content, operation.Index, operation.Block)
+		return nil
+
+	case models.OperationUpdate:
+		if operation.Index < 0 || operation.Index >= len(doc.Content) {
+			return errors.New(ErrOutOfRange)
+		}
+		if !json.Valid(operation.Block) {
+			return errors.New(ErrIncorrectBlock)
+		}
+		doc.Content[operation.Index] = operation.Block
+		return nil
+
+	case models.OperationDelete:
+		if operation.Index < 0 || operation.Index >= len(doc.Content) {
+			return errors.New(ErrOutOfRange)
+		}
+		doc.Content = slices.Delete(doc.Content, operation.Index, operation.Index+1)
+		return nil
+
+	default:
+		return errors.New(ErrUnknownOperation)
+	}
+}
+
+func noop() models.EditOperation {
+	return models.EditOperation{Type: models.OperationNoop}
+}
+
+// Transform - взаимная трансформация двух параллельных операций, созданных поверх одного состояния.
+// earlier упорядочена сервером раньше later; результат - earlier, примененная после later, и later, примененная после earlier.
+// При конфликте правок одного блока или заголовка побеждает later, вставки в одну позицию сохраняют порядок сервера
+func Transform(earlier models.EditOperation, later models.EditOperation) (models.EditOperation, models.EditOperation) {
+	if earlier.Type == models.OperationNoop || later.Type == models.OperationNoop {
+		return earlier, later
+	}
+
+	if earlier.Type == models.OperationTitle || later.Type == models.OperationTitle {
+		if earlier.Type == later.Type {
+			return noop(), later
+		}
+		return earlier, later
+	}
+
+	switch {
+	case earlier.Type == models.OperationInsert && later.Type == models.OperationInsert:
+		if earlier.Index <= later.Index {
+			later.Index++
+		} else {
+			earlier.Index++
+		}
+
+	case earlier.Type == models.OperationInsert:
+		if earlier.Index <= later.Index {
+			later.Index++
+		} else if later.Type == models.OperationDelete {
+			earlier.Index--
+		}
+
+	case later.Type == models.OperationInsert:
+		if later.Index <= earlier.Index {
+			earlier.Index++
+		} else if earlier.Type == models.OperationDelete {
+			later.Index--
+		}
+
+	case earlier.Index == later.Index:
+		switch {
+		case earlier.Type == models.OperationDelete && later.Type == models.OperationDelete:
+			return noop(), noop()
+		case earlier.Type == models.OperationDelete:
+			return earlier, noop()
+		default:
+			return noop(), later
+		}
+
+	case earlier.Index < later.Index:
+		if earlier.Type == models.OperationDelete {
+			later.Index--
+		}
+
+	default:
+		if later.Type == models.OperationDelete {
+			earlier.Index--
+		}
+	}
+
+	return earlier, later
+}
@@ -0,0 +1,428 @@
+package collab
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func block(text string) json.RawMessage {
+	return json.RawMessage(`{"pluginName":"textBlock","content":"` + text + `"}`)
+}
+
+func insert(index int, text string) models.EditOperation {
+	return models.EditOperation{Type: models.OperationInsert, Index: index, Block: block(text)}
+}
+
+func update(index int, text string) models.EditOperation {
+	return models.EditOperation{Type: models.OperationUpdate, Index: index, Block: block(text)}
+}
+
+func remove(index int) models.EditOperation {
+	return models.EditOperation{Type: models.OperationDelete, Index: index}
+}
+
+func title(text string) models.EditOperation {
+	return models.EditOperation{Type: models.OperationTitle, Title: text}
+}
+
+func TestTransform(t *testing.T) {
+	tests := []struct {
+		name          string
+		earlier       models.EditOperation
+		later         models.EditOperation
+		wantEarlier   models.EditOperation
+		wantLater     models.EditOperation
+		initialBlocks int
+	}{
+		{
+			name:          "Test_Transform_InsertInsert_SameIndex",
+			earlier:       insert(1, "a"),
+			later:         insert(1, "b"),
+			wantEarlier:   insert(1, "a"),
+			wantLater:     insert(2, "b"),
+			initialBlocks: 2,
+		},
+		{
+			name:          "Test_Transform_InsertDelete_Before",
+			earlier:       insert(0, "a"),
+			later:         remove(1),
+			wantEarlier:   insert(0, "a"),
+			wantLater:     remove(2),
+			initialBlocks: 2,
+		},
+		{
+			name:          "Test_Transform_DeleteInsert_After",
+			earlier:       remove(0),
+			later:         insert(2, "b"),
+			wantEarlier:   remove(0),
+			wantLater:     insert(1, "b"),
+			initialBlocks: 2,
+		},
+		{
+			name:          "Test_Transform_DeleteDelete_Same",
+			earlier:       remove(1),
+			later:         remove(1),
+			wantEarlier:   noop(),
+			wantLater:     noop(),
+			initialBlocks: 2,
+		},
+		{
+			name:          "Test_Transform_DeleteUpdate_Same",
+			earlier:       remove(1),
+			later:         update(1, "b"),
+			wantEarlier:   remove(1),
+			wantLater:     noop(),
+			initialBlocks: 2,
+		},
+		{
+			name:          "Test_Transform_UpdateUpdate_Same",
+			earlier:       update(0, "a"),
+			later:         update(0, "b"),
+			wantEarlier:   noop(),
+			wantLater:     update(0, "b"),
+			initialBlocks: 1,
+		},
+		{
+			name:          "Test_Transform_TitleTitle",
+			earlier:       title("a"),
+			later:         title("b"),
+			wantEarlier:   noop(),
+			wantLater:     title("b"),
+			initialBlocks: 0,
+		},
+		{
+			name:          "Test_Transform_TitleBlock",
+			earlier:       title("a"),
+			later:         remove(0),
+			wantEarlier:   title("a"),
+			wantLater:     remove(0),
+			initialBlocks: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotEarlier, gotLater := Transform(tt.earlier, tt.later)
+			assert.Equal(t, tt.wantEarlier, gotEarlier)
+			assert.Equal(t, tt.wantLater, gotLater)
+
+			// оба порядка применения приводят к одному документу
+			first, second := newDocumentWithBlocks(t, tt.initialBlocks), newDocumentWithBlocks(t, tt.initialBlocks)
+			assert.NoError(t, first.Apply(tt.earlier))
+			assert.NoError(t, first.Apply(gotLater))
+			assert.NoError(t, second.Apply(tt.later))
+			assert.NoError(t, second.Apply(gotEarlier))
+			assert.Equal(t, documentData(t, first), documentData(t, second))
+		})
+	}
+}
+
+func TestDocument_Receive(t *testing.T) {
+	tests := []struct {
+		name         string
+		operations   []models.EditOperation
+		baseRevision int64
+		operation    models.EditOperation
+		want         models.EditOperation
+		wantErr      error
+	}{
+		{
+			name:         "Test_Receive_Concurrent",
+			operations:   []models.EditOperation{insert(0, "a")},
+			baseRevision: 0,
+			operation:    update(0, "b"),
+			want:         update(1, "b"),
+		},
+		{
+			name:         "Test_Receive_UpToDate",
+			operations:   []models.EditOperation{insert(0, "a")},
+			baseRevision: 1,
+			operation:    update(0, "b"),
+			want:         update(0, "b"),
+		},
+		{
+			name:         "Test_Receive_FutureRevision",
+			baseRevision: 1,
+			operation:    update(0, "b"),
+			wantErr:      errors.New(ErrFutureRevision),
+		},
+		{
+			name:         "Test_Receive_OutOfRange",
+			baseRevision: 0,
+			operation:    update(5, "b"),
+			wantErr:      errors.New(ErrOutOfRange),
+		},
+		{
+			name:         "Test_Receive_IncorrectBlock",
+			baseRevision: 0,
+			operation:    models.EditOperation{Type: models.OperationInsert, Index: 0, Block: json.RawMessage(`{`)},
+			wantErr:      errors.New(ErrIncorrectBlock),
+		},
+		{
+			name:         "Test_Receive_UnknownOperation",
+			baseRevision: 0,
+			operation:    models.EditOperation{Type: "move"},
+			wantErr:      errors.New(ErrUnknownOperation),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := newDocumentWithBlocks(t, 1)
+			for i, operation := range tt.operations {
+				_, err := doc.Receive(operation, int64(i))
+				assert.NoError(t, err)
+			}
+
+			got, err := doc.Receive(tt.operation, tt.baseRevision)
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want, got)
+				assert.Equal(t, int64(len(tt.operations)+1), doc.Revision)
+			}
+		})
+	}
+}
+
+func TestDocument_StaleRevision(t *testing.T) {
+	doc := newDocumentWithBlocks(t, 0)
+	for i := 0; i < maxHistory+1; i++ {
+		_, err := doc.Receive(title(fmt.Sprint(i)), int64(i))
+		assert.NoError(t, err)
+	}
+
+	_, err := doc.Receive(title("late"), 0)
+	assert.Equal(t, errors.New(ErrStaleRevision), err)
+
+	_, err = doc.Receive(title("late"), 1)
+	assert.NoError(t, err)
+}
+
+func TestDocument_Reset(t *testing.T) {
+	doc := newDocumentWithBlocks(t, 1)
+	_, err := doc.Receive(insert(0, "a"), 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, doc.Reset(`{"title":"new","content":[]}`))
+	assert.Equal(t, int64(2), doc.Revision)
+	assert.Equal(t, "new", doc.Title)
+
+	_, err = doc.Receive(update(0, "b"), 1)
+	assert.Equal(t, errors.New(ErrStaleRevision), err)
+}
+
+func TestDocument_Data(t *testing.T) {
+	doc, err := NewDocument(`{"title":"title","content":[{"content":"a"}],"theme":"dark"}`)
+	assert.NoError(t, err)
+
+	_, err = doc.Receive(insert(1, "b"), 0)
+	assert.NoError(t, err)
+
+	data, err := doc.Data()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"title":"title","content":[{"content":"a"},{"pluginName":"textBlock","content":"b"}],"theme":"dark"}`, data)
+
+	empty, err := NewDocument("")
+	assert.NoError(t, err)
+	data, err = empty.Data()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"title":"","content":[]}`, data)
+
+	_, err = NewDocument(`{"content":"text"}`)
+	assert.Error(t, err)
+}
+
+// simClient - клиент редактора: применяет свои правки сразу, отправляет по одной и ждет подтверждения,
+// чужие операции трансформирует против неподтвержденных правок
+type simClient struct {
+	doc      *Document
+	revision int64
+	inflight *models.EditOperation
+	buffer   []models.EditOperation
+
+	outbox []models.OperationMessage
+	inbox  []models.OperationMessage
+}
+
+func (c *simClient) edit(operation models.EditOperation) error {
+	if err := c.doc.Apply(operation); err != nil {
+		return err
+	}
+
+	if c.inflight != nil {
+		c.buffer = append(c.buffer, operation)
+		return nil
+	}
+	c.send(operation)
+	return nil
+}
+
+func (c *simClient) send(operation models.EditOperation) {
+	c.inflight = &operation
+	c.outbox = append(c.outbox, models.OperationMessage{Type: "operation", BaseRevision: c.revision, Operation: operation})
+}
+
+func (c *simClient) receive(message models.OperationMessage, own bool) error {
+	c.revision = message.Revision
+
+	if own {
+		c.inflight = nil
+		if len(c.buffer) > 0 {
+			next := c.buffer[0]
+			c.buffer = c.buffer[1:]
+			c.send(next)
+		}
+		return nil
+	}
+
+	operation := message.Operation
+	if c.inflight != nil {
+		var pending models.EditOperation
+		operation, pending = Transform(operation, *c.inflight)
+		c.inflight = &pending
+	}
+	for i := range c.buffer {
+		operation, c.buffer[i] = Transform(operation, c.buffer[i])
+	}
+
+	return c.doc.Apply(operation)
+}
+
+func randomOperation(random *rand.Rand, doc *Document, step int) models.EditOperation {
+	text := fmt.Sprint(step)
+	size := len(doc.Content)
+
+	switch choice := random.Intn(10); {
+	case choice < 4 || size == 0:
+		return insert(random.Intn(size+1), text)
+	case choice < 6:
+		return remove(random.Intn(size))
+	case choice < 9:
+		return update(random.Intn(size), text)
+	default:
+		return title(text)
+	}
+}
+
+// simulate - случайное, но воспроизводимое по seed чередование правок, доставки на сервер и рассылки клиентам
+func simulate(t *testing.T, seed int64, clientsCount int, steps int) {
+	random := rand.New(rand.NewSource(seed))
+	initial := `{"title":"title","content":[{"content":"a"},{"content":"b"}]}`
+
+	server, err := NewDocument(initial)
+	assert.NoError(t, err)
+
+	clients := make([]*simClient, clientsCount)
+	for i := range clients {
+		doc, err := NewDocument(initial)
+		assert.NoError(t, err)
+		clients[i] = &simClient{doc: doc}
+	}
+
+	deliver := func(sender int) {
+		message := clients[sender].outbox[0]
+		clients[sender].outbox = clients[sender].outbox[1:]
+
+		applied, err := server.Receive(message.Operation, message.BaseRevision)
+		assert.NoError(t, err)
+
+		message.Operation = applied
+		message.Revision = server.Revision
+		message.UserId[0] = byte(sender)
+		for _, client := range clients {
+			client.inbox = append(client.inbox, message)
+		}
+	}
+
+	dispatch := func(receiver int) {
+		message := clients[receiver].inbox[0]
+		clients[receiver].inbox = clients[receiver].inbox[1:]
+		assert.NoError(t, clients[receiver].receive(message, int(message.UserId[0]) == receiver))
+	}
+
+	for step := 0; step < steps; step++ {
+		i := random.Intn(clientsCount)
+		switch random.Intn(3) {
+		case 0:
+			assert.NoError(t, clients[i].edit(randomOperation(random, clients[i].doc, step)))
+		case 1:
+			if len(clients[i].outbox) > 0 {
+				deliver(i)
+			}
+		default:
+			if len(clients[i].inbox) > 0 {
+				dispatch(i)
+			}
+		}
+	}
+
+	for pending := true; pending; {
+		pending = false
+		for i, client := range clients {
+			if len(client.outbox) > 0 {
+				deliver(i)
+				pending = true
+			}
+			if len(client.inbox) > 0 {
+				dispatch(i)
+				pending = true
+			}
+		}
+	}
+
+	expected := documentData(t, server)
+	for i, client := range clients {
+		assert.Nil(t, client.inflight)
+		assert.Equal(t, server.Revision, client.revision)
+		assert.Equal(t, expected, documentData(t, client.doc), fmt.Sprintf("seed %d, client %d", seed, i))
+	}
+}
+
+func TestDocument_ConcurrentClients(t *testing.T) {
+	for seed := int64(1); seed <= 50; seed++ {
+		t.Run(fmt.Sprintf("Test_ConcurrentClients_Seed%d", seed), func(t *testing.T) {
+			simulate(t, seed, 3, 300)
+		})
+	}
+}
+
+func TestDocument_ConcurrentInsertsSameIndex(t *testing.T) {
+	server := newDocumentWithBlocks(t, 1)
+	first, second := newDocumentWithBlocks(t, 1), newDocumentWithBlocks(t, 1)
+
+	// оба клиента вставляют блок в начало поверх ревизии 0
+	assert.NoError(t, first.Apply(insert(0, "first")))
+	assert.NoError(t, second.Apply(insert(0, "second")))
+
+	appliedFirst, err := server.Receive(insert(0, "first"), 0)
+	assert.NoError(t, err)
+	appliedSecond, err := server.Receive(insert(0, "second"), 0)
+	assert.NoError(t, err)
+	assert.Equal(t, insert(1, "second"), appliedSecond)
+
+	assert.NoError(t, first.Apply(appliedSecond))
+	serverFirst, _ := Transform(appliedFirst, insert(0, "second"))
+	assert.NoError(t, second.Apply(serverFirst))
+
+	assert.Equal(t, documentData(t, server), documentData(t, first))
+	assert.Equal(t, documentData(t, server), documentData(t, second))
+}
+
+func newDocumentWithBlocks(t *testing.T, count int) *Document {
+	doc, err := NewDocument(`{"title":"title"}`)
+	assert.NoError(t, err)
+	for i := 0; i < count; i++ {
+		assert.NoError(t, doc.Apply(insert(i, fmt.Sprint("initial", i))))
+	}
+	return doc
+}
+
+func documentData(t *testing.T, doc *Document) string {
+	data, err := doc.Data()
+	assert.NoError(t, err)
+	return data
+}
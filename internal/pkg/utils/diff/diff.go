@@ -0,0 +1,95 @@
+package diff
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/models"
+)
+
+type noteData struct {
+	Title   string            `json:"title"`
+	Content []json.RawMessage `json:"content"`
+}
+
+func parseNoteData(data string) (noteData, error) {
+	result := noteData{}
+	if data == "" {
+		return result, nil
+	}
+
+	if err := json.Unmarshal([]byte(data), &result); err != nil {
+		return noteData{}, err
+	}
+
+	for i, block := range result.Content {
+		compacted := bytes.Buffer{}
+		if err := json.Compact(&compacted, block); err != nil {
+			return noteData{}, err
+		}
+		result.Content[i] = compacted.Bytes()
+	}
+
+	return result, nil
+}
+
+func CompareNotes(oldData string, newData string) (models.RevisionDiff, error) {
+	oldNote, err := parseNoteData(oldData)
+	if err != nil {
+		return models.RevisionDiff{}, err
+	}
+
+	newNote, err := parseNoteData(newData)
+	if err != nil {
+		return models.RevisionDiff{}, err
+	}
+
+	return models.RevisionDiff{
+		OldTitle: oldNote.Title,
+		NewTitle: newNote.Title,
+		Blocks:   compareBlocks(oldNote.Content, newNote.Content),
+	}, nil
+}
+
+func compareBlocks(oldBlocks []json.RawMessage, newBlocks []json.RawMessage) []models.BlockChange {
+	n, m := len(oldBlocks), len(newBlocks)
+
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if bytes.Equal(oldBlocks[i], newBlocks[j]) {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	result := make([]models.BlockChange, 0, max(n, m))
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case bytes.Equal(oldBlocks[i], newBlocks[j]):
+			result = append(result, models.BlockChange{Type: models.BlockUnchanged, Block: string(oldBlocks[i])})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			result = append(result, models.BlockChange{Type: models.BlockRemoved, Block: string(oldBlocks[i])})
+			i++
+		default:
+			result = append(result, models.BlockChange{Type: models.BlockAdded, Block: string(newBlocks[j])})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		result = append(result, models.BlockChange{Type: models.BlockRemoved, Block: string(oldBlocks[i])})
+	}
+	for ; j < m; j++ {
+		result = append(result, models.BlockChange{Type: models.BlockAdded, Block: string(newBlocks[j])})
+	}
+
+	return result
+}
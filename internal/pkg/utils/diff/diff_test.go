@@ -0,0 +1,84 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareNotes(t *testing.T) {
+	tests := []struct {
+		name     string
+		oldData  string
+		newData  string
+		expected models.RevisionDiff
+		wantErr  bool
+	}{
+		{
+			name:    "Test_CompareNotes_Equal",
+			oldData: `{"title":"title","content":[{"pluginName":"textBlock","content":"a"}]}`,
+			newData: `{"title": "title", "content": [{"pluginName": "textBlock", "content": "a"}]}`,
+			expected: models.RevisionDiff{
+				OldTitle: "title",
+				NewTitle: "title",
+				Blocks: []models.BlockChange{
+					{Type: models.BlockUnchanged, Block: `{"pluginName":"textBlock","content":"a"}`},
+				},
+			},
+		},
+		{
+			name:    "Test_CompareNotes_Changed",
+			oldData: `{"title":"old","content":[{"content":"a"},{"content":"b"},{"content":"c"}]}`,
+			newData: `{"title":"new","content":[{"content":"a"},{"content":"x"},{"content":"c"},{"content":"d"}]}`,
+			expected: models.RevisionDiff{
+				OldTitle: "old",
+				NewTitle: "new",
+				Blocks: []models.BlockChange{
+					{Type: models.BlockUnchanged, Block: `{"content":"a"}`},
+					{Type: models.BlockRemoved, Block: `{"content":"b"}`},
+					{Type: models.BlockAdded, Block: `{"content":"x"}`},
+					{Type: models.BlockUnchanged, Block: `{"content":"c"}`},
+					{Type: models.BlockAdded, Block: `{"content":"d"}`},
+				},
+			},
+		},
+		{
+			name:    "Test_CompareNotes_FromEmpty",
+			oldData: "",
+			newData: `{"title":"new","content":[{"content":"a"}]}`,
+			expected: models.RevisionDiff{
+				OldTitle: "",
+				NewTitle: "new",
+				Blocks: []models.BlockChange{
+					{Type: models.BlockAdded, Block: `{"content":"a"}`},
+				},
+			},
+		},
+		{
+			name:    "Test_CompareNotes_InvalidOld",
+			oldData: "{",
+			newData: `{"title":"new"}`,
+			wantErr: true,
+		},
+		{
+			name:    "Test_CompareNotes_InvalidNew",
+			oldData: `{"title":"old"}`,
+			newData: `{"title":1}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CompareNotes(tt.oldData, tt.newData)
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package passhash
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_1_scratch_senior_devs/internal/pkg/utils/responses"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHash(t *testing.T) {
+	first, err := Hash("secret")
+	assert.NoError(t, err)
+	second, err := Hash("secret")
+	assert.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(first, argonPrefix))
+	assert.NotEqual(t, first, second)
+}
+
+func TestCheck(t *testing.T) {
+	encoded, err := Hash("secret")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		encoded  string
+		password string
+		want     bool
+	}{
+		{name: "correct", encoded: encoded, password: "secret", want: true},
+		{name: "wrong", encoded: encoded, password: "Secret", want: false},
+		{name: "legacy correct", encoded: responses.GetHash("secret"), password: "secret", want: true},
+		{name: "legacy wrong", encoded: responses.GetHash("secret"), password: "other", want: false},
+		{name: "broken params", encoded: "$argon2id$v=19$m=x$salt$key", password: "secret", want: false},
+		{name: "broken salt", encoded: "$argon2id$v=19$m=19456,t=2,p=1$!!!$key", password: "secret", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Check(tt.encoded, tt.password))
+		})
+	}
+}
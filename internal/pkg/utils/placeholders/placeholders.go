@@ -0,0 +1,17 @@
+package placeholders
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+func Fill(data string, values map[string]string) string {
+	for name, value := range values {
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			continue
+		}
+		data = strings.ReplaceAll(data, "{{"+name+"}}", string(encoded[1:len(encoded)-1]))
+	}
+	return data
+}
@@ -0,0 +1,41 @@
+package placeholders
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFill(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		values   map[string]string
+		expected string
+	}{
+		{
+			name:     "Test_Fill_Success",
+			data:     `{"title":"Встреча {{date}}","content":[{"content":"Привет, {{username}}! {{username}}"}]}`,
+			values:   map[string]string{"date": "01.05.2024", "username": "user"},
+			expected: `{"title":"Встреча 01.05.2024","content":[{"content":"Привет, user! user"}]}`,
+		},
+		{
+			name:     "Test_Fill_Escape",
+			data:     `{"title":"{{username}}"}`,
+			values:   map[string]string{"username": `us"er`},
+			expected: `{"title":"us\"er"}`,
+		},
+		{
+			name:     "Test_Fill_Unknown",
+			data:     `{"title":"{{time}}"}`,
+			values:   map[string]string{"date": "01.05.2024"},
+			expected: `{"title":"{{time}}"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Fill(tt.data, tt.values))
+		})
+	}
+}
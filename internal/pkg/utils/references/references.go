@@ -0,0 +1,46 @@
+package references
+
+import (
+	"regexp"
+	"strings"
+)
+
+var reference = regexp.MustCompile(`(data-(?:imgid|fileid|noteid)(?:"\s*:\s*"|=\\?"))([0-9a-fA-F-]{36})`)
+
+var noteReference = regexp.MustCompile(`data-noteid(?:"\s*:\s*"|=\\?")([0-9a-fA-F-]{36})`)
+
+var wikiLink = regexp.MustCompile(`\[\[([^\[\]]+)\]\]`)
+
+func Replace(data string, replacements map[string]string) string {
+	return reference.ReplaceAllStringFunc(data, func(match string) string {
+		groups := reference.FindStringSubmatch(match)
+		if newID, found := replacements[strings.ToLower(groups[2])]; found {
+			return groups[1] + newID
+		}
+		return match
+	})
+}
+
+// Notes - id заметок, на которые ссылаются элементы с data-noteid, без повторов
+func Notes(data string) []string {
+	return unique(noteReference.FindAllStringSubmatch(data, -1))
+}
+
+// WikiTitles - заголовки из ссылок вида [[Заголовок]] в нижнем регистре, без повторов
+func WikiTitles(data string) []string {
+	return unique(wikiLink.FindAllStringSubmatch(data, -1))
+}
+
+func unique(matches [][]string) []string {
+	result := make([]string, 0, len(matches))
+	seen := make(map[string]struct{}, len(matches))
+	for _, match := range matches {
+		value := strings.ToLower(strings.TrimSpace(match[1]))
+		if _, ok := seen[value]; ok || value == "" {
+			continue
+		}
+		seen[value] = struct{}{}
+		result = append(result, value)
+	}
+	return result
+}
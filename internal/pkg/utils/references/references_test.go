@@ -0,0 +1,125 @@
+package references
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplace(t *testing.T) {
+	oldImg := "aa498c1c-04b2-4047-8814-6caa0a131237"
+	newImg := "1b6f5e0e-8d4a-4f62-9a0c-6a1f5b7c2d11"
+	oldFile := "6d774c99-e60d-400f-b82b-12622645093b"
+	newFile := "2c7a6f1f-9e5b-4a73-8b1d-7b2a6c8d3e22"
+	oldNote := "8f6621e9-68ba-468a-907f-5fcf0c8ae9ae"
+	newNote := "3d8b7a2a-af6c-4b84-9c2e-8c3b7d9e4f33"
+	unknown := "4e9c8b3b-b07d-4c95-ad3f-9d4c8eaf5a44"
+
+	replacements := map[string]string{
+		oldImg:  newImg,
+		oldFile: newFile,
+		oldNote: newNote,
+	}
+
+	tests := []struct {
+		name     string
+		data     string
+		expected string
+	}{
+		{
+			name:     "Test_Replace_Attributes",
+			data:     `{"content":[{"pluginName":"img","attributes":{"data-imgid":"` + oldImg + `"}},{"pluginName":"file","attributes":{"data-fileid": "` + oldFile + `"}},{"pluginName":"subnote","attributes":{"data-noteid":"` + oldNote + `"}}]}`,
+			expected: `{"content":[{"pluginName":"img","attributes":{"data-imgid":"` + newImg + `"}},{"pluginName":"file","attributes":{"data-fileid": "` + newFile + `"}},{"pluginName":"subnote","attributes":{"data-noteid":"` + newNote + `"}}]}`,
+		},
+		{
+			name:     "Test_Replace_Html",
+			data:     `{"content":[{"content":"<img data-imgid=\"` + oldImg + `\"><button data-noteid=\"` + oldNote + `\">"}]}`,
+			expected: `{"content":[{"content":"<img data-imgid=\"` + newImg + `\"><button data-noteid=\"` + newNote + `\">"}]}`,
+		},
+		{
+			name:     "Test_Replace_Unknown",
+			data:     `{"content":[{"attributes":{"data-imgid":"` + unknown + `"}}]}`,
+			expected: `{"content":[{"attributes":{"data-imgid":"` + unknown + `"}}]}`,
+		},
+		{
+			name:     "Test_Replace_OtherAttribute",
+			data:     `{"content":[{"attributes":{"id":"` + oldImg + `"}}]}`,
+			expected: `{"content":[{"attributes":{"id":"` + oldImg + `"}}]}`,
+		},
+		{
+			name:     "Test_Replace_Empty",
+			data:     "",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Replace(tt.data, replacements))
+		})
+	}
+}
+
+func TestNotes(t *testing.T) {
+	first := "8f6621e9-68ba-468a-907f-5fcf0c8ae9ae"
+	second := "3d8b7a2a-af6c-4b84-9c2e-8c3b7d9e4f33"
+
+	tests := []struct {
+		name     string
+		data     string
+		expected []string
+	}{
+		{
+			name:     "Test_Notes_AttributesAndHtml",
+			data:     `{"content":[{"pluginName":"subnote","attributes":{"data-noteid":"` + first + `"}},{"content":"<button data-noteid=\"` + strings.ToUpper(second) + `\">"}]}`,
+			expected: []string{first, second},
+		},
+		{
+			name:     "Test_Notes_Duplicate",
+			data:     `{"content":[{"attributes":{"data-noteid":"` + first + `"}},{"attributes":{"data-noteid":"` + first + `"}}]}`,
+			expected: []string{first},
+		},
+		{
+			name:     "Test_Notes_OtherReferences",
+			data:     `{"content":[{"attributes":{"data-imgid":"` + first + `"}}]}`,
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Notes(tt.data))
+		})
+	}
+}
+
+func TestWikiTitles(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected []string
+	}{
+		{
+			name:     "Test_WikiTitles_Success",
+			data:     `{"content":[{"content":"see [[Meeting notes]] and [[ Roadmap ]]"}]}`,
+			expected: []string{"meeting notes", "roadmap"},
+		},
+		{
+			name:     "Test_WikiTitles_CaseInsensitiveDuplicate",
+			data:     `{"content":[{"content":"[[Roadmap]] [[ROADMAP]]"}]}`,
+			expected: []string{"roadmap"},
+		},
+		{
+			name:     "Test_WikiTitles_Empty",
+			data:     `{"content":[{"content":"[[]] [[ ]] [single]"}]}`,
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, WikiTitles(tt.data))
+		})
+	}
+}